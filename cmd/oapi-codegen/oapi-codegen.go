@@ -39,14 +39,18 @@ func main() {
 		includeTags  string
 		excludeTags  string
 		templatesDir string
+		esMaxDepth   int
+		esDialect    string
 	)
 	flag.StringVar(&packageName, "package", "", "The package name for generated code")
 	flag.StringVar(&generate, "generate", "types,estemplate,client,server,spec",
-		`Comma-separated list of code to generate; valid options: "types", "estemplate", "client", "chi-server", "server", "spec", "skip-fmt", "skip-prune"`)
+		`Comma-separated list of code to generate; valid options: "types", "estemplate", "client", "client-fake", "chi-server", "server", "mock-server", "spec", "fast-binding", "nullable", "skip-fmt", "skip-prune"`)
 	flag.StringVar(&outputFile, "o", "", "Where to output generated code, stdout is default")
 	flag.StringVar(&includeTags, "include-tags", "", "Only include operations with the given tags. Comma-separated list of tags.")
 	flag.StringVar(&excludeTags, "exclude-tags", "", "Exclude operations that are tagged with the given tags. Comma-separated list of tags.")
 	flag.StringVar(&templatesDir, "templates", "", "Path to directory containing user templates")
+	flag.IntVar(&esMaxDepth, "es-max-depth", 0, "How often a recursive schema is expanded within itself in elastic search mappings")
+	flag.StringVar(&esDialect, "es-dialect", "es7", `The flavour of the elastic search index templates; valid options: "es6", "es7", "es8", "opensearch2"`)
 	flag.Parse()
 
 	if flag.NArg() < 1 {
@@ -69,6 +73,8 @@ func main() {
 		switch g {
 		case "client":
 			opts.GenerateClient = true
+		case "client-fake":
+			opts.GenerateClientFake = true
 		case "chi-server":
 			opts.GenerateChiServer = true
 		case "server":
@@ -77,6 +83,12 @@ func main() {
 			opts.GenerateTypes = true
 		case "estemplate":
 			opts.GenerateEsTemplate = true
+		case "mock-server":
+			opts.GenerateMockServer = true
+		case "fast-binding":
+			opts.FastParamBinding = true
+		case "nullable":
+			opts.NullableTypes = true
 		case "spec":
 			opts.EmbedSpec = true
 		case "skip-fmt":
@@ -92,6 +104,8 @@ func main() {
 
 	opts.IncludeTags = splitCSVArg(includeTags)
 	opts.ExcludeTags = splitCSVArg(excludeTags)
+	opts.EsMaxRecursionDepth = esMaxDepth
+	opts.EsDialect = esDialect
 
 	if opts.GenerateEchoServer && opts.GenerateChiServer {
 		errExit("can not specify both server and chi-server targets simultaneously")
@@ -127,6 +141,19 @@ func main() {
 			errExit("error writing generated code to file: %s", err)
 		}
 	}
+
+	if opts.GenerateEsTemplate {
+		pipelines, err := codegen.GenerateEsPipelines(swagger, opts)
+		if err != nil {
+			errExit("error generating ingest pipelines: %s\n", err)
+		}
+		if pipelines != "" {
+			err = ioutil.WriteFile("es-ingest-pipelines.json", []byte(pipelines), 0644)
+			if err != nil {
+				errExit("error writing generated code to file: %s", err)
+			}
+		}
+	}
 }
 
 func splitCSVArg(input string) []string {
@@ -6,10 +6,12 @@ package api
 import (
 	"bytes"
 	"compress/gzip"
+	"context"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
-	"github.com/deepmap/oapi-codegen/pkg/runtime"
 	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/indigonote/oapi-codegen/pkg/runtime"
 	"github.com/labstack/echo/v4"
 	"net/http"
 	"strings"
@@ -34,6 +36,20 @@ type ServerInterface interface {
 // ServerInterfaceWrapper converts echo contexts to parameters.
 type ServerInterfaceWrapper struct {
 	Handler ServerInterface
+
+	// ErrorHandlerFunc answers requests whose parameters can't be bound, with
+	// their InvalidParamFormatError, RequiredParamError, UnmarshalingParamError
+	// or TooManyValuesForParamError, of the runtime package. They are answered
+	// with a 400 echo.HTTPError if it is nil.
+	ErrorHandlerFunc func(ctx echo.Context, err error) error
+}
+
+// handleError answers a request whose parameters can't be bound.
+func (w *ServerInterfaceWrapper) handleError(ctx echo.Context, err error) error {
+	if w.ErrorHandlerFunc != nil {
+		return w.ErrorHandlerFunc(ctx, err)
+	}
+	return echo.NewHTTPError(http.StatusBadRequest, err.Error())
 }
 
 // FindPets converts echo context to params.
@@ -46,14 +62,14 @@ func (w *ServerInterfaceWrapper) FindPets(ctx echo.Context) error {
 
 	err = runtime.BindQueryParameter("form", true, false, "tags", ctx.QueryParams(), &params.Tags)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tags: %s", err))
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationQuery, "tags", err))
 	}
 
 	// ------------- Optional query parameter "limit" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationQuery, "limit", err))
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
@@ -78,7 +94,7 @@ func (w *ServerInterfaceWrapper) DeletePet(ctx echo.Context) error {
 
 	err = runtime.BindStyledParameter("simple", false, "id", ctx.Param("id"), &id)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationPath, "id", err))
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
@@ -94,7 +110,7 @@ func (w *ServerInterfaceWrapper) FindPetById(ctx echo.Context) error {
 
 	err = runtime.BindStyledParameter("simple", false, "id", ctx.Param("id"), &id)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationPath, "id", err))
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
@@ -117,53 +133,151 @@ type EchoRouter interface {
 	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
 }
 
+// RegisterOptions configures the middlewares and the error handling of the
+// routes added by RegisterHandlersWithOptions.
+type RegisterOptions struct {
+	// The middlewares of operations, by operation ID.
+	OperationMiddlewares map[string][]echo.MiddlewareFunc
+
+	// The middlewares of the operations with a tag, by tag.
+	TagMiddlewares map[string][]echo.MiddlewareFunc
+
+	// The ErrorHandlerFunc of the ServerInterfaceWrapper.
+	ErrorHandlerFunc func(ctx echo.Context, err error) error
+}
+
 // RegisterHandlers adds each server route to the EchoRouter.
 func RegisterHandlers(router EchoRouter, si ServerInterface) {
+	RegisterHandlersWithOptions(router, si, RegisterOptions{})
+}
+
+// RegisterHandlersWithOptions adds each server route to the EchoRouter, with
+// the middlewares of the tags of its operation, in the order of the tags, and
+// then those of the operation. The OperationInfo of the operation is put into
+// the context of the request before any of them runs.
+func RegisterHandlersWithOptions(router EchoRouter, si ServerInterface, opts RegisterOptions) {
 
 	wrapper := ServerInterfaceWrapper{
-		Handler: si,
+		Handler:          si,
+		ErrorHandlerFunc: opts.ErrorHandlerFunc,
 	}
 
-	router.GET("/pets", wrapper.FindPets)
-	router.POST("/pets", wrapper.AddPet)
-	router.DELETE("/pets/:id", wrapper.DeletePet)
-	router.GET("/pets/:id", wrapper.FindPetById)
+	router.GET("/pets", wrapper.FindPets, opts.middlewares("FindPets")...)
+	router.POST("/pets", wrapper.AddPet, opts.middlewares("AddPet")...)
+	router.DELETE("/pets/:id", wrapper.DeletePet, opts.middlewares("DeletePet")...)
+	router.GET("/pets/:id", wrapper.FindPetById, opts.middlewares("FindPetById")...)
+
+}
+
+// middlewares returns the middlewares of the route of an operation.
+func (opts RegisterOptions) middlewares(operationId string) []echo.MiddlewareFunc {
+	info := Operations[operationId]
+	middlewares := []echo.MiddlewareFunc{
+		func(next echo.HandlerFunc) echo.HandlerFunc {
+			return func(ctx echo.Context) error {
+				r := ctx.Request()
+				ctx.SetRequest(r.WithContext(ContextWithOperationInfo(r.Context(), info)))
+				return next(ctx)
+			}
+		},
+	}
+	for _, tag := range info.Tags {
+		middlewares = append(middlewares, opts.TagMiddlewares[tag]...)
+	}
+	return append(middlewares, opts.OperationMiddlewares[operationId]...)
+}
+
+// OperationInfo describes an operation of the API, for the middlewares serving
+// it, which find it in the context of its requests.
+type OperationInfo struct {
+	OperationId string                     // The operation ID, as the names of the generated code use it
+	Method      string                     // The HTTP method, eg. GET
+	Path        string                     // The path template of the spec, eg. /pets/{id}
+	Tags        []string                   // The tags of the operation
+	Security    []OperationSecurity        // The security providers of the operation
+	Extensions  map[string]json.RawMessage // The x- extensions of the operation, by name
+}
+
+// OperationSecurity is a security provider of an operation, and the scopes it
+// requires.
+type OperationSecurity struct {
+	ProviderName string
+	Scopes       []string
+}
+
+// Operations holds the OperationInfo of every operation, by operation ID.
+var Operations = map[string]OperationInfo{
+	"FindPets": {
+		OperationId: "FindPets",
+		Method:      "GET",
+		Path:        "/pets",
+	},
+	"AddPet": {
+		OperationId: "AddPet",
+		Method:      "POST",
+		Path:        "/pets",
+	},
+	"DeletePet": {
+		OperationId: "DeletePet",
+		Method:      "DELETE",
+		Path:        "/pets/{id}",
+	},
+	"FindPetById": {
+		OperationId: "FindPetById",
+		Method:      "GET",
+		Path:        "/pets/{id}",
+	},
+}
+
+// operationInfoKey is the context key of the OperationInfo of a request.
+type operationInfoKey struct{}
+
+// ContextWithOperationInfo returns a copy of ctx holding info, which
+// OperationInfoFromContext returns.
+func ContextWithOperationInfo(ctx context.Context, info OperationInfo) context.Context {
+	return context.WithValue(ctx, operationInfoKey{}, info)
+}
 
+// OperationInfoFromContext returns the OperationInfo of the operation serving
+// a request, from its context, and whether there's one.
+func OperationInfoFromContext(ctx context.Context) (OperationInfo, bool) {
+	info, ok := ctx.Value(operationInfoKey{}).(OperationInfo)
+	return info, ok
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+RX224byRH9lUInbxkNadnZBAQCRGt5AQK7thLv5iG2AhR7imQZfRl3V1PiGvz3oHqG",
-	"N0m2s0gQJNgXiTPTl1OnTlWf/mRs9H0MFCSb2SeT7Zo81p+vUopJf/Qp9pSEqb62sSP931G2iXvhGMxs",
-	"GAz1W2OWMXkUMzMc5PmlaYxsexoeaUXJ7BrjKWdcfXah/efD1CyJw8rsdo1J9LFwos7M3plxw/3w211j",
-	"XtPdDclj3AH9E9u9Rk8QlyBrgp7ENCYU53DhyMwkFXoIoDH3F5QvBFe6vdC9mFt9t4oXtmSJfv8JXb/G",
-	"ULyiw/s/PZu++OPv//BNRZj554rE4z374s3sctoYz2F4mD5FV130IfYft/0D7B7vv6ewkrWZPb+sa+4f",
-	"LxvTowglnfiPd1cXf8eLn29/91WCK9bbw6i4+EBWKguCq6wjyGEWtjWykXh07s3SzN59Mr9NtDQz85vJ",
-	"UWWTUWKTMVG75mGmuHsc60+BPxYC7s4DPlXaNy8GBgYan01PSX32mNQHgXL3OMzd7U6HcVjGQflB0NYI",
-	"ySM7MzPYsxD6P+c7XK0otRxVQVVp5u3wDq5u5vAjoSqhJJ20Fulnk8nJnF3zINwryOh7R3WyrFGgZMqA",
-	"GnaWmAgwAwag+2GYROjIx5AloRAsCaUkysChkvWmp6ArPW+nkHuyvGSLdavGOLYUMh1LxFz1aNcEl+30",
-	"DHKeTSZ3d3ct1s9tTKvJODdPvp+/fPX67auLy3barsW7qllKPr9ZvqW0YUtPxT2pQyYqQhZ3ytnNGKZp",
-	"zIZSHkh51k7bqa4cewrYs5mZ5/VV1fa6ameiBOmP1SDFc1r/SlJSyIDOVSZhmaKvDOVtFvID1fpcMiVY",
-	"K8nWUs4g8X14jR4ydWBj6NhTkOKBsrTwA5KlgBmEfB8TZFyxCGfI2DOFBgJZSOsYbMmQyZ8MYAH0JC1c",
-	"USAMgAKrhBvuELCsCjWAFhhtcVyntvCyJFywlASx4wguJvINxBQwEdCKBMjRiC6QbcCWlEvW0nFkpeQW",
-	"rgtn8AxSUs+5gb64DQdMuhelqEE3IBwsdyUIbDBxyfBB+1sL8wBrtLBWEJgzQe9QCKFjK8UrHfOhxDQW",
-	"7LjnbDmsAINoNMfYHa+Kw0Pk/RoTScI9iToefHSUhQnY95Q6Vqb+xhv0Q0Do+GNBDx2jMpMww0eNbUOO",
-	"BUIMIDFJTEoJLyl0h91buElImYIoTArsjwBKCgib6Ir0KLChQAEV8ECu/vFYkq4xD8eVl5RG1pdo2XE+",
-	"26TuoH+aY34t5NihI01s1yiPlhKKBqb/W3hbck+hY2XZoYqniy6mRhWYyYqquUZZpaJRN7ChNdviELTR",
-	"pa54cLygFFv4IaYFAxXOPnanadDPVdgOLQfG9n14H95SVzNRMixJxefiIqY6geJRMalIKr4FrQ2PdcGR",
-	"fM6uASpn1TKkHFxRHao6W7hZYybnhsLoKY3TK801vSSwxGJ5UQbCcb+PjjudvyE3po43lBI251trnQB3",
-	"zaEQAy/WLfwk0JNzFISynjB9zIW0kvZF1IJSgfsq0KLbc7lfaR9WZbKpQA6yCCVYkMRZ6gG2YUFq4buS",
-	"LQFJ7QZd4UMVaKfIlhwlrnAG/e4neFVLwSoeW3zGAB5XGjK5MVst/KUMU310mrche1QG7RyhNIfmA1is",
-	"FskwcpTnEPYojrHJHKpRxaIJBg7NEcpYuIEz7wFnxWBZSscKNWeEInudjYkcdjojre7Xws1pYipzI8Y+",
-	"kXDxJ51rEE1pTvStrbd9r0ecmot63M07MzPfcej0fKnHRlICKOXqVs4PCzU4erAu2QklWGyNWgEzMx8L",
-	"pe3xnK9GqBmdc/UvQr6eQQ+s1cFeYEq41ecs23rsqY2pRugcwWhmIBS/oKTOJ1EuTiqsVM+yz2By7FnO",
-	"QH3Vk+9u1RDlXltLRX85ne5dD4XB1/W9G43D5ENWiJ+eCvtLpm9wfA+I2D3yPz0J7MEM7miJxckvwvMl",
-	"GMPd5omNS6D7Xlur9uBhTGNy8R7T9gkDodj6mJ+wGi8ToVTLFuhOx+69WPU1egYP2HWI2jnn4h11j8R6",
-	"1alWzeBVKcu3sdv+x1jYO/DHNNyQqMaw6/TfAbY59cx6Pdr9m5r5qlT+f6TxKOH1e/Wjk0/c7QaJOJIn",
-	"bqHDe52bOaxcvd3AArXNxkE182vIRWN6QiPXdfYgky92tPm19pB+yO2IZewfaqCP7YO7R5n+XC+pt65/",
-	"oZe8eBy1AhlQdP9Libw+JKNmYQvza4X35QvFecYOeZxff+74+XY7735RvpYkdv1fS9evtowfZHTIfh1C",
-	"abNP09k9fn8lb08utno73d3u/hkAAP//vwErel4TAAA=",
+	"H4sIAAAAAAAC/+RY224byRH9lUInbxkNadnZBAQCRGt5AQK7thLv5iG2AhR7imQZfRl3V1PiGvz3oHqG",
+	"N0m2s0gQJNgXkZzpy6lTp6pP65Ox0fcxUJBsZp9MtmvyWL++Sikm/dKn2FMSpvrYxo70s6NsE/fCMZjZ",
+	"MBjqu8YsY/IoZmY4yPNL0xjZ9jT8pBUls2uMp5xx9dmF9q8PU7MkDiuz2zUm0cfCiToze2fGDffDb3eN",
+	"eU13NySPcQf0T2z3Gj1BXIKsCXoS05hQnMOFIzOTVOghgMbcX1C+EFzp9kL3Ym712Spe2JIl+v0rdP0a",
+	"Q/GKDu//9Gz64o+//8M3FWHmnysSj/fsizezy2ljPIfhx/QpuuqiD7H/uO0fYPd4/z2FlazN7PllXXP/",
+	"87IxPYpQ0on/eHd18Xe8+Pn2d18luGK9PYyKiw9kpbIguMo6ghxmYVsjG4lH594szezdJ/PbREszM7+Z",
+	"HFU2GSU2GRO1ax5mirvHsf4U+GMh4O484FOlffNiYGCg8dn0lNRnj0l9ECh3j8Pc3e50GIdlHJQfBG2N",
+	"kDyyMzODPQuh/3O+w9WKUsvRNKPSzNvhGVzdzOFHQlVCSTppLdLPJpOTObvmQbhXkNH3jupkWaNAyZQB",
+	"NewsMRFgBgxA98MwidCRjyFLQiFYEkpJlIFDJetNT0FXet5OIfdkeckW61aNcWwpZDqWiLnq0a4JLtvp",
+	"GeQ8m0zu7u5arK/bmFaTcW6efD9/+er121cXl+20XYt3VbOUfH6zfEtpw5aeintSh0xUhCzulLObMUzT",
+	"mA2lPJDyrJ22U1059hSwZzMzz+ujqu111c5ECdIvq0GK57T+laSkkAGdq0zCMkVfGcrbLOQHqvV3yZRg",
+	"rSRbSzmDxPfhNXrI1IGNoWNPQYoHytLCD0iWAmYQ8n1MkHHFIpwhY88UGghkIa1jsCVDJn8ygAXQk7Rw",
+	"RYEwAAqsEm64Q8CyKtQAWmC0xXGd2sLLknDBUhLEjiO4mMg3EFPAREArEiBHI7pAtgFbUi5ZS8eRlZJb",
+	"uC6cwTNIST3nBvriNhww6V6UogbdgHCw3JUgsMHEJcMH7W8tzAOs0cJaQWDOBL1DIYSOrRSvdMyHEtNY",
+	"sOOes+WwAgyi0Rxjd7wqDg+R92tMJAn3JOp48NFRFiZg31PqWJn6G2/QDwGh448FPXSMykzCDB81tg05",
+	"FggxgMQkMSklvKTQHXZv4SYhZQqiMCmwPwIoKSBsoivSo8CGAgVUwAO5+sdjSbrGPBxXXlIaWV+iZcf5",
+	"bJO6g/5pjvm1kGOHjjSxXaM8WkooGph+tvC25J5Cx8qyQxVPF11MjSowkxVVc42ySkWjbmBDa7bFIXAQ",
+	"Sl3x4HhBKbbwQ0wLBiqcfexO06Cvq7AdWg6M7fvwPrylrmaiZFiSis/FRUx1AsWjYlKRVHwLWhseRY7k",
+	"c3YNUDmrliHl4IrqUNXZws0aMzk3FEZPaZxeaa7pJYElFsuLMhCO+3103On8DbkxdbyhlLA531rrBLhr",
+	"DoUYeLFu4SeBnpyjIJT1hOljLpToWEQtKBW4rwItuj2X+5X2YVUmmwrkIItQggVJnKUeYBsWpBa+K9kS",
+	"kNRu0BU+VIF2imzJUeIKZ9DvfoJXtRSs4rHFZwzgcaUhkxuz1cJfyjDVR+d4nz0qg3aOUJpD8wEsVotk",
+	"GDnKcwh7FMfYZA7VqGLRBAOH5ghlLNzAmfeAs2KwLKVjhZozQpG9zsZEDjudkVb3a+HmNDGVuRFjn0i4",
+	"+JPONYimNCf61tbbvtcjTs1FPe7mnZmZ7zh0er7UYyMpAZRydSvnh4UaHD1Yl+yEEiy2Rq2AmZmPhdL2",
+	"eM7rONOMzrn6FyFfz6AH1upgLzAl3OrvLNt67KmNqUboHMFoZiAUv6CkzidRLk4qrFTPss9gcuxZzkB9",
+	"1ZPvbhuTKPfaWir6y+l073ooDL6u791oHCYfcgzHC8NZ2F8yfYPje0DE7pH/6UlgD2ZwR0ssTn4Rni/B",
+	"GO42T2xcAt33ZIW0Bw9jGpOL95i2TxgIxdbH/ITVeJkIpVq2QHc6du/Fqq/RM3jArkMS6YLxjrpHYr3q",
+	"VKtm8KqU5dvYbf9jLOwd+GMabkhUY9h1+nGAbU49s6RCu39TM1+Vyv+PNB4lvL6vfnTyibvdIBFH8sQt",
+	"dHiuczOHlau3G1igttk4qGZ+DbloTE9o5LrOHmTyxY42v9Ye0g+5HbGM/UMN9LF9cPco05/rJfXW9S/0",
+	"khePo1YgA4rufymR14dk1CxsYX6t8L58oTjP2CGP8+vPHT/fbufdL8rXksSu/2vp+tWW8YOMDtmvQyht",
+	"9mk6u8fvr+TtycUWe9b/HvxzAL8BK3peEwAA",
 }
 
 // GetSwagger returns the Swagger specification corresponding to the generated code
@@ -3,6 +3,12 @@
 // Code generated by github.com/indigonote/oapi-codegen DO NOT EDIT.
 package api
 
+import (
+	"fmt"
+	"github.com/indigonote/oapi-codegen/pkg/runtime"
+	"net/url"
+)
+
 // Error defines model for Error.
 type Error struct {
 
@@ -49,3 +55,161 @@ type AddPetJSONBody NewPet
 
 // AddPetRequestBody defines body for AddPet for application/json ContentType.
 type AddPetJSONRequestBody AddPetJSONBody
+
+// FindPetsURL returns the URL of FindPets on server, with its parameters
+// serialized as the client serializes them.
+func FindPetsURL(server string, params *FindPetsParams) (*url.URL, error) {
+	var err error
+
+	queryUrl, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	basePath := fmt.Sprintf("/pets")
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
+	}
+
+	queryUrl, err = queryUrl.Parse(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryUrl.Query()
+
+	if params.Tags != nil {
+
+		if queryFrag, err := runtime.StyleParam("form", true, "tags", *params.Tags); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParam("form", true, "limit", *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryUrl.RawQuery = queryValues.Encode()
+
+	return queryUrl, nil
+}
+
+// AddPetURL returns the URL of AddPet on server, with its parameters
+// serialized as the client serializes them.
+func AddPetURL(server string) (*url.URL, error) {
+	var err error
+
+	queryUrl, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	basePath := fmt.Sprintf("/pets")
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
+	}
+
+	queryUrl, err = queryUrl.Parse(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return queryUrl, nil
+}
+
+// DeletePetURL returns the URL of DeletePet on server, with its parameters
+// serialized as the client serializes them.
+func DeletePetURL(server string, id int64) (*url.URL, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParam("simple", false, "id", id)
+	if err != nil {
+		return nil, err
+	}
+
+	queryUrl, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	basePath := fmt.Sprintf("/pets/%s", pathParam0)
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
+	}
+
+	queryUrl, err = queryUrl.Parse(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return queryUrl, nil
+}
+
+// FindPetByIdURL returns the URL of FindPetById on server, with its parameters
+// serialized as the client serializes them.
+func FindPetByIdURL(server string, id int64) (*url.URL, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParam("simple", false, "id", id)
+	if err != nil {
+		return nil, err
+	}
+
+	queryUrl, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	basePath := fmt.Sprintf("/pets/%s", pathParam0)
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
+	}
+
+	queryUrl, err = queryUrl.Parse(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return queryUrl, nil
+}
+
+// OperationRoute describes an operation of the API, whatever the router serving
+// it.
+type OperationRoute struct {
+	Method      string // The HTTP method, eg. GET
+	Path        string // The path template of the spec, eg. /pets/{id}
+	OperationId string // The operation ID, as the names of the generated code use it
+}
+
+// OperationRoutes lists the operations of the API, in the order of their paths.
+var OperationRoutes = []OperationRoute{
+	{Method: "GET", Path: "/pets", OperationId: "FindPets"},
+	{Method: "POST", Path: "/pets", OperationId: "AddPet"},
+	{Method: "DELETE", Path: "/pets/{id}", OperationId: "DeletePet"},
+	{Method: "GET", Path: "/pets/{id}", OperationId: "FindPetById"},
+}
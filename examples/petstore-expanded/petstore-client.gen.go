@@ -8,12 +8,16 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	"github.com/indigonote/oapi-codegen/pkg/runtime"
 	"io"
 	"io/ioutil"
+	"math/rand"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 )
 
 // Error defines model for Error.
@@ -31,7 +35,7 @@ type NewPet struct {
 
 	// Name of the pet
 	Name *string `json:"name" validate:"omitempty,alphanum,max=1048576"`
-	Size int     `json:"size" validate:"min=0,max=20"`
+	Size int     `json:"size" validate:"max=20,min=0"`
 
 	// Type of the pet
 	Tag *string `json:"tag,omitempty" validate:"omitempty,max=32,regex=^[A-Za-z]+,min=2"`
@@ -44,7 +48,7 @@ type Pet struct {
 	// Embedded fields due to inline allOf schema
 
 	// Unique id of the pet
-	Id int64 `json:"id" validate:"max=100,min=1"`
+	Id int64 `json:"id" validate:"min=1,max=100"`
 }
 
 // FindPetsParams defines parameters for FindPets.
@@ -63,9 +67,175 @@ type AddPetJSONBody NewPet
 // AddPetRequestBody defines body for AddPet for application/json ContentType.
 type AddPetJSONRequestBody AddPetJSONBody
 
+// FindPetsURL returns the URL of FindPets on server, with its parameters
+// serialized as the client serializes them.
+func FindPetsURL(server string, params *FindPetsParams) (*url.URL, error) {
+	var err error
+
+	queryUrl, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	basePath := fmt.Sprintf("/pets")
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
+	}
+
+	queryUrl, err = queryUrl.Parse(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryUrl.Query()
+
+	if params.Tags != nil {
+
+		if queryFrag, err := runtime.StyleParam("form", true, "tags", *params.Tags); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParam("form", true, "limit", *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryUrl.RawQuery = queryValues.Encode()
+
+	return queryUrl, nil
+}
+
+// AddPetURL returns the URL of AddPet on server, with its parameters
+// serialized as the client serializes them.
+func AddPetURL(server string) (*url.URL, error) {
+	var err error
+
+	queryUrl, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	basePath := fmt.Sprintf("/pets")
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
+	}
+
+	queryUrl, err = queryUrl.Parse(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return queryUrl, nil
+}
+
+// DeletePetURL returns the URL of DeletePet on server, with its parameters
+// serialized as the client serializes them.
+func DeletePetURL(server string, id int64) (*url.URL, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParam("simple", false, "id", id)
+	if err != nil {
+		return nil, err
+	}
+
+	queryUrl, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	basePath := fmt.Sprintf("/pets/%s", pathParam0)
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
+	}
+
+	queryUrl, err = queryUrl.Parse(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return queryUrl, nil
+}
+
+// FindPetByIdURL returns the URL of FindPetById on server, with its parameters
+// serialized as the client serializes them.
+func FindPetByIdURL(server string, id int64) (*url.URL, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParam("simple", false, "id", id)
+	if err != nil {
+		return nil, err
+	}
+
+	queryUrl, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	basePath := fmt.Sprintf("/pets/%s", pathParam0)
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
+	}
+
+	queryUrl, err = queryUrl.Parse(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return queryUrl, nil
+}
+
+// OperationRoute describes an operation of the API, whatever the router serving
+// it.
+type OperationRoute struct {
+	Method      string // The HTTP method, eg. GET
+	Path        string // The path template of the spec, eg. /pets/{id}
+	OperationId string // The operation ID, as the names of the generated code use it
+}
+
+// OperationRoutes lists the operations of the API, in the order of their paths.
+var OperationRoutes = []OperationRoute{
+	{Method: "GET", Path: "/pets", OperationId: "FindPets"},
+	{Method: "POST", Path: "/pets", OperationId: "AddPet"},
+	{Method: "DELETE", Path: "/pets/{id}", OperationId: "DeletePet"},
+	{Method: "GET", Path: "/pets/{id}", OperationId: "FindPetById"},
+}
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
+// ResponseEditorFn is the function signature for the ResponseEditor callback
+// function, which may inspect or replace the response before it is parsed
+type ResponseEditorFn func(ctx context.Context, rsp *http.Response) error
+
+// RequestValidatorFn is the function signature for the callback validating
+// requests before they are sent, given the server they were built against
+type RequestValidatorFn func(ctx context.Context, server string, req *http.Request) error
+
 // Doer performs HTTP requests.
 //
 // The standard http.Client implements this interface.
@@ -83,9 +253,31 @@ type Client struct {
 	// customized settings, such as certificate chains.
 	Client HttpRequestDoer
 
+	// A list of callbacks for modifying requests which are generated before
+	// sending over the network, in the order they are run.
+	RequestEditors []RequestEditorFn
+
 	// A callback for modifying requests which are generated before sending over
-	// the network.
+	// the network, which runs before RequestEditors.
+	//
+	// Deprecated: use RequestEditors, or WithRequestEditorFn.
 	RequestEditor RequestEditorFn
+
+	// A list of callbacks for inspecting responses before they are returned,
+	// in the order they are run.
+	ResponseEditors []ResponseEditorFn
+
+	// A callback validating requests once the request editors ran, requests
+	// failing it aren't sent. See WithRequestValidation.
+	RequestValidator RequestValidatorFn
+
+	// How failed requests are retried, they aren't when nil.
+	RetryPolicy *RetryPolicy
+
+	// The servers of the operations which override the servers of the API,
+	// keyed by operation ID, when they aren't the first of their servers. See
+	// WithOperationServer.
+	OperationServers map[string]string
 }
 
 // ClientOption allows setting custom parameters during construction
@@ -125,160 +317,326 @@ func WithHTTPClient(doer HttpRequestDoer) ClientOption {
 
 // WithRequestEditorFn allows setting up a callback function, which will be
 // called right before sending the request. This can be used to mutate the request.
+// It may be given more than once, the callbacks are called in the same order.
 func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 	return func(c *Client) error {
-		c.RequestEditor = fn
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// WithResponseEditorFn allows setting up a callback function, which will be
+// called with every response, before it is returned or parsed. It may be
+// given more than once, the callbacks are called in the same order.
+func WithResponseEditorFn(fn ResponseEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.ResponseEditors = append(c.ResponseEditors, fn)
+		return nil
+	}
+}
+
+// applyEditors runs the request editors of the client, followed by those
+// given for this call only.
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	if c.RequestEditor != nil {
+		if err := c.RequestEditor(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RetryPolicy describes how the client retries requests which failed with a
+// network error, a 5xx or a 429 response.
+type RetryPolicy struct {
+	// The number of retries after the first attempt.
+	MaxRetries int
+
+	// The backoff before the first retry, it doubles for each of the
+	// following ones, with some jitter so that clients don't retry in lockstep.
+	MinBackoff time.Duration
+
+	// The upper bound of any backoff, including those asked for by the server
+	// through a Retry-After header.
+	MaxBackoff time.Duration
+
+	// Whether to retry operations which aren't idempotent. Operations are
+	// idempotent when their method is, or when marked with x-idempotent.
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryPolicy returns a policy retrying idempotent operations three
+// times, backing off from 100ms up to 10s.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries: 3,
+		MinBackoff: 100 * time.Millisecond,
+		MaxBackoff: 10 * time.Second,
+	}
+}
+
+// WithRetryPolicy allows retrying failed requests. Request bodies are buffered
+// so that they can be sent again.
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(c *Client) error {
+		c.RetryPolicy = &policy
 		return nil
 	}
 }
 
+// retryJitter randomizes backoffs, it isn't safe for concurrent use.
+var (
+	retryJitter   = rand.New(rand.NewSource(time.Now().UnixNano()))
+	retryJitterMu sync.Mutex
+)
+
+// do sends the request, and runs the response editors of the client on its
+// response.
+func (c *Client) do(req *http.Request, idempotent bool) (*http.Response, error) {
+	rsp, err := c.send(req, idempotent)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range c.ResponseEditors {
+		if err := r(req.Context(), rsp); err != nil {
+			rsp.Body.Close()
+			return nil, err
+		}
+	}
+	return rsp, nil
+}
+
+// send sends the request, retrying it according to the retry policy of the
+// client.
+func (c *Client) send(req *http.Request, idempotent bool) (*http.Response, error) {
+	policy := c.RetryPolicy
+	if policy == nil || policy.MaxRetries <= 0 || !(idempotent || policy.RetryNonIdempotent) {
+		return c.Client.Do(req)
+	}
+
+	// The body is consumed by each attempt, so we need a fresh one for every
+	// retry.
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		buf, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.GetBody = func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(bytes.NewReader(buf)), nil
+		}
+		req.Body, _ = req.GetBody()
+	}
+
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+		rsp, err := c.Client.Do(req)
+		if attempt >= policy.MaxRetries || !shouldRetry(req, rsp, err) {
+			return rsp, err
+		}
+
+		backoff := policy.backoff(attempt, rsp)
+		if rsp != nil {
+			// Drain the body, so that the connection can be reused.
+			io.Copy(ioutil.Discard, rsp.Body)
+			rsp.Body.Close()
+		}
+		timer := time.NewTimer(backoff)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func shouldRetry(req *http.Request, rsp *http.Response, err error) bool {
+	if err != nil {
+		// Nothing to retry once the caller gave up.
+		return req.Context().Err() == nil
+	}
+	return rsp.StatusCode == http.StatusTooManyRequests || rsp.StatusCode >= 500
+}
+
+// backoff returns how long to wait before the given retry, preferring the
+// delay asked for by the Retry-After header of the response.
+func (p *RetryPolicy) backoff(attempt int, rsp *http.Response) time.Duration {
+	if rsp != nil {
+		if after, ok := retryAfter(rsp.Header.Get("Retry-After")); ok {
+			if p.MaxBackoff > 0 && after > p.MaxBackoff {
+				return p.MaxBackoff
+			}
+			return after
+		}
+	}
+
+	backoff := p.MinBackoff << uint(attempt)
+	if backoff <= 0 || (p.MaxBackoff > 0 && backoff > p.MaxBackoff) {
+		backoff = p.MaxBackoff
+	}
+	if backoff <= 0 {
+		return 0
+	}
+	// Wait somewhere between half and all of the backoff.
+	retryJitterMu.Lock()
+	jitter := time.Duration(retryJitter.Int63n(int64(backoff)/2 + 1))
+	retryJitterMu.Unlock()
+	return backoff/2 + jitter
+}
+
+// retryAfter parses a Retry-After header, which either holds a number of
+// seconds or a date.
+func retryAfter(header string) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(header); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(header); err == nil {
+		after := time.Until(date)
+		if after < 0 {
+			after = 0
+		}
+		return after, true
+	}
+	return 0, false
+}
+
 // The interface specification for the client above.
 type ClientInterface interface {
 	// FindPets request
-	FindPets(ctx context.Context, params *FindPetsParams) (*http.Response, error)
+	FindPets(ctx context.Context, params *FindPetsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// AddPet request  with any body
-	AddPetWithBody(ctx context.Context, contentType string, body io.Reader) (*http.Response, error)
+	AddPetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	AddPet(ctx context.Context, body AddPetJSONRequestBody) (*http.Response, error)
+	AddPet(ctx context.Context, body AddPetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// DeletePet request
-	DeletePet(ctx context.Context, id int64) (*http.Response, error)
+	DeletePet(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// FindPetById request
-	FindPetById(ctx context.Context, id int64) (*http.Response, error)
+	FindPetById(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
-func (c *Client) FindPets(ctx context.Context, params *FindPetsParams) (*http.Response, error) {
-	req, err := NewFindPetsRequest(c.Server, params)
+func (c *Client) FindPets(ctx context.Context, params *FindPetsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server := c.Server
+	req, err := NewFindPetsRequest(server, params)
 	if err != nil {
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if c.RequestEditor != nil {
-		err = c.RequestEditor(ctx, req)
-		if err != nil {
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
 			return nil, err
 		}
 	}
-	return c.Client.Do(req)
+	return c.do(req, true)
 }
 
-func (c *Client) AddPetWithBody(ctx context.Context, contentType string, body io.Reader) (*http.Response, error) {
-	req, err := NewAddPetRequestWithBody(c.Server, contentType, body)
+func (c *Client) AddPetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server := c.Server
+	req, err := NewAddPetRequestWithBody(server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if c.RequestEditor != nil {
-		err = c.RequestEditor(ctx, req)
-		if err != nil {
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
 			return nil, err
 		}
 	}
-	return c.Client.Do(req)
+	return c.do(req, false)
 }
 
-func (c *Client) AddPet(ctx context.Context, body AddPetJSONRequestBody) (*http.Response, error) {
-	req, err := NewAddPetRequest(c.Server, body)
+func (c *Client) AddPet(ctx context.Context, body AddPetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server := c.Server
+	req, err := NewAddPetRequest(server, body)
 	if err != nil {
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if c.RequestEditor != nil {
-		err = c.RequestEditor(ctx, req)
-		if err != nil {
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
 			return nil, err
 		}
 	}
-	return c.Client.Do(req)
+	return c.do(req, false)
 }
 
-func (c *Client) DeletePet(ctx context.Context, id int64) (*http.Response, error) {
-	req, err := NewDeletePetRequest(c.Server, id)
+func (c *Client) DeletePet(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server := c.Server
+	req, err := NewDeletePetRequest(server, id)
 	if err != nil {
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if c.RequestEditor != nil {
-		err = c.RequestEditor(ctx, req)
-		if err != nil {
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
 			return nil, err
 		}
 	}
-	return c.Client.Do(req)
+	return c.do(req, true)
 }
 
-func (c *Client) FindPetById(ctx context.Context, id int64) (*http.Response, error) {
-	req, err := NewFindPetByIdRequest(c.Server, id)
+func (c *Client) FindPetById(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server := c.Server
+	req, err := NewFindPetByIdRequest(server, id)
 	if err != nil {
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if c.RequestEditor != nil {
-		err = c.RequestEditor(ctx, req)
-		if err != nil {
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
 			return nil, err
 		}
 	}
-	return c.Client.Do(req)
+	return c.do(req, true)
 }
 
 // NewFindPetsRequest generates requests for FindPets
 func NewFindPetsRequest(server string, params *FindPetsParams) (*http.Request, error) {
-	var err error
-
-	queryUrl, err := url.Parse(server)
+	queryUrl, err := FindPetsURL(server, params)
 	if err != nil {
 		return nil, err
 	}
 
-	basePath := fmt.Sprintf("/pets")
-	if basePath[0] == '/' {
-		basePath = basePath[1:]
-	}
-
-	queryUrl, err = queryUrl.Parse(basePath)
-	if err != nil {
-		return nil, err
-	}
-
-	queryValues := queryUrl.Query()
-
-	if params.Tags != nil {
-
-		if queryFrag, err := runtime.StyleParam("form", true, "tags", *params.Tags); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-	}
-
-	if params.Limit != nil {
-
-		if queryFrag, err := runtime.StyleParam("form", true, "limit", *params.Limit); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-	}
-
-	queryUrl.RawQuery = queryValues.Encode()
-
 	req, err := http.NewRequest("GET", queryUrl.String(), nil)
 	if err != nil {
 		return nil, err
@@ -300,19 +658,7 @@ func NewAddPetRequest(server string, body AddPetJSONRequestBody) (*http.Request,
 
 // NewAddPetRequestWithBody generates requests for AddPet with any type of body
 func NewAddPetRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	queryUrl, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	basePath := fmt.Sprintf("/pets")
-	if basePath[0] == '/' {
-		basePath = basePath[1:]
-	}
-
-	queryUrl, err = queryUrl.Parse(basePath)
+	queryUrl, err := AddPetURL(server)
 	if err != nil {
 		return nil, err
 	}
@@ -328,31 +674,27 @@ func NewAddPetRequestWithBody(server string, contentType string, body io.Reader)
 
 // NewDeletePetRequest generates requests for DeletePet
 func NewDeletePetRequest(server string, id int64) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParam("simple", false, "id", id)
+	queryUrl, err := DeletePetURL(server, id)
 	if err != nil {
 		return nil, err
 	}
 
-	queryUrl, err := url.Parse(server)
+	req, err := http.NewRequest("DELETE", queryUrl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	basePath := fmt.Sprintf("/pets/%s", pathParam0)
-	if basePath[0] == '/' {
-		basePath = basePath[1:]
-	}
+	return req, nil
+}
 
-	queryUrl, err = queryUrl.Parse(basePath)
+// NewFindPetByIdRequest generates requests for FindPetById
+func NewFindPetByIdRequest(server string, id int64) (*http.Request, error) {
+	queryUrl, err := FindPetByIdURL(server, id)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryUrl.String(), nil)
+	req, err := http.NewRequest("GET", queryUrl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -360,38 +702,130 @@ func NewDeletePetRequest(server string, id int64) (*http.Request, error) {
 	return req, nil
 }
 
-// NewFindPetByIdRequest generates requests for FindPetById
-func NewFindPetByIdRequest(server string, id int64) (*http.Request, error) {
-	var err error
+// serverDefinition is a server of the API, whose URL may hold variables.
+type serverDefinition struct {
+	url       string
+	variables []serverVariable
+}
 
-	var pathParam0 string
+// serverVariable is a variable of the URL of a server.
+type serverVariable struct {
+	name         string
+	defaultValue string
+	enum         []string
+}
 
-	pathParam0, err = runtime.StyleParam("simple", false, "id", id)
-	if err != nil {
-		return nil, err
-	}
+// ServerVariables holds the values of the variables of the URL of a server. It
+// is one of the types generated for the servers with variables, such as those
+// given to WithServer.
+type ServerVariables interface {
+	// serverURL returns the URL of the server whose variables these are.
+	serverURL() string
+	// values returns the values of the variables, by name.
+	values() map[string]string
+}
 
-	queryUrl, err := url.Parse(server)
-	if err != nil {
-		return nil, err
+// resolve replaces the variables in the URL of the server with their values,
+// or their defaults, checking them against their enum. vars must be those of
+// the server, or nil.
+func (s serverDefinition) resolve(vars ServerVariables) (string, error) {
+	var values map[string]string
+	if vars != nil {
+		if vars.serverURL() != s.url {
+			return "", fmt.Errorf("variables of server %s given for server %s", vars.serverURL(), s.url)
+		}
+		values = vars.values()
 	}
 
-	basePath := fmt.Sprintf("/pets/%s", pathParam0)
-	if basePath[0] == '/' {
-		basePath = basePath[1:]
+	serverURL := s.url
+	for _, v := range s.variables {
+		value := values[v.name]
+		if value == "" {
+			value = v.defaultValue
+		}
+		if value == "" {
+			return "", fmt.Errorf("missing value for variable %s of server %s", v.name, s.url)
+		}
+		valid := len(v.enum) == 0
+		for _, e := range v.enum {
+			valid = valid || value == e
+		}
+		if !valid {
+			return "", fmt.Errorf("invalid value %q for variable %s of server %s, must be one of: %s", value, v.name, s.url, strings.Join(v.enum, ", "))
+		}
+		serverURL = strings.Replace(serverURL, "{"+v.name+"}", value, -1)
 	}
+	return serverURL, nil
+}
 
-	queryUrl, err = queryUrl.Parse(basePath)
-	if err != nil {
-		return nil, err
+// servers lists the servers of the API, in the order of the spec.
+var servers = []serverDefinition{
+	{url: "http://petstore.swagger.io/api"},
+}
+
+// operationServers lists the servers of the operations which override those
+// of the API.
+var operationServers = map[string][]serverDefinition{}
+
+// ServerURL0 is the URL of server 0.
+const ServerURL0 = "http://petstore.swagger.io/api"
+
+// WithServer sets the server of the client to one of the servers of the API,
+// by its index in the spec. Its variables take the given values, which must
+// be the variables of that server, such as a ServerProductionVariables for a
+// production server. They are checked against their enum, and take their
+// default when empty, or when vars is nil.
+func WithServer(index int, vars ServerVariables) ClientOption {
+	return func(c *Client) error {
+		if index < 0 || index >= len(servers) {
+			return fmt.Errorf("no server at index %d", index)
+		}
+		server, err := servers[index].resolve(vars)
+		if err != nil {
+			return err
+		}
+		c.Server = server
+		return nil
 	}
+}
 
-	req, err := http.NewRequest("GET", queryUrl.String(), nil)
-	if err != nil {
-		return nil, err
+// WithOperationServer sets the server of an operation which overrides the
+// servers of the API to another of its servers, by its index in the spec. Its
+// variables are handled as by WithServer, and are of the types named after the
+// operation, such as GetPetServer0Variables.
+func WithOperationServer(operationID string, index int, vars ServerVariables) ClientOption {
+	return func(c *Client) error {
+		defs := operationServers[operationID]
+		if index < 0 || index >= len(defs) {
+			return fmt.Errorf("operation %s has no server at index %d", operationID, index)
+		}
+		server, err := defs[index].resolve(vars)
+		if err != nil {
+			return err
+		}
+		if c.OperationServers == nil {
+			c.OperationServers = map[string]string{}
+		}
+		c.OperationServers[operationID] = server
+		return nil
 	}
+}
 
-	return req, nil
+// operationServer returns the server of an operation which overrides the
+// servers of the API, which is its first one unless set otherwise.
+func (c *Client) operationServer(operationID string) (string, error) {
+	server, found := c.OperationServers[operationID]
+	if !found {
+		var err error
+		server, err = operationServers[operationID][0].resolve(nil)
+		if err != nil {
+			return "", err
+		}
+	}
+	if !strings.HasSuffix(server, "/") {
+		server += "/"
+	}
+	return server, nil
 }
 
 // ClientWithResponses builds on ClientInterface to offer response payloads
@@ -424,18 +858,18 @@ func WithBaseURL(baseURL string) ClientOption {
 // ClientWithResponsesInterface is the interface specification for the client with responses above.
 type ClientWithResponsesInterface interface {
 	// FindPets request
-	FindPetsWithResponse(ctx context.Context, params *FindPetsParams) (*FindPetsResponse, error)
+	FindPetsWithResponse(ctx context.Context, params *FindPetsParams, reqEditors ...RequestEditorFn) (*FindPetsResponse, error)
 
 	// AddPet request  with any body
-	AddPetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader) (*AddPetResponse, error)
+	AddPetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddPetResponse, error)
 
-	AddPetWithResponse(ctx context.Context, body AddPetJSONRequestBody) (*AddPetResponse, error)
+	AddPetWithResponse(ctx context.Context, body AddPetJSONRequestBody, reqEditors ...RequestEditorFn) (*AddPetResponse, error)
 
 	// DeletePet request
-	DeletePetWithResponse(ctx context.Context, id int64) (*DeletePetResponse, error)
+	DeletePetWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*DeletePetResponse, error)
 
 	// FindPetById request
-	FindPetByIdWithResponse(ctx context.Context, id int64) (*FindPetByIdResponse, error)
+	FindPetByIdWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*FindPetByIdResponse, error)
 }
 
 type FindPetsResponse struct {
@@ -530,8 +964,8 @@ func (r FindPetByIdResponse) StatusCode() int {
 }
 
 // FindPetsWithResponse request returning *FindPetsResponse
-func (c *ClientWithResponses) FindPetsWithResponse(ctx context.Context, params *FindPetsParams) (*FindPetsResponse, error) {
-	rsp, err := c.FindPets(ctx, params)
+func (c *ClientWithResponses) FindPetsWithResponse(ctx context.Context, params *FindPetsParams, reqEditors ...RequestEditorFn) (*FindPetsResponse, error) {
+	rsp, err := c.FindPets(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
@@ -539,16 +973,16 @@ func (c *ClientWithResponses) FindPetsWithResponse(ctx context.Context, params *
 }
 
 // AddPetWithBodyWithResponse request with arbitrary body returning *AddPetResponse
-func (c *ClientWithResponses) AddPetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader) (*AddPetResponse, error) {
-	rsp, err := c.AddPetWithBody(ctx, contentType, body)
+func (c *ClientWithResponses) AddPetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddPetResponse, error) {
+	rsp, err := c.AddPetWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
 	return ParseAddPetResponse(rsp)
 }
 
-func (c *ClientWithResponses) AddPetWithResponse(ctx context.Context, body AddPetJSONRequestBody) (*AddPetResponse, error) {
-	rsp, err := c.AddPet(ctx, body)
+func (c *ClientWithResponses) AddPetWithResponse(ctx context.Context, body AddPetJSONRequestBody, reqEditors ...RequestEditorFn) (*AddPetResponse, error) {
+	rsp, err := c.AddPet(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
@@ -556,8 +990,8 @@ func (c *ClientWithResponses) AddPetWithResponse(ctx context.Context, body AddPe
 }
 
 // DeletePetWithResponse request returning *DeletePetResponse
-func (c *ClientWithResponses) DeletePetWithResponse(ctx context.Context, id int64) (*DeletePetResponse, error) {
-	rsp, err := c.DeletePet(ctx, id)
+func (c *ClientWithResponses) DeletePetWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*DeletePetResponse, error) {
+	rsp, err := c.DeletePet(ctx, id, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
@@ -565,8 +999,8 @@ func (c *ClientWithResponses) DeletePetWithResponse(ctx context.Context, id int6
 }
 
 // FindPetByIdWithResponse request returning *FindPetByIdResponse
-func (c *ClientWithResponses) FindPetByIdWithResponse(ctx context.Context, id int64) (*FindPetByIdResponse, error) {
-	rsp, err := c.FindPetById(ctx, id)
+func (c *ClientWithResponses) FindPetByIdWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*FindPetByIdResponse, error) {
+	rsp, err := c.FindPetById(ctx, id, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
@@ -697,3 +1131,154 @@ func ParseFindPetByIdResponse(rsp *http.Response) (*FindPetByIdResponse, error)
 
 	return response, nil
 }
+
+// APIError is an error response from the server, with the payload of the
+// default response of the operation when the API documents one.
+type APIError struct {
+	Operation    string
+	StatusCode   int
+	Body         []byte
+	HTTPResponse *http.Response
+	// The decoded default response, eg. *Error, or nil
+	Model interface{}
+}
+
+// Error describes the response.
+func (e *APIError) Error() string {
+	return fmt.Sprintf("%s returned %d %s", e.Operation, e.StatusCode, http.StatusText(e.StatusCode))
+}
+
+// FindPetsOrError calls FindPetsWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) FindPetsOrError(ctx context.Context, params *FindPetsParams, reqEditors ...RequestEditorFn) (*[]Pet, error) {
+	rsp, err := c.FindPetsWithResponse(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return findPetsOrError(rsp)
+}
+
+// findPetsOrError returns the payload of a success response to
+// FindPets, or an error for any other response.
+func findPetsOrError(rsp *FindPetsResponse) (*[]Pet, error) {
+	if rsp.JSON200 != nil {
+		return rsp.JSON200, nil
+	}
+	if rsp.StatusCode() >= 200 && rsp.StatusCode() < 300 {
+		return nil, nil
+	}
+
+	apiErr := APIError{
+		Operation:    "FindPets",
+		StatusCode:   rsp.StatusCode(),
+		Body:         rsp.Body,
+		HTTPResponse: rsp.HTTPResponse,
+	}
+	if rsp.JSONDefault != nil {
+		apiErr.Model = rsp.JSONDefault
+	}
+	return nil, &apiErr
+}
+
+// AddPetWithBodyOrError calls AddPetWithBodyWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) AddPetWithBodyOrError(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*Pet, error) {
+	rsp, err := c.AddPetWithBodyWithResponse(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return addPetOrError(rsp)
+}
+
+// AddPetOrError calls AddPetWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) AddPetOrError(ctx context.Context, body AddPetJSONRequestBody, reqEditors ...RequestEditorFn) (*Pet, error) {
+	rsp, err := c.AddPetWithResponse(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return addPetOrError(rsp)
+}
+
+// addPetOrError returns the payload of a success response to
+// AddPet, or an error for any other response.
+func addPetOrError(rsp *AddPetResponse) (*Pet, error) {
+	if rsp.JSON200 != nil {
+		return rsp.JSON200, nil
+	}
+	if rsp.StatusCode() >= 200 && rsp.StatusCode() < 300 {
+		return nil, nil
+	}
+
+	apiErr := APIError{
+		Operation:    "AddPet",
+		StatusCode:   rsp.StatusCode(),
+		Body:         rsp.Body,
+		HTTPResponse: rsp.HTTPResponse,
+	}
+	if rsp.JSONDefault != nil {
+		apiErr.Model = rsp.JSONDefault
+	}
+	return nil, &apiErr
+}
+
+// DeletePetOrError calls DeletePetWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) DeletePetOrError(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*DeletePetResponse, error) {
+	rsp, err := c.DeletePetWithResponse(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return deletePetOrError(rsp)
+}
+
+// deletePetOrError returns the payload of a success response to
+// DeletePet, or an error for any other response.
+func deletePetOrError(rsp *DeletePetResponse) (*DeletePetResponse, error) {
+	if rsp.StatusCode() >= 200 && rsp.StatusCode() < 300 {
+		return rsp, nil
+	}
+
+	apiErr := APIError{
+		Operation:    "DeletePet",
+		StatusCode:   rsp.StatusCode(),
+		Body:         rsp.Body,
+		HTTPResponse: rsp.HTTPResponse,
+	}
+	if rsp.JSONDefault != nil {
+		apiErr.Model = rsp.JSONDefault
+	}
+	return nil, &apiErr
+}
+
+// FindPetByIdOrError calls FindPetByIdWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) FindPetByIdOrError(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*Pet, error) {
+	rsp, err := c.FindPetByIdWithResponse(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return findPetByIdOrError(rsp)
+}
+
+// findPetByIdOrError returns the payload of a success response to
+// FindPetById, or an error for any other response.
+func findPetByIdOrError(rsp *FindPetByIdResponse) (*Pet, error) {
+	if rsp.JSON200 != nil {
+		return rsp.JSON200, nil
+	}
+	if rsp.StatusCode() >= 200 && rsp.StatusCode() < 300 {
+		return nil, nil
+	}
+
+	apiErr := APIError{
+		Operation:    "FindPetById",
+		StatusCode:   rsp.StatusCode(),
+		Body:         rsp.Body,
+		HTTPResponse: rsp.HTTPResponse,
+	}
+	if rsp.JSONDefault != nil {
+		apiErr.Model = rsp.JSONDefault
+	}
+	return nil, &apiErr
+}
@@ -4,7 +4,7 @@
 package message
 
 import (
-	openapi_types "github.com/deepmap/oapi-codegen/pkg/types"
+	openapi_types "github.com/indigonote/oapi-codegen/pkg/types"
 )
 
 // MedicalPoint defines model for MedicalPoint.
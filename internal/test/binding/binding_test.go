@@ -0,0 +1,196 @@
+package binding
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indigonote/oapi-codegen/internal/test/binding/fast"
+	"github.com/indigonote/oapi-codegen/internal/test/binding/fastchi"
+	"github.com/indigonote/oapi-codegen/internal/test/binding/reflection"
+	"github.com/indigonote/oapi-codegen/pkg/testutil"
+	openapi_types "github.com/indigonote/oapi-codegen/pkg/types"
+)
+
+// reflectionServer answers with the parameters bound by reflection.
+type reflectionServer struct {
+	discard bool
+}
+
+func (s *reflectionServer) ListThings(ctx echo.Context, params reflection.ListThingsParams) error {
+	return reply(ctx, s.discard, params)
+}
+
+func (s *reflectionServer) GetThing(ctx echo.Context, id int64, day openapi_types.Date, tags []string) error {
+	return reply(ctx, s.discard, []interface{}{id, day, tags})
+}
+
+// fastServer answers with the parameters bound by the generated code.
+type fastServer struct {
+	discard bool
+}
+
+func (s *fastServer) ListThings(ctx echo.Context, params fast.ListThingsParams) error {
+	return reply(ctx, s.discard, params)
+}
+
+func (s *fastServer) GetThing(ctx echo.Context, id int64, day openapi_types.Date, tags []string) error {
+	return reply(ctx, s.discard, []interface{}{id, day, tags})
+}
+
+func reply(ctx echo.Context, discard bool, params interface{}) error {
+	if discard {
+		return ctx.NoContent(http.StatusNoContent)
+	}
+	return ctx.JSON(http.StatusOK, params)
+}
+
+func newServers(discard bool) (withReflection, withGeneratedCode *echo.Echo) {
+	withReflection = echo.New()
+	reflection.RegisterHandlers(withReflection, &reflectionServer{discard: discard})
+	withGeneratedCode = echo.New()
+	fast.RegisterHandlers(withGeneratedCode, &fastServer{discard: discard})
+	return withReflection, withGeneratedCode
+}
+
+func TestFastBinding(t *testing.T) {
+	withReflection, withGeneratedCode := newServers(false)
+
+	for _, tt := range []struct {
+		name string
+		req  *testutil.RequestBuilder
+		code int
+	}{
+		{"path", testutil.NewRequest().Get("/things/12/2020-02-03/;tags=a;tags=b"), http.StatusOK},
+		{"path with invalid integer", testutil.NewRequest().Get("/things/twelve/2020-02-03/;tags=a"), http.StatusBadRequest},
+		{"path with invalid date", testutil.NewRequest().Get("/things/12/2020-02-30/;tags=a"), http.StatusBadRequest},
+		{"path with invalid array", testutil.NewRequest().Get("/things/12/2020-02-03/tags=a"), http.StatusBadRequest},
+
+		{"required query", testutil.NewRequest().Get("/things?limit=5"), http.StatusOK},
+		{"missing required query", testutil.NewRequest().Get("/things?ratio=0.5"), http.StatusBadRequest},
+		{"empty required query", testutil.NewRequest().Get("/things?limit="), http.StatusBadRequest},
+		{"repeated primitive query", testutil.NewRequest().Get("/things?limit=5&limit=6"), http.StatusBadRequest},
+		{"optional queries", testutil.NewRequest().Get("/things?limit=5&ratio=0.25&active=true&since=2020-01-02T03:04:05Z&color=red&weight=1.5"), http.StatusOK},
+		{"date as time", testutil.NewRequest().Get("/things?limit=5&since=2020-01-02"), http.StatusOK},
+		{"invalid boolean", testutil.NewRequest().Get("/things?limit=5&active=maybe"), http.StatusBadRequest},
+		{"invalid number", testutil.NewRequest().Get("/things?limit=5&ratio=half"), http.StatusBadRequest},
+		{"integer overflow", testutil.NewRequest().Get("/things?limit=5&ids=1,2147483648"), http.StatusBadRequest},
+		{"exploded array", testutil.NewRequest().Get("/things?limit=5&colors=red&colors=blue"), http.StatusOK},
+		{"unexploded array", testutil.NewRequest().Get("/things?limit=5&ids=3,4,5"), http.StatusOK},
+		{"repeated unexploded array", testutil.NewRequest().Get("/things?limit=5&ids=3&ids=4"), http.StatusBadRequest},
+		{"several values for unexploded primitive", testutil.NewRequest().Get("/things?limit=5&weight=1,2"), http.StatusBadRequest},
+		{"object", testutil.NewRequest().Get("/things?limit=5&name=tom&owner=ann"), http.StatusOK},
+
+		{"headers", testutil.NewRequest().Get("/things?limit=5").WithHeader("X-Request-Id", "abc").
+			WithHeader("X-Retries", "3").WithHeader("X-Sizes", "1,2,3"), http.StatusOK},
+		{"empty header", testutil.NewRequest().Get("/things?limit=5").WithHeader("X-Retries", ""), http.StatusBadRequest},
+		{"invalid header", testutil.NewRequest().Get("/things?limit=5").WithHeader("X-Sizes", "1,two"), http.StatusBadRequest},
+		{"header list with spaces", testutil.NewRequest().Get("/things?limit=5").WithHeader("X-Sizes", "1, 2, 3"), http.StatusOK},
+
+		{"cookies", testutil.NewRequest().Get("/things?limit=5").WithCookieNameValue("session", "xyz").
+			WithCookieNameValue("visits", "7"), http.StatusOK},
+		{"exploded cookie array", testutil.NewRequest().Get("/things?limit=5").WithCookieNameValue("flags", "a").
+			WithCookieNameValue("flags", "b"), http.StatusOK},
+		{"invalid cookie", testutil.NewRequest().Get("/things?limit=5").WithCookieNameValue("visits", "many"), http.StatusBadRequest},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			expected := tt.req.Go(t, withReflection)
+			got := tt.req.Go(t, withGeneratedCode)
+			assert.Equal(t, tt.code, expected.Code())
+			assert.Equal(t, expected.Code(), got.Code())
+			assert.Equal(t, expected.Recorder.Body.String(), got.Recorder.Body.String())
+		})
+	}
+}
+
+func TestFastBindingChi(t *testing.T) {
+	var params fastchi.ListThingsParams
+	var id int64
+	handler := fastchi.Handler(&chiServer{params: &params, id: &id})
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/things/12/2020-02-03/;tags=a", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, int64(12), id)
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/things?limit=5&ids=3,4&color=red", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, 5, params.Limit)
+	assert.Equal(t, &[]int32{3, 4}, params.Ids)
+	assert.Equal(t, fastchi.Color("red"), *params.Color)
+
+	// Lists may be given in several headers, and in a cookie per value
+	req := httptest.NewRequest("GET", "/things?limit=5", nil)
+	req.Header.Add("X-Sizes", "1, 2")
+	req.Header.Add("X-Sizes", "3")
+	req.AddCookie(&http.Cookie{Name: "flags", Value: "a"})
+	req.AddCookie(&http.Cookie{Name: "flags", Value: "b"})
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, &fastchi.Sizes{1, 2, 3}, params.XSizes)
+	assert.Equal(t, &[]string{"a", "b"}, params.Flags)
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/things?limit=five", nil))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "Invalid format for parameter limit")
+}
+
+// chiServer records the parameters bound by the chi wrapper.
+type chiServer struct {
+	params *fastchi.ListThingsParams
+	id     *int64
+}
+
+func (s *chiServer) ListThings(w http.ResponseWriter, r *http.Request, params fastchi.ListThingsParams) {
+	*s.params = params
+}
+
+func (s *chiServer) GetThing(w http.ResponseWriter, r *http.Request, id int64, day openapi_types.Date, tags []string) {
+	*s.id = id
+}
+
+func benchmarkBinding(b *testing.B, target string, header http.Header) {
+	withReflection, withGeneratedCode := newServers(true)
+	for _, bm := range []struct {
+		name string
+		e    *echo.Echo
+	}{
+		{"reflection", withReflection},
+		{"generated", withGeneratedCode},
+	} {
+		b.Run(bm.name, func(b *testing.B) {
+			req := httptest.NewRequest("GET", target, nil)
+			req.Header = header
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				rec := httptest.NewRecorder()
+				bm.e.ServeHTTP(rec, req)
+				if rec.Code != http.StatusNoContent {
+					b.Fatalf("unexpected status %d: %s", rec.Code, rec.Body.String())
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkPathParameters(b *testing.B) {
+	benchmarkBinding(b, "/things/12/2020-02-03/;tags=a;tags=b;tags=c", http.Header{})
+}
+
+func BenchmarkQueryParameters(b *testing.B) {
+	benchmarkBinding(b, "/things?limit=5&ratio=0.25&active=true&color=red&colors=red&colors=blue&ids=3,4,5&weight=1.5", http.Header{})
+}
+
+func BenchmarkHeaderParameters(b *testing.B) {
+	benchmarkBinding(b, "/things?limit=5", http.Header{
+		"X-Request-Id": {"abc"},
+		"X-Retries":    {"3"},
+		"X-Sizes":      {"1,2,3"},
+	})
+}
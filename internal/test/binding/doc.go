@@ -0,0 +1,7 @@
+// Package binding checks that the servers binding parameters with generated
+// code bind them as those binding them by reflection do.
+package binding
+
+//go:generate go run github.com/indigonote/oapi-codegen/cmd/oapi-codegen --package=reflection --generate=types,server -o reflection/reflection.gen.go binding.yaml
+//go:generate go run github.com/indigonote/oapi-codegen/cmd/oapi-codegen --package=fast --generate=types,server,fast-binding -o fast/fast.gen.go binding.yaml
+//go:generate go run github.com/indigonote/oapi-codegen/cmd/oapi-codegen --package=fastchi --generate=types,chi-server,fast-binding -o fastchi/fastchi.gen.go binding.yaml
@@ -0,0 +1,856 @@
+// Package fast provides primitives to interact the openapi HTTP API.
+//
+// Code generated by github.com/indigonote/oapi-codegen DO NOT EDIT.
+package fast
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/indigonote/oapi-codegen/pkg/runtime"
+	openapi_types "github.com/indigonote/oapi-codegen/pkg/types"
+	"github.com/labstack/echo/v4"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Color defines model for Color.
+type Color string
+
+// List of Color
+const (
+	Color_red   Color = "red"
+	Color_green Color = "green"
+	Color_blue  Color = "blue"
+)
+
+// Filter defines model for Filter.
+type Filter struct {
+	Name  *string `json:"name,omitempty"`
+	Owner *string `json:"owner,omitempty"`
+}
+
+// Sizes defines model for Sizes.
+type Sizes []int
+
+// Bound defines model for Bound.
+type Bound map[string]interface{}
+
+// ListThingsParams defines parameters for ListThings.
+type ListThingsParams struct {
+	Limit      int        `json:"limit"`
+	Ratio      *float64   `json:"ratio,omitempty"`
+	Active     *bool      `json:"active,omitempty"`
+	Since      *time.Time `json:"since,omitempty"`
+	Color      *Color     `json:"color,omitempty"`
+	Colors     *[]Color   `json:"colors,omitempty"`
+	Ids        *[]int32   `json:"ids,omitempty"`
+	Weight     *float32   `json:"weight,omitempty"`
+	Filter     *Filter    `json:"filter,omitempty"`
+	XRequestId *string    `json:"X-Request-Id,omitempty"`
+	XRetries   *int32     `json:"X-Retries,omitempty"`
+	XSizes     *Sizes     `json:"X-Sizes,omitempty"`
+	Session    *string    `json:"session,omitempty"`
+	Visits     *int64     `json:"visits,omitempty"`
+	Flags      *[]string  `json:"flags,omitempty"`
+}
+
+// ListThingsURL returns the URL of ListThings on server, with its parameters
+// serialized as the client serializes them.
+func ListThingsURL(server string, params *ListThingsParams) (*url.URL, error) {
+	var err error
+
+	queryUrl, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	basePath := fmt.Sprintf("/things")
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
+	}
+
+	queryUrl, err = queryUrl.Parse(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryUrl.Query()
+
+	if queryFrag, err := runtime.StyleParam("form", true, "limit", params.Limit); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	if params.Ratio != nil {
+
+		if queryFrag, err := runtime.StyleParam("form", true, "ratio", *params.Ratio); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Active != nil {
+
+		if queryFrag, err := runtime.StyleParam("form", true, "active", *params.Active); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Since != nil {
+
+		if queryFrag, err := runtime.StyleParam("form", true, "since", *params.Since); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Color != nil {
+
+		if queryFrag, err := runtime.StyleParam("form", true, "color", *params.Color); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Colors != nil {
+
+		if queryFrag, err := runtime.StyleParam("form", true, "colors", *params.Colors); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Ids != nil {
+
+		if queryFrag, err := runtime.StyleParam("form", false, "ids", *params.Ids); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Weight != nil {
+
+		if queryFrag, err := runtime.StyleParam("form", false, "weight", *params.Weight); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Filter != nil {
+
+		if queryFrag, err := runtime.StyleParam("form", true, "filter", *params.Filter); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryUrl.RawQuery = queryValues.Encode()
+
+	return queryUrl, nil
+}
+
+// GetThingURL returns the URL of GetThing on server, with its parameters
+// serialized as the client serializes them.
+func GetThingURL(server string, id int64, day openapi_types.Date, tags []string) (*url.URL, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParam("simple", false, "id", id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParam("simple", false, "day", day)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParam("matrix", true, "tags", tags)
+	if err != nil {
+		return nil, err
+	}
+
+	queryUrl, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	basePath := fmt.Sprintf("/things/%s/%s/%s", pathParam0, pathParam1, pathParam2)
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
+	}
+
+	queryUrl, err = queryUrl.Parse(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return queryUrl, nil
+}
+
+// OperationRoute describes an operation of the API, whatever the router serving
+// it.
+type OperationRoute struct {
+	Method      string // The HTTP method, eg. GET
+	Path        string // The path template of the spec, eg. /pets/{id}
+	OperationId string // The operation ID, as the names of the generated code use it
+}
+
+// OperationRoutes lists the operations of the API, in the order of their paths.
+var OperationRoutes = []OperationRoute{
+	{Method: "GET", Path: "/things", OperationId: "ListThings"},
+	{Method: "GET", Path: "/things/{id}/{day}/{tags}", OperationId: "GetThing"},
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (GET /things)
+	ListThings(ctx echo.Context, params ListThingsParams) error
+
+	// (GET /things/{id}/{day}/{tags})
+	GetThing(ctx echo.Context, id int64, day openapi_types.Date, tags []string) error
+}
+
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+
+	// ErrorHandlerFunc answers requests whose parameters can't be bound, with
+	// their InvalidParamFormatError, RequiredParamError, UnmarshalingParamError
+	// or TooManyValuesForParamError, of the runtime package. They are answered
+	// with a 400 echo.HTTPError if it is nil.
+	ErrorHandlerFunc func(ctx echo.Context, err error) error
+}
+
+// handleError answers a request whose parameters can't be bound.
+func (w *ServerInterfaceWrapper) handleError(ctx echo.Context, err error) error {
+	if w.ErrorHandlerFunc != nil {
+		return w.ErrorHandlerFunc(ctx, err)
+	}
+	return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+}
+
+// ListThings converts echo context to params.
+func (w *ServerInterfaceWrapper) ListThings(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListThingsParams
+	// ------------- Required query parameter "limit" -------------
+
+	err = bindListThingsQueryLimit(ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationQuery, "limit", err))
+	}
+
+	// ------------- Optional query parameter "ratio" -------------
+
+	err = bindListThingsQueryRatio(ctx.QueryParams(), &params.Ratio)
+	if err != nil {
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationQuery, "ratio", err))
+	}
+
+	// ------------- Optional query parameter "active" -------------
+
+	err = bindListThingsQueryActive(ctx.QueryParams(), &params.Active)
+	if err != nil {
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationQuery, "active", err))
+	}
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = bindListThingsQuerySince(ctx.QueryParams(), &params.Since)
+	if err != nil {
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationQuery, "since", err))
+	}
+
+	// ------------- Optional query parameter "color" -------------
+
+	err = bindListThingsQueryColor(ctx.QueryParams(), &params.Color)
+	if err != nil {
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationQuery, "color", err))
+	}
+
+	// ------------- Optional query parameter "colors" -------------
+
+	err = bindListThingsQueryColors(ctx.QueryParams(), &params.Colors)
+	if err != nil {
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationQuery, "colors", err))
+	}
+
+	// ------------- Optional query parameter "ids" -------------
+
+	err = bindListThingsQueryIds(ctx.QueryParams(), &params.Ids)
+	if err != nil {
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationQuery, "ids", err))
+	}
+
+	// ------------- Optional query parameter "weight" -------------
+
+	err = bindListThingsQueryWeight(ctx.QueryParams(), &params.Weight)
+	if err != nil {
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationQuery, "weight", err))
+	}
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", ctx.QueryParams(), &params.Filter)
+	if err != nil {
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationQuery, "filter", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "X-Request-Id" -------------
+
+	err = bindListThingsHeaderXRequestId(headers, &params.XRequestId)
+	if err != nil {
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationHeader, "X-Request-Id", err))
+	}
+
+	// ------------- Optional header parameter "X-Retries" -------------
+
+	err = bindListThingsHeaderXRetries(headers, &params.XRetries)
+	if err != nil {
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationHeader, "X-Retries", err))
+	}
+
+	// ------------- Optional header parameter "X-Sizes" -------------
+
+	err = bindListThingsHeaderXSizes(headers, &params.XSizes)
+	if err != nil {
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationHeader, "X-Sizes", err))
+	}
+
+	err = bindListThingsCookieSession(ctx.Cookies(), &params.Session)
+	if err != nil {
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationCookie, "session", err))
+	}
+
+	err = bindListThingsCookieVisits(ctx.Cookies(), &params.Visits)
+	if err != nil {
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationCookie, "visits", err))
+	}
+
+	err = bindListThingsCookieFlags(ctx.Cookies(), &params.Flags)
+	if err != nil {
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationCookie, "flags", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ListThings(ctx, params)
+	return err
+}
+
+// GetThing converts echo context to params.
+func (w *ServerInterfaceWrapper) GetThing(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = bindGetThingPathId(ctx.Param("id"), &id)
+	if err != nil {
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationPath, "id", err))
+	}
+
+	// ------------- Path parameter "day" -------------
+	var day openapi_types.Date
+
+	err = bindGetThingPathDay(ctx.Param("day"), &day)
+	if err != nil {
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationPath, "day", err))
+	}
+
+	// ------------- Path parameter "tags" -------------
+	var tags []string
+
+	err = bindGetThingPathTags(ctx.Param("tags"), &tags)
+	if err != nil {
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationPath, "tags", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetThing(ctx, id, day, tags)
+	return err
+}
+
+// This is a simple interface which specifies echo.Route addition functions which
+// are present on both echo.Echo and echo.Group, since we want to allow using
+// either of them for path registration
+type EchoRouter interface {
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+}
+
+// RegisterOptions configures the middlewares and the error handling of the
+// routes added by RegisterHandlersWithOptions.
+type RegisterOptions struct {
+	// The middlewares of operations, by operation ID.
+	OperationMiddlewares map[string][]echo.MiddlewareFunc
+
+	// The middlewares of the operations with a tag, by tag.
+	TagMiddlewares map[string][]echo.MiddlewareFunc
+
+	// The ErrorHandlerFunc of the ServerInterfaceWrapper.
+	ErrorHandlerFunc func(ctx echo.Context, err error) error
+}
+
+// RegisterHandlers adds each server route to the EchoRouter.
+func RegisterHandlers(router EchoRouter, si ServerInterface) {
+	RegisterHandlersWithOptions(router, si, RegisterOptions{})
+}
+
+// RegisterHandlersWithOptions adds each server route to the EchoRouter, with
+// the middlewares of the tags of its operation, in the order of the tags, and
+// then those of the operation. The OperationInfo of the operation is put into
+// the context of the request before any of them runs.
+func RegisterHandlersWithOptions(router EchoRouter, si ServerInterface, opts RegisterOptions) {
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:          si,
+		ErrorHandlerFunc: opts.ErrorHandlerFunc,
+	}
+
+	router.GET("/things", wrapper.ListThings, opts.middlewares("ListThings")...)
+	router.GET("/things/:id/:day/:tags", wrapper.GetThing, opts.middlewares("GetThing")...)
+
+}
+
+// middlewares returns the middlewares of the route of an operation.
+func (opts RegisterOptions) middlewares(operationId string) []echo.MiddlewareFunc {
+	info := Operations[operationId]
+	middlewares := []echo.MiddlewareFunc{
+		func(next echo.HandlerFunc) echo.HandlerFunc {
+			return func(ctx echo.Context) error {
+				r := ctx.Request()
+				ctx.SetRequest(r.WithContext(ContextWithOperationInfo(r.Context(), info)))
+				return next(ctx)
+			}
+		},
+	}
+	for _, tag := range info.Tags {
+		middlewares = append(middlewares, opts.TagMiddlewares[tag]...)
+	}
+	return append(middlewares, opts.OperationMiddlewares[operationId]...)
+}
+
+// OperationInfo describes an operation of the API, for the middlewares serving
+// it, which find it in the context of its requests.
+type OperationInfo struct {
+	OperationId string                     // The operation ID, as the names of the generated code use it
+	Method      string                     // The HTTP method, eg. GET
+	Path        string                     // The path template of the spec, eg. /pets/{id}
+	Tags        []string                   // The tags of the operation
+	Security    []OperationSecurity        // The security providers of the operation
+	Extensions  map[string]json.RawMessage // The x- extensions of the operation, by name
+}
+
+// OperationSecurity is a security provider of an operation, and the scopes it
+// requires.
+type OperationSecurity struct {
+	ProviderName string
+	Scopes       []string
+}
+
+// Operations holds the OperationInfo of every operation, by operation ID.
+var Operations = map[string]OperationInfo{
+	"ListThings": {
+		OperationId: "ListThings",
+		Method:      "GET",
+		Path:        "/things",
+	},
+	"GetThing": {
+		OperationId: "GetThing",
+		Method:      "GET",
+		Path:        "/things/{id}/{day}/{tags}",
+	},
+}
+
+// operationInfoKey is the context key of the OperationInfo of a request.
+type operationInfoKey struct{}
+
+// ContextWithOperationInfo returns a copy of ctx holding info, which
+// OperationInfoFromContext returns.
+func ContextWithOperationInfo(ctx context.Context, info OperationInfo) context.Context {
+	return context.WithValue(ctx, operationInfoKey{}, info)
+}
+
+// OperationInfoFromContext returns the OperationInfo of the operation serving
+// a request, from its context, and whether there's one.
+func OperationInfoFromContext(ctx context.Context) (OperationInfo, bool) {
+	info, ok := ctx.Value(operationInfoKey{}).(OperationInfo)
+	return info, ok
+}
+
+// bindListThingsQueryLimit binds the query parameter "limit" without
+// reflection.
+func bindListThingsQueryLimit(queryParams url.Values, dest *int) error {
+	values, found, err := runtime.QueryParameterValues(true, true, false, "limit", queryParams)
+	if !found || err != nil {
+		return err
+	}
+	value := values[0]
+
+	bound, err := runtime.ParseInt(value)
+	if err != nil {
+		return err
+	}
+	*dest = bound
+	return nil
+}
+
+// bindListThingsQueryRatio binds the query parameter "ratio" without
+// reflection.
+func bindListThingsQueryRatio(queryParams url.Values, dest **float64) error {
+	values, found, err := runtime.QueryParameterValues(true, false, false, "ratio", queryParams)
+	if !found || err != nil {
+		return err
+	}
+	value := values[0]
+
+	bound, err := runtime.ParseFloat64(value)
+	if err != nil {
+		return err
+	}
+	*dest = &bound
+	return nil
+}
+
+// bindListThingsQueryActive binds the query parameter "active" without
+// reflection.
+func bindListThingsQueryActive(queryParams url.Values, dest **bool) error {
+	values, found, err := runtime.QueryParameterValues(true, false, false, "active", queryParams)
+	if !found || err != nil {
+		return err
+	}
+	value := values[0]
+
+	bound, err := runtime.ParseBool(value)
+	if err != nil {
+		return err
+	}
+	*dest = &bound
+	return nil
+}
+
+// bindListThingsQuerySince binds the query parameter "since" without
+// reflection.
+func bindListThingsQuerySince(queryParams url.Values, dest **time.Time) error {
+	values, found, err := runtime.QueryParameterValues(true, false, false, "since", queryParams)
+	if !found || err != nil {
+		return err
+	}
+	value := values[0]
+
+	bound, err := runtime.ParseTime(value)
+	if err != nil {
+		return err
+	}
+	*dest = &bound
+	return nil
+}
+
+// bindListThingsQueryColor binds the query parameter "color" without
+// reflection.
+func bindListThingsQueryColor(queryParams url.Values, dest **Color) error {
+	values, found, err := runtime.QueryParameterValues(true, false, false, "color", queryParams)
+	if !found || err != nil {
+		return err
+	}
+	value := values[0]
+
+	bound := Color(value)
+	*dest = &bound
+	return nil
+}
+
+// bindListThingsQueryColors binds the query parameter "colors" without
+// reflection.
+func bindListThingsQueryColors(queryParams url.Values, dest **[]Color) error {
+	values, found, err := runtime.QueryParameterValues(true, false, true, "colors", queryParams)
+	if !found || err != nil {
+		return err
+	}
+
+	bound := make([]Color, len(values))
+	for i, value := range values {
+		bound[i] = Color(value)
+	}
+	*dest = &bound
+	return nil
+}
+
+// bindListThingsQueryIds binds the query parameter "ids" without
+// reflection.
+func bindListThingsQueryIds(queryParams url.Values, dest **[]int32) error {
+	values, found, err := runtime.QueryParameterValues(false, false, true, "ids", queryParams)
+	if !found || err != nil {
+		return err
+	}
+
+	bound := make([]int32, len(values))
+	for i, value := range values {
+		v, err := runtime.ParseInt32(value)
+		if err != nil {
+			return fmt.Errorf("error setting array element: %s", err)
+		}
+		bound[i] = v
+	}
+	*dest = &bound
+	return nil
+}
+
+// bindListThingsQueryWeight binds the query parameter "weight" without
+// reflection.
+func bindListThingsQueryWeight(queryParams url.Values, dest **float32) error {
+	values, found, err := runtime.QueryParameterValues(false, false, false, "weight", queryParams)
+	if !found || err != nil {
+		return err
+	}
+	value := values[0]
+
+	bound, err := runtime.ParseFloat32(value)
+	if err != nil {
+		return err
+	}
+	*dest = &bound
+	return nil
+}
+
+// bindListThingsHeaderXRequestId binds the header parameter "X-Request-Id" without
+// reflection.
+func bindListThingsHeaderXRequestId(headers http.Header, dest **string) error {
+	value, found, err := runtime.HeaderParameterValue(false, false, "X-Request-Id", headers)
+	if !found || err != nil {
+		return err
+	}
+	value, err = runtime.StyledParameterValue("simple", false, "X-Request-Id", value)
+	if err != nil {
+		return err
+	}
+
+	bound := value
+	*dest = &bound
+	return nil
+}
+
+// bindListThingsHeaderXRetries binds the header parameter "X-Retries" without
+// reflection.
+func bindListThingsHeaderXRetries(headers http.Header, dest **int32) error {
+	value, found, err := runtime.HeaderParameterValue(false, false, "X-Retries", headers)
+	if !found || err != nil {
+		return err
+	}
+	value, err = runtime.StyledParameterValue("simple", false, "X-Retries", value)
+	if err != nil {
+		return err
+	}
+
+	bound, err := runtime.ParseInt32(value)
+	if err != nil {
+		return err
+	}
+	*dest = &bound
+	return nil
+}
+
+// bindListThingsHeaderXSizes binds the header parameter "X-Sizes" without
+// reflection.
+func bindListThingsHeaderXSizes(headers http.Header, dest **Sizes) error {
+	value, found, err := runtime.HeaderParameterValue(false, true, "X-Sizes", headers)
+	if !found || err != nil {
+		return err
+	}
+	values, err := runtime.SplitStyledParameter("simple", false, "X-Sizes", value)
+	if err != nil {
+		return err
+	}
+
+	bound := make(Sizes, len(values))
+	for i, value := range values {
+		v, err := runtime.ParseInt(value)
+		if err != nil {
+			return fmt.Errorf("error setting array element: %s", err)
+		}
+		bound[i] = v
+	}
+	*dest = &bound
+	return nil
+}
+
+// bindListThingsCookieSession binds the cookie parameter "session" without
+// reflection.
+func bindListThingsCookieSession(cookies []*http.Cookie, dest **string) error {
+	values, found, err := runtime.CookieParameterValues(true, false, false, "session", cookies)
+	if !found || err != nil {
+		return err
+	}
+	value := values[0]
+
+	bound := value
+	*dest = &bound
+	return nil
+}
+
+// bindListThingsCookieVisits binds the cookie parameter "visits" without
+// reflection.
+func bindListThingsCookieVisits(cookies []*http.Cookie, dest **int64) error {
+	values, found, err := runtime.CookieParameterValues(true, false, false, "visits", cookies)
+	if !found || err != nil {
+		return err
+	}
+	value := values[0]
+
+	bound, err := runtime.ParseInt64(value)
+	if err != nil {
+		return err
+	}
+	*dest = &bound
+	return nil
+}
+
+// bindListThingsCookieFlags binds the cookie parameter "flags" without
+// reflection.
+func bindListThingsCookieFlags(cookies []*http.Cookie, dest **[]string) error {
+	values, found, err := runtime.CookieParameterValues(true, false, true, "flags", cookies)
+	if !found || err != nil {
+		return err
+	}
+
+	bound := make([]string, len(values))
+	copy(bound, values)
+	*dest = &bound
+	return nil
+}
+
+// bindGetThingPathId binds the path parameter "id" without
+// reflection.
+func bindGetThingPathId(value string, dest *int64) error {
+	value, err := runtime.StyledParameterValue("simple", false, "id", value)
+	if err != nil {
+		return err
+	}
+
+	bound, err := runtime.ParseInt64(value)
+	if err != nil {
+		return err
+	}
+	*dest = bound
+	return nil
+}
+
+// bindGetThingPathDay binds the path parameter "day" without
+// reflection.
+func bindGetThingPathDay(value string, dest *openapi_types.Date) error {
+	value, err := runtime.StyledParameterValue("simple", false, "day", value)
+	if err != nil {
+		return err
+	}
+
+	bound, err := runtime.ParseDate(value)
+	if err != nil {
+		return err
+	}
+	*dest = bound
+	return nil
+}
+
+// bindGetThingPathTags binds the path parameter "tags" without
+// reflection.
+func bindGetThingPathTags(value string, dest *[]string) error {
+	values, err := runtime.SplitStyledParameter("matrix", true, "tags", value)
+	if err != nil {
+		return err
+	}
+
+	bound := make([]string, len(values))
+	copy(bound, values)
+	*dest = bound
+	return nil
+}
@@ -4,6 +4,7 @@
 package client
 
 import (
+	"bufio"
 	"bytes"
 	"compress/gzip"
 	"context"
@@ -11,14 +12,27 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/indigonote/oapi-codegen/pkg/runtime"
 	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
 	"io"
 	"io/ioutil"
+	"math/rand"
 	"net/http"
 	"net/url"
+	"reflect"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 )
 
+// ErrorObject defines model for ErrorObject.
+type ErrorObject struct {
+	Message string `json:"message"`
+}
+
 // SchemaObject defines model for SchemaObject.
 type SchemaObject struct {
 	FirstName string `json:"firstName"`
@@ -28,260 +42,165 @@ type SchemaObject struct {
 // PostBothJSONBody defines parameters for PostBoth.
 type PostBothJSONBody SchemaObject
 
-// PostJsonJSONBody defines parameters for PostJson.
-type PostJsonJSONBody SchemaObject
-
-// PostBothRequestBody defines body for PostBoth for application/json ContentType.
-type PostBothJSONRequestBody PostBothJSONBody
-
-// PostJsonRequestBody defines body for PostJson for application/json ContentType.
-type PostJsonJSONRequestBody PostJsonJSONBody
-
-// RequestEditorFn  is the function signature for the RequestEditor callback function
-type RequestEditorFn func(ctx context.Context, req *http.Request) error
-
-// Doer performs HTTP requests.
-//
-// The standard http.Client implements this interface.
-type HttpRequestDoer interface {
-	Do(req *http.Request) (*http.Response, error)
+// ListCursorParams defines parameters for ListCursor.
+type ListCursorParams struct {
+	Cursor *string `json:"cursor,omitempty"`
 }
 
-// Client which conforms to the OpenAPI3 specification for this service.
-type Client struct {
-	// The endpoint of the server conforming to this interface, with scheme,
-	// https://api.deepmap.com for example.
-	Server string
+// PostDownloadJSONBody defines parameters for PostDownload.
+type PostDownloadJSONBody SchemaObject
 
-	// Doer for performing requests, typically a *http.Client with any
-	// customized settings, such as certificate chains.
-	Client HttpRequestDoer
+// PostJsonJSONBody defines parameters for PostJson.
+type PostJsonJSONBody SchemaObject
 
-	// A callback for modifying requests which are generated before sending over
-	// the network.
-	RequestEditor RequestEditorFn
+// ListOffsetParams defines parameters for ListOffset.
+type ListOffsetParams struct {
+	Offset *int `json:"offset,omitempty"`
+	Limit  *int `json:"limit,omitempty"`
 }
 
-// ClientOption allows setting custom parameters during construction
-type ClientOption func(*Client) error
+// PostBothRequestBody defines body for PostBoth for application/json ContentType.
+type PostBothJSONRequestBody PostBothJSONBody
 
-// Creates a new Client, with reasonable defaults
-func NewClient(server string, opts ...ClientOption) (*Client, error) {
-	// create a client with sane default values
-	client := Client{
-		Server: server,
-	}
-	// mutate client and add all optional params
-	for _, o := range opts {
-		if err := o(&client); err != nil {
-			return nil, err
-		}
-	}
-	// ensure the server URL always has a trailing slash
-	if !strings.HasSuffix(client.Server, "/") {
-		client.Server += "/"
-	}
-	// create httpClient, if not already present
-	if client.Client == nil {
-		client.Client = http.DefaultClient
-	}
-	return &client, nil
-}
+// PostDownloadRequestBody defines body for PostDownload for application/json ContentType.
+type PostDownloadJSONRequestBody PostDownloadJSONBody
 
-// WithHTTPClient allows overriding the default Doer, which is
-// automatically created using http.Client. This is useful for tests.
-func WithHTTPClient(doer HttpRequestDoer) ClientOption {
-	return func(c *Client) error {
-		c.Client = doer
-		return nil
-	}
-}
+// PostJsonRequestBody defines body for PostJson for application/json ContentType.
+type PostJsonJSONRequestBody PostJsonJSONBody
 
-// WithRequestEditorFn allows setting up a callback function, which will be
-// called right before sending the request. This can be used to mutate the request.
-func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
-	return func(c *Client) error {
-		c.RequestEditor = fn
-		return nil
-	}
+// GetEventsEvent is an event of the text/event-stream response of GetEvents.
+type GetEventsEvent struct {
+	// The ID of the last event which had one
+	ID string
+	// The type of the event, "message" by default
+	Event string
+	// The reconnection time asked for by the server, if any
+	Retry time.Duration
+	Data  SchemaObject
 }
 
-// The interface specification for the client above.
-type ClientInterface interface {
-	// PostBoth request  with any body
-	PostBothWithBody(ctx context.Context, contentType string, body io.Reader) (*http.Response, error)
-
-	PostBoth(ctx context.Context, body PostBothJSONRequestBody) (*http.Response, error)
-
-	// GetBoth request
-	GetBoth(ctx context.Context) (*http.Response, error)
-
-	// PostJson request  with any body
-	PostJsonWithBody(ctx context.Context, contentType string, body io.Reader) (*http.Response, error)
-
-	PostJson(ctx context.Context, body PostJsonJSONRequestBody) (*http.Response, error)
-
-	// GetJson request
-	GetJson(ctx context.Context) (*http.Response, error)
-
-	// PostOther request  with any body
-	PostOtherWithBody(ctx context.Context, contentType string, body io.Reader) (*http.Response, error)
-
-	// GetOther request
-	GetOther(ctx context.Context) (*http.Response, error)
+// GetObjectURL returns the URL of GetObject on server, with its parameters
+// serialized as the client serializes them.
+func GetObjectURL(server string, id int) (*url.URL, error) {
+	var err error
 
-	// GetJsonWithTrailingSlash request
-	GetJsonWithTrailingSlash(ctx context.Context) (*http.Response, error)
-}
+	var pathParam0 string
 
-func (c *Client) PostBothWithBody(ctx context.Context, contentType string, body io.Reader) (*http.Response, error) {
-	req, err := NewPostBothRequestWithBody(c.Server, contentType, body)
+	pathParam0, err = runtime.StyleParam("simple", false, "id", id)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if c.RequestEditor != nil {
-		err = c.RequestEditor(ctx, req)
-		if err != nil {
-			return nil, err
-		}
-	}
-	return c.Client.Do(req)
-}
 
-func (c *Client) PostBoth(ctx context.Context, body PostBothJSONRequestBody) (*http.Response, error) {
-	req, err := NewPostBothRequest(c.Server, body)
+	queryUrl, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if c.RequestEditor != nil {
-		err = c.RequestEditor(ctx, req)
-		if err != nil {
-			return nil, err
-		}
+
+	basePath := fmt.Sprintf("/objects/%s", pathParam0)
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
 	}
-	return c.Client.Do(req)
-}
 
-func (c *Client) GetBoth(ctx context.Context) (*http.Response, error) {
-	req, err := NewGetBothRequest(c.Server)
+	queryUrl, err = queryUrl.Parse(basePath)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if c.RequestEditor != nil {
-		err = c.RequestEditor(ctx, req)
-		if err != nil {
-			return nil, err
-		}
-	}
-	return c.Client.Do(req)
+
+	return queryUrl, nil
 }
 
-func (c *Client) PostJsonWithBody(ctx context.Context, contentType string, body io.Reader) (*http.Response, error) {
-	req, err := NewPostJsonRequestWithBody(c.Server, contentType, body)
+// PostBothURL returns the URL of PostBoth on server, with its parameters
+// serialized as the client serializes them.
+func PostBothURL(server string) (*url.URL, error) {
+	var err error
+
+	queryUrl, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if c.RequestEditor != nil {
-		err = c.RequestEditor(ctx, req)
-		if err != nil {
-			return nil, err
-		}
+
+	basePath := fmt.Sprintf("/with_both_bodies")
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
 	}
-	return c.Client.Do(req)
-}
 
-func (c *Client) PostJson(ctx context.Context, body PostJsonJSONRequestBody) (*http.Response, error) {
-	req, err := NewPostJsonRequest(c.Server, body)
+	queryUrl, err = queryUrl.Parse(basePath)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if c.RequestEditor != nil {
-		err = c.RequestEditor(ctx, req)
-		if err != nil {
-			return nil, err
-		}
-	}
-	return c.Client.Do(req)
+
+	return queryUrl, nil
 }
 
-func (c *Client) GetJson(ctx context.Context) (*http.Response, error) {
-	req, err := NewGetJsonRequest(c.Server)
+// GetBothURL returns the URL of GetBoth on server, with its parameters
+// serialized as the client serializes them.
+func GetBothURL(server string) (*url.URL, error) {
+	var err error
+
+	queryUrl, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if c.RequestEditor != nil {
-		err = c.RequestEditor(ctx, req)
-		if err != nil {
-			return nil, err
-		}
+
+	basePath := fmt.Sprintf("/with_both_responses")
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
 	}
-	return c.Client.Do(req)
-}
 
-func (c *Client) PostOtherWithBody(ctx context.Context, contentType string, body io.Reader) (*http.Response, error) {
-	req, err := NewPostOtherRequestWithBody(c.Server, contentType, body)
+	queryUrl, err = queryUrl.Parse(basePath)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if c.RequestEditor != nil {
-		err = c.RequestEditor(ctx, req)
-		if err != nil {
-			return nil, err
-		}
-	}
-	return c.Client.Do(req)
+
+	return queryUrl, nil
 }
 
-func (c *Client) GetOther(ctx context.Context) (*http.Response, error) {
-	req, err := NewGetOtherRequest(c.Server)
+// ListCursorURL returns the URL of ListCursor on server, with its parameters
+// serialized as the client serializes them.
+func ListCursorURL(server string, params *ListCursorParams) (*url.URL, error) {
+	var err error
+
+	queryUrl, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if c.RequestEditor != nil {
-		err = c.RequestEditor(ctx, req)
-		if err != nil {
-			return nil, err
-		}
+
+	basePath := fmt.Sprintf("/with_cursor_pagination")
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
 	}
-	return c.Client.Do(req)
-}
 
-func (c *Client) GetJsonWithTrailingSlash(ctx context.Context) (*http.Response, error) {
-	req, err := NewGetJsonWithTrailingSlashRequest(c.Server)
+	queryUrl, err = queryUrl.Parse(basePath)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if c.RequestEditor != nil {
-		err = c.RequestEditor(ctx, req)
-		if err != nil {
+
+	queryValues := queryUrl.Query()
+
+	if params.Cursor != nil {
+
+		if queryFrag, err := runtime.StyleParam("form", true, "cursor", *params.Cursor); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
 		}
-	}
-	return c.Client.Do(req)
-}
 
-// NewPostBothRequest calls the generic PostBoth builder with application/json body
-func NewPostBothRequest(server string, body PostBothJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostBothRequestWithBody(server, "application/json", bodyReader)
+
+	queryUrl.RawQuery = queryValues.Encode()
+
+	return queryUrl, nil
 }
 
-// NewPostBothRequestWithBody generates requests for PostBoth with any type of body
-func NewPostBothRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// PostDownloadURL returns the URL of PostDownload on server, with its parameters
+// serialized as the client serializes them.
+func PostDownloadURL(server string) (*url.URL, error) {
 	var err error
 
 	queryUrl, err := url.Parse(server)
@@ -289,7 +208,7 @@ func NewPostBothRequestWithBody(server string, contentType string, body io.Reade
 		return nil, err
 	}
 
-	basePath := fmt.Sprintf("/with_both_bodies")
+	basePath := fmt.Sprintf("/with_download")
 	if basePath[0] == '/' {
 		basePath = basePath[1:]
 	}
@@ -299,17 +218,12 @@ func NewPostBothRequestWithBody(server string, contentType string, body io.Reade
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryUrl.String(), body)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Add("Content-Type", contentType)
-	return req, nil
+	return queryUrl, nil
 }
 
-// NewGetBothRequest generates requests for GetBoth
-func NewGetBothRequest(server string) (*http.Request, error) {
+// GetWithErrorsURL returns the URL of GetWithErrors on server, with its parameters
+// serialized as the client serializes them.
+func GetWithErrorsURL(server string) (*url.URL, error) {
 	var err error
 
 	queryUrl, err := url.Parse(server)
@@ -317,7 +231,7 @@ func NewGetBothRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	basePath := fmt.Sprintf("/with_both_responses")
+	basePath := fmt.Sprintf("/with_error_responses")
 	if basePath[0] == '/' {
 		basePath = basePath[1:]
 	}
@@ -327,27 +241,35 @@ func NewGetBothRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryUrl.String(), nil)
+	return queryUrl, nil
+}
+
+// GetEventsURL returns the URL of GetEvents on server, with its parameters
+// serialized as the client serializes them.
+func GetEventsURL(server string) (*url.URL, error) {
+	var err error
+
+	queryUrl, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
+	basePath := fmt.Sprintf("/with_event_stream")
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
+	}
 
-// NewPostJsonRequest calls the generic PostJson builder with application/json body
-func NewPostJsonRequest(server string, body PostJsonJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	queryUrl, err = queryUrl.Parse(basePath)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostJsonRequestWithBody(server, "application/json", bodyReader)
+
+	return queryUrl, nil
 }
 
-// NewPostJsonRequestWithBody generates requests for PostJson with any type of body
-func NewPostJsonRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// PostJsonURL returns the URL of PostJson on server, with its parameters
+// serialized as the client serializes them.
+func PostJsonURL(server string) (*url.URL, error) {
 	var err error
 
 	queryUrl, err := url.Parse(server)
@@ -365,17 +287,12 @@ func NewPostJsonRequestWithBody(server string, contentType string, body io.Reade
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryUrl.String(), body)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Add("Content-Type", contentType)
-	return req, nil
+	return queryUrl, nil
 }
 
-// NewGetJsonRequest generates requests for GetJson
-func NewGetJsonRequest(server string) (*http.Request, error) {
+// GetJsonURL returns the URL of GetJson on server, with its parameters
+// serialized as the client serializes them.
+func GetJsonURL(server string) (*url.URL, error) {
 	var err error
 
 	queryUrl, err := url.Parse(server)
@@ -393,16 +310,12 @@ func NewGetJsonRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryUrl.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
+	return queryUrl, nil
 }
 
-// NewPostOtherRequestWithBody generates requests for PostOther with any type of body
-func NewPostOtherRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// ListLinkURL returns the URL of ListLink on server, with its parameters
+// serialized as the client serializes them.
+func ListLinkURL(server string) (*url.URL, error) {
 	var err error
 
 	queryUrl, err := url.Parse(server)
@@ -410,7 +323,7 @@ func NewPostOtherRequestWithBody(server string, contentType string, body io.Read
 		return nil, err
 	}
 
-	basePath := fmt.Sprintf("/with_other_body")
+	basePath := fmt.Sprintf("/with_link_pagination")
 	if basePath[0] == '/' {
 		basePath = basePath[1:]
 	}
@@ -420,17 +333,12 @@ func NewPostOtherRequestWithBody(server string, contentType string, body io.Read
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryUrl.String(), body)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Add("Content-Type", contentType)
-	return req, nil
+	return queryUrl, nil
 }
 
-// NewGetOtherRequest generates requests for GetOther
-func NewGetOtherRequest(server string) (*http.Request, error) {
+// GetExportURL returns the URL of GetExport on server, with its parameters
+// serialized as the client serializes them.
+func GetExportURL(server string) (*url.URL, error) {
 	var err error
 
 	queryUrl, err := url.Parse(server)
@@ -438,7 +346,7 @@ func NewGetOtherRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	basePath := fmt.Sprintf("/with_other_response")
+	basePath := fmt.Sprintf("/with_ndjson")
 	if basePath[0] == '/' {
 		basePath = basePath[1:]
 	}
@@ -448,476 +356,4787 @@ func NewGetOtherRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryUrl.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	return queryUrl, nil
+}
+
+// ListOffsetURL returns the URL of ListOffset on server, with its parameters
+// serialized as the client serializes them.
+func ListOffsetURL(server string, params *ListOffsetParams) (*url.URL, error) {
+	var err error
+
+	queryUrl, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	basePath := fmt.Sprintf("/with_offset_pagination")
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
+	}
+
+	queryUrl, err = queryUrl.Parse(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryUrl.Query()
+
+	if params.Offset != nil {
+
+		if queryFrag, err := runtime.StyleParam("form", true, "offset", *params.Offset); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParam("form", true, "limit", *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryUrl.RawQuery = queryValues.Encode()
+
+	return queryUrl, nil
+}
+
+// PostOtherURL returns the URL of PostOther on server, with its parameters
+// serialized as the client serializes them.
+func PostOtherURL(server string) (*url.URL, error) {
+	var err error
+
+	queryUrl, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	basePath := fmt.Sprintf("/with_other_body")
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
+	}
+
+	queryUrl, err = queryUrl.Parse(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return queryUrl, nil
+}
+
+// GetOtherURL returns the URL of GetOther on server, with its parameters
+// serialized as the client serializes them.
+func GetOtherURL(server string) (*url.URL, error) {
+	var err error
+
+	queryUrl, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	basePath := fmt.Sprintf("/with_other_response")
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
+	}
+
+	queryUrl, err = queryUrl.Parse(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return queryUrl, nil
+}
+
+// GetWithServerURL returns the URL of GetWithServer on server, with its parameters
+// serialized as the client serializes them.
+func GetWithServerURL(server string) (*url.URL, error) {
+	var err error
+
+	queryUrl, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	basePath := fmt.Sprintf("/with_server_override")
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
+	}
+
+	queryUrl, err = queryUrl.Parse(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return queryUrl, nil
+}
+
+// GetJsonWithTrailingSlashURL returns the URL of GetJsonWithTrailingSlash on server, with its parameters
+// serialized as the client serializes them.
+func GetJsonWithTrailingSlashURL(server string) (*url.URL, error) {
+	var err error
+
+	queryUrl, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	basePath := fmt.Sprintf("/with_trailing_slash/")
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
+	}
+
+	queryUrl, err = queryUrl.Parse(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return queryUrl, nil
+}
+
+// OperationRoute describes an operation of the API, whatever the router serving
+// it.
+type OperationRoute struct {
+	Method      string // The HTTP method, eg. GET
+	Path        string // The path template of the spec, eg. /pets/{id}
+	OperationId string // The operation ID, as the names of the generated code use it
+}
+
+// OperationRoutes lists the operations of the API, in the order of their paths.
+var OperationRoutes = []OperationRoute{
+	{Method: "GET", Path: "/objects/{id}", OperationId: "GetObject"},
+	{Method: "POST", Path: "/with_both_bodies", OperationId: "PostBoth"},
+	{Method: "GET", Path: "/with_both_responses", OperationId: "GetBoth"},
+	{Method: "GET", Path: "/with_cursor_pagination", OperationId: "ListCursor"},
+	{Method: "POST", Path: "/with_download", OperationId: "PostDownload"},
+	{Method: "GET", Path: "/with_error_responses", OperationId: "GetWithErrors"},
+	{Method: "GET", Path: "/with_event_stream", OperationId: "GetEvents"},
+	{Method: "POST", Path: "/with_json_body", OperationId: "PostJson"},
+	{Method: "GET", Path: "/with_json_response", OperationId: "GetJson"},
+	{Method: "GET", Path: "/with_link_pagination", OperationId: "ListLink"},
+	{Method: "GET", Path: "/with_ndjson", OperationId: "GetExport"},
+	{Method: "GET", Path: "/with_offset_pagination", OperationId: "ListOffset"},
+	{Method: "POST", Path: "/with_other_body", OperationId: "PostOther"},
+	{Method: "GET", Path: "/with_other_response", OperationId: "GetOther"},
+	{Method: "GET", Path: "/with_server_override", OperationId: "GetWithServer"},
+	{Method: "GET", Path: "/with_trailing_slash/", OperationId: "GetJsonWithTrailingSlash"},
+}
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// ResponseEditorFn is the function signature for the ResponseEditor callback
+// function, which may inspect or replace the response before it is parsed
+type ResponseEditorFn func(ctx context.Context, rsp *http.Response) error
+
+// RequestValidatorFn is the function signature for the callback validating
+// requests before they are sent, given the server they were built against
+type RequestValidatorFn func(ctx context.Context, server string, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before
+	// sending over the network, in the order they are run.
+	RequestEditors []RequestEditorFn
+
+	// A callback for modifying requests which are generated before sending over
+	// the network, which runs before RequestEditors.
+	//
+	// Deprecated: use RequestEditors, or WithRequestEditorFn.
+	RequestEditor RequestEditorFn
+
+	// A list of callbacks for inspecting responses before they are returned,
+	// in the order they are run.
+	ResponseEditors []ResponseEditorFn
+
+	// A callback validating requests once the request editors ran, requests
+	// failing it aren't sent. See WithRequestValidation.
+	RequestValidator RequestValidatorFn
+
+	// How failed requests are retried, they aren't when nil.
+	RetryPolicy *RetryPolicy
+
+	// The servers of the operations which override the servers of the API,
+	// keyed by operation ID, when they aren't the first of their servers. See
+	// WithOperationServer.
+	OperationServers map[string]string
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = http.DefaultClient
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+// It may be given more than once, the callbacks are called in the same order.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// WithResponseEditorFn allows setting up a callback function, which will be
+// called with every response, before it is returned or parsed. It may be
+// given more than once, the callbacks are called in the same order.
+func WithResponseEditorFn(fn ResponseEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.ResponseEditors = append(c.ResponseEditors, fn)
+		return nil
+	}
+}
+
+// applyEditors runs the request editors of the client, followed by those
+// given for this call only.
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	if c.RequestEditor != nil {
+		if err := c.RequestEditor(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RetryPolicy describes how the client retries requests which failed with a
+// network error, a 5xx or a 429 response.
+type RetryPolicy struct {
+	// The number of retries after the first attempt.
+	MaxRetries int
+
+	// The backoff before the first retry, it doubles for each of the
+	// following ones, with some jitter so that clients don't retry in lockstep.
+	MinBackoff time.Duration
+
+	// The upper bound of any backoff, including those asked for by the server
+	// through a Retry-After header.
+	MaxBackoff time.Duration
+
+	// Whether to retry operations which aren't idempotent. Operations are
+	// idempotent when their method is, or when marked with x-idempotent.
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryPolicy returns a policy retrying idempotent operations three
+// times, backing off from 100ms up to 10s.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries: 3,
+		MinBackoff: 100 * time.Millisecond,
+		MaxBackoff: 10 * time.Second,
+	}
+}
+
+// WithRetryPolicy allows retrying failed requests. Request bodies are buffered
+// so that they can be sent again.
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(c *Client) error {
+		c.RetryPolicy = &policy
+		return nil
+	}
+}
+
+// retryJitter randomizes backoffs, it isn't safe for concurrent use.
+var (
+	retryJitter   = rand.New(rand.NewSource(time.Now().UnixNano()))
+	retryJitterMu sync.Mutex
+)
+
+// do sends the request, and runs the response editors of the client on its
+// response.
+func (c *Client) do(req *http.Request, idempotent bool) (*http.Response, error) {
+	rsp, err := c.send(req, idempotent)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range c.ResponseEditors {
+		if err := r(req.Context(), rsp); err != nil {
+			rsp.Body.Close()
+			return nil, err
+		}
+	}
+	return rsp, nil
+}
+
+// send sends the request, retrying it according to the retry policy of the
+// client.
+func (c *Client) send(req *http.Request, idempotent bool) (*http.Response, error) {
+	policy := c.RetryPolicy
+	if policy == nil || policy.MaxRetries <= 0 || !(idempotent || policy.RetryNonIdempotent) {
+		return c.Client.Do(req)
+	}
+
+	// The body is consumed by each attempt, so we need a fresh one for every
+	// retry.
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		buf, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.GetBody = func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(bytes.NewReader(buf)), nil
+		}
+		req.Body, _ = req.GetBody()
+	}
+
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+		rsp, err := c.Client.Do(req)
+		if attempt >= policy.MaxRetries || !shouldRetry(req, rsp, err) {
+			return rsp, err
+		}
+
+		backoff := policy.backoff(attempt, rsp)
+		if rsp != nil {
+			// Drain the body, so that the connection can be reused.
+			io.Copy(ioutil.Discard, rsp.Body)
+			rsp.Body.Close()
+		}
+		timer := time.NewTimer(backoff)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func shouldRetry(req *http.Request, rsp *http.Response, err error) bool {
+	if err != nil {
+		// Nothing to retry once the caller gave up.
+		return req.Context().Err() == nil
+	}
+	return rsp.StatusCode == http.StatusTooManyRequests || rsp.StatusCode >= 500
+}
+
+// backoff returns how long to wait before the given retry, preferring the
+// delay asked for by the Retry-After header of the response.
+func (p *RetryPolicy) backoff(attempt int, rsp *http.Response) time.Duration {
+	if rsp != nil {
+		if after, ok := retryAfter(rsp.Header.Get("Retry-After")); ok {
+			if p.MaxBackoff > 0 && after > p.MaxBackoff {
+				return p.MaxBackoff
+			}
+			return after
+		}
+	}
+
+	backoff := p.MinBackoff << uint(attempt)
+	if backoff <= 0 || (p.MaxBackoff > 0 && backoff > p.MaxBackoff) {
+		backoff = p.MaxBackoff
+	}
+	if backoff <= 0 {
+		return 0
+	}
+	// Wait somewhere between half and all of the backoff.
+	retryJitterMu.Lock()
+	jitter := time.Duration(retryJitter.Int63n(int64(backoff)/2 + 1))
+	retryJitterMu.Unlock()
+	return backoff/2 + jitter
+}
+
+// retryAfter parses a Retry-After header, which either holds a number of
+// seconds or a date.
+func retryAfter(header string) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(header); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(header); err == nil {
+		after := time.Until(date)
+		if after < 0 {
+			after = 0
+		}
+		return after, true
+	}
+	return 0, false
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// GetObject request
+	GetObject(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostBoth request  with any body
+	PostBothWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostBoth(ctx context.Context, body PostBothJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetBoth request
+	GetBoth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListCursor request
+	ListCursor(ctx context.Context, params *ListCursorParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostDownload request  with any body
+	PostDownloadWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostDownload(ctx context.Context, body PostDownloadJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWithErrors request
+	GetWithErrors(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetEvents request
+	GetEvents(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostJson request  with any body
+	PostJsonWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostJson(ctx context.Context, body PostJsonJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetJson request
+	GetJson(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListLink request
+	ListLink(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetExport request
+	GetExport(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListOffset request
+	ListOffset(ctx context.Context, params *ListOffsetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostOther request  with any body
+	PostOtherWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetOther request
+	GetOther(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWithServer request
+	GetWithServer(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetJsonWithTrailingSlash request
+	GetJsonWithTrailingSlash(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) GetObject(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server := c.Server
+	req, err := NewGetObjectRequest(server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
+			return nil, err
+		}
+	}
+	return c.do(req, true)
+}
+
+func (c *Client) PostBothWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server := c.Server
+	req, err := NewPostBothRequestWithBody(server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
+			return nil, err
+		}
+	}
+	return c.do(req, false)
+}
+
+func (c *Client) PostBoth(ctx context.Context, body PostBothJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server := c.Server
+	req, err := NewPostBothRequest(server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
+			return nil, err
+		}
+	}
+	return c.do(req, false)
+}
+
+func (c *Client) GetBoth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server := c.Server
+	req, err := NewGetBothRequest(server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
+			return nil, err
+		}
+	}
+	return c.do(req, true)
+}
+
+func (c *Client) ListCursor(ctx context.Context, params *ListCursorParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server := c.Server
+	req, err := NewListCursorRequest(server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
+			return nil, err
+		}
+	}
+	return c.do(req, true)
+}
+
+func (c *Client) PostDownloadWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server := c.Server
+	req, err := NewPostDownloadRequestWithBody(server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
+			return nil, err
+		}
+	}
+	return c.do(req, false)
+}
+
+func (c *Client) PostDownload(ctx context.Context, body PostDownloadJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server := c.Server
+	req, err := NewPostDownloadRequest(server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
+			return nil, err
+		}
+	}
+	return c.do(req, false)
+}
+
+func (c *Client) GetWithErrors(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server := c.Server
+	req, err := NewGetWithErrorsRequest(server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
+			return nil, err
+		}
+	}
+	return c.do(req, true)
+}
+
+func (c *Client) GetEvents(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server := c.Server
+	req, err := NewGetEventsRequest(server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
+			return nil, err
+		}
+	}
+	return c.do(req, true)
+}
+
+func (c *Client) PostJsonWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server := c.Server
+	req, err := NewPostJsonRequestWithBody(server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
+			return nil, err
+		}
+	}
+	return c.do(req, false)
+}
+
+func (c *Client) PostJson(ctx context.Context, body PostJsonJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server := c.Server
+	req, err := NewPostJsonRequest(server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
+			return nil, err
+		}
+	}
+	return c.do(req, false)
+}
+
+func (c *Client) GetJson(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server := c.Server
+	req, err := NewGetJsonRequest(server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
+			return nil, err
+		}
+	}
+	return c.do(req, true)
+}
+
+func (c *Client) ListLink(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server := c.Server
+	req, err := NewListLinkRequest(server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
+			return nil, err
+		}
+	}
+	return c.do(req, true)
+}
+
+func (c *Client) GetExport(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server := c.Server
+	req, err := NewGetExportRequest(server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
+			return nil, err
+		}
+	}
+	return c.do(req, true)
+}
+
+func (c *Client) ListOffset(ctx context.Context, params *ListOffsetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server := c.Server
+	req, err := NewListOffsetRequest(server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
+			return nil, err
+		}
+	}
+	return c.do(req, true)
+}
+
+func (c *Client) PostOtherWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server := c.Server
+	req, err := NewPostOtherRequestWithBody(server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
+			return nil, err
+		}
+	}
+	return c.do(req, true)
+}
+
+func (c *Client) GetOther(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server := c.Server
+	req, err := NewGetOtherRequest(server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
+			return nil, err
+		}
+	}
+	return c.do(req, true)
+}
+
+func (c *Client) GetWithServer(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server, err := c.operationServer("GetWithServer")
+	if err != nil {
+		return nil, err
+	}
+	req, err := NewGetWithServerRequest(server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
+			return nil, err
+		}
+	}
+	return c.do(req, true)
+}
+
+func (c *Client) GetJsonWithTrailingSlash(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server := c.Server
+	req, err := NewGetJsonWithTrailingSlashRequest(server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
+			return nil, err
+		}
+	}
+	return c.do(req, true)
+}
+
+// NewGetObjectRequest generates requests for GetObject
+func NewGetObjectRequest(server string, id int) (*http.Request, error) {
+	queryUrl, err := GetObjectURL(server, id)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryUrl.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostBothRequest calls the generic PostBoth builder with application/json body
+func NewPostBothRequest(server string, body PostBothJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostBothRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostBothRequestWithBody generates requests for PostBoth with any type of body
+func NewPostBothRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	queryUrl, err := PostBothURL(server)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryUrl.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+	return req, nil
+}
+
+// NewGetBothRequest generates requests for GetBoth
+func NewGetBothRequest(server string) (*http.Request, error) {
+	queryUrl, err := GetBothURL(server)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryUrl.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListCursorRequest generates requests for ListCursor
+func NewListCursorRequest(server string, params *ListCursorParams) (*http.Request, error) {
+	queryUrl, err := ListCursorURL(server, params)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryUrl.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostDownloadRequest calls the generic PostDownload builder with application/json body
+func NewPostDownloadRequest(server string, body PostDownloadJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostDownloadRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostDownloadRequestWithBody generates requests for PostDownload with any type of body
+func NewPostDownloadRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	queryUrl, err := PostDownloadURL(server)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryUrl.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+	return req, nil
+}
+
+// NewGetWithErrorsRequest generates requests for GetWithErrors
+func NewGetWithErrorsRequest(server string) (*http.Request, error) {
+	queryUrl, err := GetWithErrorsURL(server)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryUrl.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetEventsRequest generates requests for GetEvents
+func NewGetEventsRequest(server string) (*http.Request, error) {
+	queryUrl, err := GetEventsURL(server)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryUrl.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostJsonRequest calls the generic PostJson builder with application/json body
+func NewPostJsonRequest(server string, body PostJsonJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostJsonRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostJsonRequestWithBody generates requests for PostJson with any type of body
+func NewPostJsonRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	queryUrl, err := PostJsonURL(server)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryUrl.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+	return req, nil
+}
+
+// NewGetJsonRequest generates requests for GetJson
+func NewGetJsonRequest(server string) (*http.Request, error) {
+	queryUrl, err := GetJsonURL(server)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryUrl.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListLinkRequest generates requests for ListLink
+func NewListLinkRequest(server string) (*http.Request, error) {
+	queryUrl, err := ListLinkURL(server)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryUrl.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetExportRequest generates requests for GetExport
+func NewGetExportRequest(server string) (*http.Request, error) {
+	queryUrl, err := GetExportURL(server)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryUrl.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListOffsetRequest generates requests for ListOffset
+func NewListOffsetRequest(server string, params *ListOffsetParams) (*http.Request, error) {
+	queryUrl, err := ListOffsetURL(server, params)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryUrl.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostOtherRequestWithBody generates requests for PostOther with any type of body
+func NewPostOtherRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	queryUrl, err := PostOtherURL(server)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryUrl.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+	return req, nil
+}
+
+// NewGetOtherRequest generates requests for GetOther
+func NewGetOtherRequest(server string) (*http.Request, error) {
+	queryUrl, err := GetOtherURL(server)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryUrl.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetWithServerRequest generates requests for GetWithServer
+func NewGetWithServerRequest(server string) (*http.Request, error) {
+	queryUrl, err := GetWithServerURL(server)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryUrl.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetJsonWithTrailingSlashRequest generates requests for GetJsonWithTrailingSlash
+func NewGetJsonWithTrailingSlashRequest(server string) (*http.Request, error) {
+	queryUrl, err := GetJsonWithTrailingSlashURL(server)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryUrl.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// serverDefinition is a server of the API, whose URL may hold variables.
+type serverDefinition struct {
+	url       string
+	variables []serverVariable
+}
+
+// serverVariable is a variable of the URL of a server.
+type serverVariable struct {
+	name         string
+	defaultValue string
+	enum         []string
+}
+
+// ServerVariables holds the values of the variables of the URL of a server. It
+// is one of the types generated for the servers with variables, such as those
+// given to WithServer.
+type ServerVariables interface {
+	// serverURL returns the URL of the server whose variables these are.
+	serverURL() string
+	// values returns the values of the variables, by name.
+	values() map[string]string
+}
+
+// resolve replaces the variables in the URL of the server with their values,
+// or their defaults, checking them against their enum. vars must be those of
+// the server, or nil.
+func (s serverDefinition) resolve(vars ServerVariables) (string, error) {
+	var values map[string]string
+	if vars != nil {
+		if vars.serverURL() != s.url {
+			return "", fmt.Errorf("variables of server %s given for server %s", vars.serverURL(), s.url)
+		}
+		values = vars.values()
+	}
+
+	serverURL := s.url
+	for _, v := range s.variables {
+		value := values[v.name]
+		if value == "" {
+			value = v.defaultValue
+		}
+		if value == "" {
+			return "", fmt.Errorf("missing value for variable %s of server %s", v.name, s.url)
+		}
+		valid := len(v.enum) == 0
+		for _, e := range v.enum {
+			valid = valid || value == e
+		}
+		if !valid {
+			return "", fmt.Errorf("invalid value %q for variable %s of server %s, must be one of: %s", value, v.name, s.url, strings.Join(v.enum, ", "))
+		}
+		serverURL = strings.Replace(serverURL, "{"+v.name+"}", value, -1)
+	}
+	return serverURL, nil
+}
+
+// servers lists the servers of the API, in the order of the spec.
+var servers = []serverDefinition{
+	{url: "https://{region}.api.example.com/{basePath}", variables: []serverVariable{
+		{name: "basePath", defaultValue: "v1"},
+		{name: "region", defaultValue: "eu", enum: []string{"eu", "us"}},
+	}},
+	{url: "http://localhost:8080"},
+}
+
+// operationServers lists the servers of the operations which override those
+// of the API.
+var operationServers = map[string][]serverDefinition{
+	"GetWithServer": {
+		{url: "https://uploads.example.com/{version}", variables: []serverVariable{
+			{name: "version", defaultValue: "v2", enum: []string{"v1", "v2"}},
+		}},
+		{url: "https://uploads.example.org"},
+	},
+}
+
+// ServerProductionVariables holds the variables of the URL of the Production server,
+// https://{region}.api.example.com/{basePath}
+type ServerProductionVariables struct {
+	// Defaults to v1 when empty.
+	BasePath string
+	// The region of the deployment
+	// One of: eu, us
+	// Defaults to eu when empty.
+	Region string
+}
+
+func (vars ServerProductionVariables) serverURL() string {
+	return "https://{region}.api.example.com/{basePath}"
+}
+
+func (vars ServerProductionVariables) values() map[string]string {
+	return map[string]string{
+		"basePath": vars.BasePath,
+		"region":   vars.Region,
+	}
+}
+
+// ServerURLProduction returns the URL of the Production server, after checking
+// the variables against their enum.
+func ServerURLProduction(vars ServerProductionVariables) (string, error) {
+	return servers[0].resolve(vars)
+}
+
+// ServerURLLocal is the URL of the Local server.
+const ServerURLLocal = "http://localhost:8080"
+
+// GetWithServerServer0Variables holds the variables of the URL of server 0 of
+// GetWithServer, https://uploads.example.com/{version}
+type GetWithServerServer0Variables struct {
+	// One of: v1, v2
+	// Defaults to v2 when empty.
+	Version string
+}
+
+func (vars GetWithServerServer0Variables) serverURL() string {
+	return "https://uploads.example.com/{version}"
+}
+
+func (vars GetWithServerServer0Variables) values() map[string]string {
+	return map[string]string{
+		"version": vars.Version,
+	}
+}
+
+// WithServer sets the server of the client to one of the servers of the API,
+// by its index in the spec. Its variables take the given values, which must
+// be the variables of that server, such as a ServerProductionVariables for a
+// production server. They are checked against their enum, and take their
+// default when empty, or when vars is nil.
+func WithServer(index int, vars ServerVariables) ClientOption {
+	return func(c *Client) error {
+		if index < 0 || index >= len(servers) {
+			return fmt.Errorf("no server at index %d", index)
+		}
+		server, err := servers[index].resolve(vars)
+		if err != nil {
+			return err
+		}
+		c.Server = server
+		return nil
+	}
+}
+
+// WithOperationServer sets the server of an operation which overrides the
+// servers of the API to another of its servers, by its index in the spec. Its
+// variables are handled as by WithServer, and are of the types named after the
+// operation, such as GetPetServer0Variables.
+func WithOperationServer(operationID string, index int, vars ServerVariables) ClientOption {
+	return func(c *Client) error {
+		defs := operationServers[operationID]
+		if index < 0 || index >= len(defs) {
+			return fmt.Errorf("operation %s has no server at index %d", operationID, index)
+		}
+		server, err := defs[index].resolve(vars)
+		if err != nil {
+			return err
+		}
+		if c.OperationServers == nil {
+			c.OperationServers = map[string]string{}
+		}
+		c.OperationServers[operationID] = server
+		return nil
+	}
+}
+
+// operationServer returns the server of an operation which overrides the
+// servers of the API, which is its first one unless set otherwise.
+func (c *Client) operationServer(operationID string) (string, error) {
+	server, found := c.OperationServers[operationID]
+	if !found {
+		var err error
+		server, err = operationServers[operationID][0].resolve(nil)
+		if err != nil {
+			return "", err
+		}
+	}
+	if !strings.HasSuffix(server, "/") {
+		server += "/"
+	}
+	return server, nil
+}
+
+// RequestValidationError is returned by the client for a request which
+// doesn't conform to the spec, it isn't sent.
+type RequestValidationError struct {
+	Method string
+	URL    string
+	// Why the request is invalid, usually an *openapi3filter.RequestError,
+	// or an *openapi3filter.RouteError when it matches no operation.
+	Err error
+}
+
+func (e *RequestValidationError) Error() string {
+	return fmt.Sprintf("invalid request %s %s: %s", e.Method, e.URL, e.Err)
+}
+
+// Cause returns why the request is invalid.
+func (e *RequestValidationError) Cause() error {
+	return e.Err
+}
+
+// Unwrap returns why the request is invalid.
+func (e *RequestValidationError) Unwrap() error {
+	return e.Err
+}
+
+// WithRequestValidation validates the parameters and bodies of requests
+// against the given spec, usually the one returned by GetSwagger, before they
+// are sent. Requests are validated once the request editors ran, so security
+// requirements are assumed to be met.
+func WithRequestValidation(swagger *openapi3.Swagger) ClientOption {
+	return func(c *Client) error {
+		// Requests are matched against their path relative to the server of
+		// the client, which may be none of the servers of the spec.
+		spec := *swagger
+		spec.Servers = nil
+		router := openapi3filter.NewRouter()
+		if err := router.AddSwagger(&spec); err != nil {
+			return fmt.Errorf("error loading spec for request validation: %s", err)
+		}
+		c.RequestValidator = func(ctx context.Context, server string, req *http.Request) error {
+			return validateRequest(ctx, router, server, req)
+		}
+		return nil
+	}
+}
+
+// validateRequest validates a request built against the given server.
+func validateRequest(ctx context.Context, router *openapi3filter.Router, server string, req *http.Request) error {
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return err
+	}
+	invalid := func(err error) error {
+		return &RequestValidationError{Method: req.Method, URL: req.URL.String(), Err: err}
+	}
+
+	path := strings.TrimPrefix(req.URL.Path, strings.TrimSuffix(serverURL.Path, "/"))
+	route, pathParams, err := router.FindRoute(req.Method, &url.URL{Path: path})
+	if err != nil {
+		return invalid(err)
+	}
+	input := &openapi3filter.RequestValidationInput{
+		Request:    req,
+		PathParams: pathParams,
+		Route:      route,
+		Options: &openapi3filter.Options{
+			AuthenticationFunc: func(context.Context, *openapi3filter.AuthenticationInput) error {
+				return nil
+			},
+		},
+	}
+	if err := openapi3filter.ValidateRequest(ctx, input); err != nil {
+		return invalid(err)
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetObject request
+	GetObjectWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetObjectResponse, error)
+
+	// PostBoth request  with any body
+	PostBothWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostBothResponse, error)
+
+	PostBothWithResponse(ctx context.Context, body PostBothJSONRequestBody, reqEditors ...RequestEditorFn) (*PostBothResponse, error)
+
+	// GetBoth request
+	GetBothWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetBothResponse, error)
+
+	// ListCursor request
+	ListCursorWithResponse(ctx context.Context, params *ListCursorParams, reqEditors ...RequestEditorFn) (*ListCursorResponse, error)
+
+	// PostDownload request  with any body
+	PostDownloadWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostDownloadResponse, error)
+
+	PostDownloadWithResponse(ctx context.Context, body PostDownloadJSONRequestBody, reqEditors ...RequestEditorFn) (*PostDownloadResponse, error)
+
+	// GetWithErrors request
+	GetWithErrorsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWithErrorsResponse, error)
+
+	// GetEvents request
+	GetEventsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetEventsResponse, error)
+
+	// PostJson request  with any body
+	PostJsonWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostJsonResponse, error)
+
+	PostJsonWithResponse(ctx context.Context, body PostJsonJSONRequestBody, reqEditors ...RequestEditorFn) (*PostJsonResponse, error)
+
+	// GetJson request
+	GetJsonWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetJsonResponse, error)
+
+	// ListLink request
+	ListLinkWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListLinkResponse, error)
+
+	// GetExport request
+	GetExportWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetExportResponse, error)
+
+	// ListOffset request
+	ListOffsetWithResponse(ctx context.Context, params *ListOffsetParams, reqEditors ...RequestEditorFn) (*ListOffsetResponse, error)
+
+	// PostOther request  with any body
+	PostOtherWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOtherResponse, error)
+
+	// GetOther request
+	GetOtherWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOtherResponse, error)
+
+	// GetWithServer request
+	GetWithServerWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWithServerResponse, error)
+
+	// GetJsonWithTrailingSlash request
+	GetJsonWithTrailingSlashWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetJsonWithTrailingSlashResponse, error)
+}
+
+type GetObjectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SchemaObject
+	JSON404      *ErrorObject
+}
+
+// Status returns HTTPResponse.Status
+func (r GetObjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetObjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostBothResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostBothResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostBothResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBothResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SchemaObject
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBothResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBothResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListCursorResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Data *[]SchemaObject `json:"data,omitempty"`
+		Meta *struct {
+			Next *string `json:"next,omitempty"`
+		} `json:"meta,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r ListCursorResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListCursorResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostDownloadResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostDownloadResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostDownloadResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWithErrorsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SchemaObject
+	JSON404      *ErrorObject
+	JSONDefault  *ErrorObject
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWithErrorsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWithErrorsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetEventsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostJsonResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostJsonResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostJsonResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetJsonResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SchemaObject
+}
+
+// Status returns HTTPResponse.Status
+func (r GetJsonResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetJsonResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListLinkResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Data *[]SchemaObject `json:"data,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r ListLinkResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListLinkResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetExportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetExportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetExportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListOffsetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]SchemaObject
+}
+
+// Status returns HTTPResponse.Status
+func (r ListOffsetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListOffsetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostOtherResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostOtherResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostOtherResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOtherResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOtherResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOtherResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWithServerResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWithServerResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWithServerResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetJsonWithTrailingSlashResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SchemaObject
+}
+
+// Status returns HTTPResponse.Status
+func (r GetJsonWithTrailingSlashResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetJsonWithTrailingSlashResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetObjectWithResponse request returning *GetObjectResponse
+func (c *ClientWithResponses) GetObjectWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetObjectResponse, error) {
+	rsp, err := c.GetObject(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetObjectResponse(rsp)
+}
+
+// PostBothWithBodyWithResponse request with arbitrary body returning *PostBothResponse
+func (c *ClientWithResponses) PostBothWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostBothResponse, error) {
+	rsp, err := c.PostBothWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostBothResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostBothWithResponse(ctx context.Context, body PostBothJSONRequestBody, reqEditors ...RequestEditorFn) (*PostBothResponse, error) {
+	rsp, err := c.PostBoth(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostBothResponse(rsp)
+}
+
+// GetBothWithResponse request returning *GetBothResponse
+func (c *ClientWithResponses) GetBothWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetBothResponse, error) {
+	rsp, err := c.GetBoth(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBothResponse(rsp)
+}
+
+// ListCursorWithResponse request returning *ListCursorResponse
+func (c *ClientWithResponses) ListCursorWithResponse(ctx context.Context, params *ListCursorParams, reqEditors ...RequestEditorFn) (*ListCursorResponse, error) {
+	rsp, err := c.ListCursor(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListCursorResponse(rsp)
+}
+
+// PostDownloadWithBodyWithResponse request with arbitrary body returning *PostDownloadResponse
+func (c *ClientWithResponses) PostDownloadWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostDownloadResponse, error) {
+	rsp, err := c.PostDownloadWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostDownloadResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostDownloadWithResponse(ctx context.Context, body PostDownloadJSONRequestBody, reqEditors ...RequestEditorFn) (*PostDownloadResponse, error) {
+	rsp, err := c.PostDownload(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostDownloadResponse(rsp)
+}
+
+// GetWithErrorsWithResponse request returning *GetWithErrorsResponse
+func (c *ClientWithResponses) GetWithErrorsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWithErrorsResponse, error) {
+	rsp, err := c.GetWithErrors(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWithErrorsResponse(rsp)
+}
+
+// GetEventsWithResponse request returning *GetEventsResponse
+func (c *ClientWithResponses) GetEventsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetEventsResponse, error) {
+	rsp, err := c.GetEvents(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEventsResponse(rsp)
+}
+
+// PostJsonWithBodyWithResponse request with arbitrary body returning *PostJsonResponse
+func (c *ClientWithResponses) PostJsonWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostJsonResponse, error) {
+	rsp, err := c.PostJsonWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostJsonResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostJsonWithResponse(ctx context.Context, body PostJsonJSONRequestBody, reqEditors ...RequestEditorFn) (*PostJsonResponse, error) {
+	rsp, err := c.PostJson(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostJsonResponse(rsp)
+}
+
+// GetJsonWithResponse request returning *GetJsonResponse
+func (c *ClientWithResponses) GetJsonWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetJsonResponse, error) {
+	rsp, err := c.GetJson(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetJsonResponse(rsp)
+}
+
+// ListLinkWithResponse request returning *ListLinkResponse
+func (c *ClientWithResponses) ListLinkWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListLinkResponse, error) {
+	rsp, err := c.ListLink(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListLinkResponse(rsp)
+}
+
+// GetExportWithResponse request returning *GetExportResponse
+func (c *ClientWithResponses) GetExportWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetExportResponse, error) {
+	rsp, err := c.GetExport(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetExportResponse(rsp)
+}
+
+// ListOffsetWithResponse request returning *ListOffsetResponse
+func (c *ClientWithResponses) ListOffsetWithResponse(ctx context.Context, params *ListOffsetParams, reqEditors ...RequestEditorFn) (*ListOffsetResponse, error) {
+	rsp, err := c.ListOffset(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListOffsetResponse(rsp)
+}
+
+// PostOtherWithBodyWithResponse request with arbitrary body returning *PostOtherResponse
+func (c *ClientWithResponses) PostOtherWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOtherResponse, error) {
+	rsp, err := c.PostOtherWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostOtherResponse(rsp)
+}
+
+// GetOtherWithResponse request returning *GetOtherResponse
+func (c *ClientWithResponses) GetOtherWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOtherResponse, error) {
+	rsp, err := c.GetOther(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOtherResponse(rsp)
+}
+
+// GetWithServerWithResponse request returning *GetWithServerResponse
+func (c *ClientWithResponses) GetWithServerWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWithServerResponse, error) {
+	rsp, err := c.GetWithServer(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWithServerResponse(rsp)
+}
+
+// GetJsonWithTrailingSlashWithResponse request returning *GetJsonWithTrailingSlashResponse
+func (c *ClientWithResponses) GetJsonWithTrailingSlashWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetJsonWithTrailingSlashResponse, error) {
+	rsp, err := c.GetJsonWithTrailingSlash(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetJsonWithTrailingSlashResponse(rsp)
+}
+
+// ParseGetObjectResponse parses an HTTP response from a GetObjectWithResponse call
+func ParseGetObjectResponse(rsp *http.Response) (*GetObjectResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetObjectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SchemaObject
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorObject
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostBothResponse parses an HTTP response from a PostBothWithResponse call
+func ParsePostBothResponse(rsp *http.Response) (*PostBothResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostBothResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	}
+
+	return response, nil
+}
+
+// ParseGetBothResponse parses an HTTP response from a GetBothWithResponse call
+func ParseGetBothResponse(rsp *http.Response) (*GetBothResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBothResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SchemaObject
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (application/octet-stream) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseListCursorResponse parses an HTTP response from a ListCursorWithResponse call
+func ParseListCursorResponse(rsp *http.Response) (*ListCursorResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListCursorResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Data *[]SchemaObject `json:"data,omitempty"`
+			Meta *struct {
+				Next *string `json:"next,omitempty"`
+			} `json:"meta,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostDownloadResponse parses an HTTP response from a PostDownloadWithResponse call
+func ParsePostDownloadResponse(rsp *http.Response) (*PostDownloadResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostDownloadResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	}
+
+	return response, nil
+}
+
+// ParseGetWithErrorsResponse parses an HTTP response from a GetWithErrorsWithResponse call
+func ParseGetWithErrorsResponse(rsp *http.Response) (*GetWithErrorsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWithErrorsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SchemaObject
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorObject
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json"):
+		var dest ErrorObject
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetEventsResponse parses an HTTP response from a GetEventsWithResponse call
+func ParseGetEventsResponse(rsp *http.Response) (*GetEventsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	}
+
+	return response, nil
+}
+
+// ParsePostJsonResponse parses an HTTP response from a PostJsonWithResponse call
+func ParsePostJsonResponse(rsp *http.Response) (*PostJsonResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostJsonResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	}
+
+	return response, nil
+}
+
+// ParseGetJsonResponse parses an HTTP response from a GetJsonWithResponse call
+func ParseGetJsonResponse(rsp *http.Response) (*GetJsonResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetJsonResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SchemaObject
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListLinkResponse parses an HTTP response from a ListLinkWithResponse call
+func ParseListLinkResponse(rsp *http.Response) (*ListLinkResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListLinkResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Data *[]SchemaObject `json:"data,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetExportResponse parses an HTTP response from a GetExportWithResponse call
+func ParseGetExportResponse(rsp *http.Response) (*GetExportResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetExportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	}
+
+	return response, nil
+}
+
+// ParseListOffsetResponse parses an HTTP response from a ListOffsetWithResponse call
+func ParseListOffsetResponse(rsp *http.Response) (*ListOffsetResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListOffsetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []SchemaObject
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostOtherResponse parses an HTTP response from a PostOtherWithResponse call
+func ParsePostOtherResponse(rsp *http.Response) (*PostOtherResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostOtherResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	}
+
+	return response, nil
+}
+
+// ParseGetOtherResponse parses an HTTP response from a GetOtherWithResponse call
+func ParseGetOtherResponse(rsp *http.Response) (*GetOtherResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOtherResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	}
+
+	return response, nil
+}
+
+// ParseGetWithServerResponse parses an HTTP response from a GetWithServerWithResponse call
+func ParseGetWithServerResponse(rsp *http.Response) (*GetWithServerResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWithServerResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	}
+
+	return response, nil
+}
+
+// ParseGetJsonWithTrailingSlashResponse parses an HTTP response from a GetJsonWithTrailingSlashWithResponse call
+func ParseGetJsonWithTrailingSlashResponse(rsp *http.Response) (*GetJsonWithTrailingSlashResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetJsonWithTrailingSlashResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SchemaObject
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// APIError is an error response from the server, with the payload of the
+// default response of the operation when the API documents one.
+type APIError struct {
+	Operation    string
+	StatusCode   int
+	Body         []byte
+	HTTPResponse *http.Response
+	// The decoded default response, eg. *Error, or nil
+	Model interface{}
+}
+
+// Error describes the response.
+func (e *APIError) Error() string {
+	return fmt.Sprintf("%s returned %d %s", e.Operation, e.StatusCode, http.StatusText(e.StatusCode))
+}
+
+// GetObjectError is returned by the OrError methods of GetObject for the documented error
+// responses of GetObject, with their decoded payload.
+type GetObjectError struct {
+	APIError
+	JSON404 *ErrorObject
+}
+
+// GetObjectOrError calls GetObjectWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) GetObjectOrError(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*SchemaObject, error) {
+	rsp, err := c.GetObjectWithResponse(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return getObjectOrError(rsp)
+}
+
+// getObjectOrError returns the payload of a success response to
+// GetObject, or an error for any other response.
+func getObjectOrError(rsp *GetObjectResponse) (*SchemaObject, error) {
+	if rsp.JSON200 != nil {
+		return rsp.JSON200, nil
+	}
+	if rsp.StatusCode() >= 200 && rsp.StatusCode() < 300 {
+		return nil, nil
+	}
+
+	apiErr := APIError{
+		Operation:    "GetObject",
+		StatusCode:   rsp.StatusCode(),
+		Body:         rsp.Body,
+		HTTPResponse: rsp.HTTPResponse,
+	}
+	switch rsp.StatusCode() {
+	case 404:
+		return nil, &GetObjectError{
+			APIError: apiErr,
+			JSON404:  rsp.JSON404,
+		}
+	}
+	return nil, &apiErr
+}
+
+// PostBothWithBodyOrError calls PostBothWithBodyWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) PostBothWithBodyOrError(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostBothResponse, error) {
+	rsp, err := c.PostBothWithBodyWithResponse(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return postBothOrError(rsp)
+}
+
+// PostBothOrError calls PostBothWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) PostBothOrError(ctx context.Context, body PostBothJSONRequestBody, reqEditors ...RequestEditorFn) (*PostBothResponse, error) {
+	rsp, err := c.PostBothWithResponse(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return postBothOrError(rsp)
+}
+
+// postBothOrError returns the payload of a success response to
+// PostBoth, or an error for any other response.
+func postBothOrError(rsp *PostBothResponse) (*PostBothResponse, error) {
+	if rsp.StatusCode() >= 200 && rsp.StatusCode() < 300 {
+		return rsp, nil
+	}
+
+	apiErr := APIError{
+		Operation:    "PostBoth",
+		StatusCode:   rsp.StatusCode(),
+		Body:         rsp.Body,
+		HTTPResponse: rsp.HTTPResponse,
+	}
+	return nil, &apiErr
+}
+
+// GetBothOrError calls GetBothWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) GetBothOrError(ctx context.Context, reqEditors ...RequestEditorFn) (*SchemaObject, error) {
+	rsp, err := c.GetBothWithResponse(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return getBothOrError(rsp)
+}
+
+// getBothOrError returns the payload of a success response to
+// GetBoth, or an error for any other response.
+func getBothOrError(rsp *GetBothResponse) (*SchemaObject, error) {
+	if rsp.JSON200 != nil {
+		return rsp.JSON200, nil
+	}
+	if rsp.StatusCode() >= 200 && rsp.StatusCode() < 300 {
+		return nil, nil
+	}
+
+	apiErr := APIError{
+		Operation:    "GetBoth",
+		StatusCode:   rsp.StatusCode(),
+		Body:         rsp.Body,
+		HTTPResponse: rsp.HTTPResponse,
+	}
+	return nil, &apiErr
+}
+
+// ListCursorOrError calls ListCursorWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) ListCursorOrError(ctx context.Context, params *ListCursorParams, reqEditors ...RequestEditorFn) (*struct {
+	Data *[]SchemaObject `json:"data,omitempty"`
+	Meta *struct {
+		Next *string `json:"next,omitempty"`
+	} `json:"meta,omitempty"`
+}, error) {
+	rsp, err := c.ListCursorWithResponse(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return listCursorOrError(rsp)
+}
+
+// listCursorOrError returns the payload of a success response to
+// ListCursor, or an error for any other response.
+func listCursorOrError(rsp *ListCursorResponse) (*struct {
+	Data *[]SchemaObject `json:"data,omitempty"`
+	Meta *struct {
+		Next *string `json:"next,omitempty"`
+	} `json:"meta,omitempty"`
+}, error) {
+	if rsp.JSON200 != nil {
+		return rsp.JSON200, nil
+	}
+	if rsp.StatusCode() >= 200 && rsp.StatusCode() < 300 {
+		return nil, nil
+	}
+
+	apiErr := APIError{
+		Operation:    "ListCursor",
+		StatusCode:   rsp.StatusCode(),
+		Body:         rsp.Body,
+		HTTPResponse: rsp.HTTPResponse,
+	}
+	return nil, &apiErr
+}
+
+// PostDownloadWithBodyOrError calls PostDownloadWithBodyWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) PostDownloadWithBodyOrError(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostDownloadResponse, error) {
+	rsp, err := c.PostDownloadWithBodyWithResponse(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return postDownloadOrError(rsp)
+}
+
+// PostDownloadOrError calls PostDownloadWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) PostDownloadOrError(ctx context.Context, body PostDownloadJSONRequestBody, reqEditors ...RequestEditorFn) (*PostDownloadResponse, error) {
+	rsp, err := c.PostDownloadWithResponse(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return postDownloadOrError(rsp)
+}
+
+// postDownloadOrError returns the payload of a success response to
+// PostDownload, or an error for any other response.
+func postDownloadOrError(rsp *PostDownloadResponse) (*PostDownloadResponse, error) {
+	if rsp.StatusCode() >= 200 && rsp.StatusCode() < 300 {
+		return rsp, nil
+	}
+
+	apiErr := APIError{
+		Operation:    "PostDownload",
+		StatusCode:   rsp.StatusCode(),
+		Body:         rsp.Body,
+		HTTPResponse: rsp.HTTPResponse,
+	}
+	return nil, &apiErr
+}
+
+// GetWithErrorsError is returned by the OrError methods of GetWithErrors for the documented error
+// responses of GetWithErrors, with their decoded payload.
+type GetWithErrorsError struct {
+	APIError
+	JSON404 *ErrorObject
+}
+
+// GetWithErrorsOrError calls GetWithErrorsWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) GetWithErrorsOrError(ctx context.Context, reqEditors ...RequestEditorFn) (*SchemaObject, error) {
+	rsp, err := c.GetWithErrorsWithResponse(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return getWithErrorsOrError(rsp)
+}
+
+// getWithErrorsOrError returns the payload of a success response to
+// GetWithErrors, or an error for any other response.
+func getWithErrorsOrError(rsp *GetWithErrorsResponse) (*SchemaObject, error) {
+	if rsp.JSON200 != nil {
+		return rsp.JSON200, nil
+	}
+	if rsp.StatusCode() >= 200 && rsp.StatusCode() < 300 {
+		return nil, nil
+	}
+
+	apiErr := APIError{
+		Operation:    "GetWithErrors",
+		StatusCode:   rsp.StatusCode(),
+		Body:         rsp.Body,
+		HTTPResponse: rsp.HTTPResponse,
+	}
+	switch rsp.StatusCode() {
+	case 404, 409:
+		return nil, &GetWithErrorsError{
+			APIError: apiErr,
+			JSON404:  rsp.JSON404,
+		}
+	}
+	if rsp.JSONDefault != nil {
+		apiErr.Model = rsp.JSONDefault
+	}
+	return nil, &apiErr
+}
+
+// GetEventsOrError calls GetEventsWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) GetEventsOrError(ctx context.Context, reqEditors ...RequestEditorFn) (*GetEventsResponse, error) {
+	rsp, err := c.GetEventsWithResponse(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return getEventsOrError(rsp)
+}
+
+// getEventsOrError returns the payload of a success response to
+// GetEvents, or an error for any other response.
+func getEventsOrError(rsp *GetEventsResponse) (*GetEventsResponse, error) {
+	if rsp.StatusCode() >= 200 && rsp.StatusCode() < 300 {
+		return rsp, nil
+	}
+
+	apiErr := APIError{
+		Operation:    "GetEvents",
+		StatusCode:   rsp.StatusCode(),
+		Body:         rsp.Body,
+		HTTPResponse: rsp.HTTPResponse,
+	}
+	return nil, &apiErr
+}
+
+// PostJsonWithBodyOrError calls PostJsonWithBodyWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) PostJsonWithBodyOrError(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostJsonResponse, error) {
+	rsp, err := c.PostJsonWithBodyWithResponse(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return postJsonOrError(rsp)
+}
+
+// PostJsonOrError calls PostJsonWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) PostJsonOrError(ctx context.Context, body PostJsonJSONRequestBody, reqEditors ...RequestEditorFn) (*PostJsonResponse, error) {
+	rsp, err := c.PostJsonWithResponse(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return postJsonOrError(rsp)
+}
+
+// postJsonOrError returns the payload of a success response to
+// PostJson, or an error for any other response.
+func postJsonOrError(rsp *PostJsonResponse) (*PostJsonResponse, error) {
+	if rsp.StatusCode() >= 200 && rsp.StatusCode() < 300 {
+		return rsp, nil
+	}
+
+	apiErr := APIError{
+		Operation:    "PostJson",
+		StatusCode:   rsp.StatusCode(),
+		Body:         rsp.Body,
+		HTTPResponse: rsp.HTTPResponse,
+	}
+	return nil, &apiErr
+}
+
+// GetJsonOrError calls GetJsonWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) GetJsonOrError(ctx context.Context, reqEditors ...RequestEditorFn) (*SchemaObject, error) {
+	rsp, err := c.GetJsonWithResponse(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return getJsonOrError(rsp)
+}
+
+// getJsonOrError returns the payload of a success response to
+// GetJson, or an error for any other response.
+func getJsonOrError(rsp *GetJsonResponse) (*SchemaObject, error) {
+	if rsp.JSON200 != nil {
+		return rsp.JSON200, nil
+	}
+	if rsp.StatusCode() >= 200 && rsp.StatusCode() < 300 {
+		return nil, nil
+	}
+
+	apiErr := APIError{
+		Operation:    "GetJson",
+		StatusCode:   rsp.StatusCode(),
+		Body:         rsp.Body,
+		HTTPResponse: rsp.HTTPResponse,
+	}
+	return nil, &apiErr
+}
+
+// ListLinkOrError calls ListLinkWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) ListLinkOrError(ctx context.Context, reqEditors ...RequestEditorFn) (*struct {
+	Data *[]SchemaObject `json:"data,omitempty"`
+}, error) {
+	rsp, err := c.ListLinkWithResponse(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return listLinkOrError(rsp)
+}
+
+// listLinkOrError returns the payload of a success response to
+// ListLink, or an error for any other response.
+func listLinkOrError(rsp *ListLinkResponse) (*struct {
+	Data *[]SchemaObject `json:"data,omitempty"`
+}, error) {
+	if rsp.JSON200 != nil {
+		return rsp.JSON200, nil
+	}
+	if rsp.StatusCode() >= 200 && rsp.StatusCode() < 300 {
+		return nil, nil
+	}
+
+	apiErr := APIError{
+		Operation:    "ListLink",
+		StatusCode:   rsp.StatusCode(),
+		Body:         rsp.Body,
+		HTTPResponse: rsp.HTTPResponse,
+	}
+	return nil, &apiErr
+}
+
+// GetExportOrError calls GetExportWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) GetExportOrError(ctx context.Context, reqEditors ...RequestEditorFn) (*GetExportResponse, error) {
+	rsp, err := c.GetExportWithResponse(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return getExportOrError(rsp)
+}
+
+// getExportOrError returns the payload of a success response to
+// GetExport, or an error for any other response.
+func getExportOrError(rsp *GetExportResponse) (*GetExportResponse, error) {
+	if rsp.StatusCode() >= 200 && rsp.StatusCode() < 300 {
+		return rsp, nil
+	}
+
+	apiErr := APIError{
+		Operation:    "GetExport",
+		StatusCode:   rsp.StatusCode(),
+		Body:         rsp.Body,
+		HTTPResponse: rsp.HTTPResponse,
+	}
+	return nil, &apiErr
+}
+
+// ListOffsetOrError calls ListOffsetWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) ListOffsetOrError(ctx context.Context, params *ListOffsetParams, reqEditors ...RequestEditorFn) (*[]SchemaObject, error) {
+	rsp, err := c.ListOffsetWithResponse(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return listOffsetOrError(rsp)
+}
+
+// listOffsetOrError returns the payload of a success response to
+// ListOffset, or an error for any other response.
+func listOffsetOrError(rsp *ListOffsetResponse) (*[]SchemaObject, error) {
+	if rsp.JSON200 != nil {
+		return rsp.JSON200, nil
+	}
+	if rsp.StatusCode() >= 200 && rsp.StatusCode() < 300 {
+		return nil, nil
+	}
+
+	apiErr := APIError{
+		Operation:    "ListOffset",
+		StatusCode:   rsp.StatusCode(),
+		Body:         rsp.Body,
+		HTTPResponse: rsp.HTTPResponse,
+	}
+	return nil, &apiErr
+}
+
+// PostOtherWithBodyOrError calls PostOtherWithBodyWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) PostOtherWithBodyOrError(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOtherResponse, error) {
+	rsp, err := c.PostOtherWithBodyWithResponse(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return postOtherOrError(rsp)
+}
+
+// postOtherOrError returns the payload of a success response to
+// PostOther, or an error for any other response.
+func postOtherOrError(rsp *PostOtherResponse) (*PostOtherResponse, error) {
+	if rsp.StatusCode() >= 200 && rsp.StatusCode() < 300 {
+		return rsp, nil
+	}
+
+	apiErr := APIError{
+		Operation:    "PostOther",
+		StatusCode:   rsp.StatusCode(),
+		Body:         rsp.Body,
+		HTTPResponse: rsp.HTTPResponse,
+	}
+	return nil, &apiErr
+}
+
+// GetOtherOrError calls GetOtherWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) GetOtherOrError(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOtherResponse, error) {
+	rsp, err := c.GetOtherWithResponse(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return getOtherOrError(rsp)
+}
+
+// getOtherOrError returns the payload of a success response to
+// GetOther, or an error for any other response.
+func getOtherOrError(rsp *GetOtherResponse) (*GetOtherResponse, error) {
+	if rsp.StatusCode() >= 200 && rsp.StatusCode() < 300 {
+		return rsp, nil
+	}
+
+	apiErr := APIError{
+		Operation:    "GetOther",
+		StatusCode:   rsp.StatusCode(),
+		Body:         rsp.Body,
+		HTTPResponse: rsp.HTTPResponse,
+	}
+	return nil, &apiErr
+}
+
+// GetWithServerOrError calls GetWithServerWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) GetWithServerOrError(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWithServerResponse, error) {
+	rsp, err := c.GetWithServerWithResponse(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return getWithServerOrError(rsp)
+}
+
+// getWithServerOrError returns the payload of a success response to
+// GetWithServer, or an error for any other response.
+func getWithServerOrError(rsp *GetWithServerResponse) (*GetWithServerResponse, error) {
+	if rsp.StatusCode() >= 200 && rsp.StatusCode() < 300 {
+		return rsp, nil
+	}
+
+	apiErr := APIError{
+		Operation:    "GetWithServer",
+		StatusCode:   rsp.StatusCode(),
+		Body:         rsp.Body,
+		HTTPResponse: rsp.HTTPResponse,
+	}
+	return nil, &apiErr
+}
+
+// GetJsonWithTrailingSlashOrError calls GetJsonWithTrailingSlashWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) GetJsonWithTrailingSlashOrError(ctx context.Context, reqEditors ...RequestEditorFn) (*SchemaObject, error) {
+	rsp, err := c.GetJsonWithTrailingSlashWithResponse(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return getJsonWithTrailingSlashOrError(rsp)
+}
+
+// getJsonWithTrailingSlashOrError returns the payload of a success response to
+// GetJsonWithTrailingSlash, or an error for any other response.
+func getJsonWithTrailingSlashOrError(rsp *GetJsonWithTrailingSlashResponse) (*SchemaObject, error) {
+	if rsp.JSON200 != nil {
+		return rsp.JSON200, nil
+	}
+	if rsp.StatusCode() >= 200 && rsp.StatusCode() < 300 {
+		return nil, nil
+	}
+
+	apiErr := APIError{
+		Operation:    "GetJsonWithTrailingSlash",
+		StatusCode:   rsp.StatusCode(),
+		Body:         rsp.Body,
+		HTTPResponse: rsp.HTTPResponse,
+	}
+	return nil, &apiErr
+}
+
+// GetBothStream calls GetBoth, returning its application/octet-stream response as it
+// arrives, or an error for any response other than a success.
+func (c *ClientWithResponses) GetBothStream(ctx context.Context, reqEditors ...RequestEditorFn) (io.ReadCloser, error) {
+	rsp, err := c.GetBoth(ctx, streamEditors("application/octet-stream", reqEditors)...)
+	if err != nil {
+		return nil, err
+	}
+	return newGetBothStream(ctx, rsp)
+}
+
+func newGetBothStream(ctx context.Context, rsp *http.Response) (io.ReadCloser, error) {
+	if rsp.StatusCode < 200 || rsp.StatusCode >= 300 {
+		return nil, streamResponseError("GetBoth", rsp)
+	}
+	return rsp.Body, nil
+}
+
+// PostDownloadWithBodyStream calls PostDownloadWithBody, returning its application/octet-stream response as it
+// arrives, or an error for any response other than a success.
+func (c *ClientWithResponses) PostDownloadWithBodyStream(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (io.ReadCloser, error) {
+	rsp, err := c.PostDownloadWithBody(ctx, contentType, body, streamEditors("application/octet-stream", reqEditors)...)
+	if err != nil {
+		return nil, err
+	}
+	return newPostDownloadStream(ctx, rsp)
+}
+
+// PostDownloadStream calls PostDownload, returning its application/octet-stream response as it
+// arrives, or an error for any response other than a success.
+func (c *ClientWithResponses) PostDownloadStream(ctx context.Context, body PostDownloadJSONRequestBody, reqEditors ...RequestEditorFn) (io.ReadCloser, error) {
+	rsp, err := c.PostDownload(ctx, body, streamEditors("application/octet-stream", reqEditors)...)
+	if err != nil {
+		return nil, err
+	}
+	return newPostDownloadStream(ctx, rsp)
+}
+
+func newPostDownloadStream(ctx context.Context, rsp *http.Response) (io.ReadCloser, error) {
+	if rsp.StatusCode < 200 || rsp.StatusCode >= 300 {
+		return nil, streamResponseError("PostDownload", rsp)
+	}
+	return rsp.Body, nil
+}
+
+// GetEventsEventStream reads the events sent by GetEvents as they arrive,
+// it must be closed once done with.
+type GetEventsEventStream struct {
+	ctx    context.Context
+	rsp    *http.Response
+	events *sseReader
+	event  GetEventsEvent
+	err    error
+}
+
+// Next waits for the next event. It returns false once the stream is over,
+// or when it failed, see Err.
+func (s *GetEventsEventStream) Next() bool {
+	if s.err != nil {
+		return false
+	}
+	ev, err := s.events.next()
+	if err != nil {
+		s.err = streamError(s.ctx, err)
+		return false
+	}
+	s.event = GetEventsEvent{ID: ev.id, Event: ev.event, Retry: ev.retry}
+	if err := json.Unmarshal([]byte(ev.data), &s.event.Data); err != nil {
+		s.err = fmt.Errorf("error decoding event of GetEvents: %s", err)
+		return false
+	}
+	return true
+}
+
+// Event returns the current event.
+func (s *GetEventsEventStream) Event() GetEventsEvent {
+	return s.event
+}
+
+// Err returns the error which ended the stream, if any.
+func (s *GetEventsEventStream) Err() error {
+	return s.err
+}
+
+// Close ends the stream.
+func (s *GetEventsEventStream) Close() error {
+	return s.rsp.Body.Close()
+}
+
+// GetEventsStream calls GetEvents, returning its text/event-stream response as it
+// arrives, or an error for any response other than a success.
+func (c *ClientWithResponses) GetEventsStream(ctx context.Context, reqEditors ...RequestEditorFn) (*GetEventsEventStream, error) {
+	rsp, err := c.GetEvents(ctx, streamEditors("text/event-stream", reqEditors)...)
+	if err != nil {
+		return nil, err
+	}
+	return newGetEventsStream(ctx, rsp)
+}
+
+func newGetEventsStream(ctx context.Context, rsp *http.Response) (*GetEventsEventStream, error) {
+	if rsp.StatusCode < 200 || rsp.StatusCode >= 300 {
+		return nil, streamResponseError("GetEvents", rsp)
+	}
+	return &GetEventsEventStream{ctx: ctx, rsp: rsp, events: newSSEReader(rsp.Body)}, nil
+}
+
+// GetExportDecoder decodes the lines sent by GetExport as they arrive, it
+// must be closed once done with.
+type GetExportDecoder struct {
+	ctx  context.Context
+	rsp  *http.Response
+	dec  *json.Decoder
+	item SchemaObject
+	err  error
+}
+
+// Next waits for the next line. It returns false once the stream is over,
+// or when it failed, see Err.
+func (d *GetExportDecoder) Next() bool {
+	if d.err != nil {
+		return false
+	}
+	var item SchemaObject
+	if err := d.dec.Decode(&item); err != nil {
+		d.err = streamError(d.ctx, err)
+		return false
+	}
+	d.item = item
+	return true
+}
+
+// Item returns the current line.
+func (d *GetExportDecoder) Item() SchemaObject {
+	return d.item
+}
+
+// Err returns the error which ended the stream, if any.
+func (d *GetExportDecoder) Err() error {
+	return d.err
+}
+
+// Close ends the stream.
+func (d *GetExportDecoder) Close() error {
+	return d.rsp.Body.Close()
+}
+
+// GetExportStream calls GetExport, returning its application/x-ndjson response as it
+// arrives, or an error for any response other than a success.
+func (c *ClientWithResponses) GetExportStream(ctx context.Context, reqEditors ...RequestEditorFn) (*GetExportDecoder, error) {
+	rsp, err := c.GetExport(ctx, streamEditors("application/x-ndjson", reqEditors)...)
+	if err != nil {
+		return nil, err
+	}
+	return newGetExportStream(ctx, rsp)
+}
+
+func newGetExportStream(ctx context.Context, rsp *http.Response) (*GetExportDecoder, error) {
+	if rsp.StatusCode < 200 || rsp.StatusCode >= 300 {
+		return nil, streamResponseError("GetExport", rsp)
+	}
+	return &GetExportDecoder{ctx: ctx, rsp: rsp, dec: json.NewDecoder(rsp.Body)}, nil
+}
+
+// GetOtherStream calls GetOther, returning its application/octet-stream response as it
+// arrives, or an error for any response other than a success.
+func (c *ClientWithResponses) GetOtherStream(ctx context.Context, reqEditors ...RequestEditorFn) (io.ReadCloser, error) {
+	rsp, err := c.GetOther(ctx, streamEditors("application/octet-stream", reqEditors)...)
+	if err != nil {
+		return nil, err
+	}
+	return newGetOtherStream(ctx, rsp)
+}
+
+func newGetOtherStream(ctx context.Context, rsp *http.Response) (io.ReadCloser, error) {
+	if rsp.StatusCode < 200 || rsp.StatusCode >= 300 {
+		return nil, streamResponseError("GetOther", rsp)
+	}
+	return rsp.Body, nil
+}
+
+// streamEditors asks for the streamed media type, before running the given
+// request editors.
+func streamEditors(contentType string, reqEditors []RequestEditorFn) []RequestEditorFn {
+	accept := func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("Accept", contentType)
+		return nil
+	}
+	return append([]RequestEditorFn{accept}, reqEditors...)
+}
+
+// streamResponseError reads an error response to a streamed operation.
+func streamResponseError(operation string, rsp *http.Response) error {
+	defer rsp.Body.Close()
+	body, err := ioutil.ReadAll(rsp.Body)
+	if err != nil {
+		return err
+	}
+	return &APIError{
+		Operation:    operation,
+		StatusCode:   rsp.StatusCode,
+		Body:         body,
+		HTTPResponse: rsp,
+	}
+}
+
+// streamError returns why a stream ended, nil when it was complete.
+func streamError(ctx context.Context, err error) error {
+	if err == io.EOF {
+		return nil
+	}
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return err
+}
+
+// sseEvent is a Server-Sent Event.
+type sseEvent struct {
+	id    string
+	event string
+	data  string
+	retry time.Duration
+}
+
+// sseReader parses a stream of Server-Sent Events, as described in
+// https://html.spec.whatwg.org/multipage/server-sent-events.html
+type sseReader struct {
+	r      *bufio.Reader
+	lastID string
+	retry  time.Duration
+}
+
+func newSSEReader(r io.Reader) *sseReader {
+	return &sseReader{r: bufio.NewReader(r)}
+}
+
+// next returns the next event, or io.EOF at the end of the stream.
+func (r *sseReader) next() (sseEvent, error) {
+	var ev sseEvent
+	var data strings.Builder
+	hasData := false
+	for {
+		line, err := r.r.ReadString('\n')
+		if err != nil {
+			// An incomplete event at the end of the stream is dropped.
+			return sseEvent{}, err
+		}
+		line = strings.TrimSuffix(strings.TrimSuffix(line, "\n"), "\r")
+
+		if line == "" {
+			if !hasData {
+				ev.event = ""
+				continue
+			}
+			ev.id = r.lastID
+			ev.retry = r.retry
+			if ev.event == "" {
+				ev.event = "message"
+			}
+			ev.data = strings.TrimSuffix(data.String(), "\n")
+			return ev, nil
+		}
+		if line[0] == ':' {
+			// A comment, eg. to keep the connection alive
+			continue
+		}
+
+		field, value := line, ""
+		if i := strings.IndexByte(line, ':'); i >= 0 {
+			field, value = line[:i], strings.TrimPrefix(line[i+1:], " ")
+		}
+		switch field {
+		case "event":
+			ev.event = value
+		case "data":
+			data.WriteString(value)
+			data.WriteByte('\n')
+			hasData = true
+		case "id":
+			if !strings.ContainsRune(value, 0) {
+				r.lastID = value
+			}
+		case "retry":
+			if ms, err := strconv.Atoi(value); err == nil && ms >= 0 {
+				r.retry = time.Duration(ms) * time.Millisecond
+			}
+		}
+	}
+}
+
+// ListCursorIterator pages through the results of ListCursor, see ListCursorIter.
+type ListCursorIterator struct {
+	fetch func() ([]SchemaObject, bool, error)
+	page  []SchemaObject
+	item  SchemaObject
+	more  bool
+	err   error
+}
+
+// Next advances the iterator to the next item, fetching the next page when
+// the current one is exhausted. It returns false once there are no more items,
+// or when a page couldn't be fetched, see Err.
+func (it *ListCursorIterator) Next() bool {
+	for len(it.page) == 0 {
+		if !it.more || it.err != nil {
+			return false
+		}
+		it.page, it.more, it.err = it.fetch()
+	}
+	it.item, it.page = it.page[0], it.page[1:]
+	return true
+}
+
+// Item returns the current item.
+func (it *ListCursorIterator) Item() SchemaObject {
+	return it.item
+}
+
+// Err returns the error which stopped the iteration, if any.
+func (it *ListCursorIterator) Err() error {
+	return it.err
+}
+
+// ListCursorIter returns an iterator over the results of ListCursor, requesting
+// the pages as they are needed.
+func (c *ClientWithResponses) ListCursorIter(ctx context.Context, params *ListCursorParams, reqEditors ...RequestEditorFn) *ListCursorIterator {
+	var p ListCursorParams
+	if params != nil {
+		p = *params
+	}
+	editors := reqEditors
+	// The cursors of the pages already requested, a server handing one out
+	// again would be paged through forever.
+	seen := make(map[string]bool)
+	it := &ListCursorIterator{more: true}
+	it.fetch = func() ([]SchemaObject, bool, error) {
+		rsp, err := c.ListCursorWithResponse(ctx, &p, editors...)
+		if err != nil {
+			return nil, false, err
+		}
+		if rsp.StatusCode() != http.StatusOK {
+			return nil, false, fmt.Errorf("unexpected status paging through ListCursor: %s", rsp.Status())
+		}
+
+		var items []SchemaObject
+		if _, err := paginationValue(rsp.Body, "/data", &items); err != nil {
+			return nil, false, err
+		}
+
+		var next, zero string
+		found, err := paginationValue(rsp.Body, "/meta/next", &next)
+		if err != nil {
+			return nil, false, err
+		}
+		if !found || next == zero {
+			return items, false, nil
+		}
+		current := zero
+		if p.Cursor != nil {
+			current = *p.Cursor
+		}
+		seen[current] = true
+		if seen[next] {
+			return items, false, fmt.Errorf("ListCursor returned the cursor %v of a page already requested as the next one", next)
+		}
+		p.Cursor = &next
+		return items, true, nil
+	}
+	return it
+}
+
+// ListCursorAll returns all the results of ListCursor, going through every
+// page.
+func (c *ClientWithResponses) ListCursorAll(ctx context.Context, params *ListCursorParams, reqEditors ...RequestEditorFn) ([]SchemaObject, error) {
+	var items []SchemaObject
+	it := c.ListCursorIter(ctx, params, reqEditors...)
+	for it.Next() {
+		items = append(items, it.Item())
+	}
+	return items, it.Err()
+}
+
+// ListLinkIterator pages through the results of ListLink, see ListLinkIter.
+type ListLinkIterator struct {
+	fetch func() ([]SchemaObject, bool, error)
+	page  []SchemaObject
+	item  SchemaObject
+	more  bool
+	err   error
+}
+
+// Next advances the iterator to the next item, fetching the next page when
+// the current one is exhausted. It returns false once there are no more items,
+// or when a page couldn't be fetched, see Err.
+func (it *ListLinkIterator) Next() bool {
+	for len(it.page) == 0 {
+		if !it.more || it.err != nil {
+			return false
+		}
+		it.page, it.more, it.err = it.fetch()
+	}
+	it.item, it.page = it.page[0], it.page[1:]
+	return true
+}
+
+// Item returns the current item.
+func (it *ListLinkIterator) Item() SchemaObject {
+	return it.item
+}
+
+// Err returns the error which stopped the iteration, if any.
+func (it *ListLinkIterator) Err() error {
+	return it.err
+}
+
+// ListLinkIter returns an iterator over the results of ListLink, requesting
+// the pages as they are needed.
+func (c *ClientWithResponses) ListLinkIter(ctx context.Context, reqEditors ...RequestEditorFn) *ListLinkIterator {
+	editors := reqEditors
+	// The pages already requested, a server linking to one again would be
+	// paged through forever.
+	seen := make(map[string]bool)
+	it := &ListLinkIterator{more: true}
+	it.fetch = func() ([]SchemaObject, bool, error) {
+		rsp, err := c.ListLinkWithResponse(ctx, editors...)
+		if err != nil {
+			return nil, false, err
+		}
+		if rsp.StatusCode() != http.StatusOK {
+			return nil, false, fmt.Errorf("unexpected status paging through ListLink: %s", rsp.Status())
+		}
+
+		var items []SchemaObject
+		if _, err := paginationValue(rsp.Body, "/data", &items); err != nil {
+			return nil, false, err
+		}
+
+		next, err := paginationNextLink(rsp.HTTPResponse)
+		if err != nil {
+			return nil, false, err
+		}
+		if next == nil {
+			return items, false, nil
+		}
+		if req := rsp.HTTPResponse.Request; req != nil && req.URL != nil {
+			seen[req.URL.String()] = true
+		}
+		if seen[next.String()] {
+			return items, false, fmt.Errorf("ListLink returned the page %s already requested as the next one", next)
+		}
+		editors = append(append([]RequestEditorFn{}, reqEditors...), func(ctx context.Context, req *http.Request) error {
+			req.URL = next
+			req.Host = next.Host
+			return nil
+		})
+		return items, true, nil
+	}
+	return it
+}
+
+// ListLinkAll returns all the results of ListLink, going through every
+// page.
+func (c *ClientWithResponses) ListLinkAll(ctx context.Context, reqEditors ...RequestEditorFn) ([]SchemaObject, error) {
+	var items []SchemaObject
+	it := c.ListLinkIter(ctx, reqEditors...)
+	for it.Next() {
+		items = append(items, it.Item())
+	}
+	return items, it.Err()
+}
+
+// ListOffsetIterator pages through the results of ListOffset, see ListOffsetIter.
+type ListOffsetIterator struct {
+	fetch func() ([]SchemaObject, bool, error)
+	page  []SchemaObject
+	item  SchemaObject
+	more  bool
+	err   error
+}
+
+// Next advances the iterator to the next item, fetching the next page when
+// the current one is exhausted. It returns false once there are no more items,
+// or when a page couldn't be fetched, see Err.
+func (it *ListOffsetIterator) Next() bool {
+	for len(it.page) == 0 {
+		if !it.more || it.err != nil {
+			return false
+		}
+		it.page, it.more, it.err = it.fetch()
+	}
+	it.item, it.page = it.page[0], it.page[1:]
+	return true
+}
+
+// Item returns the current item.
+func (it *ListOffsetIterator) Item() SchemaObject {
+	return it.item
+}
+
+// Err returns the error which stopped the iteration, if any.
+func (it *ListOffsetIterator) Err() error {
+	return it.err
+}
+
+// ListOffsetIter returns an iterator over the results of ListOffset, requesting
+// the pages as they are needed.
+func (c *ClientWithResponses) ListOffsetIter(ctx context.Context, params *ListOffsetParams, reqEditors ...RequestEditorFn) *ListOffsetIterator {
+	var p ListOffsetParams
+	if params != nil {
+		p = *params
+	}
+	editors := reqEditors
+	it := &ListOffsetIterator{more: true}
+	it.fetch = func() ([]SchemaObject, bool, error) {
+		rsp, err := c.ListOffsetWithResponse(ctx, &p, editors...)
+		if err != nil {
+			return nil, false, err
+		}
+		if rsp.StatusCode() != http.StatusOK {
+			return nil, false, fmt.Errorf("unexpected status paging through ListOffset: %s", rsp.Status())
+		}
+
+		var items []SchemaObject
+		if _, err := paginationValue(rsp.Body, "", &items); err != nil {
+			return nil, false, err
+		}
+
+		if len(items) == 0 {
+			return nil, false, nil
+		}
+		offset := int(0)
+		if p.Offset != nil {
+			offset = *p.Offset
+		}
+		offset += int(len(items))
+		p.Offset = &offset
+		return items, true, nil
+	}
+	return it
+}
+
+// ListOffsetAll returns all the results of ListOffset, going through every
+// page.
+func (c *ClientWithResponses) ListOffsetAll(ctx context.Context, params *ListOffsetParams, reqEditors ...RequestEditorFn) ([]SchemaObject, error) {
+	var items []SchemaObject
+	it := c.ListOffsetIter(ctx, params, reqEditors...)
+	for it.Next() {
+		items = append(items, it.Item())
+	}
+	return items, it.Err()
+}
+
+// paginationValue decodes the value found at a JSON pointer of body into
+// dest. It returns false, leaving dest alone, when there is no such value or
+// when it is null.
+func paginationValue(body []byte, pointer string, dest interface{}) (bool, error) {
+	value := json.RawMessage(body)
+	if pointer != "" && pointer != "/" {
+		unescape := strings.NewReplacer("~1", "/", "~0", "~")
+		for _, token := range strings.Split(strings.TrimPrefix(pointer, "/"), "/") {
+			var object map[string]json.RawMessage
+			if err := json.Unmarshal(value, &object); err != nil {
+				return false, fmt.Errorf("error decoding %s: %s", pointer, err)
+			}
+			var found bool
+			if value, found = object[unescape.Replace(token)]; !found {
+				return false, nil
+			}
+		}
+	}
+	if bytes.Equal(bytes.TrimSpace(value), []byte("null")) {
+		return false, nil
+	}
+	if err := json.Unmarshal(value, dest); err != nil {
+		return false, fmt.Errorf("error decoding %s: %s", pointer, err)
+	}
+	return true, nil
+}
+
+// paginationNextLink returns the target of the rel="next" Link header of rsp,
+// resolved against the URL of its request, or nil when there is none.
+func paginationNextLink(rsp *http.Response) (*url.URL, error) {
+	for _, header := range rsp.Header["Link"] {
+		rest := header
+		for {
+			start := strings.Index(rest, "<")
+			end := strings.Index(rest, ">")
+			if start < 0 || end < start {
+				break
+			}
+			target := rest[start+1 : end]
+			rest = rest[end+1:]
+			params := rest
+			if i := strings.Index(rest, "<"); i >= 0 {
+				params = rest[:i]
+			}
+
+			for _, param := range strings.Split(params, ";") {
+				kv := strings.SplitN(strings.Trim(param, " ,"), "=", 2)
+				if len(kv) != 2 || !strings.EqualFold(strings.TrimSpace(kv[0]), "rel") {
+					continue
+				}
+				for _, rel := range strings.Fields(strings.Trim(kv[1], "\"")) {
+					if !strings.EqualFold(rel, "next") {
+						continue
+					}
+					next, err := url.Parse(target)
+					if err != nil {
+						return nil, fmt.Errorf("invalid Link header: %s", err)
+					}
+					if rsp.Request != nil && rsp.Request.URL != nil {
+						next = rsp.Request.URL.ResolveReference(next)
+					}
+					return next, nil
+				}
+			}
+		}
+	}
+	return nil, nil
+}
+
+// FakeTestingT is the part of testing.TB which the fake client reports failed
+// assertions to.
+type FakeTestingT interface {
+	Helper()
+	Errorf(format string, args ...interface{})
+}
+
+// FakeCall is a call made to the fake client.
+type FakeCall struct {
+	Operation   string        // The operation ID
+	PathParams  []interface{} // The path parameters, in order
+	Params      interface{}   // The parameters object, when the operation has one
+	ContentType string        // The media type of the body, when there is one
+	Body        interface{}   // The body, as a []byte when given as an io.Reader
+}
+
+func (c FakeCall) String() string {
+	args := make([]string, 0, len(c.PathParams)+1)
+	for _, p := range c.PathParams {
+		args = append(args, fmt.Sprintf("%v", p))
+	}
+	if params := reflect.ValueOf(c.Params); params.Kind() == reflect.Ptr && !params.IsNil() {
+		args = append(args, fmt.Sprintf("%+v", params.Elem().Interface()))
+	}
+	return fmt.Sprintf("%s(%s)", c.Operation, strings.Join(args, ", "))
+}
+
+// UnexpectedCallError is returned by the fake client for the calls which no
+// stub answers.
+type UnexpectedCallError struct {
+	Call FakeCall
+}
+
+func (e *UnexpectedCallError) Error() string {
+	return fmt.Sprintf("unexpected call to %s", e.Call)
+}
+
+// FakeClientWithResponses is an in-memory ClientWithResponsesInterface for
+// tests. Calls are answered by the latest stub matching them, see the On
+// methods, and fail with an *UnexpectedCallError when there is none. Stubs are
+// meant to be set up before the calls, which may be concurrent.
+type FakeClientWithResponses struct {
+	mu    sync.Mutex
+	stubs []*fakeStub
+	calls []FakeCall
+}
+
+var _ ClientWithResponsesInterface = (*FakeClientWithResponses)(nil)
+
+// NewFakeClientWithResponses returns a fake client without any stub.
+func NewFakeClientWithResponses() *FakeClientWithResponses {
+	return &FakeClientWithResponses{}
+}
+
+// Calls returns the calls made so far, in order.
+func (f *FakeClientWithResponses) Calls() []FakeCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeCall(nil), f.calls...)
+}
+
+// CallsTo returns the calls made so far to the given operation, in order.
+func (f *FakeClientWithResponses) CallsTo(operation string) []FakeCall {
+	var calls []FakeCall
+	for _, call := range f.Calls() {
+		if call.Operation == operation {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// AssertCalled checks that the given operation was called, with the given path
+// parameters when there are any.
+func (f *FakeClientWithResponses) AssertCalled(t FakeTestingT, operation string, pathParams ...interface{}) bool {
+	t.Helper()
+	calls := f.CallsTo(operation)
+	for _, call := range calls {
+		if len(pathParams) == 0 || reflect.DeepEqual(pathParams, call.PathParams) {
+			return true
+		}
+	}
+	if len(calls) == 0 {
+		t.Errorf("%s wasn't called", operation)
+	} else {
+		t.Errorf("%s wasn't called with %v, but: %v", operation, pathParams, calls)
+	}
+	return false
+}
+
+// AssertNotCalled checks that the given operation wasn't called.
+func (f *FakeClientWithResponses) AssertNotCalled(t FakeTestingT, operation string) bool {
+	t.Helper()
+	if calls := f.CallsTo(operation); len(calls) > 0 {
+		t.Errorf("%s was called: %v", operation, calls)
+		return false
+	}
+	return true
+}
+
+// AssertExpectations checks that every stub answered a call, as many times as
+// it was given with Times.
+func (f *FakeClientWithResponses) AssertExpectations(t FakeTestingT) bool {
+	t.Helper()
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	ok := true
+	for _, s := range f.stubs {
+		switch {
+		case s.times > 0 && s.calls != s.times:
+			t.Errorf("%s was called %d times, instead of %d", s.call, s.calls, s.times)
+			ok = false
+		case s.calls == 0:
+			t.Errorf("%s wasn't called", s.call)
+			ok = false
+		}
+	}
+	return ok
+}
+
+// stub adds a stub answering the calls matching the given one.
+func (f *FakeClientWithResponses) stub(call FakeCall) *fakeStub {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	s := &fakeStub{call: call}
+	f.stubs = append(f.stubs, s)
+	return s
+}
+
+// do records a call, and returns the answer of the latest stub matching it.
+func (f *FakeClientWithResponses) do(call FakeCall) (interface{}, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, call)
+	for i := len(f.stubs) - 1; i >= 0; i-- {
+		s := f.stubs[i]
+		if !s.matches(call) {
+			continue
+		}
+		s.calls++
+		if s.err != nil {
+			return nil, s.err
+		}
+		if s.response == nil {
+			return nil, fmt.Errorf("the stub of %s returns nothing", s.call)
+		}
+		return s.response, nil
+	}
+	return nil, &UnexpectedCallError{Call: call}
+}
+
+// fakeStub answers the calls matching its call, which matches any parameters
+// or body unless given.
+type fakeStub struct {
+	call      FakeCall
+	hasParams bool
+	hasBody   bool
+	response  interface{}
+	err       error
+	times     int // How many calls the stub answers, any number when 0
+	calls     int
+}
+
+func (s *fakeStub) matches(call FakeCall) bool {
+	switch {
+	case s.call.Operation != call.Operation || !reflect.DeepEqual(s.call.PathParams, call.PathParams):
+		return false
+	case s.hasParams && !reflect.DeepEqual(s.call.Params, call.Params):
+		return false
+	case s.hasBody && (s.call.ContentType != call.ContentType || !reflect.DeepEqual(s.call.Body, call.Body)):
+		return false
+	}
+	return s.times == 0 || s.calls < s.times
+}
+
+// returnPayload answers with the given status code, and the encoded payload.
+func (s *fakeStub) returnPayload(statusCode int, contentType string, payload interface{}, marshal func(interface{}) ([]byte, error), response func(body []byte, rsp *http.Response) interface{}) {
+	body, err := marshal(payload)
+	if err != nil {
+		s.err = err
+		return
+	}
+	s.response = response(body, fakeHTTPResponse(statusCode, contentType, body))
+}
+
+func fakeHTTPResponse(statusCode int, contentType string, body []byte) *http.Response {
+	rsp := &http.Response{
+		Status:     fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode)),
+		StatusCode: statusCode,
+		Header:     http.Header{},
+		Body:       ioutil.NopCloser(bytes.NewReader(body)),
+	}
+	if contentType != "" {
+		rsp.Header.Set("Content-Type", contentType)
+	}
+	return rsp
+}
+
+// GetObjectStub answers the calls to GetObject, see OnGetObject.
+type GetObjectStub struct {
+	stub *fakeStub
+}
+
+// OnGetObject stubs the calls to GetObject with the given path parameters.
+func (f *FakeClientWithResponses) OnGetObject(id int) *GetObjectStub {
+	return &GetObjectStub{f.stub(FakeCall{Operation: "GetObject", PathParams: []interface{}{id}})}
+}
+
+// Times only answers the given number of calls, which AssertExpectations
+// checks.
+func (s *GetObjectStub) Times(n int) *GetObjectStub {
+	s.stub.times = n
+	return s
+}
+
+// Return answers with the given response.
+func (s *GetObjectStub) Return(rsp *GetObjectResponse) *GetObjectStub {
+	s.stub.response = rsp
+	return s
+}
+
+// ReturnError fails with the given error.
+func (s *GetObjectStub) ReturnError(err error) *GetObjectStub {
+	s.stub.err = err
+	return s
+}
+
+// ReturnStatus answers with the given status code, and no body.
+func (s *GetObjectStub) ReturnStatus(statusCode int) *GetObjectStub {
+	s.stub.response = &GetObjectResponse{HTTPResponse: fakeHTTPResponse(statusCode, "", nil)}
+	return s
+}
+
+// Return200 answers with a 200, and the given payload.
+func (s *GetObjectStub) Return200(payload SchemaObject) *GetObjectStub {
+	s.stub.returnPayload(200, "application/json", payload, json.Marshal, func(body []byte, rsp *http.Response) interface{} {
+		return &GetObjectResponse{Body: body, HTTPResponse: rsp, JSON200: &payload}
+	})
+	return s
+}
+
+// Return404 answers with a 404, and the given payload.
+func (s *GetObjectStub) Return404(payload ErrorObject) *GetObjectStub {
+	s.stub.returnPayload(404, "application/json", payload, json.Marshal, func(body []byte, rsp *http.Response) interface{} {
+		return &GetObjectResponse{Body: body, HTTPResponse: rsp, JSON404: &payload}
+	})
+	return s
+}
+
+// GetObjectWithResponse records the call, and answers it with the latest stub matching it.
+func (f *FakeClientWithResponses) GetObjectWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetObjectResponse, error) {
+	return f.doGetObject(FakeCall{Operation: "GetObject", PathParams: []interface{}{id}})
+}
+
+func (f *FakeClientWithResponses) doGetObject(call FakeCall) (*GetObjectResponse, error) {
+	rsp, err := f.do(call)
+	if err != nil {
+		return nil, err
+	}
+	return rsp.(*GetObjectResponse), nil
+}
+
+// PostBothStub answers the calls to PostBoth, see OnPostBoth.
+type PostBothStub struct {
+	stub *fakeStub
+}
+
+// OnPostBoth stubs the calls to PostBoth.
+func (f *FakeClientWithResponses) OnPostBoth() *PostBothStub {
+	return &PostBothStub{f.stub(FakeCall{Operation: "PostBoth", PathParams: []interface{}{}})}
+}
+
+// WithBody only answers the calls with the given body.
+func (s *PostBothStub) WithBody(contentType string, body []byte) *PostBothStub {
+	s.stub.call.ContentType, s.stub.call.Body = contentType, body
+	s.stub.hasBody = true
+	return s
+}
+
+// WithJSONBody only answers the calls with the given application/json body.
+func (s *PostBothStub) WithJSONBody(body PostBothJSONRequestBody) *PostBothStub {
+	s.stub.call.ContentType, s.stub.call.Body = "application/json", body
+	s.stub.hasBody = true
+	return s
+}
+
+// Times only answers the given number of calls, which AssertExpectations
+// checks.
+func (s *PostBothStub) Times(n int) *PostBothStub {
+	s.stub.times = n
+	return s
+}
+
+// Return answers with the given response.
+func (s *PostBothStub) Return(rsp *PostBothResponse) *PostBothStub {
+	s.stub.response = rsp
+	return s
+}
+
+// ReturnError fails with the given error.
+func (s *PostBothStub) ReturnError(err error) *PostBothStub {
+	s.stub.err = err
+	return s
+}
+
+// ReturnStatus answers with the given status code, and no body.
+func (s *PostBothStub) ReturnStatus(statusCode int) *PostBothStub {
+	s.stub.response = &PostBothResponse{HTTPResponse: fakeHTTPResponse(statusCode, "", nil)}
+	return s
+}
+
+// PostBothWithBodyWithResponse records the call, and answers it with the latest stub matching it.
+func (f *FakeClientWithResponses) PostBothWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostBothResponse, error) {
+	var buf []byte
+	if body != nil {
+		var err error
+		if buf, err = ioutil.ReadAll(body); err != nil {
+			return nil, err
+		}
+	}
+	return f.doPostBoth(FakeCall{Operation: "PostBoth", PathParams: []interface{}{}, ContentType: contentType, Body: buf})
+}
+
+// PostBothWithResponse records the call, and answers it with the latest stub matching it.
+func (f *FakeClientWithResponses) PostBothWithResponse(ctx context.Context, body PostBothJSONRequestBody, reqEditors ...RequestEditorFn) (*PostBothResponse, error) {
+	return f.doPostBoth(FakeCall{Operation: "PostBoth", PathParams: []interface{}{}, ContentType: "application/json", Body: body})
+}
+
+func (f *FakeClientWithResponses) doPostBoth(call FakeCall) (*PostBothResponse, error) {
+	rsp, err := f.do(call)
+	if err != nil {
+		return nil, err
+	}
+	return rsp.(*PostBothResponse), nil
+}
+
+// GetBothStub answers the calls to GetBoth, see OnGetBoth.
+type GetBothStub struct {
+	stub *fakeStub
+}
+
+// OnGetBoth stubs the calls to GetBoth.
+func (f *FakeClientWithResponses) OnGetBoth() *GetBothStub {
+	return &GetBothStub{f.stub(FakeCall{Operation: "GetBoth", PathParams: []interface{}{}})}
+}
+
+// Times only answers the given number of calls, which AssertExpectations
+// checks.
+func (s *GetBothStub) Times(n int) *GetBothStub {
+	s.stub.times = n
+	return s
+}
+
+// Return answers with the given response.
+func (s *GetBothStub) Return(rsp *GetBothResponse) *GetBothStub {
+	s.stub.response = rsp
+	return s
+}
+
+// ReturnError fails with the given error.
+func (s *GetBothStub) ReturnError(err error) *GetBothStub {
+	s.stub.err = err
+	return s
+}
+
+// ReturnStatus answers with the given status code, and no body.
+func (s *GetBothStub) ReturnStatus(statusCode int) *GetBothStub {
+	s.stub.response = &GetBothResponse{HTTPResponse: fakeHTTPResponse(statusCode, "", nil)}
+	return s
+}
+
+// Return200 answers with a 200, and the given payload.
+func (s *GetBothStub) Return200(payload SchemaObject) *GetBothStub {
+	s.stub.returnPayload(200, "application/json", payload, json.Marshal, func(body []byte, rsp *http.Response) interface{} {
+		return &GetBothResponse{Body: body, HTTPResponse: rsp, JSON200: &payload}
+	})
+	return s
+}
+
+// GetBothWithResponse records the call, and answers it with the latest stub matching it.
+func (f *FakeClientWithResponses) GetBothWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetBothResponse, error) {
+	return f.doGetBoth(FakeCall{Operation: "GetBoth", PathParams: []interface{}{}})
+}
+
+func (f *FakeClientWithResponses) doGetBoth(call FakeCall) (*GetBothResponse, error) {
+	rsp, err := f.do(call)
+	if err != nil {
+		return nil, err
+	}
+	return rsp.(*GetBothResponse), nil
+}
+
+// ListCursorStub answers the calls to ListCursor, see OnListCursor.
+type ListCursorStub struct {
+	stub *fakeStub
+}
+
+// OnListCursor stubs the calls to ListCursor.
+func (f *FakeClientWithResponses) OnListCursor() *ListCursorStub {
+	return &ListCursorStub{f.stub(FakeCall{Operation: "ListCursor", PathParams: []interface{}{}})}
+}
+
+// WithParams only answers the calls with the given parameters.
+func (s *ListCursorStub) WithParams(params ListCursorParams) *ListCursorStub {
+	s.stub.call.Params = &params
+	s.stub.hasParams = true
+	return s
+}
+
+// Times only answers the given number of calls, which AssertExpectations
+// checks.
+func (s *ListCursorStub) Times(n int) *ListCursorStub {
+	s.stub.times = n
+	return s
+}
+
+// Return answers with the given response.
+func (s *ListCursorStub) Return(rsp *ListCursorResponse) *ListCursorStub {
+	s.stub.response = rsp
+	return s
+}
+
+// ReturnError fails with the given error.
+func (s *ListCursorStub) ReturnError(err error) *ListCursorStub {
+	s.stub.err = err
+	return s
+}
+
+// ReturnStatus answers with the given status code, and no body.
+func (s *ListCursorStub) ReturnStatus(statusCode int) *ListCursorStub {
+	s.stub.response = &ListCursorResponse{HTTPResponse: fakeHTTPResponse(statusCode, "", nil)}
+	return s
+}
+
+// Return200 answers with a 200, and the given payload.
+func (s *ListCursorStub) Return200(payload struct {
+	Data *[]SchemaObject `json:"data,omitempty"`
+	Meta *struct {
+		Next *string `json:"next,omitempty"`
+	} `json:"meta,omitempty"`
+}) *ListCursorStub {
+	s.stub.returnPayload(200, "application/json", payload, json.Marshal, func(body []byte, rsp *http.Response) interface{} {
+		return &ListCursorResponse{Body: body, HTTPResponse: rsp, JSON200: &payload}
+	})
+	return s
+}
+
+// ListCursorWithResponse records the call, and answers it with the latest stub matching it.
+func (f *FakeClientWithResponses) ListCursorWithResponse(ctx context.Context, params *ListCursorParams, reqEditors ...RequestEditorFn) (*ListCursorResponse, error) {
+	return f.doListCursor(FakeCall{Operation: "ListCursor", PathParams: []interface{}{}, Params: params})
+}
+
+func (f *FakeClientWithResponses) doListCursor(call FakeCall) (*ListCursorResponse, error) {
+	rsp, err := f.do(call)
+	if err != nil {
+		return nil, err
+	}
+	return rsp.(*ListCursorResponse), nil
+}
+
+// PostDownloadStub answers the calls to PostDownload, see OnPostDownload.
+type PostDownloadStub struct {
+	stub *fakeStub
+}
+
+// OnPostDownload stubs the calls to PostDownload.
+func (f *FakeClientWithResponses) OnPostDownload() *PostDownloadStub {
+	return &PostDownloadStub{f.stub(FakeCall{Operation: "PostDownload", PathParams: []interface{}{}})}
+}
+
+// WithBody only answers the calls with the given body.
+func (s *PostDownloadStub) WithBody(contentType string, body []byte) *PostDownloadStub {
+	s.stub.call.ContentType, s.stub.call.Body = contentType, body
+	s.stub.hasBody = true
+	return s
+}
+
+// WithJSONBody only answers the calls with the given application/json body.
+func (s *PostDownloadStub) WithJSONBody(body PostDownloadJSONRequestBody) *PostDownloadStub {
+	s.stub.call.ContentType, s.stub.call.Body = "application/json", body
+	s.stub.hasBody = true
+	return s
+}
+
+// Times only answers the given number of calls, which AssertExpectations
+// checks.
+func (s *PostDownloadStub) Times(n int) *PostDownloadStub {
+	s.stub.times = n
+	return s
+}
+
+// Return answers with the given response.
+func (s *PostDownloadStub) Return(rsp *PostDownloadResponse) *PostDownloadStub {
+	s.stub.response = rsp
+	return s
+}
+
+// ReturnError fails with the given error.
+func (s *PostDownloadStub) ReturnError(err error) *PostDownloadStub {
+	s.stub.err = err
+	return s
+}
+
+// ReturnStatus answers with the given status code, and no body.
+func (s *PostDownloadStub) ReturnStatus(statusCode int) *PostDownloadStub {
+	s.stub.response = &PostDownloadResponse{HTTPResponse: fakeHTTPResponse(statusCode, "", nil)}
+	return s
+}
+
+// PostDownloadWithBodyWithResponse records the call, and answers it with the latest stub matching it.
+func (f *FakeClientWithResponses) PostDownloadWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostDownloadResponse, error) {
+	var buf []byte
+	if body != nil {
+		var err error
+		if buf, err = ioutil.ReadAll(body); err != nil {
+			return nil, err
+		}
+	}
+	return f.doPostDownload(FakeCall{Operation: "PostDownload", PathParams: []interface{}{}, ContentType: contentType, Body: buf})
+}
+
+// PostDownloadWithResponse records the call, and answers it with the latest stub matching it.
+func (f *FakeClientWithResponses) PostDownloadWithResponse(ctx context.Context, body PostDownloadJSONRequestBody, reqEditors ...RequestEditorFn) (*PostDownloadResponse, error) {
+	return f.doPostDownload(FakeCall{Operation: "PostDownload", PathParams: []interface{}{}, ContentType: "application/json", Body: body})
+}
+
+func (f *FakeClientWithResponses) doPostDownload(call FakeCall) (*PostDownloadResponse, error) {
+	rsp, err := f.do(call)
+	if err != nil {
+		return nil, err
+	}
+	return rsp.(*PostDownloadResponse), nil
+}
+
+// GetWithErrorsStub answers the calls to GetWithErrors, see OnGetWithErrors.
+type GetWithErrorsStub struct {
+	stub *fakeStub
+}
+
+// OnGetWithErrors stubs the calls to GetWithErrors.
+func (f *FakeClientWithResponses) OnGetWithErrors() *GetWithErrorsStub {
+	return &GetWithErrorsStub{f.stub(FakeCall{Operation: "GetWithErrors", PathParams: []interface{}{}})}
+}
+
+// Times only answers the given number of calls, which AssertExpectations
+// checks.
+func (s *GetWithErrorsStub) Times(n int) *GetWithErrorsStub {
+	s.stub.times = n
+	return s
+}
+
+// Return answers with the given response.
+func (s *GetWithErrorsStub) Return(rsp *GetWithErrorsResponse) *GetWithErrorsStub {
+	s.stub.response = rsp
+	return s
+}
 
-	return req, nil
+// ReturnError fails with the given error.
+func (s *GetWithErrorsStub) ReturnError(err error) *GetWithErrorsStub {
+	s.stub.err = err
+	return s
 }
 
-// NewGetJsonWithTrailingSlashRequest generates requests for GetJsonWithTrailingSlash
-func NewGetJsonWithTrailingSlashRequest(server string) (*http.Request, error) {
-	var err error
+// ReturnStatus answers with the given status code, and no body.
+func (s *GetWithErrorsStub) ReturnStatus(statusCode int) *GetWithErrorsStub {
+	s.stub.response = &GetWithErrorsResponse{HTTPResponse: fakeHTTPResponse(statusCode, "", nil)}
+	return s
+}
 
-	queryUrl, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+// Return200 answers with a 200, and the given payload.
+func (s *GetWithErrorsStub) Return200(payload SchemaObject) *GetWithErrorsStub {
+	s.stub.returnPayload(200, "application/json", payload, json.Marshal, func(body []byte, rsp *http.Response) interface{} {
+		return &GetWithErrorsResponse{Body: body, HTTPResponse: rsp, JSON200: &payload}
+	})
+	return s
+}
 
-	basePath := fmt.Sprintf("/with_trailing_slash/")
-	if basePath[0] == '/' {
-		basePath = basePath[1:]
-	}
+// Return404 answers with a 404, and the given payload.
+func (s *GetWithErrorsStub) Return404(payload ErrorObject) *GetWithErrorsStub {
+	s.stub.returnPayload(404, "application/json", payload, json.Marshal, func(body []byte, rsp *http.Response) interface{} {
+		return &GetWithErrorsResponse{Body: body, HTTPResponse: rsp, JSON404: &payload}
+	})
+	return s
+}
 
-	queryUrl, err = queryUrl.Parse(basePath)
-	if err != nil {
-		return nil, err
-	}
+// ReturnDefault answers with the given status code, and the given payload.
+func (s *GetWithErrorsStub) ReturnDefault(statusCode int, payload ErrorObject) *GetWithErrorsStub {
+	s.stub.returnPayload(statusCode, "application/json", payload, json.Marshal, func(body []byte, rsp *http.Response) interface{} {
+		return &GetWithErrorsResponse{Body: body, HTTPResponse: rsp, JSONDefault: &payload}
+	})
+	return s
+}
 
-	req, err := http.NewRequest("GET", queryUrl.String(), nil)
+// GetWithErrorsWithResponse records the call, and answers it with the latest stub matching it.
+func (f *FakeClientWithResponses) GetWithErrorsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWithErrorsResponse, error) {
+	return f.doGetWithErrors(FakeCall{Operation: "GetWithErrors", PathParams: []interface{}{}})
+}
+
+func (f *FakeClientWithResponses) doGetWithErrors(call FakeCall) (*GetWithErrorsResponse, error) {
+	rsp, err := f.do(call)
 	if err != nil {
 		return nil, err
 	}
+	return rsp.(*GetWithErrorsResponse), nil
+}
 
-	return req, nil
+// GetEventsStub answers the calls to GetEvents, see OnGetEvents.
+type GetEventsStub struct {
+	stub *fakeStub
 }
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
+// OnGetEvents stubs the calls to GetEvents.
+func (f *FakeClientWithResponses) OnGetEvents() *GetEventsStub {
+	return &GetEventsStub{f.stub(FakeCall{Operation: "GetEvents", PathParams: []interface{}{}})}
 }
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return &ClientWithResponses{client}, nil
+// Times only answers the given number of calls, which AssertExpectations
+// checks.
+func (s *GetEventsStub) Times(n int) *GetEventsStub {
+	s.stub.times = n
+	return s
 }
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
-	}
+// Return answers with the given response.
+func (s *GetEventsStub) Return(rsp *GetEventsResponse) *GetEventsStub {
+	s.stub.response = rsp
+	return s
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// PostBoth request  with any body
-	PostBothWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader) (*PostBothResponse, error)
+// ReturnError fails with the given error.
+func (s *GetEventsStub) ReturnError(err error) *GetEventsStub {
+	s.stub.err = err
+	return s
+}
 
-	PostBothWithResponse(ctx context.Context, body PostBothJSONRequestBody) (*PostBothResponse, error)
+// ReturnStatus answers with the given status code, and no body.
+func (s *GetEventsStub) ReturnStatus(statusCode int) *GetEventsStub {
+	s.stub.response = &GetEventsResponse{HTTPResponse: fakeHTTPResponse(statusCode, "", nil)}
+	return s
+}
 
-	// GetBoth request
-	GetBothWithResponse(ctx context.Context) (*GetBothResponse, error)
+// GetEventsWithResponse records the call, and answers it with the latest stub matching it.
+func (f *FakeClientWithResponses) GetEventsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetEventsResponse, error) {
+	return f.doGetEvents(FakeCall{Operation: "GetEvents", PathParams: []interface{}{}})
+}
 
-	// PostJson request  with any body
-	PostJsonWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader) (*PostJsonResponse, error)
+func (f *FakeClientWithResponses) doGetEvents(call FakeCall) (*GetEventsResponse, error) {
+	rsp, err := f.do(call)
+	if err != nil {
+		return nil, err
+	}
+	return rsp.(*GetEventsResponse), nil
+}
 
-	PostJsonWithResponse(ctx context.Context, body PostJsonJSONRequestBody) (*PostJsonResponse, error)
+// PostJsonStub answers the calls to PostJson, see OnPostJson.
+type PostJsonStub struct {
+	stub *fakeStub
+}
 
-	// GetJson request
-	GetJsonWithResponse(ctx context.Context) (*GetJsonResponse, error)
+// OnPostJson stubs the calls to PostJson.
+func (f *FakeClientWithResponses) OnPostJson() *PostJsonStub {
+	return &PostJsonStub{f.stub(FakeCall{Operation: "PostJson", PathParams: []interface{}{}})}
+}
 
-	// PostOther request  with any body
-	PostOtherWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader) (*PostOtherResponse, error)
+// WithBody only answers the calls with the given body.
+func (s *PostJsonStub) WithBody(contentType string, body []byte) *PostJsonStub {
+	s.stub.call.ContentType, s.stub.call.Body = contentType, body
+	s.stub.hasBody = true
+	return s
+}
 
-	// GetOther request
-	GetOtherWithResponse(ctx context.Context) (*GetOtherResponse, error)
+// WithJSONBody only answers the calls with the given application/json body.
+func (s *PostJsonStub) WithJSONBody(body PostJsonJSONRequestBody) *PostJsonStub {
+	s.stub.call.ContentType, s.stub.call.Body = "application/json", body
+	s.stub.hasBody = true
+	return s
+}
 
-	// GetJsonWithTrailingSlash request
-	GetJsonWithTrailingSlashWithResponse(ctx context.Context) (*GetJsonWithTrailingSlashResponse, error)
+// Times only answers the given number of calls, which AssertExpectations
+// checks.
+func (s *PostJsonStub) Times(n int) *PostJsonStub {
+	s.stub.times = n
+	return s
 }
 
-type PostBothResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// Return answers with the given response.
+func (s *PostJsonStub) Return(rsp *PostJsonResponse) *PostJsonStub {
+	s.stub.response = rsp
+	return s
 }
 
-// Status returns HTTPResponse.Status
-func (r PostBothResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+// ReturnError fails with the given error.
+func (s *PostJsonStub) ReturnError(err error) *PostJsonStub {
+	s.stub.err = err
+	return s
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostBothResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ReturnStatus answers with the given status code, and no body.
+func (s *PostJsonStub) ReturnStatus(statusCode int) *PostJsonStub {
+	s.stub.response = &PostJsonResponse{HTTPResponse: fakeHTTPResponse(statusCode, "", nil)}
+	return s
+}
+
+// PostJsonWithBodyWithResponse records the call, and answers it with the latest stub matching it.
+func (f *FakeClientWithResponses) PostJsonWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostJsonResponse, error) {
+	var buf []byte
+	if body != nil {
+		var err error
+		if buf, err = ioutil.ReadAll(body); err != nil {
+			return nil, err
+		}
 	}
-	return 0
+	return f.doPostJson(FakeCall{Operation: "PostJson", PathParams: []interface{}{}, ContentType: contentType, Body: buf})
 }
 
-type GetBothResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// PostJsonWithResponse records the call, and answers it with the latest stub matching it.
+func (f *FakeClientWithResponses) PostJsonWithResponse(ctx context.Context, body PostJsonJSONRequestBody, reqEditors ...RequestEditorFn) (*PostJsonResponse, error) {
+	return f.doPostJson(FakeCall{Operation: "PostJson", PathParams: []interface{}{}, ContentType: "application/json", Body: body})
 }
 
-// Status returns HTTPResponse.Status
-func (r GetBothResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (f *FakeClientWithResponses) doPostJson(call FakeCall) (*PostJsonResponse, error) {
+	rsp, err := f.do(call)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return rsp.(*PostJsonResponse), nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetBothResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+// GetJsonStub answers the calls to GetJson, see OnGetJson.
+type GetJsonStub struct {
+	stub *fakeStub
 }
 
-type PostJsonResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// OnGetJson stubs the calls to GetJson.
+func (f *FakeClientWithResponses) OnGetJson() *GetJsonStub {
+	return &GetJsonStub{f.stub(FakeCall{Operation: "GetJson", PathParams: []interface{}{}})}
 }
 
-// Status returns HTTPResponse.Status
-func (r PostJsonResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+// Times only answers the given number of calls, which AssertExpectations
+// checks.
+func (s *GetJsonStub) Times(n int) *GetJsonStub {
+	s.stub.times = n
+	return s
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostJsonResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+// Return answers with the given response.
+func (s *GetJsonStub) Return(rsp *GetJsonResponse) *GetJsonStub {
+	s.stub.response = rsp
+	return s
 }
 
-type GetJsonResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// ReturnError fails with the given error.
+func (s *GetJsonStub) ReturnError(err error) *GetJsonStub {
+	s.stub.err = err
+	return s
 }
 
-// Status returns HTTPResponse.Status
-func (r GetJsonResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+// ReturnStatus answers with the given status code, and no body.
+func (s *GetJsonStub) ReturnStatus(statusCode int) *GetJsonStub {
+	s.stub.response = &GetJsonResponse{HTTPResponse: fakeHTTPResponse(statusCode, "", nil)}
+	return s
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetJsonResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+// Return200 answers with a 200, and the given payload.
+func (s *GetJsonStub) Return200(payload SchemaObject) *GetJsonStub {
+	s.stub.returnPayload(200, "application/json", payload, json.Marshal, func(body []byte, rsp *http.Response) interface{} {
+		return &GetJsonResponse{Body: body, HTTPResponse: rsp, JSON200: &payload}
+	})
+	return s
 }
 
-type PostOtherResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// GetJsonWithResponse records the call, and answers it with the latest stub matching it.
+func (f *FakeClientWithResponses) GetJsonWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetJsonResponse, error) {
+	return f.doGetJson(FakeCall{Operation: "GetJson", PathParams: []interface{}{}})
 }
 
-// Status returns HTTPResponse.Status
-func (r PostOtherResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (f *FakeClientWithResponses) doGetJson(call FakeCall) (*GetJsonResponse, error) {
+	rsp, err := f.do(call)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return rsp.(*GetJsonResponse), nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostOtherResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+// ListLinkStub answers the calls to ListLink, see OnListLink.
+type ListLinkStub struct {
+	stub *fakeStub
 }
 
-type GetOtherResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// OnListLink stubs the calls to ListLink.
+func (f *FakeClientWithResponses) OnListLink() *ListLinkStub {
+	return &ListLinkStub{f.stub(FakeCall{Operation: "ListLink", PathParams: []interface{}{}})}
 }
 
-// Status returns HTTPResponse.Status
-func (r GetOtherResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+// Times only answers the given number of calls, which AssertExpectations
+// checks.
+func (s *ListLinkStub) Times(n int) *ListLinkStub {
+	s.stub.times = n
+	return s
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetOtherResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+// Return answers with the given response.
+func (s *ListLinkStub) Return(rsp *ListLinkResponse) *ListLinkStub {
+	s.stub.response = rsp
+	return s
 }
 
-type GetJsonWithTrailingSlashResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// ReturnError fails with the given error.
+func (s *ListLinkStub) ReturnError(err error) *ListLinkStub {
+	s.stub.err = err
+	return s
 }
 
-// Status returns HTTPResponse.Status
-func (r GetJsonWithTrailingSlashResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+// ReturnStatus answers with the given status code, and no body.
+func (s *ListLinkStub) ReturnStatus(statusCode int) *ListLinkStub {
+	s.stub.response = &ListLinkResponse{HTTPResponse: fakeHTTPResponse(statusCode, "", nil)}
+	return s
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetJsonWithTrailingSlashResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+// Return200 answers with a 200, and the given payload.
+func (s *ListLinkStub) Return200(payload struct {
+	Data *[]SchemaObject `json:"data,omitempty"`
+}) *ListLinkStub {
+	s.stub.returnPayload(200, "application/json", payload, json.Marshal, func(body []byte, rsp *http.Response) interface{} {
+		return &ListLinkResponse{Body: body, HTTPResponse: rsp, JSON200: &payload}
+	})
+	return s
 }
 
-// PostBothWithBodyWithResponse request with arbitrary body returning *PostBothResponse
-func (c *ClientWithResponses) PostBothWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader) (*PostBothResponse, error) {
-	rsp, err := c.PostBothWithBody(ctx, contentType, body)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostBothResponse(rsp)
+// ListLinkWithResponse records the call, and answers it with the latest stub matching it.
+func (f *FakeClientWithResponses) ListLinkWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListLinkResponse, error) {
+	return f.doListLink(FakeCall{Operation: "ListLink", PathParams: []interface{}{}})
 }
 
-func (c *ClientWithResponses) PostBothWithResponse(ctx context.Context, body PostBothJSONRequestBody) (*PostBothResponse, error) {
-	rsp, err := c.PostBoth(ctx, body)
+func (f *FakeClientWithResponses) doListLink(call FakeCall) (*ListLinkResponse, error) {
+	rsp, err := f.do(call)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostBothResponse(rsp)
+	return rsp.(*ListLinkResponse), nil
 }
 
-// GetBothWithResponse request returning *GetBothResponse
-func (c *ClientWithResponses) GetBothWithResponse(ctx context.Context) (*GetBothResponse, error) {
-	rsp, err := c.GetBoth(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetBothResponse(rsp)
+// GetExportStub answers the calls to GetExport, see OnGetExport.
+type GetExportStub struct {
+	stub *fakeStub
 }
 
-// PostJsonWithBodyWithResponse request with arbitrary body returning *PostJsonResponse
-func (c *ClientWithResponses) PostJsonWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader) (*PostJsonResponse, error) {
-	rsp, err := c.PostJsonWithBody(ctx, contentType, body)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostJsonResponse(rsp)
+// OnGetExport stubs the calls to GetExport.
+func (f *FakeClientWithResponses) OnGetExport() *GetExportStub {
+	return &GetExportStub{f.stub(FakeCall{Operation: "GetExport", PathParams: []interface{}{}})}
 }
 
-func (c *ClientWithResponses) PostJsonWithResponse(ctx context.Context, body PostJsonJSONRequestBody) (*PostJsonResponse, error) {
-	rsp, err := c.PostJson(ctx, body)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostJsonResponse(rsp)
+// Times only answers the given number of calls, which AssertExpectations
+// checks.
+func (s *GetExportStub) Times(n int) *GetExportStub {
+	s.stub.times = n
+	return s
 }
 
-// GetJsonWithResponse request returning *GetJsonResponse
-func (c *ClientWithResponses) GetJsonWithResponse(ctx context.Context) (*GetJsonResponse, error) {
-	rsp, err := c.GetJson(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetJsonResponse(rsp)
+// Return answers with the given response.
+func (s *GetExportStub) Return(rsp *GetExportResponse) *GetExportStub {
+	s.stub.response = rsp
+	return s
 }
 
-// PostOtherWithBodyWithResponse request with arbitrary body returning *PostOtherResponse
-func (c *ClientWithResponses) PostOtherWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader) (*PostOtherResponse, error) {
-	rsp, err := c.PostOtherWithBody(ctx, contentType, body)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostOtherResponse(rsp)
+// ReturnError fails with the given error.
+func (s *GetExportStub) ReturnError(err error) *GetExportStub {
+	s.stub.err = err
+	return s
 }
 
-// GetOtherWithResponse request returning *GetOtherResponse
-func (c *ClientWithResponses) GetOtherWithResponse(ctx context.Context) (*GetOtherResponse, error) {
-	rsp, err := c.GetOther(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetOtherResponse(rsp)
+// ReturnStatus answers with the given status code, and no body.
+func (s *GetExportStub) ReturnStatus(statusCode int) *GetExportStub {
+	s.stub.response = &GetExportResponse{HTTPResponse: fakeHTTPResponse(statusCode, "", nil)}
+	return s
 }
 
-// GetJsonWithTrailingSlashWithResponse request returning *GetJsonWithTrailingSlashResponse
-func (c *ClientWithResponses) GetJsonWithTrailingSlashWithResponse(ctx context.Context) (*GetJsonWithTrailingSlashResponse, error) {
-	rsp, err := c.GetJsonWithTrailingSlash(ctx)
+// GetExportWithResponse records the call, and answers it with the latest stub matching it.
+func (f *FakeClientWithResponses) GetExportWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetExportResponse, error) {
+	return f.doGetExport(FakeCall{Operation: "GetExport", PathParams: []interface{}{}})
+}
+
+func (f *FakeClientWithResponses) doGetExport(call FakeCall) (*GetExportResponse, error) {
+	rsp, err := f.do(call)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetJsonWithTrailingSlashResponse(rsp)
+	return rsp.(*GetExportResponse), nil
 }
 
-// ParsePostBothResponse parses an HTTP response from a PostBothWithResponse call
-func ParsePostBothResponse(rsp *http.Response) (*PostBothResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer rsp.Body.Close()
+// ListOffsetStub answers the calls to ListOffset, see OnListOffset.
+type ListOffsetStub struct {
+	stub *fakeStub
+}
+
+// OnListOffset stubs the calls to ListOffset.
+func (f *FakeClientWithResponses) OnListOffset() *ListOffsetStub {
+	return &ListOffsetStub{f.stub(FakeCall{Operation: "ListOffset", PathParams: []interface{}{}})}
+}
+
+// WithParams only answers the calls with the given parameters.
+func (s *ListOffsetStub) WithParams(params ListOffsetParams) *ListOffsetStub {
+	s.stub.call.Params = &params
+	s.stub.hasParams = true
+	return s
+}
+
+// Times only answers the given number of calls, which AssertExpectations
+// checks.
+func (s *ListOffsetStub) Times(n int) *ListOffsetStub {
+	s.stub.times = n
+	return s
+}
+
+// Return answers with the given response.
+func (s *ListOffsetStub) Return(rsp *ListOffsetResponse) *ListOffsetStub {
+	s.stub.response = rsp
+	return s
+}
+
+// ReturnError fails with the given error.
+func (s *ListOffsetStub) ReturnError(err error) *ListOffsetStub {
+	s.stub.err = err
+	return s
+}
+
+// ReturnStatus answers with the given status code, and no body.
+func (s *ListOffsetStub) ReturnStatus(statusCode int) *ListOffsetStub {
+	s.stub.response = &ListOffsetResponse{HTTPResponse: fakeHTTPResponse(statusCode, "", nil)}
+	return s
+}
+
+// Return200 answers with a 200, and the given payload.
+func (s *ListOffsetStub) Return200(payload []SchemaObject) *ListOffsetStub {
+	s.stub.returnPayload(200, "application/json", payload, json.Marshal, func(body []byte, rsp *http.Response) interface{} {
+		return &ListOffsetResponse{Body: body, HTTPResponse: rsp, JSON200: &payload}
+	})
+	return s
+}
+
+// ListOffsetWithResponse records the call, and answers it with the latest stub matching it.
+func (f *FakeClientWithResponses) ListOffsetWithResponse(ctx context.Context, params *ListOffsetParams, reqEditors ...RequestEditorFn) (*ListOffsetResponse, error) {
+	return f.doListOffset(FakeCall{Operation: "ListOffset", PathParams: []interface{}{}, Params: params})
+}
+
+func (f *FakeClientWithResponses) doListOffset(call FakeCall) (*ListOffsetResponse, error) {
+	rsp, err := f.do(call)
 	if err != nil {
 		return nil, err
 	}
+	return rsp.(*ListOffsetResponse), nil
+}
 
-	response := &PostBothResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+// PostOtherStub answers the calls to PostOther, see OnPostOther.
+type PostOtherStub struct {
+	stub *fakeStub
+}
 
-	switch {
-	}
+// OnPostOther stubs the calls to PostOther.
+func (f *FakeClientWithResponses) OnPostOther() *PostOtherStub {
+	return &PostOtherStub{f.stub(FakeCall{Operation: "PostOther", PathParams: []interface{}{}})}
+}
+
+// WithBody only answers the calls with the given body.
+func (s *PostOtherStub) WithBody(contentType string, body []byte) *PostOtherStub {
+	s.stub.call.ContentType, s.stub.call.Body = contentType, body
+	s.stub.hasBody = true
+	return s
+}
+
+// Times only answers the given number of calls, which AssertExpectations
+// checks.
+func (s *PostOtherStub) Times(n int) *PostOtherStub {
+	s.stub.times = n
+	return s
+}
+
+// Return answers with the given response.
+func (s *PostOtherStub) Return(rsp *PostOtherResponse) *PostOtherStub {
+	s.stub.response = rsp
+	return s
+}
+
+// ReturnError fails with the given error.
+func (s *PostOtherStub) ReturnError(err error) *PostOtherStub {
+	s.stub.err = err
+	return s
+}
+
+// ReturnStatus answers with the given status code, and no body.
+func (s *PostOtherStub) ReturnStatus(statusCode int) *PostOtherStub {
+	s.stub.response = &PostOtherResponse{HTTPResponse: fakeHTTPResponse(statusCode, "", nil)}
+	return s
+}
 
-	return response, nil
+// PostOtherWithBodyWithResponse records the call, and answers it with the latest stub matching it.
+func (f *FakeClientWithResponses) PostOtherWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOtherResponse, error) {
+	var buf []byte
+	if body != nil {
+		var err error
+		if buf, err = ioutil.ReadAll(body); err != nil {
+			return nil, err
+		}
+	}
+	return f.doPostOther(FakeCall{Operation: "PostOther", PathParams: []interface{}{}, ContentType: contentType, Body: buf})
 }
 
-// ParseGetBothResponse parses an HTTP response from a GetBothWithResponse call
-func ParseGetBothResponse(rsp *http.Response) (*GetBothResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer rsp.Body.Close()
+func (f *FakeClientWithResponses) doPostOther(call FakeCall) (*PostOtherResponse, error) {
+	rsp, err := f.do(call)
 	if err != nil {
 		return nil, err
 	}
+	return rsp.(*PostOtherResponse), nil
+}
 
-	response := &GetBothResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+// GetOtherStub answers the calls to GetOther, see OnGetOther.
+type GetOtherStub struct {
+	stub *fakeStub
+}
 
-	switch {
-	}
+// OnGetOther stubs the calls to GetOther.
+func (f *FakeClientWithResponses) OnGetOther() *GetOtherStub {
+	return &GetOtherStub{f.stub(FakeCall{Operation: "GetOther", PathParams: []interface{}{}})}
+}
 
-	return response, nil
+// Times only answers the given number of calls, which AssertExpectations
+// checks.
+func (s *GetOtherStub) Times(n int) *GetOtherStub {
+	s.stub.times = n
+	return s
 }
 
-// ParsePostJsonResponse parses an HTTP response from a PostJsonWithResponse call
-func ParsePostJsonResponse(rsp *http.Response) (*PostJsonResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer rsp.Body.Close()
-	if err != nil {
-		return nil, err
-	}
+// Return answers with the given response.
+func (s *GetOtherStub) Return(rsp *GetOtherResponse) *GetOtherStub {
+	s.stub.response = rsp
+	return s
+}
 
-	response := &PostJsonResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+// ReturnError fails with the given error.
+func (s *GetOtherStub) ReturnError(err error) *GetOtherStub {
+	s.stub.err = err
+	return s
+}
 
-	switch {
-	}
+// ReturnStatus answers with the given status code, and no body.
+func (s *GetOtherStub) ReturnStatus(statusCode int) *GetOtherStub {
+	s.stub.response = &GetOtherResponse{HTTPResponse: fakeHTTPResponse(statusCode, "", nil)}
+	return s
+}
 
-	return response, nil
+// GetOtherWithResponse records the call, and answers it with the latest stub matching it.
+func (f *FakeClientWithResponses) GetOtherWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOtherResponse, error) {
+	return f.doGetOther(FakeCall{Operation: "GetOther", PathParams: []interface{}{}})
 }
 
-// ParseGetJsonResponse parses an HTTP response from a GetJsonWithResponse call
-func ParseGetJsonResponse(rsp *http.Response) (*GetJsonResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer rsp.Body.Close()
+func (f *FakeClientWithResponses) doGetOther(call FakeCall) (*GetOtherResponse, error) {
+	rsp, err := f.do(call)
 	if err != nil {
 		return nil, err
 	}
+	return rsp.(*GetOtherResponse), nil
+}
 
-	response := &GetJsonResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+// GetWithServerStub answers the calls to GetWithServer, see OnGetWithServer.
+type GetWithServerStub struct {
+	stub *fakeStub
+}
 
-	switch {
-	}
+// OnGetWithServer stubs the calls to GetWithServer.
+func (f *FakeClientWithResponses) OnGetWithServer() *GetWithServerStub {
+	return &GetWithServerStub{f.stub(FakeCall{Operation: "GetWithServer", PathParams: []interface{}{}})}
+}
 
-	return response, nil
+// Times only answers the given number of calls, which AssertExpectations
+// checks.
+func (s *GetWithServerStub) Times(n int) *GetWithServerStub {
+	s.stub.times = n
+	return s
 }
 
-// ParsePostOtherResponse parses an HTTP response from a PostOtherWithResponse call
-func ParsePostOtherResponse(rsp *http.Response) (*PostOtherResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer rsp.Body.Close()
-	if err != nil {
-		return nil, err
-	}
+// Return answers with the given response.
+func (s *GetWithServerStub) Return(rsp *GetWithServerResponse) *GetWithServerStub {
+	s.stub.response = rsp
+	return s
+}
 
-	response := &PostOtherResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+// ReturnError fails with the given error.
+func (s *GetWithServerStub) ReturnError(err error) *GetWithServerStub {
+	s.stub.err = err
+	return s
+}
 
-	switch {
-	}
+// ReturnStatus answers with the given status code, and no body.
+func (s *GetWithServerStub) ReturnStatus(statusCode int) *GetWithServerStub {
+	s.stub.response = &GetWithServerResponse{HTTPResponse: fakeHTTPResponse(statusCode, "", nil)}
+	return s
+}
 
-	return response, nil
+// GetWithServerWithResponse records the call, and answers it with the latest stub matching it.
+func (f *FakeClientWithResponses) GetWithServerWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWithServerResponse, error) {
+	return f.doGetWithServer(FakeCall{Operation: "GetWithServer", PathParams: []interface{}{}})
 }
 
-// ParseGetOtherResponse parses an HTTP response from a GetOtherWithResponse call
-func ParseGetOtherResponse(rsp *http.Response) (*GetOtherResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer rsp.Body.Close()
+func (f *FakeClientWithResponses) doGetWithServer(call FakeCall) (*GetWithServerResponse, error) {
+	rsp, err := f.do(call)
 	if err != nil {
 		return nil, err
 	}
+	return rsp.(*GetWithServerResponse), nil
+}
 
-	response := &GetOtherResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+// GetJsonWithTrailingSlashStub answers the calls to GetJsonWithTrailingSlash, see OnGetJsonWithTrailingSlash.
+type GetJsonWithTrailingSlashStub struct {
+	stub *fakeStub
+}
 
-	switch {
-	}
+// OnGetJsonWithTrailingSlash stubs the calls to GetJsonWithTrailingSlash.
+func (f *FakeClientWithResponses) OnGetJsonWithTrailingSlash() *GetJsonWithTrailingSlashStub {
+	return &GetJsonWithTrailingSlashStub{f.stub(FakeCall{Operation: "GetJsonWithTrailingSlash", PathParams: []interface{}{}})}
+}
 
-	return response, nil
+// Times only answers the given number of calls, which AssertExpectations
+// checks.
+func (s *GetJsonWithTrailingSlashStub) Times(n int) *GetJsonWithTrailingSlashStub {
+	s.stub.times = n
+	return s
 }
 
-// ParseGetJsonWithTrailingSlashResponse parses an HTTP response from a GetJsonWithTrailingSlashWithResponse call
-func ParseGetJsonWithTrailingSlashResponse(rsp *http.Response) (*GetJsonWithTrailingSlashResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer rsp.Body.Close()
-	if err != nil {
-		return nil, err
-	}
+// Return answers with the given response.
+func (s *GetJsonWithTrailingSlashStub) Return(rsp *GetJsonWithTrailingSlashResponse) *GetJsonWithTrailingSlashStub {
+	s.stub.response = rsp
+	return s
+}
 
-	response := &GetJsonWithTrailingSlashResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+// ReturnError fails with the given error.
+func (s *GetJsonWithTrailingSlashStub) ReturnError(err error) *GetJsonWithTrailingSlashStub {
+	s.stub.err = err
+	return s
+}
 
-	switch {
-	}
+// ReturnStatus answers with the given status code, and no body.
+func (s *GetJsonWithTrailingSlashStub) ReturnStatus(statusCode int) *GetJsonWithTrailingSlashStub {
+	s.stub.response = &GetJsonWithTrailingSlashResponse{HTTPResponse: fakeHTTPResponse(statusCode, "", nil)}
+	return s
+}
 
-	return response, nil
+// Return200 answers with a 200, and the given payload.
+func (s *GetJsonWithTrailingSlashStub) Return200(payload SchemaObject) *GetJsonWithTrailingSlashStub {
+	s.stub.returnPayload(200, "application/json", payload, json.Marshal, func(body []byte, rsp *http.Response) interface{} {
+		return &GetJsonWithTrailingSlashResponse{Body: body, HTTPResponse: rsp, JSON200: &payload}
+	})
+	return s
+}
+
+// GetJsonWithTrailingSlashWithResponse records the call, and answers it with the latest stub matching it.
+func (f *FakeClientWithResponses) GetJsonWithTrailingSlashWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetJsonWithTrailingSlashResponse, error) {
+	return f.doGetJsonWithTrailingSlash(FakeCall{Operation: "GetJsonWithTrailingSlash", PathParams: []interface{}{}})
+}
+
+func (f *FakeClientWithResponses) doGetJsonWithTrailingSlash(call FakeCall) (*GetJsonWithTrailingSlashResponse, error) {
+	rsp, err := f.do(call)
+	if err != nil {
+		return nil, err
+	}
+	return rsp.(*GetJsonWithTrailingSlashResponse), nil
 }
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 
+	// (GET /objects/{id})
+	GetObject(ctx echo.Context, id int) error
+
 	// (POST /with_both_bodies)
 	PostBoth(ctx echo.Context) error
 
 	// (GET /with_both_responses)
 	GetBoth(ctx echo.Context) error
 
+	// (GET /with_cursor_pagination)
+	ListCursor(ctx echo.Context, params ListCursorParams) error
+
+	// (POST /with_download)
+	PostDownload(ctx echo.Context) error
+
+	// (GET /with_error_responses)
+	GetWithErrors(ctx echo.Context) error
+
+	// (GET /with_event_stream)
+	GetEvents(ctx echo.Context) error
+
 	// (POST /with_json_body)
 	PostJson(ctx echo.Context) error
 
 	// (GET /with_json_response)
 	GetJson(ctx echo.Context) error
 
+	// (GET /with_link_pagination)
+	ListLink(ctx echo.Context) error
+
+	// (GET /with_ndjson)
+	GetExport(ctx echo.Context) error
+
+	// (GET /with_offset_pagination)
+	ListOffset(ctx echo.Context, params ListOffsetParams) error
+
 	// (POST /with_other_body)
 	PostOther(ctx echo.Context) error
 
 	// (GET /with_other_response)
 	GetOther(ctx echo.Context) error
 
+	// (GET /with_server_override)
+	GetWithServer(ctx echo.Context) error
+
 	// (GET /with_trailing_slash/)
 	GetJsonWithTrailingSlash(ctx echo.Context) error
 }
@@ -925,6 +5144,36 @@ type ServerInterface interface {
 // ServerInterfaceWrapper converts echo contexts to parameters.
 type ServerInterfaceWrapper struct {
 	Handler ServerInterface
+
+	// ErrorHandlerFunc answers requests whose parameters can't be bound, with
+	// their InvalidParamFormatError, RequiredParamError, UnmarshalingParamError
+	// or TooManyValuesForParamError, of the runtime package. They are answered
+	// with a 400 echo.HTTPError if it is nil.
+	ErrorHandlerFunc func(ctx echo.Context, err error) error
+}
+
+// handleError answers a request whose parameters can't be bound.
+func (w *ServerInterfaceWrapper) handleError(ctx echo.Context, err error) error {
+	if w.ErrorHandlerFunc != nil {
+		return w.ErrorHandlerFunc(ctx, err)
+	}
+	return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+}
+
+// GetObject converts echo context to params.
+func (w *ServerInterfaceWrapper) GetObject(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameter("simple", false, "id", ctx.Param("id"), &id)
+	if err != nil {
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationPath, "id", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetObject(ctx, id)
+	return err
 }
 
 // PostBoth converts echo context to params.
@@ -945,6 +5194,51 @@ func (w *ServerInterfaceWrapper) GetBoth(ctx echo.Context) error {
 	return err
 }
 
+// ListCursor converts echo context to params.
+func (w *ServerInterfaceWrapper) ListCursor(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListCursorParams
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationQuery, "cursor", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ListCursor(ctx, params)
+	return err
+}
+
+// PostDownload converts echo context to params.
+func (w *ServerInterfaceWrapper) PostDownload(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PostDownload(ctx)
+	return err
+}
+
+// GetWithErrors converts echo context to params.
+func (w *ServerInterfaceWrapper) GetWithErrors(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetWithErrors(ctx)
+	return err
+}
+
+// GetEvents converts echo context to params.
+func (w *ServerInterfaceWrapper) GetEvents(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetEvents(ctx)
+	return err
+}
+
 // PostJson converts echo context to params.
 func (w *ServerInterfaceWrapper) PostJson(ctx echo.Context) error {
 	var err error
@@ -958,6 +5252,8 @@ func (w *ServerInterfaceWrapper) PostJson(ctx echo.Context) error {
 func (w *ServerInterfaceWrapper) GetJson(ctx echo.Context) error {
 	var err error
 
+	// Deprecated: the scopes are set under string keys only for handlers
+	// which predate OperationInfoFromContext, and its Security.
 	ctx.Set("OpenId.Scopes", []string{"json.read", "json.admin"})
 
 	// Invoke the callback with all the unmarshalled arguments
@@ -965,6 +5261,49 @@ func (w *ServerInterfaceWrapper) GetJson(ctx echo.Context) error {
 	return err
 }
 
+// ListLink converts echo context to params.
+func (w *ServerInterfaceWrapper) ListLink(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ListLink(ctx)
+	return err
+}
+
+// GetExport converts echo context to params.
+func (w *ServerInterfaceWrapper) GetExport(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetExport(ctx)
+	return err
+}
+
+// ListOffset converts echo context to params.
+func (w *ServerInterfaceWrapper) ListOffset(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListOffsetParams
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationQuery, "offset", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationQuery, "limit", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ListOffset(ctx, params)
+	return err
+}
+
 // PostOther converts echo context to params.
 func (w *ServerInterfaceWrapper) PostOther(ctx echo.Context) error {
 	var err error
@@ -983,10 +5322,21 @@ func (w *ServerInterfaceWrapper) GetOther(ctx echo.Context) error {
 	return err
 }
 
+// GetWithServer converts echo context to params.
+func (w *ServerInterfaceWrapper) GetWithServer(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetWithServer(ctx)
+	return err
+}
+
 // GetJsonWithTrailingSlash converts echo context to params.
 func (w *ServerInterfaceWrapper) GetJsonWithTrailingSlash(ctx echo.Context) error {
 	var err error
 
+	// Deprecated: the scopes are set under string keys only for handlers
+	// which predate OperationInfoFromContext, and its Security.
 	ctx.Set("OpenId.Scopes", []string{"json.read", "json.admin"})
 
 	// Invoke the callback with all the unmarshalled arguments
@@ -1009,35 +5359,391 @@ type EchoRouter interface {
 	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
 }
 
+// RegisterOptions configures the middlewares and the error handling of the
+// routes added by RegisterHandlersWithOptions.
+type RegisterOptions struct {
+	// The middlewares of operations, by operation ID.
+	OperationMiddlewares map[string][]echo.MiddlewareFunc
+
+	// The middlewares of the operations with a tag, by tag.
+	TagMiddlewares map[string][]echo.MiddlewareFunc
+
+	// The ErrorHandlerFunc of the ServerInterfaceWrapper.
+	ErrorHandlerFunc func(ctx echo.Context, err error) error
+}
+
 // RegisterHandlers adds each server route to the EchoRouter.
 func RegisterHandlers(router EchoRouter, si ServerInterface) {
+	RegisterHandlersWithOptions(router, si, RegisterOptions{})
+}
+
+// RegisterHandlersWithOptions adds each server route to the EchoRouter, with
+// the middlewares of the tags of its operation, in the order of the tags, and
+// then those of the operation. The OperationInfo of the operation is put into
+// the context of the request before any of them runs.
+func RegisterHandlersWithOptions(router EchoRouter, si ServerInterface, opts RegisterOptions) {
 
 	wrapper := ServerInterfaceWrapper{
-		Handler: si,
+		Handler:          si,
+		ErrorHandlerFunc: opts.ErrorHandlerFunc,
 	}
 
-	router.POST("/with_both_bodies", wrapper.PostBoth)
-	router.GET("/with_both_responses", wrapper.GetBoth)
-	router.POST("/with_json_body", wrapper.PostJson)
-	router.GET("/with_json_response", wrapper.GetJson)
-	router.POST("/with_other_body", wrapper.PostOther)
-	router.GET("/with_other_response", wrapper.GetOther)
-	router.GET("/with_trailing_slash/", wrapper.GetJsonWithTrailingSlash)
+	router.GET("/objects/:id", wrapper.GetObject, opts.middlewares("GetObject")...)
+	router.POST("/with_both_bodies", wrapper.PostBoth, opts.middlewares("PostBoth")...)
+	router.GET("/with_both_responses", wrapper.GetBoth, opts.middlewares("GetBoth")...)
+	router.GET("/with_cursor_pagination", wrapper.ListCursor, opts.middlewares("ListCursor")...)
+	router.POST("/with_download", wrapper.PostDownload, opts.middlewares("PostDownload")...)
+	router.GET("/with_error_responses", wrapper.GetWithErrors, opts.middlewares("GetWithErrors")...)
+	router.GET("/with_event_stream", wrapper.GetEvents, opts.middlewares("GetEvents")...)
+	router.POST("/with_json_body", wrapper.PostJson, opts.middlewares("PostJson")...)
+	router.GET("/with_json_response", wrapper.GetJson, opts.middlewares("GetJson")...)
+	router.GET("/with_link_pagination", wrapper.ListLink, opts.middlewares("ListLink")...)
+	router.GET("/with_ndjson", wrapper.GetExport, opts.middlewares("GetExport")...)
+	router.GET("/with_offset_pagination", wrapper.ListOffset, opts.middlewares("ListOffset")...)
+	router.POST("/with_other_body", wrapper.PostOther, opts.middlewares("PostOther")...)
+	router.GET("/with_other_response", wrapper.GetOther, opts.middlewares("GetOther")...)
+	router.GET("/with_server_override", wrapper.GetWithServer, opts.middlewares("GetWithServer")...)
+	router.GET("/with_trailing_slash/", wrapper.GetJsonWithTrailingSlash, opts.middlewares("GetJsonWithTrailingSlash")...)
 
 }
 
+// middlewares returns the middlewares of the route of an operation.
+func (opts RegisterOptions) middlewares(operationId string) []echo.MiddlewareFunc {
+	info := Operations[operationId]
+	middlewares := []echo.MiddlewareFunc{
+		func(next echo.HandlerFunc) echo.HandlerFunc {
+			return func(ctx echo.Context) error {
+				r := ctx.Request()
+				ctx.SetRequest(r.WithContext(ContextWithOperationInfo(r.Context(), info)))
+				return next(ctx)
+			}
+		},
+	}
+	for _, tag := range info.Tags {
+		middlewares = append(middlewares, opts.TagMiddlewares[tag]...)
+	}
+	return append(middlewares, opts.OperationMiddlewares[operationId]...)
+}
+
+// OperationInfo describes an operation of the API, for the middlewares serving
+// it, which find it in the context of its requests.
+type OperationInfo struct {
+	OperationId string                     // The operation ID, as the names of the generated code use it
+	Method      string                     // The HTTP method, eg. GET
+	Path        string                     // The path template of the spec, eg. /pets/{id}
+	Tags        []string                   // The tags of the operation
+	Security    []OperationSecurity        // The security providers of the operation
+	Extensions  map[string]json.RawMessage // The x- extensions of the operation, by name
+}
+
+// OperationSecurity is a security provider of an operation, and the scopes it
+// requires.
+type OperationSecurity struct {
+	ProviderName string
+	Scopes       []string
+}
+
+// Operations holds the OperationInfo of every operation, by operation ID.
+var Operations = map[string]OperationInfo{
+	"GetObject": {
+		OperationId: "GetObject",
+		Method:      "GET",
+		Path:        "/objects/{id}",
+	},
+	"PostBoth": {
+		OperationId: "PostBoth",
+		Method:      "POST",
+		Path:        "/with_both_bodies",
+	},
+	"GetBoth": {
+		OperationId: "GetBoth",
+		Method:      "GET",
+		Path:        "/with_both_responses",
+	},
+	"ListCursor": {
+		OperationId: "ListCursor",
+		Method:      "GET",
+		Path:        "/with_cursor_pagination",
+		Extensions: map[string]json.RawMessage{
+			"x-pagination": json.RawMessage("{\"cursorParam\":\"cursor\",\"items\":\"/data\",\"nextCursor\":\"/meta/next\",\"strategy\":\"cursor\"}"),
+		},
+	},
+	"PostDownload": {
+		OperationId: "PostDownload",
+		Method:      "POST",
+		Path:        "/with_download",
+	},
+	"GetWithErrors": {
+		OperationId: "GetWithErrors",
+		Method:      "GET",
+		Path:        "/with_error_responses",
+	},
+	"GetEvents": {
+		OperationId: "GetEvents",
+		Method:      "GET",
+		Path:        "/with_event_stream",
+	},
+	"PostJson": {
+		OperationId: "PostJson",
+		Method:      "POST",
+		Path:        "/with_json_body",
+	},
+	"GetJson": {
+		OperationId: "GetJson",
+		Method:      "GET",
+		Path:        "/with_json_response",
+		Security: []OperationSecurity{
+			{ProviderName: "OpenId", Scopes: []string{"json.read", "json.admin"}},
+		},
+	},
+	"ListLink": {
+		OperationId: "ListLink",
+		Method:      "GET",
+		Path:        "/with_link_pagination",
+		Extensions: map[string]json.RawMessage{
+			"x-pagination": json.RawMessage("{\"items\":\"/data\",\"strategy\":\"link\"}"),
+		},
+	},
+	"GetExport": {
+		OperationId: "GetExport",
+		Method:      "GET",
+		Path:        "/with_ndjson",
+	},
+	"ListOffset": {
+		OperationId: "ListOffset",
+		Method:      "GET",
+		Path:        "/with_offset_pagination",
+		Extensions: map[string]json.RawMessage{
+			"x-pagination": json.RawMessage("{\"cursorParam\":\"offset\",\"strategy\":\"offset\"}"),
+		},
+	},
+	"PostOther": {
+		OperationId: "PostOther",
+		Method:      "POST",
+		Path:        "/with_other_body",
+		Extensions: map[string]json.RawMessage{
+			"x-idempotent": json.RawMessage("true"),
+		},
+	},
+	"GetOther": {
+		OperationId: "GetOther",
+		Method:      "GET",
+		Path:        "/with_other_response",
+	},
+	"GetWithServer": {
+		OperationId: "GetWithServer",
+		Method:      "GET",
+		Path:        "/with_server_override",
+	},
+	"GetJsonWithTrailingSlash": {
+		OperationId: "GetJsonWithTrailingSlash",
+		Method:      "GET",
+		Path:        "/with_trailing_slash/",
+		Security: []OperationSecurity{
+			{ProviderName: "OpenId", Scopes: []string{"json.read", "json.admin"}},
+		},
+	},
+}
+
+// operationInfoKey is the context key of the OperationInfo of a request.
+type operationInfoKey struct{}
+
+// ContextWithOperationInfo returns a copy of ctx holding info, which
+// OperationInfoFromContext returns.
+func ContextWithOperationInfo(ctx context.Context, info OperationInfo) context.Context {
+	return context.WithValue(ctx, operationInfoKey{}, info)
+}
+
+// OperationInfoFromContext returns the OperationInfo of the operation serving
+// a request, from its context, and whether there's one.
+func OperationInfoFromContext(ctx context.Context) (OperationInfo, bool) {
+	info, ok := ctx.Value(operationInfoKey{}).(OperationInfo)
+	return info, ok
+}
+
+// GetEventsWriter writes the text/event-stream response of GetEvents, one
+// event at a time. It must be closed before the handler returns.
+type GetEventsWriter struct {
+	*streamWriter
+}
+
+// NewGetEventsWriter starts the text/event-stream response of GetEvents.
+// The stream ends when the client goes away, which cancels the context of r.
+func NewGetEventsWriter(w http.ResponseWriter, r *http.Request) *GetEventsWriter {
+	return &GetEventsWriter{newStreamWriter(w, r, "text/event-stream")}
+}
+
+// Send writes an event and flushes it to the client. It fails once the
+// client went away.
+func (s *GetEventsWriter) Send(ev GetEventsEvent) error {
+	data, err := json.Marshal(ev.Data)
+	if err != nil {
+		return err
+	}
+	buf, err := formatEvent(ev.ID, ev.Event, ev.Retry, string(data))
+	if err != nil {
+		return err
+	}
+	return s.write(buf)
+}
+
+// Heartbeat sends a comment every interval, until the writer is closed, so
+// that idle streams aren't closed by proxies.
+func (s *GetEventsWriter) Heartbeat(interval time.Duration) {
+	s.heartbeat(interval, []byte(":\n\n"))
+}
+
+// GetExportWriter writes the application/x-ndjson response of GetExport, one
+// line at a time. It must be closed before the handler returns.
+type GetExportWriter struct {
+	*streamWriter
+}
+
+// NewGetExportWriter starts the application/x-ndjson response of GetExport.
+// The stream ends when the client goes away, which cancels the context of r.
+func NewGetExportWriter(w http.ResponseWriter, r *http.Request) *GetExportWriter {
+	return &GetExportWriter{newStreamWriter(w, r, "application/x-ndjson")}
+}
+
+// Send writes a line and flushes it to the client. It fails once the client
+// went away.
+func (s *GetExportWriter) Send(item SchemaObject) error {
+	buf, err := json.Marshal(item)
+	if err != nil {
+		return err
+	}
+	return s.write(append(buf, '\n'))
+}
+
+// Heartbeat sends an empty line every interval, until the writer is closed,
+// so that idle streams aren't closed by proxies.
+func (s *GetExportWriter) Heartbeat(interval time.Duration) {
+	s.heartbeat(interval, []byte("\n"))
+}
+
+// streamWriter writes a streamed response, flushing what it writes to the
+// client right away.
+type streamWriter struct {
+	ctx    context.Context
+	w      http.ResponseWriter
+	mu     sync.Mutex
+	closed chan struct{}
+}
+
+func newStreamWriter(w http.ResponseWriter, r *http.Request, contentType string) *streamWriter {
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	if f, ok := w.(http.Flusher); ok {
+		f.Flush()
+	}
+	return &streamWriter{ctx: r.Context(), w: w, closed: make(chan struct{})}
+}
+
+// Done is closed once the client went away.
+func (s *streamWriter) Done() <-chan struct{} {
+	return s.ctx.Done()
+}
+
+// Close stops the heartbeats, and any further write.
+func (s *streamWriter) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	select {
+	case <-s.closed:
+	default:
+		close(s.closed)
+	}
+	return nil
+}
+
+func (s *streamWriter) write(buf []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	select {
+	case <-s.closed:
+		return errors.New("write to closed stream")
+	default:
+	}
+	if err := s.ctx.Err(); err != nil {
+		return err
+	}
+	if _, err := s.w.Write(buf); err != nil {
+		return err
+	}
+	if f, ok := s.w.(http.Flusher); ok {
+		f.Flush()
+	}
+	return nil
+}
+
+func (s *streamWriter) heartbeat(interval time.Duration, beat []byte) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-s.closed:
+				return
+			case <-s.ctx.Done():
+				return
+			case <-ticker.C:
+				if err := s.write(beat); err != nil {
+					return
+				}
+			}
+		}
+	}()
+}
+
+// formatEvent formats a Server-Sent Event, as described in
+// https://html.spec.whatwg.org/multipage/server-sent-events.html
+func formatEvent(id, event string, retry time.Duration, data string) ([]byte, error) {
+	if strings.ContainsAny(id, "\r\n\x00") || strings.ContainsAny(event, "\r\n") {
+		return nil, errors.New("event IDs and types can't hold line breaks")
+	}
+	var buf bytes.Buffer
+	if id != "" {
+		fmt.Fprintf(&buf, "id: %s\n", id)
+	}
+	if event != "" {
+		fmt.Fprintf(&buf, "event: %s\n", event)
+	}
+	if retry > 0 {
+		fmt.Fprintf(&buf, "retry: %d\n", retry/time.Millisecond)
+	}
+	data = strings.Replace(strings.Replace(data, "\r\n", "\n", -1), "\r", "\n", -1)
+	for _, line := range strings.Split(data, "\n") {
+		fmt.Fprintf(&buf, "data: %s\n", line)
+	}
+	buf.WriteString("\n")
+	return buf.Bytes(), nil
+}
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/8yUz24TMRDGX2U1cFyyKdz2CAdUJAgikTiEqHK8k9jVrm1mJq1W0b47GicliahCkGjV",
-	"SzTO/NE338/rLdjYpRgwCEO9BbYOO5PDaQ4ny1u0oudEMSGJx5xdeWL5YjrUg/QJoQYW8mENQwkU28cS",
-	"msGfG0/YQD3fVZVHoxaDlviwitrcIFvySXwMUMPMeS4EWbi4dygOqRCHxYfWY5DChGYffvfiviGnGBi5",
-	"MITFGgOSEWwKG4nQStv/CFBC6y0GzjpDXgQ+X89UvXhR+TBDlmKKdIcEJdwh8U7K1Wg8GmthTBhM8lDD",
-	"u9F4dAUlJCMu+1Pde3E3y5h/mr1pKXK2Uo00utd1AzV8jSzvozjYuYN6anqtszEIhtxiUmq9zU3VLauM",
-	"B1gavSZcQQ2vqgPNao+yOuGo/h6PilZQ3rAQmu505CpSZwRqWPpgqIfyD5gnNIU2mP/YOw912LSt1hw5",
-	"cZTdwhof8eIjHqw4qn07Hr9UE4bDjipJaffnWX9S5c/C+p8IZfUP2XOAfut/QkAqi9FuyEsP9XwLk4RZ",
-	"wBx07ojQNFDuYtN0PsBiWBx2ifo+XIBionUXs3i2j2Un/xIWhwXOw/hfV1zI+NaH9Q23hl31t2uij/Fs",
-	"3zLVjhd6b4bhVwAAAP//2pHiCAkHAAA=",
+	"H4sIAAAAAAAC/+RYTY/bNhP+K8K871GxnDSHVMemQZEi7QbNFj2kiwVXGlvMSiQzHHltGPrvxVCyJfkr",
+	"DuJut+jFlsghZ+Z55oPiGjJbOWvQsId0DT4rsFLh8Q2Rpau7T5ixvDqyDok1hskKvVdzlEdeOYQUPJM2",
+	"c2iaGAg/15owh/TjVvCmieFD2PzYljNNnn9V1aFNYyBbnqEtSMWDrW5EwmNWk+ZV0N8qu3Jo3uZBbWkf",
+	"wlBWajT8mjBHw1qVHR7WtSs+eWsmKq+0gRQqZdQcIxtc8RC3s4QqhxTkbzvVxMD2Hs3vVEIKBbPzaZLg",
+	"UlWuxElmqyRMB08636yquXgBjQxpM7OiPUefkXasrai/LrSPGD376KFALpAiLjB6HTyIlMm7xz80F7+h",
+	"d9Z49JEijOZokBRjHmWWCDMuV38aiKHUGRofEDaBAvjl7XUwXrMAD9foOfqAtECCGBZIvjXl+WQ6mYqg",
+	"dWiU05DCd5Pp5DnE4BQXAbqkAyNZ67yRgTkG/oV9JS4JE/ATchcaspRUhYzkIf24hoC5bAfxxjydw5B6",
+	"phrjLnoHYaIN4xwJmuZGpDskRODFdCp/mTWMJlijnCt1FuxJhM4+HeTp/4QzSOF/SZ8vSTvrk1FcB9rG",
+	"dAk3tpuN4eX05cU0D3P0gGJjOZrZ2uQy2cSQPGgubu9s+Mm7vHPWH2DjvfX8g+WiQxnlLV/9XZDFo61s",
+	"xsjPPBOqarzlzFKlGFK400bRCuK9etDsRkWzR/zL/YTybAkPoDRaeSxqtzA9TnhdFKtjgRpHliLNPuo8",
+	"8CNsspq8pVun5tqodu0ReN5pz6+D9JGs/lwjrfq0zjaye6m8tfpbM3ncdXLFYVQzVv7rmNgCqojUSt4r",
+	"5AMqDC75cOvqRmxP7e7IHkUqcqHzzPoO08SwfDbmooXxveA9BLVzEpLgdRws69hJIRHrExkS+Fm6xHzV",
+	"rx7Qn9sHU1qVn64eP26kHqWCNM1XhcXF0+ZgniCRpfOKiLTqUM09/Mc7lej9fr9EZ9bMSt0aluNM1SU/",
+	"lnG1waXDTE5NgdExxws0fNtH0jGC34jcGeQyLjkJmx4Mz29hV0XtjuPq0bsioN3edUl6PLF/FmwfJ6kv",
+	"1cyDZ5uVp1ja+vYEEnDw5RL65eab5ePgayMefpfcNDe9y6U29+d26Hfa3MNTbauX64q77W/Q5AStYYsz",
+	"+Sd/AjTJ56WzxF+H2vJZv+/jpLSdzTzyuXFwFaTPO6nZjeyJj6748NJSV/oLK7/1kHeJuLvI2auHqQ+2",
+	"bmxIk3zDn1F6r0Tu7Nr7D35HCSw6x8rZ1rCww46759Tj3uEndrTz4Srk1i6QSOf4paPd9ubkkCNjlfa+",
+	"K/202ORfPbo8qp0cq/1keIm07m5kGrmcUaTVXdmq6MblEU1dSfdYyOXM4gXcDA5S8t6EhD2ty9Ichl2G",
+	"SelSm/mtL5Uvki+1VoHiulvyQVb8u3vtSZrWhHNhZKKcHnN1pzy+V1w0sGuSI5vXWXjZ4XGzpo2XLWvP",
+	"w91oUDSkGGuIofZjirHe03ddYNSulqomgOToSruqBPzdeEiTpLSZKgvrOX01fTXd2y5MS3z8NQDjv+GT",
+	"VxYAAA==",
 }
 
 // GetSwagger returns the Swagger specification corresponding to the generated code
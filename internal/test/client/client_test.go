@@ -1,9 +1,19 @@
 package client
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestTemp(t *testing.T) {
@@ -42,3 +52,710 @@ func TestTemp(t *testing.T) {
 	assert.Equal(t, expectedURL, client3.Server)
 	assert.Equal(t, expectedURL, client4.Server)
 }
+
+// doerFunc turns a function into a HttpRequestDoer.
+type doerFunc func(req *http.Request) (*http.Response, error)
+
+func (f doerFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// recordingDoer answers with the given status codes in turn, or a network
+// error for a 0 status code, and records the bodies it was sent.
+func recordingDoer(bodies *[]string, header http.Header, statusCodes ...int) HttpRequestDoer {
+	return doerFunc(func(req *http.Request) (*http.Response, error) {
+		var body string
+		if req.Body != nil {
+			b, err := ioutil.ReadAll(req.Body)
+			if err != nil {
+				return nil, err
+			}
+			body = string(b)
+		}
+		*bodies = append(*bodies, body)
+
+		statusCode := statusCodes[len(*bodies)-1]
+		if statusCode == 0 {
+			return nil, errors.New("connection reset")
+		}
+		return &http.Response{
+			StatusCode: statusCode,
+			Header:     header,
+			Body:       ioutil.NopCloser(strings.NewReader("")),
+		}, nil
+	})
+}
+
+func TestRetryPolicy(t *testing.T) {
+	policy := RetryPolicy{
+		MaxRetries: 3,
+		MinBackoff: time.Millisecond,
+		MaxBackoff: 10 * time.Millisecond,
+	}
+
+	t.Run("retries idempotent operations", func(t *testing.T) {
+		var bodies []string
+		client, err := NewClient("http://example.com",
+			WithHTTPClient(recordingDoer(&bodies, nil, 503, 0, 429, 200)),
+			WithRetryPolicy(policy))
+		require.NoError(t, err)
+
+		rsp, err := client.GetJson(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 200, rsp.StatusCode)
+		assert.Len(t, bodies, 4)
+	})
+
+	t.Run("gives up after the last retry", func(t *testing.T) {
+		var bodies []string
+		client, err := NewClient("http://example.com",
+			WithHTTPClient(recordingDoer(&bodies, nil, 500, 502, 503, 504)),
+			WithRetryPolicy(policy))
+		require.NoError(t, err)
+
+		rsp, err := client.GetJson(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 504, rsp.StatusCode)
+		assert.Len(t, bodies, 4)
+	})
+
+	t.Run("doesn't retry client errors", func(t *testing.T) {
+		var bodies []string
+		client, err := NewClient("http://example.com",
+			WithHTTPClient(recordingDoer(&bodies, nil, 404)),
+			WithRetryPolicy(policy))
+		require.NoError(t, err)
+
+		rsp, err := client.GetJson(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 404, rsp.StatusCode)
+		assert.Len(t, bodies, 1)
+	})
+
+	t.Run("doesn't retry other operations", func(t *testing.T) {
+		var bodies []string
+		client, err := NewClient("http://example.com",
+			WithHTTPClient(recordingDoer(&bodies, nil, 503)),
+			WithRetryPolicy(policy))
+		require.NoError(t, err)
+
+		rsp, err := client.PostJson(context.Background(), PostJsonJSONRequestBody{Role: "admin"})
+		require.NoError(t, err)
+		assert.Equal(t, 503, rsp.StatusCode)
+		assert.Len(t, bodies, 1)
+	})
+
+	t.Run("retries other operations when asked to", func(t *testing.T) {
+		var bodies []string
+		retryAll := policy
+		retryAll.RetryNonIdempotent = true
+		client, err := NewClient("http://example.com",
+			WithHTTPClient(recordingDoer(&bodies, nil, 503, 200)),
+			WithRetryPolicy(retryAll))
+		require.NoError(t, err)
+
+		rsp, err := client.PostJson(context.Background(), PostJsonJSONRequestBody{Role: "admin"})
+		require.NoError(t, err)
+		assert.Equal(t, 200, rsp.StatusCode)
+		require.Len(t, bodies, 2)
+		assert.Equal(t, bodies[0], bodies[1])
+	})
+
+	t.Run("rewinds bodies of x-idempotent operations", func(t *testing.T) {
+		var bodies []string
+		client, err := NewClient("http://example.com",
+			WithHTTPClient(recordingDoer(&bodies, nil, 0, 503, 200)),
+			WithRetryPolicy(policy))
+		require.NoError(t, err)
+
+		// A reader which can't be rewound by net/http itself.
+		body := ioutil.NopCloser(strings.NewReader("some bytes"))
+		rsp, err := client.PostOtherWithBody(context.Background(), "application/octet-stream", body)
+		require.NoError(t, err)
+		assert.Equal(t, 200, rsp.StatusCode)
+		assert.Equal(t, []string{"some bytes", "some bytes", "some bytes"}, bodies)
+	})
+
+	t.Run("honors Retry-After", func(t *testing.T) {
+		var bodies []string
+		// The server asks for more than the policy allows, so we wait for
+		// MaxBackoff instead.
+		header := http.Header{"Retry-After": []string{"3600"}}
+		client, err := NewClient("http://example.com",
+			WithHTTPClient(recordingDoer(&bodies, header, 429, 200)),
+			WithRetryPolicy(policy))
+		require.NoError(t, err)
+
+		start := time.Now()
+		rsp, err := client.GetJson(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 200, rsp.StatusCode)
+		assert.True(t, time.Since(start) >= policy.MaxBackoff)
+		assert.True(t, time.Since(start) < time.Minute)
+	})
+
+	t.Run("stops when the context is done", func(t *testing.T) {
+		var bodies []string
+		slow := policy
+		slow.MinBackoff = time.Hour
+		slow.MaxBackoff = time.Hour
+		client, err := NewClient("http://example.com",
+			WithHTTPClient(recordingDoer(&bodies, nil, 503, 200)),
+			WithRetryPolicy(slow))
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		_, err = client.GetJson(ctx)
+		assert.Equal(t, context.DeadlineExceeded, err)
+		assert.Len(t, bodies, 1)
+	})
+}
+
+func TestEditors(t *testing.T) {
+	var headers []http.Header
+	doer := doerFunc(func(req *http.Request) (*http.Response, error) {
+		headers = append(headers, req.Header)
+		return &http.Response{
+			StatusCode: 200,
+			Header:     http.Header{},
+			Body:       ioutil.NopCloser(strings.NewReader(`{"role": "admin", "firstName": "Alex"}`)),
+		}, nil
+	})
+	setHeader := func(name, value string) RequestEditorFn {
+		return func(ctx context.Context, req *http.Request) error {
+			req.Header.Add(name, value)
+			return nil
+		}
+	}
+
+	var statusCodes []int
+	client, err := NewClientWithResponses("http://example.com",
+		WithHTTPClient(doer),
+		WithRequestEditorFn(setHeader("Authorization", "Bearer token")),
+		WithRequestEditorFn(setHeader("X-Request-Id", "1")),
+		WithResponseEditorFn(func(ctx context.Context, rsp *http.Response) error {
+			statusCodes = append(statusCodes, rsp.StatusCode)
+			return nil
+		}),
+		WithResponseEditorFn(func(ctx context.Context, rsp *http.Response) error {
+			rsp.Header.Set("Content-Type", "application/json")
+			return nil
+		}),
+	)
+	require.NoError(t, err)
+
+	rsp, err := client.GetJsonWithResponse(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 200, rsp.StatusCode())
+	assert.Equal(t, "application/json", rsp.HTTPResponse.Header.Get("Content-Type"))
+
+	// Editors given for a single call run after those of the client.
+	_, err = client.PostJsonWithResponse(context.Background(), PostJsonJSONRequestBody{Role: "admin"},
+		setHeader("X-Request-Id", "2"), setHeader("X-Impersonate", "alex"))
+	require.NoError(t, err)
+
+	require.Len(t, headers, 2)
+	assert.Equal(t, "Bearer token", headers[0].Get("Authorization"))
+	assert.Equal(t, []string{"1"}, headers[0].Values("X-Request-Id"))
+	assert.Empty(t, headers[0].Get("X-Impersonate"))
+	assert.Equal(t, "Bearer token", headers[1].Get("Authorization"))
+	assert.Equal(t, []string{"1", "2"}, headers[1].Values("X-Request-Id"))
+	assert.Equal(t, "alex", headers[1].Get("X-Impersonate"))
+	assert.Equal(t, []int{200, 200}, statusCodes)
+
+	// Failing editors abort the call.
+	_, err = client.GetJson(context.Background(), func(ctx context.Context, req *http.Request) error {
+		return errors.New("no token")
+	})
+	assert.EqualError(t, err, "no token")
+	assert.Len(t, headers, 2)
+
+	// The deprecated single editor still runs, before the others.
+	client.ClientInterface.(*Client).RequestEditor = setHeader("X-Request-Id", "0")
+	_, err = client.GetJsonWithResponse(context.Background(), setHeader("X-Request-Id", "2"))
+	require.NoError(t, err)
+	require.Len(t, headers, 3)
+	assert.Equal(t, []string{"0", "1", "2"}, headers[2].Values("X-Request-Id"))
+}
+
+// pageDoer answers with the page registered for the request URI, with its
+// optional Link header, and records the requested URIs.
+func pageDoer(uris *[]string, pages map[string]string, links map[string]string) HttpRequestDoer {
+	return doerFunc(func(req *http.Request) (*http.Response, error) {
+		uri := req.URL.RequestURI()
+		*uris = append(*uris, uri)
+		page, found := pages[uri]
+		if !found {
+			return &http.Response{
+				StatusCode: 404,
+				Status:     "404 Not Found",
+				Header:     http.Header{},
+				Body:       ioutil.NopCloser(strings.NewReader("")),
+			}, nil
+		}
+		header := http.Header{"Content-Type": {"application/json"}}
+		if link, found := links[uri]; found {
+			header.Set("Link", link)
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Header:     header,
+			Body:       ioutil.NopCloser(strings.NewReader(page)),
+			Request:    req,
+		}, nil
+	})
+}
+
+func TestPagination(t *testing.T) {
+	t.Run("cursor", func(t *testing.T) {
+		var uris []string
+		client, err := NewClientWithResponses("http://example.com", WithHTTPClient(pageDoer(&uris, map[string]string{
+			"/with_cursor_pagination":           `{"data": [{"role": "a"}, {"role": "b"}], "meta": {"next": "c1"}}`,
+			"/with_cursor_pagination?cursor=c1": `{"data": [], "meta": {"next": "c2"}}`,
+			"/with_cursor_pagination?cursor=c2": `{"data": [{"role": "c"}], "meta": {"next": null}}`,
+		}, nil)))
+		require.NoError(t, err)
+
+		it := client.ListCursorIter(context.Background(), nil)
+		var roles []string
+		for it.Next() {
+			roles = append(roles, it.Item().Role)
+		}
+		require.NoError(t, it.Err())
+		assert.Equal(t, []string{"a", "b", "c"}, roles)
+		assert.Equal(t, []string{
+			"/with_cursor_pagination",
+			"/with_cursor_pagination?cursor=c1",
+			"/with_cursor_pagination?cursor=c2",
+		}, uris)
+	})
+
+	t.Run("offset", func(t *testing.T) {
+		var uris []string
+		client, err := NewClientWithResponses("http://example.com", WithHTTPClient(pageDoer(&uris, map[string]string{
+			"/with_offset_pagination?limit=2":          `[{"role": "a"}, {"role": "b"}]`,
+			"/with_offset_pagination?limit=2&offset=2": `[{"role": "c"}]`,
+			"/with_offset_pagination?limit=2&offset=3": `[]`,
+		}, nil)))
+		require.NoError(t, err)
+
+		limit := 2
+		params := ListOffsetParams{Limit: &limit}
+		items, err := client.ListOffsetAll(context.Background(), &params)
+		require.NoError(t, err)
+		assert.Len(t, items, 3)
+		assert.Equal(t, "c", items[2].Role)
+		assert.Len(t, uris, 3)
+		// The params of the caller are left alone
+		assert.Nil(t, params.Offset)
+	})
+
+	t.Run("link", func(t *testing.T) {
+		var uris []string
+		client, err := NewClientWithResponses("http://example.com", WithHTTPClient(pageDoer(&uris, map[string]string{
+			"/with_link_pagination":              `{"data": [{"role": "a"}]}`,
+			"/with_link_pagination?page=2&q=x,y": `{"data": [{"role": "b"}]}`,
+		}, map[string]string{
+			"/with_link_pagination": `</with_link_pagination?page=1>; rel="prev first", </with_link_pagination?page=2&q=x,y>; rel="next"`,
+		})))
+		require.NoError(t, err)
+
+		items, err := client.ListLinkAll(context.Background())
+		require.NoError(t, err)
+		assert.Len(t, items, 2)
+		assert.Equal(t, []string{"/with_link_pagination", "/with_link_pagination?page=2&q=x,y"}, uris)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		var uris []string
+		client, err := NewClientWithResponses("http://example.com", WithHTTPClient(pageDoer(&uris, map[string]string{
+			"/with_cursor_pagination": `{"data": [{"role": "a"}], "meta": {"next": "gone"}}`,
+		}, nil)))
+		require.NoError(t, err)
+
+		items, err := client.ListCursorAll(context.Background(), nil)
+		assert.EqualError(t, err, "unexpected status paging through ListCursor: 404 Not Found")
+		assert.Len(t, items, 1)
+	})
+
+	t.Run("repeated", func(t *testing.T) {
+		var uris []string
+		client, err := NewClientWithResponses("http://example.com", WithHTTPClient(pageDoer(&uris, map[string]string{
+			"/with_cursor_pagination":           `{"data": [{"role": "a"}], "meta": {"next": "c1"}}`,
+			"/with_cursor_pagination?cursor=c1": `{"data": [{"role": "b"}], "meta": {"next": "c1"}}`,
+			"/with_link_pagination":             `{"data": [{"role": "a"}]}`,
+		}, map[string]string{
+			"/with_link_pagination": `</with_link_pagination>; rel="next"`,
+		})))
+		require.NoError(t, err)
+
+		// Servers giving the same page as the next one stop the iteration
+		items, err := client.ListCursorAll(context.Background(), nil)
+		assert.EqualError(t, err, "ListCursor returned the cursor c1 of a page already requested as the next one")
+		assert.Len(t, items, 2)
+		items, err = client.ListLinkAll(context.Background())
+		assert.EqualError(t, err, "ListLink returned the page http://example.com/with_link_pagination already requested as the next one")
+		assert.Len(t, items, 1)
+		assert.Len(t, uris, 3)
+	})
+
+	t.Run("cycle", func(t *testing.T) {
+		var uris []string
+		client, err := NewClientWithResponses("http://example.com", WithHTTPClient(pageDoer(&uris, map[string]string{
+			"/with_cursor_pagination?cursor=a": `{"data": [{"role": "a"}], "meta": {"next": "b"}}`,
+			"/with_cursor_pagination?cursor=b": `{"data": [{"role": "b"}], "meta": {"next": "a"}}`,
+			"/with_link_pagination":            `{"data": [{"role": "a"}]}`,
+			"/with_link_pagination?page=b":     `{"data": [{"role": "b"}]}`,
+		}, map[string]string{
+			"/with_link_pagination":        `</with_link_pagination?page=b>; rel="next"`,
+			"/with_link_pagination?page=b": `</with_link_pagination>; rel="next"`,
+		})))
+		require.NoError(t, err)
+
+		// Servers going from a page back to one already requested stop the
+		// iteration too
+		cursor := "a"
+		items, err := client.ListCursorAll(context.Background(), &ListCursorParams{Cursor: &cursor})
+		assert.EqualError(t, err, "ListCursor returned the cursor a of a page already requested as the next one")
+		assert.Len(t, items, 2)
+		items, err = client.ListLinkAll(context.Background())
+		assert.EqualError(t, err, "ListLink returned the page http://example.com/with_link_pagination already requested as the next one")
+		assert.Len(t, items, 2)
+		assert.Len(t, uris, 4)
+	})
+}
+
+func TestOrError(t *testing.T) {
+	respond := func(statusCode int, body string) HttpRequestDoer {
+		return doerFunc(func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: statusCode,
+				Header:     http.Header{"Content-Type": {"application/json"}},
+				Body:       ioutil.NopCloser(strings.NewReader(body)),
+			}, nil
+		})
+	}
+
+	client, err := NewClientWithResponses("http://example.com",
+		WithHTTPClient(respond(200, `{"role": "admin", "firstName": "Alex"}`)))
+	require.NoError(t, err)
+	obj, err := client.GetWithErrorsOrError(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "Alex", obj.FirstName)
+
+	// Documented error responses have their own type
+	client, err = NewClientWithResponses("http://example.com",
+		WithHTTPClient(respond(404, `{"message": "no such object"}`)))
+	require.NoError(t, err)
+	_, err = client.GetWithErrorsOrError(context.Background())
+	assert.EqualError(t, err, "GetWithErrors returned 404 Not Found")
+	var opErr *GetWithErrorsError
+	require.True(t, errors.As(err, &opErr))
+	assert.Equal(t, 404, opErr.StatusCode)
+	require.NotNil(t, opErr.JSON404)
+	assert.Equal(t, "no such object", opErr.JSON404.Message)
+
+	client, err = NewClientWithResponses("http://example.com",
+		WithHTTPClient(respond(409, `{"message": "conflict"}`)))
+	require.NoError(t, err)
+	_, err = client.GetWithErrorsOrError(context.Background())
+	require.True(t, errors.As(err, &opErr))
+	assert.Equal(t, 409, opErr.StatusCode)
+	assert.Nil(t, opErr.JSON404)
+
+	// Others fall back to the default response
+	client, err = NewClientWithResponses("http://example.com",
+		WithHTTPClient(respond(500, `{"message": "oops"}`)))
+	require.NoError(t, err)
+	_, err = client.GetWithErrorsOrError(context.Background())
+	var apiErr *APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, 500, apiErr.StatusCode)
+	assert.Equal(t, `{"message": "oops"}`, string(apiErr.Body))
+	assert.Equal(t, &ErrorObject{Message: "oops"}, apiErr.Model)
+
+	// Without a default response, there is no model
+	_, err = client.PostBothWithBodyOrError(context.Background(), "application/octet-stream", strings.NewReader(""))
+	require.True(t, errors.As(err, &apiErr))
+	assert.Nil(t, apiErr.Model)
+}
+
+func TestServers(t *testing.T) {
+	server, err := ServerURLProduction(ServerProductionVariables{})
+	require.NoError(t, err)
+	assert.Equal(t, "https://eu.api.example.com/v1", server)
+
+	server, err = ServerURLProduction(ServerProductionVariables{Region: "us", BasePath: "v2"})
+	require.NoError(t, err)
+	assert.Equal(t, "https://us.api.example.com/v2", server)
+
+	_, err = ServerURLProduction(ServerProductionVariables{Region: "ue"})
+	assert.EqualError(t, err, `invalid value "ue" for variable region of server https://{region}.api.example.com/{basePath}, must be one of: eu, us`)
+	assert.Equal(t, "http://localhost:8080", ServerURLLocal)
+
+	var uris []string
+	doer := doerFunc(func(req *http.Request) (*http.Response, error) {
+		uris = append(uris, req.URL.String())
+		return &http.Response{StatusCode: 200, Header: http.Header{}, Body: ioutil.NopCloser(strings.NewReader(""))}, nil
+	})
+
+	client, err := NewClient("", WithHTTPClient(doer), WithServer(0, ServerProductionVariables{Region: "us"}))
+	require.NoError(t, err)
+	_, err = client.GetJson(context.Background())
+	require.NoError(t, err)
+
+	// Operations with their own servers use the first one by default
+	_, err = client.GetWithServer(context.Background())
+	require.NoError(t, err)
+
+	client, err = NewClient("", WithHTTPClient(doer), WithServer(1, nil),
+		WithOperationServer("GetWithServer", 0, GetWithServerServer0Variables{Version: "v1"}))
+	require.NoError(t, err)
+	_, err = client.GetWithServer(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"https://us.api.example.com/v1/with_json_response",
+		"https://uploads.example.com/v2/with_server_override",
+		"https://uploads.example.com/v1/with_server_override",
+	}, uris)
+
+	_, err = NewClient("", WithServer(2, nil))
+	assert.EqualError(t, err, "no server at index 2")
+	_, err = NewClient("", WithServer(1, ServerProductionVariables{Region: "us"}))
+	assert.EqualError(t, err, "variables of server https://{region}.api.example.com/{basePath} given for server http://localhost:8080")
+	_, err = NewClient("", WithOperationServer("GetWithServer", 0, ServerProductionVariables{}))
+	assert.Error(t, err)
+	_, err = NewClient("", WithOperationServer("GetWithServer", 0, GetWithServerServer0Variables{Version: "v3"}))
+	assert.Error(t, err)
+	_, err = NewClient("", WithOperationServer("GetJson", 0, nil))
+	assert.EqualError(t, err, "operation GetJson has no server at index 0")
+}
+
+func TestRequestValidation(t *testing.T) {
+	swagger, err := GetSwagger()
+	require.NoError(t, err)
+
+	var bodies []string
+	client, err := NewClient("https://api.example.com/v1", WithRequestValidation(swagger),
+		WithHTTPClient(recordingDoer(&bodies, nil, 200, 200)))
+	require.NoError(t, err)
+
+	_, err = client.PostJson(context.Background(), PostJsonJSONRequestBody{Role: "admin", FirstName: "Alex"})
+	require.NoError(t, err)
+	// Security requirements aren't checked, credentials are up to the editors
+	_, err = client.GetJson(context.Background())
+	require.NoError(t, err)
+	// The body is still sent once validated
+	assert.Equal(t, []string{`{"firstName":"Alex","role":"admin"}`, ""}, bodies)
+
+	_, err = client.PostJsonWithBody(context.Background(), "application/json", strings.NewReader(`{"role":"admin"}`))
+	var invalid *RequestValidationError
+	require.True(t, errors.As(err, &invalid), "unexpected error %v", err)
+	assert.Equal(t, "POST", invalid.Method)
+	assert.Equal(t, "https://api.example.com/v1/with_json_body", invalid.URL)
+	assert.Contains(t, err.Error(), "firstName")
+
+	_, err = client.PostOtherWithBody(context.Background(), "text/plain", strings.NewReader("data"))
+	assert.True(t, errors.As(err, &invalid), "unexpected error %v", err)
+
+	// Requests matching no operation of the spec aren't sent either
+	moved := func(ctx context.Context, req *http.Request) error {
+		req.URL.Path = "/v1/with_moved_body"
+		return nil
+	}
+	_, err = client.PostOtherWithBody(context.Background(), "application/octet-stream", strings.NewReader("data"), moved)
+	assert.True(t, errors.As(err, &invalid), "unexpected error %v", err)
+
+	assert.Len(t, bodies, 2)
+}
+
+func TestStreams(t *testing.T) {
+	release := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/with_event_stream":
+			assert.Equal(t, "text/event-stream", r.Header.Get("Accept"))
+			w.Header().Set("Content-Type", "text/event-stream")
+			io.WriteString(w, ": hello\n\n")
+			io.WriteString(w, "id: 1\ndata: {\"role\": \"admin\",\ndata: \"firstName\": \"Alex\"}\n\n")
+			io.WriteString(w, "event: update\r\nretry: 500\r\ndata: {\"role\": \"user\", \"firstName\": \"Sam\"}\r\n\r\n")
+			if r.URL.Query().Get("block") != "" {
+				w.(http.Flusher).Flush()
+				<-release
+			}
+			io.WriteString(w, "data: {\"role\": \"incomplete\"")
+		case "/with_ndjson":
+			io.WriteString(w, "{\"role\": \"admin\", \"firstName\": \"Alex\"}\n{\"role\": \"user\", \"firstName\": \"Sam\"}\n")
+		case "/with_download":
+			io.WriteString(w, "some bytes")
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			io.WriteString(w, "not found")
+		}
+	}))
+	defer func() {
+		close(release)
+		ts.Close()
+	}()
+
+	client, err := NewClientWithResponses(ts.URL)
+	require.NoError(t, err)
+
+	t.Run("events", func(t *testing.T) {
+		events, err := client.GetEventsStream(context.Background())
+		require.NoError(t, err)
+		defer events.Close()
+
+		require.True(t, events.Next())
+		assert.Equal(t, GetEventsEvent{ID: "1", Event: "message", Data: SchemaObject{Role: "admin", FirstName: "Alex"}}, events.Event())
+		require.True(t, events.Next())
+		assert.Equal(t, GetEventsEvent{ID: "1", Event: "update", Retry: 500 * time.Millisecond, Data: SchemaObject{Role: "user", FirstName: "Sam"}}, events.Event())
+		assert.False(t, events.Next())
+		assert.NoError(t, events.Err())
+	})
+
+	t.Run("ndjson", func(t *testing.T) {
+		dec, err := client.GetExportStream(context.Background())
+		require.NoError(t, err)
+		defer dec.Close()
+
+		var names []string
+		for dec.Next() {
+			names = append(names, dec.Item().FirstName)
+		}
+		assert.NoError(t, dec.Err())
+		assert.Equal(t, []string{"Alex", "Sam"}, names)
+	})
+
+	t.Run("download", func(t *testing.T) {
+		body, err := client.PostDownloadStream(context.Background(), PostDownloadJSONRequestBody{})
+		require.NoError(t, err)
+		defer body.Close()
+
+		buf, err := ioutil.ReadAll(body)
+		require.NoError(t, err)
+		assert.Equal(t, "some bytes", string(buf))
+	})
+
+	t.Run("error", func(t *testing.T) {
+		client, err := NewClientWithResponses(ts.URL + "/missing")
+		require.NoError(t, err)
+		_, err = client.GetExportStream(context.Background())
+		var apiErr *APIError
+		require.True(t, errors.As(err, &apiErr))
+		assert.Equal(t, 404, apiErr.StatusCode)
+		assert.Equal(t, "not found", string(apiErr.Body))
+	})
+
+	t.Run("cancel", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		block := func(ctx context.Context, req *http.Request) error {
+			req.URL.RawQuery = "block=1"
+			return nil
+		}
+		events, err := client.GetEventsStream(ctx, block)
+		require.NoError(t, err)
+		defer events.Close()
+
+		require.True(t, events.Next())
+		require.True(t, events.Next())
+		cancel()
+		assert.False(t, events.Next())
+		assert.Equal(t, context.Canceled, events.Err())
+	})
+}
+
+// recordingT records the failures of the assertions of the fake client.
+type recordingT struct {
+	errors []string
+}
+
+func (t *recordingT) Helper() {}
+
+func (t *recordingT) Errorf(format string, args ...interface{}) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+
+func TestFakeClient(t *testing.T) {
+	ctx := context.Background()
+	fake := NewFakeClientWithResponses()
+	var client ClientWithResponsesInterface = fake
+
+	object := SchemaObject{Role: "admin", FirstName: "Alex"}
+	fake.OnGetObject(1).Return200(object)
+	fake.OnGetObject(2).Return404(ErrorObject{Message: "no such object"}).Times(1)
+	fake.OnGetWithErrors().ReturnDefault(503, ErrorObject{Message: "unavailable"})
+
+	rsp, err := client.GetObjectWithResponse(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 200, rsp.StatusCode())
+	assert.Equal(t, &object, rsp.JSON200)
+	assert.JSONEq(t, `{"role":"admin","firstName":"Alex"}`, string(rsp.Body))
+	assert.Equal(t, "application/json", rsp.HTTPResponse.Header.Get("Content-Type"))
+
+	rsp, err = client.GetObjectWithResponse(ctx, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 404, rsp.StatusCode())
+	assert.Equal(t, "no such object", rsp.JSON404.Message)
+
+	errorsRsp, err := client.GetWithErrorsWithResponse(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 503, errorsRsp.StatusCode())
+	assert.Equal(t, "503 Service Unavailable", errorsRsp.Status())
+	assert.Equal(t, "unavailable", errorsRsp.JSONDefault.Message)
+
+	// Calls which no stub answers fail, including those beyond the Times of
+	// a stub
+	_, err = client.GetObjectWithResponse(ctx, 2)
+	var unexpected *UnexpectedCallError
+	require.True(t, errors.As(err, &unexpected), "unexpected error %v", err)
+	assert.EqualError(t, err, "unexpected call to GetObject(2)")
+	_, err = client.GetJsonWithResponse(ctx)
+	assert.True(t, errors.As(err, &unexpected))
+
+	// Parameters and bodies are matched when given, the latest stub first
+	offset, limit := 10, 5
+	fake.OnListOffset().ReturnStatus(204)
+	fake.OnListOffset().WithParams(ListOffsetParams{Offset: &offset}).ReturnError(errors.New("boom"))
+	listed, err := client.ListOffsetWithResponse(ctx, &ListOffsetParams{Offset: &limit})
+	require.NoError(t, err)
+	assert.Equal(t, 204, listed.StatusCode())
+	_, err = client.ListOffsetWithResponse(ctx, &ListOffsetParams{Offset: &offset})
+	assert.EqualError(t, err, "boom")
+
+	fake.OnPostBoth().WithJSONBody(PostBothJSONRequestBody(object)).ReturnStatus(201)
+	fake.OnPostBoth().WithBody("application/octet-stream", []byte("data")).ReturnStatus(202)
+	posted, err := client.PostBothWithResponse(ctx, PostBothJSONRequestBody(object))
+	require.NoError(t, err)
+	assert.Equal(t, 201, posted.StatusCode())
+	posted, err = client.PostBothWithBodyWithResponse(ctx, "application/octet-stream", strings.NewReader("data"))
+	require.NoError(t, err)
+	assert.Equal(t, 202, posted.StatusCode())
+	_, err = client.PostBothWithResponse(ctx, PostBothJSONRequestBody{Role: "user"})
+	assert.True(t, errors.As(err, &unexpected))
+
+	// Calls are recorded, and checked by the assertions
+	calls := fake.CallsTo("GetObject")
+	require.Len(t, calls, 3)
+	assert.Equal(t, []interface{}{2}, calls[1].PathParams)
+	assert.Len(t, fake.Calls(), 10)
+
+	assert.True(t, fake.AssertCalled(t, "GetObject", 1))
+	assert.True(t, fake.AssertNotCalled(t, "ListCursor"))
+	assert.True(t, fake.AssertExpectations(t))
+
+	var rt recordingT
+	assert.False(t, fake.AssertCalled(&rt, "GetObject", 3))
+	assert.False(t, fake.AssertCalled(&rt, "ListCursor"))
+	assert.False(t, fake.AssertNotCalled(&rt, "GetObject"))
+	fake.OnGetWithServer().ReturnStatus(200)
+	fake.OnGetObject(3).Return200(object).Times(2)
+	_, err = client.GetObjectWithResponse(ctx, 3)
+	require.NoError(t, err)
+	assert.False(t, fake.AssertExpectations(&rt))
+	require.Len(t, rt.errors, 5)
+	assert.Equal(t, "GetWithServer() wasn't called", rt.errors[3])
+	assert.Equal(t, "GetObject(3) was called 1 times, instead of 2", rt.errors[4])
+}
@@ -1,3 +1,3 @@
 package client
 
-//go:generate go run github.com/indigonote/oapi-codegen/cmd/oapi-codegen --package=client -o client.gen.go client.yaml
+//go:generate go run github.com/indigonote/oapi-codegen/cmd/oapi-codegen --generate=types,client,client-fake,server,spec --package=client -o client.gen.go client.yaml
@@ -10,15 +10,20 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
-	"github.com/deepmap/oapi-codegen/pkg/runtime"
 	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/indigonote/oapi-codegen/pkg/runtime"
 	"github.com/labstack/echo/v4"
 	"github.com/pkg/errors"
 	"io"
 	"io/ioutil"
+	"math/rand"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 )
 
 // AdditionalPropertiesObject1 defines model for AdditionalPropertiesObject1.
@@ -123,6 +128,118 @@ type EnsureEverythingIsReferencedJSONRequestBody RequestBody
 // BodyWithAddPropsRequestBody defines body for BodyWithAddProps for application/json ContentType.
 type BodyWithAddPropsJSONRequestBody BodyWithAddPropsJSONBody
 
+// EnsureEverythingIsReferencedURL returns the URL of EnsureEverythingIsReferenced on server, with its parameters
+// serialized as the client serializes them.
+func EnsureEverythingIsReferencedURL(server string) (*url.URL, error) {
+	var err error
+
+	queryUrl, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	basePath := fmt.Sprintf("/ensure-everything-is-referenced")
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
+	}
+
+	queryUrl, err = queryUrl.Parse(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return queryUrl, nil
+}
+
+// ParamsWithAddPropsURL returns the URL of ParamsWithAddProps on server, with its parameters
+// serialized as the client serializes them.
+func ParamsWithAddPropsURL(server string, params *ParamsWithAddPropsParams) (*url.URL, error) {
+	var err error
+
+	queryUrl, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	basePath := fmt.Sprintf("/params_with_add_props")
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
+	}
+
+	queryUrl, err = queryUrl.Parse(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryUrl.Query()
+
+	if queryFrag, err := runtime.StyleParam("simple", true, "p1", params.P1); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	if queryFrag, err := runtime.StyleParam("form", true, "p2", params.P2); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	queryUrl.RawQuery = queryValues.Encode()
+
+	return queryUrl, nil
+}
+
+// BodyWithAddPropsURL returns the URL of BodyWithAddProps on server, with its parameters
+// serialized as the client serializes them.
+func BodyWithAddPropsURL(server string) (*url.URL, error) {
+	var err error
+
+	queryUrl, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	basePath := fmt.Sprintf("/params_with_add_props")
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
+	}
+
+	queryUrl, err = queryUrl.Parse(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return queryUrl, nil
+}
+
+// OperationRoute describes an operation of the API, whatever the router serving
+// it.
+type OperationRoute struct {
+	Method      string // The HTTP method, eg. GET
+	Path        string // The path template of the spec, eg. /pets/{id}
+	OperationId string // The operation ID, as the names of the generated code use it
+}
+
+// OperationRoutes lists the operations of the API, in the order of their paths.
+var OperationRoutes = []OperationRoute{
+	{Method: "GET", Path: "/ensure-everything-is-referenced", OperationId: "EnsureEverythingIsReferenced"},
+	{Method: "GET", Path: "/params_with_add_props", OperationId: "ParamsWithAddProps"},
+	{Method: "POST", Path: "/params_with_add_props", OperationId: "BodyWithAddProps"},
+}
+
 // Getter for additional properties for ParamsWithAddPropsParams_P1. Returns the specified
 // element and whether it was found
 func (a ParamsWithAddPropsParams_P1) Get(fieldName string) (value interface{}, found bool) {
@@ -722,6 +839,14 @@ func (a AdditionalPropertiesObject5) MarshalJSON() ([]byte, error) {
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
+// ResponseEditorFn is the function signature for the ResponseEditor callback
+// function, which may inspect or replace the response before it is parsed
+type ResponseEditorFn func(ctx context.Context, rsp *http.Response) error
+
+// RequestValidatorFn is the function signature for the callback validating
+// requests before they are sent, given the server they were built against
+type RequestValidatorFn func(ctx context.Context, server string, req *http.Request) error
+
 // Doer performs HTTP requests.
 //
 // The standard http.Client implements this interface.
@@ -739,9 +864,31 @@ type Client struct {
 	// customized settings, such as certificate chains.
 	Client HttpRequestDoer
 
+	// A list of callbacks for modifying requests which are generated before
+	// sending over the network, in the order they are run.
+	RequestEditors []RequestEditorFn
+
 	// A callback for modifying requests which are generated before sending over
-	// the network.
+	// the network, which runs before RequestEditors.
+	//
+	// Deprecated: use RequestEditors, or WithRequestEditorFn.
 	RequestEditor RequestEditorFn
+
+	// A list of callbacks for inspecting responses before they are returned,
+	// in the order they are run.
+	ResponseEditors []ResponseEditorFn
+
+	// A callback validating requests once the request editors ran, requests
+	// failing it aren't sent. See WithRequestValidation.
+	RequestValidator RequestValidatorFn
+
+	// How failed requests are retried, they aren't when nil.
+	RetryPolicy *RetryPolicy
+
+	// The servers of the operations which override the servers of the API,
+	// keyed by operation ID, when they aren't the first of their servers. See
+	// WithOperationServer.
+	OperationServers map[string]string
 }
 
 // ClientOption allows setting custom parameters during construction
@@ -781,102 +928,316 @@ func WithHTTPClient(doer HttpRequestDoer) ClientOption {
 
 // WithRequestEditorFn allows setting up a callback function, which will be
 // called right before sending the request. This can be used to mutate the request.
+// It may be given more than once, the callbacks are called in the same order.
 func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 	return func(c *Client) error {
-		c.RequestEditor = fn
+		c.RequestEditors = append(c.RequestEditors, fn)
 		return nil
 	}
 }
 
+// WithResponseEditorFn allows setting up a callback function, which will be
+// called with every response, before it is returned or parsed. It may be
+// given more than once, the callbacks are called in the same order.
+func WithResponseEditorFn(fn ResponseEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.ResponseEditors = append(c.ResponseEditors, fn)
+		return nil
+	}
+}
+
+// applyEditors runs the request editors of the client, followed by those
+// given for this call only.
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	if c.RequestEditor != nil {
+		if err := c.RequestEditor(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RetryPolicy describes how the client retries requests which failed with a
+// network error, a 5xx or a 429 response.
+type RetryPolicy struct {
+	// The number of retries after the first attempt.
+	MaxRetries int
+
+	// The backoff before the first retry, it doubles for each of the
+	// following ones, with some jitter so that clients don't retry in lockstep.
+	MinBackoff time.Duration
+
+	// The upper bound of any backoff, including those asked for by the server
+	// through a Retry-After header.
+	MaxBackoff time.Duration
+
+	// Whether to retry operations which aren't idempotent. Operations are
+	// idempotent when their method is, or when marked with x-idempotent.
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryPolicy returns a policy retrying idempotent operations three
+// times, backing off from 100ms up to 10s.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries: 3,
+		MinBackoff: 100 * time.Millisecond,
+		MaxBackoff: 10 * time.Second,
+	}
+}
+
+// WithRetryPolicy allows retrying failed requests. Request bodies are buffered
+// so that they can be sent again.
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(c *Client) error {
+		c.RetryPolicy = &policy
+		return nil
+	}
+}
+
+// retryJitter randomizes backoffs, it isn't safe for concurrent use.
+var (
+	retryJitter   = rand.New(rand.NewSource(time.Now().UnixNano()))
+	retryJitterMu sync.Mutex
+)
+
+// do sends the request, and runs the response editors of the client on its
+// response.
+func (c *Client) do(req *http.Request, idempotent bool) (*http.Response, error) {
+	rsp, err := c.send(req, idempotent)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range c.ResponseEditors {
+		if err := r(req.Context(), rsp); err != nil {
+			rsp.Body.Close()
+			return nil, err
+		}
+	}
+	return rsp, nil
+}
+
+// send sends the request, retrying it according to the retry policy of the
+// client.
+func (c *Client) send(req *http.Request, idempotent bool) (*http.Response, error) {
+	policy := c.RetryPolicy
+	if policy == nil || policy.MaxRetries <= 0 || !(idempotent || policy.RetryNonIdempotent) {
+		return c.Client.Do(req)
+	}
+
+	// The body is consumed by each attempt, so we need a fresh one for every
+	// retry.
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		buf, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.GetBody = func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(bytes.NewReader(buf)), nil
+		}
+		req.Body, _ = req.GetBody()
+	}
+
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+		rsp, err := c.Client.Do(req)
+		if attempt >= policy.MaxRetries || !shouldRetry(req, rsp, err) {
+			return rsp, err
+		}
+
+		backoff := policy.backoff(attempt, rsp)
+		if rsp != nil {
+			// Drain the body, so that the connection can be reused.
+			io.Copy(ioutil.Discard, rsp.Body)
+			rsp.Body.Close()
+		}
+		timer := time.NewTimer(backoff)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func shouldRetry(req *http.Request, rsp *http.Response, err error) bool {
+	if err != nil {
+		// Nothing to retry once the caller gave up.
+		return req.Context().Err() == nil
+	}
+	return rsp.StatusCode == http.StatusTooManyRequests || rsp.StatusCode >= 500
+}
+
+// backoff returns how long to wait before the given retry, preferring the
+// delay asked for by the Retry-After header of the response.
+func (p *RetryPolicy) backoff(attempt int, rsp *http.Response) time.Duration {
+	if rsp != nil {
+		if after, ok := retryAfter(rsp.Header.Get("Retry-After")); ok {
+			if p.MaxBackoff > 0 && after > p.MaxBackoff {
+				return p.MaxBackoff
+			}
+			return after
+		}
+	}
+
+	backoff := p.MinBackoff << uint(attempt)
+	if backoff <= 0 || (p.MaxBackoff > 0 && backoff > p.MaxBackoff) {
+		backoff = p.MaxBackoff
+	}
+	if backoff <= 0 {
+		return 0
+	}
+	// Wait somewhere between half and all of the backoff.
+	retryJitterMu.Lock()
+	jitter := time.Duration(retryJitter.Int63n(int64(backoff)/2 + 1))
+	retryJitterMu.Unlock()
+	return backoff/2 + jitter
+}
+
+// retryAfter parses a Retry-After header, which either holds a number of
+// seconds or a date.
+func retryAfter(header string) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(header); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(header); err == nil {
+		after := time.Until(date)
+		if after < 0 {
+			after = 0
+		}
+		return after, true
+	}
+	return 0, false
+}
+
 // The interface specification for the client above.
 type ClientInterface interface {
 	// EnsureEverythingIsReferenced request  with any body
-	EnsureEverythingIsReferencedWithBody(ctx context.Context, contentType string, body io.Reader) (*http.Response, error)
+	EnsureEverythingIsReferencedWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	EnsureEverythingIsReferenced(ctx context.Context, body EnsureEverythingIsReferencedJSONRequestBody) (*http.Response, error)
+	EnsureEverythingIsReferenced(ctx context.Context, body EnsureEverythingIsReferencedJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// ParamsWithAddProps request
-	ParamsWithAddProps(ctx context.Context, params *ParamsWithAddPropsParams) (*http.Response, error)
+	ParamsWithAddProps(ctx context.Context, params *ParamsWithAddPropsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// BodyWithAddProps request  with any body
-	BodyWithAddPropsWithBody(ctx context.Context, contentType string, body io.Reader) (*http.Response, error)
+	BodyWithAddPropsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	BodyWithAddProps(ctx context.Context, body BodyWithAddPropsJSONRequestBody) (*http.Response, error)
+	BodyWithAddProps(ctx context.Context, body BodyWithAddPropsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
-func (c *Client) EnsureEverythingIsReferencedWithBody(ctx context.Context, contentType string, body io.Reader) (*http.Response, error) {
-	req, err := NewEnsureEverythingIsReferencedRequestWithBody(c.Server, contentType, body)
+func (c *Client) EnsureEverythingIsReferencedWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server := c.Server
+	req, err := NewEnsureEverythingIsReferencedRequestWithBody(server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if c.RequestEditor != nil {
-		err = c.RequestEditor(ctx, req)
-		if err != nil {
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
 			return nil, err
 		}
 	}
-	return c.Client.Do(req)
+	return c.do(req, true)
 }
 
-func (c *Client) EnsureEverythingIsReferenced(ctx context.Context, body EnsureEverythingIsReferencedJSONRequestBody) (*http.Response, error) {
-	req, err := NewEnsureEverythingIsReferencedRequest(c.Server, body)
+func (c *Client) EnsureEverythingIsReferenced(ctx context.Context, body EnsureEverythingIsReferencedJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server := c.Server
+	req, err := NewEnsureEverythingIsReferencedRequest(server, body)
 	if err != nil {
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if c.RequestEditor != nil {
-		err = c.RequestEditor(ctx, req)
-		if err != nil {
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
 			return nil, err
 		}
 	}
-	return c.Client.Do(req)
+	return c.do(req, true)
 }
 
-func (c *Client) ParamsWithAddProps(ctx context.Context, params *ParamsWithAddPropsParams) (*http.Response, error) {
-	req, err := NewParamsWithAddPropsRequest(c.Server, params)
+func (c *Client) ParamsWithAddProps(ctx context.Context, params *ParamsWithAddPropsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server := c.Server
+	req, err := NewParamsWithAddPropsRequest(server, params)
 	if err != nil {
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if c.RequestEditor != nil {
-		err = c.RequestEditor(ctx, req)
-		if err != nil {
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
 			return nil, err
 		}
 	}
-	return c.Client.Do(req)
+	return c.do(req, true)
 }
 
-func (c *Client) BodyWithAddPropsWithBody(ctx context.Context, contentType string, body io.Reader) (*http.Response, error) {
-	req, err := NewBodyWithAddPropsRequestWithBody(c.Server, contentType, body)
+func (c *Client) BodyWithAddPropsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server := c.Server
+	req, err := NewBodyWithAddPropsRequestWithBody(server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if c.RequestEditor != nil {
-		err = c.RequestEditor(ctx, req)
-		if err != nil {
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
 			return nil, err
 		}
 	}
-	return c.Client.Do(req)
+	return c.do(req, false)
 }
 
-func (c *Client) BodyWithAddProps(ctx context.Context, body BodyWithAddPropsJSONRequestBody) (*http.Response, error) {
-	req, err := NewBodyWithAddPropsRequest(c.Server, body)
+func (c *Client) BodyWithAddProps(ctx context.Context, body BodyWithAddPropsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server := c.Server
+	req, err := NewBodyWithAddPropsRequest(server, body)
 	if err != nil {
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if c.RequestEditor != nil {
-		err = c.RequestEditor(ctx, req)
-		if err != nil {
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
 			return nil, err
 		}
 	}
-	return c.Client.Do(req)
+	return c.do(req, false)
 }
 
 // NewEnsureEverythingIsReferencedRequest calls the generic EnsureEverythingIsReferenced builder with application/json body
@@ -892,19 +1253,7 @@ func NewEnsureEverythingIsReferencedRequest(server string, body EnsureEverything
 
 // NewEnsureEverythingIsReferencedRequestWithBody generates requests for EnsureEverythingIsReferenced with any type of body
 func NewEnsureEverythingIsReferencedRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	queryUrl, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	basePath := fmt.Sprintf("/ensure-everything-is-referenced")
-	if basePath[0] == '/' {
-		basePath = basePath[1:]
-	}
-
-	queryUrl, err = queryUrl.Parse(basePath)
+	queryUrl, err := EnsureEverythingIsReferencedURL(server)
 	if err != nil {
 		return nil, err
 	}
@@ -920,51 +1269,11 @@ func NewEnsureEverythingIsReferencedRequestWithBody(server string, contentType s
 
 // NewParamsWithAddPropsRequest generates requests for ParamsWithAddProps
 func NewParamsWithAddPropsRequest(server string, params *ParamsWithAddPropsParams) (*http.Request, error) {
-	var err error
-
-	queryUrl, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	basePath := fmt.Sprintf("/params_with_add_props")
-	if basePath[0] == '/' {
-		basePath = basePath[1:]
-	}
-
-	queryUrl, err = queryUrl.Parse(basePath)
+	queryUrl, err := ParamsWithAddPropsURL(server, params)
 	if err != nil {
 		return nil, err
 	}
 
-	queryValues := queryUrl.Query()
-
-	if queryFrag, err := runtime.StyleParam("simple", true, "p1", params.P1); err != nil {
-		return nil, err
-	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-		return nil, err
-	} else {
-		for k, v := range parsed {
-			for _, v2 := range v {
-				queryValues.Add(k, v2)
-			}
-		}
-	}
-
-	if queryFrag, err := runtime.StyleParam("form", true, "p2", params.P2); err != nil {
-		return nil, err
-	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-		return nil, err
-	} else {
-		for k, v := range parsed {
-			for _, v2 := range v {
-				queryValues.Add(k, v2)
-			}
-		}
-	}
-
-	queryUrl.RawQuery = queryValues.Encode()
-
 	req, err := http.NewRequest("GET", queryUrl.String(), nil)
 	if err != nil {
 		return nil, err
@@ -986,30 +1295,215 @@ func NewBodyWithAddPropsRequest(server string, body BodyWithAddPropsJSONRequestB
 
 // NewBodyWithAddPropsRequestWithBody generates requests for BodyWithAddProps with any type of body
 func NewBodyWithAddPropsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+	queryUrl, err := BodyWithAddPropsURL(server)
+	if err != nil {
+		return nil, err
+	}
 
-	queryUrl, err := url.Parse(server)
+	req, err := http.NewRequest("POST", queryUrl.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
-	basePath := fmt.Sprintf("/params_with_add_props")
-	if basePath[0] == '/' {
-		basePath = basePath[1:]
+	req.Header.Add("Content-Type", contentType)
+	return req, nil
+}
+
+// serverDefinition is a server of the API, whose URL may hold variables.
+type serverDefinition struct {
+	url       string
+	variables []serverVariable
+}
+
+// serverVariable is a variable of the URL of a server.
+type serverVariable struct {
+	name         string
+	defaultValue string
+	enum         []string
+}
+
+// ServerVariables holds the values of the variables of the URL of a server. It
+// is one of the types generated for the servers with variables, such as those
+// given to WithServer.
+type ServerVariables interface {
+	// serverURL returns the URL of the server whose variables these are.
+	serverURL() string
+	// values returns the values of the variables, by name.
+	values() map[string]string
+}
+
+// resolve replaces the variables in the URL of the server with their values,
+// or their defaults, checking them against their enum. vars must be those of
+// the server, or nil.
+func (s serverDefinition) resolve(vars ServerVariables) (string, error) {
+	var values map[string]string
+	if vars != nil {
+		if vars.serverURL() != s.url {
+			return "", fmt.Errorf("variables of server %s given for server %s", vars.serverURL(), s.url)
+		}
+		values = vars.values()
 	}
 
-	queryUrl, err = queryUrl.Parse(basePath)
-	if err != nil {
-		return nil, err
+	serverURL := s.url
+	for _, v := range s.variables {
+		value := values[v.name]
+		if value == "" {
+			value = v.defaultValue
+		}
+		if value == "" {
+			return "", fmt.Errorf("missing value for variable %s of server %s", v.name, s.url)
+		}
+		valid := len(v.enum) == 0
+		for _, e := range v.enum {
+			valid = valid || value == e
+		}
+		if !valid {
+			return "", fmt.Errorf("invalid value %q for variable %s of server %s, must be one of: %s", value, v.name, s.url, strings.Join(v.enum, ", "))
+		}
+		serverURL = strings.Replace(serverURL, "{"+v.name+"}", value, -1)
 	}
+	return serverURL, nil
+}
 
-	req, err := http.NewRequest("POST", queryUrl.String(), body)
+// servers lists the servers of the API, in the order of the spec.
+var servers = []serverDefinition{}
+
+// operationServers lists the servers of the operations which override those
+// of the API.
+var operationServers = map[string][]serverDefinition{}
+
+// WithServer sets the server of the client to one of the servers of the API,
+// by its index in the spec. Its variables take the given values, which must
+// be the variables of that server, such as a ServerProductionVariables for a
+// production server. They are checked against their enum, and take their
+// default when empty, or when vars is nil.
+func WithServer(index int, vars ServerVariables) ClientOption {
+	return func(c *Client) error {
+		if index < 0 || index >= len(servers) {
+			return fmt.Errorf("no server at index %d", index)
+		}
+		server, err := servers[index].resolve(vars)
+		if err != nil {
+			return err
+		}
+		c.Server = server
+		return nil
+	}
+}
+
+// WithOperationServer sets the server of an operation which overrides the
+// servers of the API to another of its servers, by its index in the spec. Its
+// variables are handled as by WithServer, and are of the types named after the
+// operation, such as GetPetServer0Variables.
+func WithOperationServer(operationID string, index int, vars ServerVariables) ClientOption {
+	return func(c *Client) error {
+		defs := operationServers[operationID]
+		if index < 0 || index >= len(defs) {
+			return fmt.Errorf("operation %s has no server at index %d", operationID, index)
+		}
+		server, err := defs[index].resolve(vars)
+		if err != nil {
+			return err
+		}
+		if c.OperationServers == nil {
+			c.OperationServers = map[string]string{}
+		}
+		c.OperationServers[operationID] = server
+		return nil
+	}
+}
+
+// operationServer returns the server of an operation which overrides the
+// servers of the API, which is its first one unless set otherwise.
+func (c *Client) operationServer(operationID string) (string, error) {
+	server, found := c.OperationServers[operationID]
+	if !found {
+		var err error
+		server, err = operationServers[operationID][0].resolve(nil)
+		if err != nil {
+			return "", err
+		}
+	}
+	if !strings.HasSuffix(server, "/") {
+		server += "/"
+	}
+	return server, nil
+}
+
+// RequestValidationError is returned by the client for a request which
+// doesn't conform to the spec, it isn't sent.
+type RequestValidationError struct {
+	Method string
+	URL    string
+	// Why the request is invalid, usually an *openapi3filter.RequestError,
+	// or an *openapi3filter.RouteError when it matches no operation.
+	Err error
+}
+
+func (e *RequestValidationError) Error() string {
+	return fmt.Sprintf("invalid request %s %s: %s", e.Method, e.URL, e.Err)
+}
+
+// Cause returns why the request is invalid.
+func (e *RequestValidationError) Cause() error {
+	return e.Err
+}
+
+// Unwrap returns why the request is invalid.
+func (e *RequestValidationError) Unwrap() error {
+	return e.Err
+}
+
+// WithRequestValidation validates the parameters and bodies of requests
+// against the given spec, usually the one returned by GetSwagger, before they
+// are sent. Requests are validated once the request editors ran, so security
+// requirements are assumed to be met.
+func WithRequestValidation(swagger *openapi3.Swagger) ClientOption {
+	return func(c *Client) error {
+		// Requests are matched against their path relative to the server of
+		// the client, which may be none of the servers of the spec.
+		spec := *swagger
+		spec.Servers = nil
+		router := openapi3filter.NewRouter()
+		if err := router.AddSwagger(&spec); err != nil {
+			return fmt.Errorf("error loading spec for request validation: %s", err)
+		}
+		c.RequestValidator = func(ctx context.Context, server string, req *http.Request) error {
+			return validateRequest(ctx, router, server, req)
+		}
+		return nil
+	}
+}
+
+// validateRequest validates a request built against the given server.
+func validateRequest(ctx context.Context, router *openapi3filter.Router, server string, req *http.Request) error {
+	serverURL, err := url.Parse(server)
 	if err != nil {
-		return nil, err
+		return err
+	}
+	invalid := func(err error) error {
+		return &RequestValidationError{Method: req.Method, URL: req.URL.String(), Err: err}
 	}
 
-	req.Header.Add("Content-Type", contentType)
-	return req, nil
+	path := strings.TrimPrefix(req.URL.Path, strings.TrimSuffix(serverURL.Path, "/"))
+	route, pathParams, err := router.FindRoute(req.Method, &url.URL{Path: path})
+	if err != nil {
+		return invalid(err)
+	}
+	input := &openapi3filter.RequestValidationInput{
+		Request:    req,
+		PathParams: pathParams,
+		Route:      route,
+		Options: &openapi3filter.Options{
+			AuthenticationFunc: func(context.Context, *openapi3filter.AuthenticationInput) error {
+				return nil
+			},
+		},
+	}
+	if err := openapi3filter.ValidateRequest(ctx, input); err != nil {
+		return invalid(err)
+	}
+	return nil
 }
 
 // ClientWithResponses builds on ClientInterface to offer response payloads
@@ -1042,17 +1536,17 @@ func WithBaseURL(baseURL string) ClientOption {
 // ClientWithResponsesInterface is the interface specification for the client with responses above.
 type ClientWithResponsesInterface interface {
 	// EnsureEverythingIsReferenced request  with any body
-	EnsureEverythingIsReferencedWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader) (*EnsureEverythingIsReferencedResponse, error)
+	EnsureEverythingIsReferencedWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EnsureEverythingIsReferencedResponse, error)
 
-	EnsureEverythingIsReferencedWithResponse(ctx context.Context, body EnsureEverythingIsReferencedJSONRequestBody) (*EnsureEverythingIsReferencedResponse, error)
+	EnsureEverythingIsReferencedWithResponse(ctx context.Context, body EnsureEverythingIsReferencedJSONRequestBody, reqEditors ...RequestEditorFn) (*EnsureEverythingIsReferencedResponse, error)
 
 	// ParamsWithAddProps request
-	ParamsWithAddPropsWithResponse(ctx context.Context, params *ParamsWithAddPropsParams) (*ParamsWithAddPropsResponse, error)
+	ParamsWithAddPropsWithResponse(ctx context.Context, params *ParamsWithAddPropsParams, reqEditors ...RequestEditorFn) (*ParamsWithAddPropsResponse, error)
 
 	// BodyWithAddProps request  with any body
-	BodyWithAddPropsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader) (*BodyWithAddPropsResponse, error)
+	BodyWithAddPropsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BodyWithAddPropsResponse, error)
 
-	BodyWithAddPropsWithResponse(ctx context.Context, body BodyWithAddPropsJSONRequestBody) (*BodyWithAddPropsResponse, error)
+	BodyWithAddPropsWithResponse(ctx context.Context, body BodyWithAddPropsJSONRequestBody, reqEditors ...RequestEditorFn) (*BodyWithAddPropsResponse, error)
 }
 
 type EnsureEverythingIsReferencedResponse struct {
@@ -1140,16 +1634,16 @@ func (r BodyWithAddPropsResponse) StatusCode() int {
 }
 
 // EnsureEverythingIsReferencedWithBodyWithResponse request with arbitrary body returning *EnsureEverythingIsReferencedResponse
-func (c *ClientWithResponses) EnsureEverythingIsReferencedWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader) (*EnsureEverythingIsReferencedResponse, error) {
-	rsp, err := c.EnsureEverythingIsReferencedWithBody(ctx, contentType, body)
+func (c *ClientWithResponses) EnsureEverythingIsReferencedWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EnsureEverythingIsReferencedResponse, error) {
+	rsp, err := c.EnsureEverythingIsReferencedWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
 	return ParseEnsureEverythingIsReferencedResponse(rsp)
 }
 
-func (c *ClientWithResponses) EnsureEverythingIsReferencedWithResponse(ctx context.Context, body EnsureEverythingIsReferencedJSONRequestBody) (*EnsureEverythingIsReferencedResponse, error) {
-	rsp, err := c.EnsureEverythingIsReferenced(ctx, body)
+func (c *ClientWithResponses) EnsureEverythingIsReferencedWithResponse(ctx context.Context, body EnsureEverythingIsReferencedJSONRequestBody, reqEditors ...RequestEditorFn) (*EnsureEverythingIsReferencedResponse, error) {
+	rsp, err := c.EnsureEverythingIsReferenced(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
@@ -1157,8 +1651,8 @@ func (c *ClientWithResponses) EnsureEverythingIsReferencedWithResponse(ctx conte
 }
 
 // ParamsWithAddPropsWithResponse request returning *ParamsWithAddPropsResponse
-func (c *ClientWithResponses) ParamsWithAddPropsWithResponse(ctx context.Context, params *ParamsWithAddPropsParams) (*ParamsWithAddPropsResponse, error) {
-	rsp, err := c.ParamsWithAddProps(ctx, params)
+func (c *ClientWithResponses) ParamsWithAddPropsWithResponse(ctx context.Context, params *ParamsWithAddPropsParams, reqEditors ...RequestEditorFn) (*ParamsWithAddPropsResponse, error) {
+	rsp, err := c.ParamsWithAddProps(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
@@ -1166,16 +1660,16 @@ func (c *ClientWithResponses) ParamsWithAddPropsWithResponse(ctx context.Context
 }
 
 // BodyWithAddPropsWithBodyWithResponse request with arbitrary body returning *BodyWithAddPropsResponse
-func (c *ClientWithResponses) BodyWithAddPropsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader) (*BodyWithAddPropsResponse, error) {
-	rsp, err := c.BodyWithAddPropsWithBody(ctx, contentType, body)
+func (c *ClientWithResponses) BodyWithAddPropsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BodyWithAddPropsResponse, error) {
+	rsp, err := c.BodyWithAddPropsWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
 	return ParseBodyWithAddPropsResponse(rsp)
 }
 
-func (c *ClientWithResponses) BodyWithAddPropsWithResponse(ctx context.Context, body BodyWithAddPropsJSONRequestBody) (*BodyWithAddPropsResponse, error) {
-	rsp, err := c.BodyWithAddProps(ctx, body)
+func (c *ClientWithResponses) BodyWithAddPropsWithResponse(ctx context.Context, body BodyWithAddPropsJSONRequestBody, reqEditors ...RequestEditorFn) (*BodyWithAddPropsResponse, error) {
+	rsp, err := c.BodyWithAddProps(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
@@ -1275,6 +1769,177 @@ func ParseBodyWithAddPropsResponse(rsp *http.Response) (*BodyWithAddPropsRespons
 	return response, nil
 }
 
+// APIError is an error response from the server, with the payload of the
+// default response of the operation when the API documents one.
+type APIError struct {
+	Operation    string
+	StatusCode   int
+	Body         []byte
+	HTTPResponse *http.Response
+	// The decoded default response, eg. *Error, or nil
+	Model interface{}
+}
+
+// Error describes the response.
+func (e *APIError) Error() string {
+	return fmt.Sprintf("%s returned %d %s", e.Operation, e.StatusCode, http.StatusText(e.StatusCode))
+}
+
+// EnsureEverythingIsReferencedWithBodyOrError calls EnsureEverythingIsReferencedWithBodyWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) EnsureEverythingIsReferencedWithBodyOrError(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*struct {
+
+	// Has additional properties with schema for dictionaries
+	Five *AdditionalPropertiesObject5 `json:"five,omitempty"`
+
+	// Has anonymous field which has additional properties
+	Four      *AdditionalPropertiesObject4 `json:"four,omitempty"`
+	JsonField *ObjectWithJsonField         `json:"jsonField,omitempty"`
+
+	// Has additional properties of type int
+	One *AdditionalPropertiesObject1 `json:"one,omitempty"`
+
+	// Allows any additional property
+	Three *AdditionalPropertiesObject3 `json:"three,omitempty"`
+
+	// Does not allow additional properties
+	Two *AdditionalPropertiesObject2 `json:"two,omitempty"`
+}, error) {
+	rsp, err := c.EnsureEverythingIsReferencedWithBodyWithResponse(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ensureEverythingIsReferencedOrError(rsp)
+}
+
+// EnsureEverythingIsReferencedOrError calls EnsureEverythingIsReferencedWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) EnsureEverythingIsReferencedOrError(ctx context.Context, body EnsureEverythingIsReferencedJSONRequestBody, reqEditors ...RequestEditorFn) (*struct {
+
+	// Has additional properties with schema for dictionaries
+	Five *AdditionalPropertiesObject5 `json:"five,omitempty"`
+
+	// Has anonymous field which has additional properties
+	Four      *AdditionalPropertiesObject4 `json:"four,omitempty"`
+	JsonField *ObjectWithJsonField         `json:"jsonField,omitempty"`
+
+	// Has additional properties of type int
+	One *AdditionalPropertiesObject1 `json:"one,omitempty"`
+
+	// Allows any additional property
+	Three *AdditionalPropertiesObject3 `json:"three,omitempty"`
+
+	// Does not allow additional properties
+	Two *AdditionalPropertiesObject2 `json:"two,omitempty"`
+}, error) {
+	rsp, err := c.EnsureEverythingIsReferencedWithResponse(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ensureEverythingIsReferencedOrError(rsp)
+}
+
+// ensureEverythingIsReferencedOrError returns the payload of a success response to
+// EnsureEverythingIsReferenced, or an error for any other response.
+func ensureEverythingIsReferencedOrError(rsp *EnsureEverythingIsReferencedResponse) (*struct {
+
+	// Has additional properties with schema for dictionaries
+	Five *AdditionalPropertiesObject5 `json:"five,omitempty"`
+
+	// Has anonymous field which has additional properties
+	Four      *AdditionalPropertiesObject4 `json:"four,omitempty"`
+	JsonField *ObjectWithJsonField         `json:"jsonField,omitempty"`
+
+	// Has additional properties of type int
+	One *AdditionalPropertiesObject1 `json:"one,omitempty"`
+
+	// Allows any additional property
+	Three *AdditionalPropertiesObject3 `json:"three,omitempty"`
+
+	// Does not allow additional properties
+	Two *AdditionalPropertiesObject2 `json:"two,omitempty"`
+}, error) {
+	if rsp.JSON200 != nil {
+		return rsp.JSON200, nil
+	}
+	if rsp.StatusCode() >= 200 && rsp.StatusCode() < 300 {
+		return nil, nil
+	}
+
+	apiErr := APIError{
+		Operation:    "EnsureEverythingIsReferenced",
+		StatusCode:   rsp.StatusCode(),
+		Body:         rsp.Body,
+		HTTPResponse: rsp.HTTPResponse,
+	}
+	if rsp.JSONDefault != nil {
+		apiErr.Model = rsp.JSONDefault
+	}
+	return nil, &apiErr
+}
+
+// ParamsWithAddPropsOrError calls ParamsWithAddPropsWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) ParamsWithAddPropsOrError(ctx context.Context, params *ParamsWithAddPropsParams, reqEditors ...RequestEditorFn) (*ParamsWithAddPropsResponse, error) {
+	rsp, err := c.ParamsWithAddPropsWithResponse(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return paramsWithAddPropsOrError(rsp)
+}
+
+// paramsWithAddPropsOrError returns the payload of a success response to
+// ParamsWithAddProps, or an error for any other response.
+func paramsWithAddPropsOrError(rsp *ParamsWithAddPropsResponse) (*ParamsWithAddPropsResponse, error) {
+	if rsp.StatusCode() >= 200 && rsp.StatusCode() < 300 {
+		return rsp, nil
+	}
+
+	apiErr := APIError{
+		Operation:    "ParamsWithAddProps",
+		StatusCode:   rsp.StatusCode(),
+		Body:         rsp.Body,
+		HTTPResponse: rsp.HTTPResponse,
+	}
+	return nil, &apiErr
+}
+
+// BodyWithAddPropsWithBodyOrError calls BodyWithAddPropsWithBodyWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) BodyWithAddPropsWithBodyOrError(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BodyWithAddPropsResponse, error) {
+	rsp, err := c.BodyWithAddPropsWithBodyWithResponse(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return bodyWithAddPropsOrError(rsp)
+}
+
+// BodyWithAddPropsOrError calls BodyWithAddPropsWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) BodyWithAddPropsOrError(ctx context.Context, body BodyWithAddPropsJSONRequestBody, reqEditors ...RequestEditorFn) (*BodyWithAddPropsResponse, error) {
+	rsp, err := c.BodyWithAddPropsWithResponse(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return bodyWithAddPropsOrError(rsp)
+}
+
+// bodyWithAddPropsOrError returns the payload of a success response to
+// BodyWithAddProps, or an error for any other response.
+func bodyWithAddPropsOrError(rsp *BodyWithAddPropsResponse) (*BodyWithAddPropsResponse, error) {
+	if rsp.StatusCode() >= 200 && rsp.StatusCode() < 300 {
+		return rsp, nil
+	}
+
+	apiErr := APIError{
+		Operation:    "BodyWithAddProps",
+		StatusCode:   rsp.StatusCode(),
+		Body:         rsp.Body,
+		HTTPResponse: rsp.HTTPResponse,
+	}
+	return nil, &apiErr
+}
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 
@@ -1291,6 +1956,20 @@ type ServerInterface interface {
 // ServerInterfaceWrapper converts echo contexts to parameters.
 type ServerInterfaceWrapper struct {
 	Handler ServerInterface
+
+	// ErrorHandlerFunc answers requests whose parameters can't be bound, with
+	// their InvalidParamFormatError, RequiredParamError, UnmarshalingParamError
+	// or TooManyValuesForParamError, of the runtime package. They are answered
+	// with a 400 echo.HTTPError if it is nil.
+	ErrorHandlerFunc func(ctx echo.Context, err error) error
+}
+
+// handleError answers a request whose parameters can't be bound.
+func (w *ServerInterfaceWrapper) handleError(ctx echo.Context, err error) error {
+	if w.ErrorHandlerFunc != nil {
+		return w.ErrorHandlerFunc(ctx, err)
+	}
+	return echo.NewHTTPError(http.StatusBadRequest, err.Error())
 }
 
 // EnsureEverythingIsReferenced converts echo context to params.
@@ -1312,14 +1991,14 @@ func (w *ServerInterfaceWrapper) ParamsWithAddProps(ctx echo.Context) error {
 
 	err = runtime.BindQueryParameter("simple", true, true, "p1", ctx.QueryParams(), &params.P1)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter p1: %s", err))
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationQuery, "p1", err))
 	}
 
 	// ------------- Required query parameter "p2" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "p2", ctx.QueryParams(), &params.P2)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter p2: %s", err))
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationQuery, "p2", err))
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
@@ -1351,39 +2030,132 @@ type EchoRouter interface {
 	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
 }
 
+// RegisterOptions configures the middlewares and the error handling of the
+// routes added by RegisterHandlersWithOptions.
+type RegisterOptions struct {
+	// The middlewares of operations, by operation ID.
+	OperationMiddlewares map[string][]echo.MiddlewareFunc
+
+	// The middlewares of the operations with a tag, by tag.
+	TagMiddlewares map[string][]echo.MiddlewareFunc
+
+	// The ErrorHandlerFunc of the ServerInterfaceWrapper.
+	ErrorHandlerFunc func(ctx echo.Context, err error) error
+}
+
 // RegisterHandlers adds each server route to the EchoRouter.
 func RegisterHandlers(router EchoRouter, si ServerInterface) {
+	RegisterHandlersWithOptions(router, si, RegisterOptions{})
+}
+
+// RegisterHandlersWithOptions adds each server route to the EchoRouter, with
+// the middlewares of the tags of its operation, in the order of the tags, and
+// then those of the operation. The OperationInfo of the operation is put into
+// the context of the request before any of them runs.
+func RegisterHandlersWithOptions(router EchoRouter, si ServerInterface, opts RegisterOptions) {
 
 	wrapper := ServerInterfaceWrapper{
-		Handler: si,
+		Handler:          si,
+		ErrorHandlerFunc: opts.ErrorHandlerFunc,
+	}
+
+	router.GET("/ensure-everything-is-referenced", wrapper.EnsureEverythingIsReferenced, opts.middlewares("EnsureEverythingIsReferenced")...)
+	router.GET("/params_with_add_props", wrapper.ParamsWithAddProps, opts.middlewares("ParamsWithAddProps")...)
+	router.POST("/params_with_add_props", wrapper.BodyWithAddProps, opts.middlewares("BodyWithAddProps")...)
+
+}
+
+// middlewares returns the middlewares of the route of an operation.
+func (opts RegisterOptions) middlewares(operationId string) []echo.MiddlewareFunc {
+	info := Operations[operationId]
+	middlewares := []echo.MiddlewareFunc{
+		func(next echo.HandlerFunc) echo.HandlerFunc {
+			return func(ctx echo.Context) error {
+				r := ctx.Request()
+				ctx.SetRequest(r.WithContext(ContextWithOperationInfo(r.Context(), info)))
+				return next(ctx)
+			}
+		},
+	}
+	for _, tag := range info.Tags {
+		middlewares = append(middlewares, opts.TagMiddlewares[tag]...)
 	}
+	return append(middlewares, opts.OperationMiddlewares[operationId]...)
+}
+
+// OperationInfo describes an operation of the API, for the middlewares serving
+// it, which find it in the context of its requests.
+type OperationInfo struct {
+	OperationId string                     // The operation ID, as the names of the generated code use it
+	Method      string                     // The HTTP method, eg. GET
+	Path        string                     // The path template of the spec, eg. /pets/{id}
+	Tags        []string                   // The tags of the operation
+	Security    []OperationSecurity        // The security providers of the operation
+	Extensions  map[string]json.RawMessage // The x- extensions of the operation, by name
+}
 
-	router.GET("/ensure-everything-is-referenced", wrapper.EnsureEverythingIsReferenced)
-	router.GET("/params_with_add_props", wrapper.ParamsWithAddProps)
-	router.POST("/params_with_add_props", wrapper.BodyWithAddProps)
+// OperationSecurity is a security provider of an operation, and the scopes it
+// requires.
+type OperationSecurity struct {
+	ProviderName string
+	Scopes       []string
+}
+
+// Operations holds the OperationInfo of every operation, by operation ID.
+var Operations = map[string]OperationInfo{
+	"EnsureEverythingIsReferenced": {
+		OperationId: "EnsureEverythingIsReferenced",
+		Method:      "GET",
+		Path:        "/ensure-everything-is-referenced",
+	},
+	"ParamsWithAddProps": {
+		OperationId: "ParamsWithAddProps",
+		Method:      "GET",
+		Path:        "/params_with_add_props",
+	},
+	"BodyWithAddProps": {
+		OperationId: "BodyWithAddProps",
+		Method:      "POST",
+		Path:        "/params_with_add_props",
+	},
+}
+
+// operationInfoKey is the context key of the OperationInfo of a request.
+type operationInfoKey struct{}
+
+// ContextWithOperationInfo returns a copy of ctx holding info, which
+// OperationInfoFromContext returns.
+func ContextWithOperationInfo(ctx context.Context, info OperationInfo) context.Context {
+	return context.WithValue(ctx, operationInfoKey{}, info)
+}
 
+// OperationInfoFromContext returns the OperationInfo of the operation serving
+// a request, from its context, and whether there's one.
+func OperationInfoFromContext(ctx context.Context) (OperationInfo, bool) {
+	info, ok := ctx.Value(operationInfoKey{}).(OperationInfo)
+	return info, ok
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9RXTW/jNhD9KwTboxLHzvaim4tu0RRoG+wG6GFjBLQ4ipjKQy05sldY6L8XQ8nflNdx",
-	"9rK5RJY5X49v3oy/yswuKouA5GX6VTr4XIOnX602EF582Lxo+GNmkQCJH1VVlSZTZCyOXrxFfuezAhaK",
-	"nypnK3DUe/ndQKn54WcHuUzlT6Nt2FFn5Ecfw/9/5i+QkWzbJCRjHGiZfuo9zPg1wRcaVaUyByGpqUCm",
-	"0pMz+Cxb/mMfvrLo18V0H/oYP1o9idTgM2cqzlGmciq8WVQliHWRwm6D9Vmwo6nWhk1Ueb+poktrHAqP",
-	"fL0T3yDBMzh5FP4P5cXWVmwREjYXbCwMkkwOoDM67hvVAiJVJ9JWXYAYJPuYBhcJR5gl66NrRJITKEyG",
-	"UchV6eGw8N8seIGWhCpLu4pj8Na6v1Npt8OlkauPKptyQV4obCJVNUc1vSL316X97nVpByaixWZhay9y",
-	"bi2xKkxWiGKIo8f3gwjuW2G/a/nnmXd5JZeg+Mup7j5fuc7v+5WhQnRORG6d0CYLh1wH+FHqXYR/DRV/",
-	"eosbUT0L5UQuVVlDULDcuoUimcqg28nA0ckZR+Nt10eKob8H1VHuuXGe/h4qwNnyDAKEU8mOq1kYBQZz",
-	"y8alyQA9bJGSf909sHcyxO7lA3gSH8EtA42W4Hx3jePrm+ubTmABVWVkKm+vb67H3BmKipD/CNDXDq5g",
-	"Ca6hwuDzlfFXDnJwgBmE23qGUPg+Rx4K4wWgrqxBEvDFePLCW0GFIrFlnMgUijmIzIEi0MKgoML4R/QV",
-	"ZEKhDjI7B1G5GkE/8o0xvmFK32mZyvchwfeb/O78h212yc4+0wyRfm/lGe3uO4frw+Tm5g07Q26W8K3G",
-	"O9XLbSJzW7vLXbxjFy+7jXbKT6w3mSz4hiLGgZeFgzf4uA0+VvZyD5PQYged3K9XuapLGmZKT4bRwSLZ",
-	"WY8q5dTCP7EKPimtn/j+/WCLTAW3WaeZwRIInA+kV2JuddOPsF4L4pIb6Yj7kAVf3FTr+5ACd/Q6gEw/",
-	"RZt1c2J4ZoZgvKXKzzW4Zj2UUlmN5a5mdbNy2wenJuqRoHpqgmx1q61sk3OyxZ3xHwbmZmfpQUQA7QVZ",
-	"MYdHpNphEBuyQvUnu4WVh1YsW7ZcWfffMAKTkwi8atWITIpDssZWhFnbzvYEC+uybBNZWR9hXxjiote+",
-	"XbqxuimD/K02DjKKApIwTx/xJPBM7JhthLMstweMdRf+8Dx/fTv3GnaW9Qt3uPX2vr6n9pAr7fHFtW37",
-	"fwAAAP//Xv36ip0PAAA=",
+	"H4sIAAAAAAAC/9RXTW/jNhD9KwO2RyWOne1FNxfdoinQNtgN0MPGCGhxFDGVh1xyZK+x0H8vSMnftNdx",
+	"9rKnyBLn6/HNm8lXUZiZNYTEXuRfhcPPDXr+1SiN8cWH9Ytl+FkYYiQOj9LaWheStaHBizcU3vmiwpkM",
+	"T9YZi457L79rrFV4+NlhKXLx02ATdtAZ+cHH+Pef6QsWLNo2i8loh0rkn3oPk/Ca8QsPbC31XkheWhS5",
+	"8Ow0PYu2bTsf3hryq2K6H32MH62eTCj0hdM25ChyMQavZ7ZGWBUJZhOszyI4Giulg4ms79dVdGkNY+GJ",
+	"z1vxNTE+oxMH4f+QHja2sEEITAnBGDSxyPag0yrtm+QME1VnwtguQAqSXUyjiyxEmGSroytEshMojI6j",
+	"UMra437hvxn0QIZB1rVZpDF4a93fqbTb46Wxaw4qG4eCPEhaJqpaHtT0itxfl/a716UdmUiGljPTeChD",
+	"a8Gi0kUF1TGOHt4PEbpvhf2u5Z9n3uWVXYLiL6e6+3zlOr/vF5or6JxAaRwoXcRDrgP8IPUuwr+aqz+9",
+	"obWonoVyJuaybjAqWGncTLLIRdTt7MjR0RlH023XR0qhvwPVQe6ldp7/PlaAM/UZBIinsi1XkzgKNJUm",
+	"GNe6QPK4QUr8dfcQvLPm4F48oGf4iG4eaTRH57trHF7fXN90AoskrRa5uL2+uR6GzpBcxfwHSL5xeIVz",
+	"dEuuND1faX/lsESHVGC8rWeMhe9y5KHSHpCUNZoY8Iv27MEb4EoybBgHhSSYIhQOJaMCTcCV9o/kLRYg",
+	"SUWZnSJY1xCqx3BjAd84pe+UyMX7mOD7dX53/sMmu0y43fUlRfqdlWewve/srw+jm5s37AylnuO3Gu9U",
+	"L7eZKE3jLnfxLrh42W60U35SvRnIQm8oYhh5WTl8g4/b6GNhLvcwii2218n9elXKpubjTOnJMNhbJDvr",
+	"gZVOzvxTUMEnqdRTuH9/tEXGENqs08xoiYzOR9JLmBq17EdYrwVpyU10xH3MIlzcWKn7mEImNgFE/inZ",
+	"rOsTx2dmDKaDxecG3XI1lHJhh2Jbs7pZuemDUxP1QFA9L6NsdautaLNzsqWt8R8H5npn6UEkROWBDUzx",
+	"kbhxFMWGDcj+ZLewhqGVyjZYLoz77zgCo5MIvGrVSEyKfbKmVoRJ2052BIuaum4zYY1PsC8Ocei1b5tu",
+	"Qd2kpvBVaYcFJwHJAk8f6STwgdgp2wRng9zuMdZd+I/n+evbudewtaxfuMOttvfVPbX7XGkPL65t2/8H",
+	"AF79+oqdDwAA",
 }
 
 // GetSwagger returns the Swagger specification corresponding to the generated code
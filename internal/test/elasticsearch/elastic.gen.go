@@ -11,12 +11,18 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/indigonote/oapi-codegen/pkg/esquery"
 	"github.com/labstack/echo/v4"
 	"io"
 	"io/ioutil"
+	"math/rand"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 )
 
 // Consulter defines model for consulter.
@@ -101,7 +107,7 @@ type FhirCodeSystem struct {
 	Id      *string        `json:"id,omitempty" validate:"omitempty,fhirID"`
 	Name    *string        `json:"name,omitempty" validate:"omitempty,max=1048576,fhirString"`
 	Status  string         `json:"status" validate:"oneof=active "`
-	Title   *string        `json:"title,omitempty" validate:"omitempty,max=1048576,fhirString"`
+	Title   *string        `json:"title,omitempty" validate:"omitempty,fhirString,max=1048576"`
 }
 
 // FhirCodeableConcept defines model for fhir-codeable-concept.
@@ -161,7 +167,7 @@ type FhirExtension struct {
 		// Embedded struct due to allOf(#/components/schemas/fhir-reference)
 		FhirReference
 	} `json:"valueReference" validate:"omitempty,fhirValueX"`
-	ValueString *string `json:"valueString" validate:"omitempty,fhirString,fhirValueX,max=1048576"`
+	ValueString *string `json:"valueString" validate:"omitempty,max=1048576,fhirString,fhirValueX"`
 }
 
 // FhirHumanName defines model for fhir-human-name.
@@ -175,7 +181,7 @@ type FhirHumanName struct {
 type FhirIdentifier struct {
 	System *string `json:"system" validate:"omitempty,fhirUri"`
 	Use    *string `json:"use" validate:"omitempty,oneof=usual official "`
-	Value  *string `json:"value" validate:"omitempty,fhirString,max=1048576"`
+	Value  *string `json:"value" validate:"omitempty,max=1048576,fhirString"`
 }
 
 // FhirMeta defines model for fhir-meta.
@@ -247,9 +253,644 @@ type PostConsultersJSONBody ConsulterCreateRequest
 // PostConsultersRequestBody defines body for PostConsulters for application/json ContentType.
 type PostConsultersJSONRequestBody PostConsultersJSONBody
 
+// PostConsultersURL returns the URL of PostConsulters on server, with its parameters
+// serialized as the client serializes them.
+func PostConsultersURL(server string) (*url.URL, error) {
+	var err error
+
+	queryUrl, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	basePath := fmt.Sprintf("/consulters")
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
+	}
+
+	queryUrl, err = queryUrl.Parse(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return queryUrl, nil
+}
+
+// OperationRoute describes an operation of the API, whatever the router serving
+// it.
+type OperationRoute struct {
+	Method      string // The HTTP method, eg. GET
+	Path        string // The path template of the spec, eg. /pets/{id}
+	OperationId string // The operation ID, as the names of the generated code use it
+}
+
+// OperationRoutes lists the operations of the API, in the order of their paths.
+var OperationRoutes = []OperationRoute{
+	{Method: "POST", Path: "/consulters", OperationId: "PostConsulters"},
+}
+
+// FhirCodeSystemFields lists the indexed field paths of the FhirCodeSystem elastic search mapping.
+var FhirCodeSystemFields = struct {
+	Concept               esquery.NestedPath
+	ConceptCode           esquery.TextField
+	ConceptCodeKeyword    esquery.KeywordField
+	ConceptConcept        esquery.NestedPath
+	ConceptDisplay        esquery.TextField
+	ConceptDisplayKeyword esquery.KeywordField
+	Content               esquery.TextField
+	ContentKeyword        esquery.KeywordField
+	Id                    esquery.KeywordField
+	Name                  esquery.TextField
+	NameKeyword           esquery.KeywordField
+	Status                esquery.TextField
+	StatusKeyword         esquery.KeywordField
+	Title                 esquery.TextField
+	TitleKeyword          esquery.KeywordField
+}{
+	Concept:               "concept",
+	ConceptCode:           "concept.code",
+	ConceptCodeKeyword:    "concept.code.keyword",
+	ConceptConcept:        "concept.concept",
+	ConceptDisplay:        "concept.display",
+	ConceptDisplayKeyword: "concept.display.keyword",
+	Content:               "content",
+	ContentKeyword:        "content.keyword",
+	Id:                    "id",
+	Name:                  "name",
+	NameKeyword:           "name.keyword",
+	Status:                "status",
+	StatusKeyword:         "status.keyword",
+	Title:                 "title",
+	TitleKeyword:          "title.keyword",
+}
+
+// FhirEncounterFields lists the indexed field paths of the FhirEncounter elastic search mapping.
+var FhirEncounterFields = struct {
+	Class                                                           esquery.NestedPath
+	ClassCode                                                       esquery.TextField
+	ClassCodeKeyword                                                esquery.KeywordField
+	ClassSystem                                                     esquery.TextField
+	ClassSystemKeyword                                              esquery.KeywordField
+	Id                                                              esquery.KeywordField
+	Meta                                                            esquery.NestedPath
+	MetaExtension                                                   esquery.NestedPath
+	MetaExtensionUrl                                                esquery.TextField
+	MetaExtensionUrlKeyword                                         esquery.KeywordField
+	MetaExtensionValueAttachment                                    esquery.NestedPath
+	MetaExtensionValueAttachmentContentType                         esquery.TextField
+	MetaExtensionValueAttachmentContentTypeKeyword                  esquery.KeywordField
+	MetaExtensionValueAttachmentCreation                            esquery.DateField
+	MetaExtensionValueAttachmentData                                esquery.TextField
+	MetaExtensionValueAttachmentDataKeyword                         esquery.KeywordField
+	MetaExtensionValueAttachmentHash                                esquery.TextField
+	MetaExtensionValueAttachmentHashKeyword                         esquery.KeywordField
+	MetaExtensionValueAttachmentLanguage                            esquery.TextField
+	MetaExtensionValueAttachmentLanguageKeyword                     esquery.KeywordField
+	MetaExtensionValueAttachmentSize                                esquery.TextField
+	MetaExtensionValueAttachmentSizeKeyword                         esquery.KeywordField
+	MetaExtensionValueAttachmentTitle                               esquery.TextField
+	MetaExtensionValueAttachmentTitleKeyword                        esquery.KeywordField
+	MetaExtensionValueAttachmentUrl                                 esquery.TextField
+	MetaExtensionValueAttachmentUrlKeyword                          esquery.KeywordField
+	MetaExtensionValueCode                                          esquery.TextField
+	MetaExtensionValueCodeKeyword                                   esquery.KeywordField
+	MetaExtensionValueInstant                                       esquery.TextField
+	MetaExtensionValueInstantKeyword                                esquery.KeywordField
+	MetaExtensionValueReference                                     esquery.NestedPath
+	MetaExtensionValueString                                        esquery.TextField
+	MetaExtensionValueStringKeyword                                 esquery.KeywordField
+	MetaLastUpdated                                                 esquery.TextField
+	MetaLastUpdatedKeyword                                          esquery.KeywordField
+	MetaSecurity                                                    esquery.NestedPath
+	MetaSecurityCode                                                esquery.TextField
+	MetaSecurityCodeKeyword                                         esquery.KeywordField
+	MetaSecuritySystem                                              esquery.TextField
+	MetaSecuritySystemKeyword                                       esquery.KeywordField
+	MetaTag                                                         esquery.NestedPath
+	MetaTagCode                                                     esquery.TextField
+	MetaTagCodeKeyword                                              esquery.KeywordField
+	MetaTagSystem                                                   esquery.TextField
+	MetaTagSystemKeyword                                            esquery.KeywordField
+	MetaVersionId                                                   esquery.TextField
+	MetaVersionIdKeyword                                            esquery.KeywordField
+	Participant                                                     esquery.NestedPath
+	ParticipantIndividual                                           esquery.NestedPath
+	ParticipantIndividualExtension                                  esquery.NestedPath
+	ParticipantIndividualExtensionUrl                               esquery.TextField
+	ParticipantIndividualExtensionUrlKeyword                        esquery.KeywordField
+	ParticipantIndividualExtensionValueAttachment                   esquery.NestedPath
+	ParticipantIndividualExtensionValueAttachmentContentType        esquery.TextField
+	ParticipantIndividualExtensionValueAttachmentContentTypeKeyword esquery.KeywordField
+	ParticipantIndividualExtensionValueAttachmentCreation           esquery.DateField
+	ParticipantIndividualExtensionValueAttachmentData               esquery.TextField
+	ParticipantIndividualExtensionValueAttachmentDataKeyword        esquery.KeywordField
+	ParticipantIndividualExtensionValueAttachmentHash               esquery.TextField
+	ParticipantIndividualExtensionValueAttachmentHashKeyword        esquery.KeywordField
+	ParticipantIndividualExtensionValueAttachmentLanguage           esquery.TextField
+	ParticipantIndividualExtensionValueAttachmentLanguageKeyword    esquery.KeywordField
+	ParticipantIndividualExtensionValueAttachmentSize               esquery.TextField
+	ParticipantIndividualExtensionValueAttachmentSizeKeyword        esquery.KeywordField
+	ParticipantIndividualExtensionValueAttachmentTitle              esquery.TextField
+	ParticipantIndividualExtensionValueAttachmentTitleKeyword       esquery.KeywordField
+	ParticipantIndividualExtensionValueAttachmentUrl                esquery.TextField
+	ParticipantIndividualExtensionValueAttachmentUrlKeyword         esquery.KeywordField
+	ParticipantIndividualExtensionValueCode                         esquery.TextField
+	ParticipantIndividualExtensionValueCodeKeyword                  esquery.KeywordField
+	ParticipantIndividualExtensionValueInstant                      esquery.TextField
+	ParticipantIndividualExtensionValueInstantKeyword               esquery.KeywordField
+	ParticipantIndividualExtensionValueReference                    esquery.NestedPath
+	ParticipantIndividualExtensionValueString                       esquery.TextField
+	ParticipantIndividualExtensionValueStringKeyword                esquery.KeywordField
+	ParticipantIndividualReference                                  esquery.TextField
+	ParticipantIndividualReferenceKeyword                           esquery.KeywordField
+	ParticipantIndividualType                                       esquery.TextField
+	ParticipantIndividualTypeKeyword                                esquery.KeywordField
+	ParticipantType                                                 esquery.NestedPath
+	ParticipantTypeCoding                                           esquery.NestedPath
+	ParticipantTypeCodingCode                                       esquery.TextField
+	ParticipantTypeCodingCodeKeyword                                esquery.KeywordField
+	ParticipantTypeCodingSystem                                     esquery.TextField
+	ParticipantTypeCodingSystemKeyword                              esquery.KeywordField
+	Status                                                          esquery.TextField
+	StatusKeyword                                                   esquery.KeywordField
+}{
+	Class:                                   "class",
+	ClassCode:                               "class.code",
+	ClassCodeKeyword:                        "class.code.keyword",
+	ClassSystem:                             "class.system",
+	ClassSystemKeyword:                      "class.system.keyword",
+	Id:                                      "id",
+	Meta:                                    "meta",
+	MetaExtension:                           "meta.extension",
+	MetaExtensionUrl:                        "meta.extension.url",
+	MetaExtensionUrlKeyword:                 "meta.extension.url.keyword",
+	MetaExtensionValueAttachment:            "meta.extension.valueAttachment",
+	MetaExtensionValueAttachmentContentType: "meta.extension.valueAttachment.contentType",
+	MetaExtensionValueAttachmentContentTypeKeyword:                  "meta.extension.valueAttachment.contentType.keyword",
+	MetaExtensionValueAttachmentCreation:                            "meta.extension.valueAttachment.creation",
+	MetaExtensionValueAttachmentData:                                "meta.extension.valueAttachment.data",
+	MetaExtensionValueAttachmentDataKeyword:                         "meta.extension.valueAttachment.data.keyword",
+	MetaExtensionValueAttachmentHash:                                "meta.extension.valueAttachment.hash",
+	MetaExtensionValueAttachmentHashKeyword:                         "meta.extension.valueAttachment.hash.keyword",
+	MetaExtensionValueAttachmentLanguage:                            "meta.extension.valueAttachment.language",
+	MetaExtensionValueAttachmentLanguageKeyword:                     "meta.extension.valueAttachment.language.keyword",
+	MetaExtensionValueAttachmentSize:                                "meta.extension.valueAttachment.size",
+	MetaExtensionValueAttachmentSizeKeyword:                         "meta.extension.valueAttachment.size.keyword",
+	MetaExtensionValueAttachmentTitle:                               "meta.extension.valueAttachment.title",
+	MetaExtensionValueAttachmentTitleKeyword:                        "meta.extension.valueAttachment.title.keyword",
+	MetaExtensionValueAttachmentUrl:                                 "meta.extension.valueAttachment.url",
+	MetaExtensionValueAttachmentUrlKeyword:                          "meta.extension.valueAttachment.url.keyword",
+	MetaExtensionValueCode:                                          "meta.extension.valueCode",
+	MetaExtensionValueCodeKeyword:                                   "meta.extension.valueCode.keyword",
+	MetaExtensionValueInstant:                                       "meta.extension.valueInstant",
+	MetaExtensionValueInstantKeyword:                                "meta.extension.valueInstant.keyword",
+	MetaExtensionValueReference:                                     "meta.extension.valueReference",
+	MetaExtensionValueString:                                        "meta.extension.valueString",
+	MetaExtensionValueStringKeyword:                                 "meta.extension.valueString.keyword",
+	MetaLastUpdated:                                                 "meta.lastUpdated",
+	MetaLastUpdatedKeyword:                                          "meta.lastUpdated.keyword",
+	MetaSecurity:                                                    "meta.security",
+	MetaSecurityCode:                                                "meta.security.code",
+	MetaSecurityCodeKeyword:                                         "meta.security.code.keyword",
+	MetaSecuritySystem:                                              "meta.security.system",
+	MetaSecuritySystemKeyword:                                       "meta.security.system.keyword",
+	MetaTag:                                                         "meta.tag",
+	MetaTagCode:                                                     "meta.tag.code",
+	MetaTagCodeKeyword:                                              "meta.tag.code.keyword",
+	MetaTagSystem:                                                   "meta.tag.system",
+	MetaTagSystemKeyword:                                            "meta.tag.system.keyword",
+	MetaVersionId:                                                   "meta.versionId",
+	MetaVersionIdKeyword:                                            "meta.versionId.keyword",
+	Participant:                                                     "participant",
+	ParticipantIndividual:                                           "participant.individual",
+	ParticipantIndividualExtension:                                  "participant.individual.extension",
+	ParticipantIndividualExtensionUrl:                               "participant.individual.extension.url",
+	ParticipantIndividualExtensionUrlKeyword:                        "participant.individual.extension.url.keyword",
+	ParticipantIndividualExtensionValueAttachment:                   "participant.individual.extension.valueAttachment",
+	ParticipantIndividualExtensionValueAttachmentContentType:        "participant.individual.extension.valueAttachment.contentType",
+	ParticipantIndividualExtensionValueAttachmentContentTypeKeyword: "participant.individual.extension.valueAttachment.contentType.keyword",
+	ParticipantIndividualExtensionValueAttachmentCreation:           "participant.individual.extension.valueAttachment.creation",
+	ParticipantIndividualExtensionValueAttachmentData:               "participant.individual.extension.valueAttachment.data",
+	ParticipantIndividualExtensionValueAttachmentDataKeyword:        "participant.individual.extension.valueAttachment.data.keyword",
+	ParticipantIndividualExtensionValueAttachmentHash:               "participant.individual.extension.valueAttachment.hash",
+	ParticipantIndividualExtensionValueAttachmentHashKeyword:        "participant.individual.extension.valueAttachment.hash.keyword",
+	ParticipantIndividualExtensionValueAttachmentLanguage:           "participant.individual.extension.valueAttachment.language",
+	ParticipantIndividualExtensionValueAttachmentLanguageKeyword:    "participant.individual.extension.valueAttachment.language.keyword",
+	ParticipantIndividualExtensionValueAttachmentSize:               "participant.individual.extension.valueAttachment.size",
+	ParticipantIndividualExtensionValueAttachmentSizeKeyword:        "participant.individual.extension.valueAttachment.size.keyword",
+	ParticipantIndividualExtensionValueAttachmentTitle:              "participant.individual.extension.valueAttachment.title",
+	ParticipantIndividualExtensionValueAttachmentTitleKeyword:       "participant.individual.extension.valueAttachment.title.keyword",
+	ParticipantIndividualExtensionValueAttachmentUrl:                "participant.individual.extension.valueAttachment.url",
+	ParticipantIndividualExtensionValueAttachmentUrlKeyword:         "participant.individual.extension.valueAttachment.url.keyword",
+	ParticipantIndividualExtensionValueCode:                         "participant.individual.extension.valueCode",
+	ParticipantIndividualExtensionValueCodeKeyword:                  "participant.individual.extension.valueCode.keyword",
+	ParticipantIndividualExtensionValueInstant:                      "participant.individual.extension.valueInstant",
+	ParticipantIndividualExtensionValueInstantKeyword:               "participant.individual.extension.valueInstant.keyword",
+	ParticipantIndividualExtensionValueReference:                    "participant.individual.extension.valueReference",
+	ParticipantIndividualExtensionValueString:                       "participant.individual.extension.valueString",
+	ParticipantIndividualExtensionValueStringKeyword:                "participant.individual.extension.valueString.keyword",
+	ParticipantIndividualReference:                                  "participant.individual.reference",
+	ParticipantIndividualReferenceKeyword:                           "participant.individual.reference.keyword",
+	ParticipantIndividualType:                                       "participant.individual.type",
+	ParticipantIndividualTypeKeyword:                                "participant.individual.type.keyword",
+	ParticipantType:                                                 "participant.type",
+	ParticipantTypeCoding:                                           "participant.type.coding",
+	ParticipantTypeCodingCode:                                       "participant.type.coding.code",
+	ParticipantTypeCodingCodeKeyword:                                "participant.type.coding.code.keyword",
+	ParticipantTypeCodingSystem:                                     "participant.type.coding.system",
+	ParticipantTypeCodingSystemKeyword:                              "participant.type.coding.system.keyword",
+	Status:                                                          "status",
+	StatusKeyword:                                                   "status.keyword",
+}
+
+// FhirPatientFields lists the indexed field paths of the FhirPatient elastic search mapping.
+var FhirPatientFields = struct {
+	Active                                                        esquery.BooleanField
+	Address                                                       esquery.NestedPath
+	AddressCountry                                                esquery.TextField
+	AddressCountryKeyword                                         esquery.KeywordField
+	AddressPostalCode                                             esquery.TextField
+	AddressPostalCodeKeyword                                      esquery.KeywordField
+	AddressText                                                   esquery.TextField
+	AddressTextKeyword                                            esquery.KeywordField
+	BirthDate                                                     esquery.DateField
+	Extension                                                     esquery.NestedPath
+	ExtensionUrl                                                  esquery.TextField
+	ExtensionUrlKeyword                                           esquery.KeywordField
+	ExtensionValueAttachment                                      esquery.NestedPath
+	ExtensionValueAttachmentContentType                           esquery.TextField
+	ExtensionValueAttachmentContentTypeKeyword                    esquery.KeywordField
+	ExtensionValueAttachmentCreation                              esquery.DateField
+	ExtensionValueAttachmentData                                  esquery.TextField
+	ExtensionValueAttachmentDataKeyword                           esquery.KeywordField
+	ExtensionValueAttachmentHash                                  esquery.TextField
+	ExtensionValueAttachmentHashKeyword                           esquery.KeywordField
+	ExtensionValueAttachmentLanguage                              esquery.TextField
+	ExtensionValueAttachmentLanguageKeyword                       esquery.KeywordField
+	ExtensionValueAttachmentSize                                  esquery.TextField
+	ExtensionValueAttachmentSizeKeyword                           esquery.KeywordField
+	ExtensionValueAttachmentTitle                                 esquery.TextField
+	ExtensionValueAttachmentTitleKeyword                          esquery.KeywordField
+	ExtensionValueAttachmentUrl                                   esquery.TextField
+	ExtensionValueAttachmentUrlKeyword                            esquery.KeywordField
+	ExtensionValueCode                                            esquery.TextField
+	ExtensionValueCodeKeyword                                     esquery.KeywordField
+	ExtensionValueInstant                                         esquery.TextField
+	ExtensionValueInstantKeyword                                  esquery.KeywordField
+	ExtensionValueReference                                       esquery.NestedPath
+	ExtensionValueString                                          esquery.TextField
+	ExtensionValueStringKeyword                                   esquery.KeywordField
+	Gender                                                        esquery.KeywordField
+	GeneralPractitioner                                           esquery.NestedPath
+	GeneralPractitionerExtension                                  esquery.NestedPath
+	GeneralPractitionerExtensionUrl                               esquery.TextField
+	GeneralPractitionerExtensionUrlKeyword                        esquery.KeywordField
+	GeneralPractitionerExtensionValueAttachment                   esquery.NestedPath
+	GeneralPractitionerExtensionValueAttachmentContentType        esquery.TextField
+	GeneralPractitionerExtensionValueAttachmentContentTypeKeyword esquery.KeywordField
+	GeneralPractitionerExtensionValueAttachmentCreation           esquery.DateField
+	GeneralPractitionerExtensionValueAttachmentData               esquery.TextField
+	GeneralPractitionerExtensionValueAttachmentDataKeyword        esquery.KeywordField
+	GeneralPractitionerExtensionValueAttachmentHash               esquery.TextField
+	GeneralPractitionerExtensionValueAttachmentHashKeyword        esquery.KeywordField
+	GeneralPractitionerExtensionValueAttachmentLanguage           esquery.TextField
+	GeneralPractitionerExtensionValueAttachmentLanguageKeyword    esquery.KeywordField
+	GeneralPractitionerExtensionValueAttachmentSize               esquery.TextField
+	GeneralPractitionerExtensionValueAttachmentSizeKeyword        esquery.KeywordField
+	GeneralPractitionerExtensionValueAttachmentTitle              esquery.TextField
+	GeneralPractitionerExtensionValueAttachmentTitleKeyword       esquery.KeywordField
+	GeneralPractitionerExtensionValueAttachmentUrl                esquery.TextField
+	GeneralPractitionerExtensionValueAttachmentUrlKeyword         esquery.KeywordField
+	GeneralPractitionerExtensionValueCode                         esquery.TextField
+	GeneralPractitionerExtensionValueCodeKeyword                  esquery.KeywordField
+	GeneralPractitionerExtensionValueInstant                      esquery.TextField
+	GeneralPractitionerExtensionValueInstantKeyword               esquery.KeywordField
+	GeneralPractitionerExtensionValueReference                    esquery.NestedPath
+	GeneralPractitionerExtensionValueString                       esquery.TextField
+	GeneralPractitionerExtensionValueStringKeyword                esquery.KeywordField
+	GeneralPractitionerReference                                  esquery.TextField
+	GeneralPractitionerReferenceKeyword                           esquery.KeywordField
+	GeneralPractitionerType                                       esquery.TextField
+	GeneralPractitionerTypeKeyword                                esquery.KeywordField
+	Id                                                            esquery.KeywordField
+	Identifier                                                    esquery.NestedPath
+	IdentifierSystem                                              esquery.TextField
+	IdentifierSystemKeyword                                       esquery.KeywordField
+	IdentifierUse                                                 esquery.TextField
+	IdentifierUseKeyword                                          esquery.KeywordField
+	IdentifierValue                                               esquery.TextField
+	IdentifierValueKeyword                                        esquery.KeywordField
+	Link                                                          esquery.NestedPath
+	LinkOther                                                     esquery.NestedPath
+	LinkOtherExtension                                            esquery.NestedPath
+	LinkOtherExtensionUrl                                         esquery.TextField
+	LinkOtherExtensionUrlKeyword                                  esquery.KeywordField
+	LinkOtherExtensionValueAttachment                             esquery.NestedPath
+	LinkOtherExtensionValueAttachmentContentType                  esquery.TextField
+	LinkOtherExtensionValueAttachmentContentTypeKeyword           esquery.KeywordField
+	LinkOtherExtensionValueAttachmentCreation                     esquery.DateField
+	LinkOtherExtensionValueAttachmentData                         esquery.TextField
+	LinkOtherExtensionValueAttachmentDataKeyword                  esquery.KeywordField
+	LinkOtherExtensionValueAttachmentHash                         esquery.TextField
+	LinkOtherExtensionValueAttachmentHashKeyword                  esquery.KeywordField
+	LinkOtherExtensionValueAttachmentLanguage                     esquery.TextField
+	LinkOtherExtensionValueAttachmentLanguageKeyword              esquery.KeywordField
+	LinkOtherExtensionValueAttachmentSize                         esquery.TextField
+	LinkOtherExtensionValueAttachmentSizeKeyword                  esquery.KeywordField
+	LinkOtherExtensionValueAttachmentTitle                        esquery.TextField
+	LinkOtherExtensionValueAttachmentTitleKeyword                 esquery.KeywordField
+	LinkOtherExtensionValueAttachmentUrl                          esquery.TextField
+	LinkOtherExtensionValueAttachmentUrlKeyword                   esquery.KeywordField
+	LinkOtherExtensionValueCode                                   esquery.TextField
+	LinkOtherExtensionValueCodeKeyword                            esquery.KeywordField
+	LinkOtherExtensionValueInstant                                esquery.TextField
+	LinkOtherExtensionValueInstantKeyword                         esquery.KeywordField
+	LinkOtherExtensionValueReference                              esquery.NestedPath
+	LinkOtherExtensionValueString                                 esquery.TextField
+	LinkOtherExtensionValueStringKeyword                          esquery.KeywordField
+	LinkOtherReference                                            esquery.TextField
+	LinkOtherReferenceKeyword                                     esquery.KeywordField
+	LinkOtherType                                                 esquery.TextField
+	LinkOtherTypeKeyword                                          esquery.KeywordField
+	LinkType                                                      esquery.TextField
+	LinkTypeKeyword                                               esquery.KeywordField
+	Meta                                                          esquery.NestedPath
+	MetaExtension                                                 esquery.NestedPath
+	MetaExtensionUrl                                              esquery.TextField
+	MetaExtensionUrlKeyword                                       esquery.KeywordField
+	MetaExtensionValueAttachment                                  esquery.NestedPath
+	MetaExtensionValueAttachmentContentType                       esquery.TextField
+	MetaExtensionValueAttachmentContentTypeKeyword                esquery.KeywordField
+	MetaExtensionValueAttachmentCreation                          esquery.DateField
+	MetaExtensionValueAttachmentData                              esquery.TextField
+	MetaExtensionValueAttachmentDataKeyword                       esquery.KeywordField
+	MetaExtensionValueAttachmentHash                              esquery.TextField
+	MetaExtensionValueAttachmentHashKeyword                       esquery.KeywordField
+	MetaExtensionValueAttachmentLanguage                          esquery.TextField
+	MetaExtensionValueAttachmentLanguageKeyword                   esquery.KeywordField
+	MetaExtensionValueAttachmentSize                              esquery.TextField
+	MetaExtensionValueAttachmentSizeKeyword                       esquery.KeywordField
+	MetaExtensionValueAttachmentTitle                             esquery.TextField
+	MetaExtensionValueAttachmentTitleKeyword                      esquery.KeywordField
+	MetaExtensionValueAttachmentUrl                               esquery.TextField
+	MetaExtensionValueAttachmentUrlKeyword                        esquery.KeywordField
+	MetaExtensionValueCode                                        esquery.TextField
+	MetaExtensionValueCodeKeyword                                 esquery.KeywordField
+	MetaExtensionValueInstant                                     esquery.TextField
+	MetaExtensionValueInstantKeyword                              esquery.KeywordField
+	MetaExtensionValueReference                                   esquery.NestedPath
+	MetaExtensionValueString                                      esquery.TextField
+	MetaExtensionValueStringKeyword                               esquery.KeywordField
+	MetaLastUpdated                                               esquery.TextField
+	MetaLastUpdatedKeyword                                        esquery.KeywordField
+	MetaSecurity                                                  esquery.NestedPath
+	MetaSecurityCode                                              esquery.TextField
+	MetaSecurityCodeKeyword                                       esquery.KeywordField
+	MetaSecuritySystem                                            esquery.TextField
+	MetaSecuritySystemKeyword                                     esquery.KeywordField
+	MetaTag                                                       esquery.NestedPath
+	MetaTagCode                                                   esquery.TextField
+	MetaTagCodeKeyword                                            esquery.KeywordField
+	MetaTagSystem                                                 esquery.TextField
+	MetaTagSystemKeyword                                          esquery.KeywordField
+	MetaVersionId                                                 esquery.TextField
+	MetaVersionIdKeyword                                          esquery.KeywordField
+	Name                                                          esquery.NestedPath
+	NameExtension                                                 esquery.NestedPath
+	NameExtensionUrl                                              esquery.TextField
+	NameExtensionUrlKeyword                                       esquery.KeywordField
+	NameExtensionValueAttachment                                  esquery.NestedPath
+	NameExtensionValueAttachmentContentType                       esquery.TextField
+	NameExtensionValueAttachmentContentTypeKeyword                esquery.KeywordField
+	NameExtensionValueAttachmentCreation                          esquery.DateField
+	NameExtensionValueAttachmentData                              esquery.TextField
+	NameExtensionValueAttachmentDataKeyword                       esquery.KeywordField
+	NameExtensionValueAttachmentHash                              esquery.TextField
+	NameExtensionValueAttachmentHashKeyword                       esquery.KeywordField
+	NameExtensionValueAttachmentLanguage                          esquery.TextField
+	NameExtensionValueAttachmentLanguageKeyword                   esquery.KeywordField
+	NameExtensionValueAttachmentSize                              esquery.TextField
+	NameExtensionValueAttachmentSizeKeyword                       esquery.KeywordField
+	NameExtensionValueAttachmentTitle                             esquery.TextField
+	NameExtensionValueAttachmentTitleKeyword                      esquery.KeywordField
+	NameExtensionValueAttachmentUrl                               esquery.TextField
+	NameExtensionValueAttachmentUrlKeyword                        esquery.KeywordField
+	NameExtensionValueCode                                        esquery.TextField
+	NameExtensionValueCodeKeyword                                 esquery.KeywordField
+	NameExtensionValueInstant                                     esquery.TextField
+	NameExtensionValueInstantKeyword                              esquery.KeywordField
+	NameExtensionValueReference                                   esquery.NestedPath
+	NameExtensionValueString                                      esquery.TextField
+	NameExtensionValueStringKeyword                               esquery.KeywordField
+	NameText                                                      esquery.TextField
+	NameTextKeyword                                               esquery.KeywordField
+	NameUse                                                       esquery.TextField
+	NameUseKeyword                                                esquery.KeywordField
+	Telecom                                                       esquery.NestedPath
+	TelecomSystem                                                 esquery.TextField
+	TelecomSystemKeyword                                          esquery.KeywordField
+	TelecomValue                                                  esquery.TextField
+	TelecomValueKeyword                                           esquery.KeywordField
+}{
+	Active:                              "active",
+	Address:                             "address",
+	AddressCountry:                      "address.country",
+	AddressCountryKeyword:               "address.country.keyword",
+	AddressPostalCode:                   "address.postalCode",
+	AddressPostalCodeKeyword:            "address.postalCode.keyword",
+	AddressText:                         "address.text",
+	AddressTextKeyword:                  "address.text.keyword",
+	BirthDate:                           "birthDate",
+	Extension:                           "extension",
+	ExtensionUrl:                        "extension.url",
+	ExtensionUrlKeyword:                 "extension.url.keyword",
+	ExtensionValueAttachment:            "extension.valueAttachment",
+	ExtensionValueAttachmentContentType: "extension.valueAttachment.contentType",
+	ExtensionValueAttachmentContentTypeKeyword:                    "extension.valueAttachment.contentType.keyword",
+	ExtensionValueAttachmentCreation:                              "extension.valueAttachment.creation",
+	ExtensionValueAttachmentData:                                  "extension.valueAttachment.data",
+	ExtensionValueAttachmentDataKeyword:                           "extension.valueAttachment.data.keyword",
+	ExtensionValueAttachmentHash:                                  "extension.valueAttachment.hash",
+	ExtensionValueAttachmentHashKeyword:                           "extension.valueAttachment.hash.keyword",
+	ExtensionValueAttachmentLanguage:                              "extension.valueAttachment.language",
+	ExtensionValueAttachmentLanguageKeyword:                       "extension.valueAttachment.language.keyword",
+	ExtensionValueAttachmentSize:                                  "extension.valueAttachment.size",
+	ExtensionValueAttachmentSizeKeyword:                           "extension.valueAttachment.size.keyword",
+	ExtensionValueAttachmentTitle:                                 "extension.valueAttachment.title",
+	ExtensionValueAttachmentTitleKeyword:                          "extension.valueAttachment.title.keyword",
+	ExtensionValueAttachmentUrl:                                   "extension.valueAttachment.url",
+	ExtensionValueAttachmentUrlKeyword:                            "extension.valueAttachment.url.keyword",
+	ExtensionValueCode:                                            "extension.valueCode",
+	ExtensionValueCodeKeyword:                                     "extension.valueCode.keyword",
+	ExtensionValueInstant:                                         "extension.valueInstant",
+	ExtensionValueInstantKeyword:                                  "extension.valueInstant.keyword",
+	ExtensionValueReference:                                       "extension.valueReference",
+	ExtensionValueString:                                          "extension.valueString",
+	ExtensionValueStringKeyword:                                   "extension.valueString.keyword",
+	Gender:                                                        "gender",
+	GeneralPractitioner:                                           "generalPractitioner",
+	GeneralPractitionerExtension:                                  "generalPractitioner.extension",
+	GeneralPractitionerExtensionUrl:                               "generalPractitioner.extension.url",
+	GeneralPractitionerExtensionUrlKeyword:                        "generalPractitioner.extension.url.keyword",
+	GeneralPractitionerExtensionValueAttachment:                   "generalPractitioner.extension.valueAttachment",
+	GeneralPractitionerExtensionValueAttachmentContentType:        "generalPractitioner.extension.valueAttachment.contentType",
+	GeneralPractitionerExtensionValueAttachmentContentTypeKeyword: "generalPractitioner.extension.valueAttachment.contentType.keyword",
+	GeneralPractitionerExtensionValueAttachmentCreation:           "generalPractitioner.extension.valueAttachment.creation",
+	GeneralPractitionerExtensionValueAttachmentData:               "generalPractitioner.extension.valueAttachment.data",
+	GeneralPractitionerExtensionValueAttachmentDataKeyword:        "generalPractitioner.extension.valueAttachment.data.keyword",
+	GeneralPractitionerExtensionValueAttachmentHash:               "generalPractitioner.extension.valueAttachment.hash",
+	GeneralPractitionerExtensionValueAttachmentHashKeyword:        "generalPractitioner.extension.valueAttachment.hash.keyword",
+	GeneralPractitionerExtensionValueAttachmentLanguage:           "generalPractitioner.extension.valueAttachment.language",
+	GeneralPractitionerExtensionValueAttachmentLanguageKeyword:    "generalPractitioner.extension.valueAttachment.language.keyword",
+	GeneralPractitionerExtensionValueAttachmentSize:               "generalPractitioner.extension.valueAttachment.size",
+	GeneralPractitionerExtensionValueAttachmentSizeKeyword:        "generalPractitioner.extension.valueAttachment.size.keyword",
+	GeneralPractitionerExtensionValueAttachmentTitle:              "generalPractitioner.extension.valueAttachment.title",
+	GeneralPractitionerExtensionValueAttachmentTitleKeyword:       "generalPractitioner.extension.valueAttachment.title.keyword",
+	GeneralPractitionerExtensionValueAttachmentUrl:                "generalPractitioner.extension.valueAttachment.url",
+	GeneralPractitionerExtensionValueAttachmentUrlKeyword:         "generalPractitioner.extension.valueAttachment.url.keyword",
+	GeneralPractitionerExtensionValueCode:                         "generalPractitioner.extension.valueCode",
+	GeneralPractitionerExtensionValueCodeKeyword:                  "generalPractitioner.extension.valueCode.keyword",
+	GeneralPractitionerExtensionValueInstant:                      "generalPractitioner.extension.valueInstant",
+	GeneralPractitionerExtensionValueInstantKeyword:               "generalPractitioner.extension.valueInstant.keyword",
+	GeneralPractitionerExtensionValueReference:                    "generalPractitioner.extension.valueReference",
+	GeneralPractitionerExtensionValueString:                       "generalPractitioner.extension.valueString",
+	GeneralPractitionerExtensionValueStringKeyword:                "generalPractitioner.extension.valueString.keyword",
+	GeneralPractitionerReference:                                  "generalPractitioner.reference",
+	GeneralPractitionerReferenceKeyword:                           "generalPractitioner.reference.keyword",
+	GeneralPractitionerType:                                       "generalPractitioner.type",
+	GeneralPractitionerTypeKeyword:                                "generalPractitioner.type.keyword",
+	Id:                                                            "id",
+	Identifier:                                                    "identifier",
+	IdentifierSystem:                                              "identifier.system",
+	IdentifierSystemKeyword:                                       "identifier.system.keyword",
+	IdentifierUse:                                                 "identifier.use",
+	IdentifierUseKeyword:                                          "identifier.use.keyword",
+	IdentifierValue:                                               "identifier.value",
+	IdentifierValueKeyword:                                        "identifier.value.keyword",
+	Link:                                                          "link",
+	LinkOther:                                                     "link.other",
+	LinkOtherExtension:                                            "link.other.extension",
+	LinkOtherExtensionUrl:                                         "link.other.extension.url",
+	LinkOtherExtensionUrlKeyword:                                  "link.other.extension.url.keyword",
+	LinkOtherExtensionValueAttachment:                             "link.other.extension.valueAttachment",
+	LinkOtherExtensionValueAttachmentContentType:                  "link.other.extension.valueAttachment.contentType",
+	LinkOtherExtensionValueAttachmentContentTypeKeyword:           "link.other.extension.valueAttachment.contentType.keyword",
+	LinkOtherExtensionValueAttachmentCreation:                     "link.other.extension.valueAttachment.creation",
+	LinkOtherExtensionValueAttachmentData:                         "link.other.extension.valueAttachment.data",
+	LinkOtherExtensionValueAttachmentDataKeyword:                  "link.other.extension.valueAttachment.data.keyword",
+	LinkOtherExtensionValueAttachmentHash:                         "link.other.extension.valueAttachment.hash",
+	LinkOtherExtensionValueAttachmentHashKeyword:                  "link.other.extension.valueAttachment.hash.keyword",
+	LinkOtherExtensionValueAttachmentLanguage:                     "link.other.extension.valueAttachment.language",
+	LinkOtherExtensionValueAttachmentLanguageKeyword:              "link.other.extension.valueAttachment.language.keyword",
+	LinkOtherExtensionValueAttachmentSize:                         "link.other.extension.valueAttachment.size",
+	LinkOtherExtensionValueAttachmentSizeKeyword:                  "link.other.extension.valueAttachment.size.keyword",
+	LinkOtherExtensionValueAttachmentTitle:                        "link.other.extension.valueAttachment.title",
+	LinkOtherExtensionValueAttachmentTitleKeyword:                 "link.other.extension.valueAttachment.title.keyword",
+	LinkOtherExtensionValueAttachmentUrl:                          "link.other.extension.valueAttachment.url",
+	LinkOtherExtensionValueAttachmentUrlKeyword:                   "link.other.extension.valueAttachment.url.keyword",
+	LinkOtherExtensionValueCode:                                   "link.other.extension.valueCode",
+	LinkOtherExtensionValueCodeKeyword:                            "link.other.extension.valueCode.keyword",
+	LinkOtherExtensionValueInstant:                                "link.other.extension.valueInstant",
+	LinkOtherExtensionValueInstantKeyword:                         "link.other.extension.valueInstant.keyword",
+	LinkOtherExtensionValueReference:                              "link.other.extension.valueReference",
+	LinkOtherExtensionValueString:                                 "link.other.extension.valueString",
+	LinkOtherExtensionValueStringKeyword:                          "link.other.extension.valueString.keyword",
+	LinkOtherReference:                                            "link.other.reference",
+	LinkOtherReferenceKeyword:                                     "link.other.reference.keyword",
+	LinkOtherType:                                                 "link.other.type",
+	LinkOtherTypeKeyword:                                          "link.other.type.keyword",
+	LinkType:                                                      "link.type",
+	LinkTypeKeyword:                                               "link.type.keyword",
+	Meta:                                                          "meta",
+	MetaExtension:                                                 "meta.extension",
+	MetaExtensionUrl:                                              "meta.extension.url",
+	MetaExtensionUrlKeyword:                                       "meta.extension.url.keyword",
+	MetaExtensionValueAttachment:                                  "meta.extension.valueAttachment",
+	MetaExtensionValueAttachmentContentType:                       "meta.extension.valueAttachment.contentType",
+	MetaExtensionValueAttachmentContentTypeKeyword:                "meta.extension.valueAttachment.contentType.keyword",
+	MetaExtensionValueAttachmentCreation:                          "meta.extension.valueAttachment.creation",
+	MetaExtensionValueAttachmentData:                              "meta.extension.valueAttachment.data",
+	MetaExtensionValueAttachmentDataKeyword:                       "meta.extension.valueAttachment.data.keyword",
+	MetaExtensionValueAttachmentHash:                              "meta.extension.valueAttachment.hash",
+	MetaExtensionValueAttachmentHashKeyword:                       "meta.extension.valueAttachment.hash.keyword",
+	MetaExtensionValueAttachmentLanguage:                          "meta.extension.valueAttachment.language",
+	MetaExtensionValueAttachmentLanguageKeyword:                   "meta.extension.valueAttachment.language.keyword",
+	MetaExtensionValueAttachmentSize:                              "meta.extension.valueAttachment.size",
+	MetaExtensionValueAttachmentSizeKeyword:                       "meta.extension.valueAttachment.size.keyword",
+	MetaExtensionValueAttachmentTitle:                             "meta.extension.valueAttachment.title",
+	MetaExtensionValueAttachmentTitleKeyword:                      "meta.extension.valueAttachment.title.keyword",
+	MetaExtensionValueAttachmentUrl:                               "meta.extension.valueAttachment.url",
+	MetaExtensionValueAttachmentUrlKeyword:                        "meta.extension.valueAttachment.url.keyword",
+	MetaExtensionValueCode:                                        "meta.extension.valueCode",
+	MetaExtensionValueCodeKeyword:                                 "meta.extension.valueCode.keyword",
+	MetaExtensionValueInstant:                                     "meta.extension.valueInstant",
+	MetaExtensionValueInstantKeyword:                              "meta.extension.valueInstant.keyword",
+	MetaExtensionValueReference:                                   "meta.extension.valueReference",
+	MetaExtensionValueString:                                      "meta.extension.valueString",
+	MetaExtensionValueStringKeyword:                               "meta.extension.valueString.keyword",
+	MetaLastUpdated:                                               "meta.lastUpdated",
+	MetaLastUpdatedKeyword:                                        "meta.lastUpdated.keyword",
+	MetaSecurity:                                                  "meta.security",
+	MetaSecurityCode:                                              "meta.security.code",
+	MetaSecurityCodeKeyword:                                       "meta.security.code.keyword",
+	MetaSecuritySystem:                                            "meta.security.system",
+	MetaSecuritySystemKeyword:                                     "meta.security.system.keyword",
+	MetaTag:                                                       "meta.tag",
+	MetaTagCode:                                                   "meta.tag.code",
+	MetaTagCodeKeyword:                                            "meta.tag.code.keyword",
+	MetaTagSystem:                                                 "meta.tag.system",
+	MetaTagSystemKeyword:                                          "meta.tag.system.keyword",
+	MetaVersionId:                                                 "meta.versionId",
+	MetaVersionIdKeyword:                                          "meta.versionId.keyword",
+	Name:                                                          "name",
+	NameExtension:                                                 "name.extension",
+	NameExtensionUrl:                                              "name.extension.url",
+	NameExtensionUrlKeyword:                                       "name.extension.url.keyword",
+	NameExtensionValueAttachment:                                  "name.extension.valueAttachment",
+	NameExtensionValueAttachmentContentType:                       "name.extension.valueAttachment.contentType",
+	NameExtensionValueAttachmentContentTypeKeyword:                "name.extension.valueAttachment.contentType.keyword",
+	NameExtensionValueAttachmentCreation:                          "name.extension.valueAttachment.creation",
+	NameExtensionValueAttachmentData:                              "name.extension.valueAttachment.data",
+	NameExtensionValueAttachmentDataKeyword:                       "name.extension.valueAttachment.data.keyword",
+	NameExtensionValueAttachmentHash:                              "name.extension.valueAttachment.hash",
+	NameExtensionValueAttachmentHashKeyword:                       "name.extension.valueAttachment.hash.keyword",
+	NameExtensionValueAttachmentLanguage:                          "name.extension.valueAttachment.language",
+	NameExtensionValueAttachmentLanguageKeyword:                   "name.extension.valueAttachment.language.keyword",
+	NameExtensionValueAttachmentSize:                              "name.extension.valueAttachment.size",
+	NameExtensionValueAttachmentSizeKeyword:                       "name.extension.valueAttachment.size.keyword",
+	NameExtensionValueAttachmentTitle:                             "name.extension.valueAttachment.title",
+	NameExtensionValueAttachmentTitleKeyword:                      "name.extension.valueAttachment.title.keyword",
+	NameExtensionValueAttachmentUrl:                               "name.extension.valueAttachment.url",
+	NameExtensionValueAttachmentUrlKeyword:                        "name.extension.valueAttachment.url.keyword",
+	NameExtensionValueCode:                                        "name.extension.valueCode",
+	NameExtensionValueCodeKeyword:                                 "name.extension.valueCode.keyword",
+	NameExtensionValueInstant:                                     "name.extension.valueInstant",
+	NameExtensionValueInstantKeyword:                              "name.extension.valueInstant.keyword",
+	NameExtensionValueReference:                                   "name.extension.valueReference",
+	NameExtensionValueString:                                      "name.extension.valueString",
+	NameExtensionValueStringKeyword:                               "name.extension.valueString.keyword",
+	NameText:                                                      "name.text",
+	NameTextKeyword:                                               "name.text.keyword",
+	NameUse:                                                       "name.use",
+	NameUseKeyword:                                                "name.use.keyword",
+	Telecom:                                                       "telecom",
+	TelecomSystem:                                                 "telecom.system",
+	TelecomSystemKeyword:                                          "telecom.system.keyword",
+	TelecomValue:                                                  "telecom.value",
+	TelecomValueKeyword:                                           "telecom.value.keyword",
+}
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
+// ResponseEditorFn is the function signature for the ResponseEditor callback
+// function, which may inspect or replace the response before it is parsed
+type ResponseEditorFn func(ctx context.Context, rsp *http.Response) error
+
+// RequestValidatorFn is the function signature for the callback validating
+// requests before they are sent, given the server they were built against
+type RequestValidatorFn func(ctx context.Context, server string, req *http.Request) error
+
 // Doer performs HTTP requests.
 //
 // The standard http.Client implements this interface.
@@ -267,9 +908,31 @@ type Client struct {
 	// customized settings, such as certificate chains.
 	Client HttpRequestDoer
 
+	// A list of callbacks for modifying requests which are generated before
+	// sending over the network, in the order they are run.
+	RequestEditors []RequestEditorFn
+
 	// A callback for modifying requests which are generated before sending over
-	// the network.
+	// the network, which runs before RequestEditors.
+	//
+	// Deprecated: use RequestEditors, or WithRequestEditorFn.
 	RequestEditor RequestEditorFn
+
+	// A list of callbacks for inspecting responses before they are returned,
+	// in the order they are run.
+	ResponseEditors []ResponseEditorFn
+
+	// A callback validating requests once the request editors ran, requests
+	// failing it aren't sent. See WithRequestValidation.
+	RequestValidator RequestValidatorFn
+
+	// How failed requests are retried, they aren't when nil.
+	RetryPolicy *RetryPolicy
+
+	// The servers of the operations which override the servers of the API,
+	// keyed by operation ID, when they aren't the first of their servers. See
+	// WithOperationServer.
+	OperationServers map[string]string
 }
 
 // ClientOption allows setting custom parameters during construction
@@ -309,49 +972,254 @@ func WithHTTPClient(doer HttpRequestDoer) ClientOption {
 
 // WithRequestEditorFn allows setting up a callback function, which will be
 // called right before sending the request. This can be used to mutate the request.
+// It may be given more than once, the callbacks are called in the same order.
 func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 	return func(c *Client) error {
-		c.RequestEditor = fn
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// WithResponseEditorFn allows setting up a callback function, which will be
+// called with every response, before it is returned or parsed. It may be
+// given more than once, the callbacks are called in the same order.
+func WithResponseEditorFn(fn ResponseEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.ResponseEditors = append(c.ResponseEditors, fn)
+		return nil
+	}
+}
+
+// applyEditors runs the request editors of the client, followed by those
+// given for this call only.
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	if c.RequestEditor != nil {
+		if err := c.RequestEditor(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RetryPolicy describes how the client retries requests which failed with a
+// network error, a 5xx or a 429 response.
+type RetryPolicy struct {
+	// The number of retries after the first attempt.
+	MaxRetries int
+
+	// The backoff before the first retry, it doubles for each of the
+	// following ones, with some jitter so that clients don't retry in lockstep.
+	MinBackoff time.Duration
+
+	// The upper bound of any backoff, including those asked for by the server
+	// through a Retry-After header.
+	MaxBackoff time.Duration
+
+	// Whether to retry operations which aren't idempotent. Operations are
+	// idempotent when their method is, or when marked with x-idempotent.
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryPolicy returns a policy retrying idempotent operations three
+// times, backing off from 100ms up to 10s.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries: 3,
+		MinBackoff: 100 * time.Millisecond,
+		MaxBackoff: 10 * time.Second,
+	}
+}
+
+// WithRetryPolicy allows retrying failed requests. Request bodies are buffered
+// so that they can be sent again.
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(c *Client) error {
+		c.RetryPolicy = &policy
 		return nil
 	}
 }
 
+// retryJitter randomizes backoffs, it isn't safe for concurrent use.
+var (
+	retryJitter   = rand.New(rand.NewSource(time.Now().UnixNano()))
+	retryJitterMu sync.Mutex
+)
+
+// do sends the request, and runs the response editors of the client on its
+// response.
+func (c *Client) do(req *http.Request, idempotent bool) (*http.Response, error) {
+	rsp, err := c.send(req, idempotent)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range c.ResponseEditors {
+		if err := r(req.Context(), rsp); err != nil {
+			rsp.Body.Close()
+			return nil, err
+		}
+	}
+	return rsp, nil
+}
+
+// send sends the request, retrying it according to the retry policy of the
+// client.
+func (c *Client) send(req *http.Request, idempotent bool) (*http.Response, error) {
+	policy := c.RetryPolicy
+	if policy == nil || policy.MaxRetries <= 0 || !(idempotent || policy.RetryNonIdempotent) {
+		return c.Client.Do(req)
+	}
+
+	// The body is consumed by each attempt, so we need a fresh one for every
+	// retry.
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		buf, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.GetBody = func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(bytes.NewReader(buf)), nil
+		}
+		req.Body, _ = req.GetBody()
+	}
+
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+		rsp, err := c.Client.Do(req)
+		if attempt >= policy.MaxRetries || !shouldRetry(req, rsp, err) {
+			return rsp, err
+		}
+
+		backoff := policy.backoff(attempt, rsp)
+		if rsp != nil {
+			// Drain the body, so that the connection can be reused.
+			io.Copy(ioutil.Discard, rsp.Body)
+			rsp.Body.Close()
+		}
+		timer := time.NewTimer(backoff)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func shouldRetry(req *http.Request, rsp *http.Response, err error) bool {
+	if err != nil {
+		// Nothing to retry once the caller gave up.
+		return req.Context().Err() == nil
+	}
+	return rsp.StatusCode == http.StatusTooManyRequests || rsp.StatusCode >= 500
+}
+
+// backoff returns how long to wait before the given retry, preferring the
+// delay asked for by the Retry-After header of the response.
+func (p *RetryPolicy) backoff(attempt int, rsp *http.Response) time.Duration {
+	if rsp != nil {
+		if after, ok := retryAfter(rsp.Header.Get("Retry-After")); ok {
+			if p.MaxBackoff > 0 && after > p.MaxBackoff {
+				return p.MaxBackoff
+			}
+			return after
+		}
+	}
+
+	backoff := p.MinBackoff << uint(attempt)
+	if backoff <= 0 || (p.MaxBackoff > 0 && backoff > p.MaxBackoff) {
+		backoff = p.MaxBackoff
+	}
+	if backoff <= 0 {
+		return 0
+	}
+	// Wait somewhere between half and all of the backoff.
+	retryJitterMu.Lock()
+	jitter := time.Duration(retryJitter.Int63n(int64(backoff)/2 + 1))
+	retryJitterMu.Unlock()
+	return backoff/2 + jitter
+}
+
+// retryAfter parses a Retry-After header, which either holds a number of
+// seconds or a date.
+func retryAfter(header string) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(header); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(header); err == nil {
+		after := time.Until(date)
+		if after < 0 {
+			after = 0
+		}
+		return after, true
+	}
+	return 0, false
+}
+
 // The interface specification for the client above.
 type ClientInterface interface {
 	// PostConsulters request  with any body
-	PostConsultersWithBody(ctx context.Context, contentType string, body io.Reader) (*http.Response, error)
+	PostConsultersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	PostConsulters(ctx context.Context, body PostConsultersJSONRequestBody) (*http.Response, error)
+	PostConsulters(ctx context.Context, body PostConsultersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
-func (c *Client) PostConsultersWithBody(ctx context.Context, contentType string, body io.Reader) (*http.Response, error) {
-	req, err := NewPostConsultersRequestWithBody(c.Server, contentType, body)
+func (c *Client) PostConsultersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server := c.Server
+	req, err := NewPostConsultersRequestWithBody(server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if c.RequestEditor != nil {
-		err = c.RequestEditor(ctx, req)
-		if err != nil {
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
 			return nil, err
 		}
 	}
-	return c.Client.Do(req)
+	return c.do(req, false)
 }
 
-func (c *Client) PostConsulters(ctx context.Context, body PostConsultersJSONRequestBody) (*http.Response, error) {
-	req, err := NewPostConsultersRequest(c.Server, body)
+func (c *Client) PostConsulters(ctx context.Context, body PostConsultersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server := c.Server
+	req, err := NewPostConsultersRequest(server, body)
 	if err != nil {
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if c.RequestEditor != nil {
-		err = c.RequestEditor(ctx, req)
-		if err != nil {
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
 			return nil, err
 		}
 	}
-	return c.Client.Do(req)
+	return c.do(req, false)
 }
 
 // NewPostConsultersRequest calls the generic PostConsulters builder with application/json body
@@ -367,30 +1235,220 @@ func NewPostConsultersRequest(server string, body PostConsultersJSONRequestBody)
 
 // NewPostConsultersRequestWithBody generates requests for PostConsulters with any type of body
 func NewPostConsultersRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+	queryUrl, err := PostConsultersURL(server)
+	if err != nil {
+		return nil, err
+	}
 
-	queryUrl, err := url.Parse(server)
+	req, err := http.NewRequest("POST", queryUrl.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
-	basePath := fmt.Sprintf("/consulters")
-	if basePath[0] == '/' {
-		basePath = basePath[1:]
+	req.Header.Add("Content-Type", contentType)
+	return req, nil
+}
+
+// serverDefinition is a server of the API, whose URL may hold variables.
+type serverDefinition struct {
+	url       string
+	variables []serverVariable
+}
+
+// serverVariable is a variable of the URL of a server.
+type serverVariable struct {
+	name         string
+	defaultValue string
+	enum         []string
+}
+
+// ServerVariables holds the values of the variables of the URL of a server. It
+// is one of the types generated for the servers with variables, such as those
+// given to WithServer.
+type ServerVariables interface {
+	// serverURL returns the URL of the server whose variables these are.
+	serverURL() string
+	// values returns the values of the variables, by name.
+	values() map[string]string
+}
+
+// resolve replaces the variables in the URL of the server with their values,
+// or their defaults, checking them against their enum. vars must be those of
+// the server, or nil.
+func (s serverDefinition) resolve(vars ServerVariables) (string, error) {
+	var values map[string]string
+	if vars != nil {
+		if vars.serverURL() != s.url {
+			return "", fmt.Errorf("variables of server %s given for server %s", vars.serverURL(), s.url)
+		}
+		values = vars.values()
 	}
 
-	queryUrl, err = queryUrl.Parse(basePath)
-	if err != nil {
-		return nil, err
+	serverURL := s.url
+	for _, v := range s.variables {
+		value := values[v.name]
+		if value == "" {
+			value = v.defaultValue
+		}
+		if value == "" {
+			return "", fmt.Errorf("missing value for variable %s of server %s", v.name, s.url)
+		}
+		valid := len(v.enum) == 0
+		for _, e := range v.enum {
+			valid = valid || value == e
+		}
+		if !valid {
+			return "", fmt.Errorf("invalid value %q for variable %s of server %s, must be one of: %s", value, v.name, s.url, strings.Join(v.enum, ", "))
+		}
+		serverURL = strings.Replace(serverURL, "{"+v.name+"}", value, -1)
 	}
+	return serverURL, nil
+}
 
-	req, err := http.NewRequest("POST", queryUrl.String(), body)
+// servers lists the servers of the API, in the order of the spec.
+var servers = []serverDefinition{
+	{url: "http://petstore.swagger.io/api"},
+}
+
+// operationServers lists the servers of the operations which override those
+// of the API.
+var operationServers = map[string][]serverDefinition{}
+
+// ServerURL0 is the URL of server 0.
+const ServerURL0 = "http://petstore.swagger.io/api"
+
+// WithServer sets the server of the client to one of the servers of the API,
+// by its index in the spec. Its variables take the given values, which must
+// be the variables of that server, such as a ServerProductionVariables for a
+// production server. They are checked against their enum, and take their
+// default when empty, or when vars is nil.
+func WithServer(index int, vars ServerVariables) ClientOption {
+	return func(c *Client) error {
+		if index < 0 || index >= len(servers) {
+			return fmt.Errorf("no server at index %d", index)
+		}
+		server, err := servers[index].resolve(vars)
+		if err != nil {
+			return err
+		}
+		c.Server = server
+		return nil
+	}
+}
+
+// WithOperationServer sets the server of an operation which overrides the
+// servers of the API to another of its servers, by its index in the spec. Its
+// variables are handled as by WithServer, and are of the types named after the
+// operation, such as GetPetServer0Variables.
+func WithOperationServer(operationID string, index int, vars ServerVariables) ClientOption {
+	return func(c *Client) error {
+		defs := operationServers[operationID]
+		if index < 0 || index >= len(defs) {
+			return fmt.Errorf("operation %s has no server at index %d", operationID, index)
+		}
+		server, err := defs[index].resolve(vars)
+		if err != nil {
+			return err
+		}
+		if c.OperationServers == nil {
+			c.OperationServers = map[string]string{}
+		}
+		c.OperationServers[operationID] = server
+		return nil
+	}
+}
+
+// operationServer returns the server of an operation which overrides the
+// servers of the API, which is its first one unless set otherwise.
+func (c *Client) operationServer(operationID string) (string, error) {
+	server, found := c.OperationServers[operationID]
+	if !found {
+		var err error
+		server, err = operationServers[operationID][0].resolve(nil)
+		if err != nil {
+			return "", err
+		}
+	}
+	if !strings.HasSuffix(server, "/") {
+		server += "/"
+	}
+	return server, nil
+}
+
+// RequestValidationError is returned by the client for a request which
+// doesn't conform to the spec, it isn't sent.
+type RequestValidationError struct {
+	Method string
+	URL    string
+	// Why the request is invalid, usually an *openapi3filter.RequestError,
+	// or an *openapi3filter.RouteError when it matches no operation.
+	Err error
+}
+
+func (e *RequestValidationError) Error() string {
+	return fmt.Sprintf("invalid request %s %s: %s", e.Method, e.URL, e.Err)
+}
+
+// Cause returns why the request is invalid.
+func (e *RequestValidationError) Cause() error {
+	return e.Err
+}
+
+// Unwrap returns why the request is invalid.
+func (e *RequestValidationError) Unwrap() error {
+	return e.Err
+}
+
+// WithRequestValidation validates the parameters and bodies of requests
+// against the given spec, usually the one returned by GetSwagger, before they
+// are sent. Requests are validated once the request editors ran, so security
+// requirements are assumed to be met.
+func WithRequestValidation(swagger *openapi3.Swagger) ClientOption {
+	return func(c *Client) error {
+		// Requests are matched against their path relative to the server of
+		// the client, which may be none of the servers of the spec.
+		spec := *swagger
+		spec.Servers = nil
+		router := openapi3filter.NewRouter()
+		if err := router.AddSwagger(&spec); err != nil {
+			return fmt.Errorf("error loading spec for request validation: %s", err)
+		}
+		c.RequestValidator = func(ctx context.Context, server string, req *http.Request) error {
+			return validateRequest(ctx, router, server, req)
+		}
+		return nil
+	}
+}
+
+// validateRequest validates a request built against the given server.
+func validateRequest(ctx context.Context, router *openapi3filter.Router, server string, req *http.Request) error {
+	serverURL, err := url.Parse(server)
 	if err != nil {
-		return nil, err
+		return err
+	}
+	invalid := func(err error) error {
+		return &RequestValidationError{Method: req.Method, URL: req.URL.String(), Err: err}
 	}
 
-	req.Header.Add("Content-Type", contentType)
-	return req, nil
+	path := strings.TrimPrefix(req.URL.Path, strings.TrimSuffix(serverURL.Path, "/"))
+	route, pathParams, err := router.FindRoute(req.Method, &url.URL{Path: path})
+	if err != nil {
+		return invalid(err)
+	}
+	input := &openapi3filter.RequestValidationInput{
+		Request:    req,
+		PathParams: pathParams,
+		Route:      route,
+		Options: &openapi3filter.Options{
+			AuthenticationFunc: func(context.Context, *openapi3filter.AuthenticationInput) error {
+				return nil
+			},
+		},
+	}
+	if err := openapi3filter.ValidateRequest(ctx, input); err != nil {
+		return invalid(err)
+	}
+	return nil
 }
 
 // ClientWithResponses builds on ClientInterface to offer response payloads
@@ -423,9 +1481,9 @@ func WithBaseURL(baseURL string) ClientOption {
 // ClientWithResponsesInterface is the interface specification for the client with responses above.
 type ClientWithResponsesInterface interface {
 	// PostConsulters request  with any body
-	PostConsultersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader) (*PostConsultersResponse, error)
+	PostConsultersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostConsultersResponse, error)
 
-	PostConsultersWithResponse(ctx context.Context, body PostConsultersJSONRequestBody) (*PostConsultersResponse, error)
+	PostConsultersWithResponse(ctx context.Context, body PostConsultersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostConsultersResponse, error)
 }
 
 type PostConsultersResponse struct {
@@ -451,16 +1509,16 @@ func (r PostConsultersResponse) StatusCode() int {
 }
 
 // PostConsultersWithBodyWithResponse request with arbitrary body returning *PostConsultersResponse
-func (c *ClientWithResponses) PostConsultersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader) (*PostConsultersResponse, error) {
-	rsp, err := c.PostConsultersWithBody(ctx, contentType, body)
+func (c *ClientWithResponses) PostConsultersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostConsultersResponse, error) {
+	rsp, err := c.PostConsultersWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
 	return ParsePostConsultersResponse(rsp)
 }
 
-func (c *ClientWithResponses) PostConsultersWithResponse(ctx context.Context, body PostConsultersJSONRequestBody) (*PostConsultersResponse, error) {
-	rsp, err := c.PostConsulters(ctx, body)
+func (c *ClientWithResponses) PostConsultersWithResponse(ctx context.Context, body PostConsultersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostConsultersResponse, error) {
+	rsp, err := c.PostConsulters(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
@@ -493,6 +1551,61 @@ func ParsePostConsultersResponse(rsp *http.Response) (*PostConsultersResponse, e
 	return response, nil
 }
 
+// APIError is an error response from the server, with the payload of the
+// default response of the operation when the API documents one.
+type APIError struct {
+	Operation    string
+	StatusCode   int
+	Body         []byte
+	HTTPResponse *http.Response
+	// The decoded default response, eg. *Error, or nil
+	Model interface{}
+}
+
+// Error describes the response.
+func (e *APIError) Error() string {
+	return fmt.Sprintf("%s returned %d %s", e.Operation, e.StatusCode, http.StatusText(e.StatusCode))
+}
+
+// PostConsultersWithBodyOrError calls PostConsultersWithBodyWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) PostConsultersWithBodyOrError(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConsulterCreateResponse, error) {
+	rsp, err := c.PostConsultersWithBodyWithResponse(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return postConsultersOrError(rsp)
+}
+
+// PostConsultersOrError calls PostConsultersWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) PostConsultersOrError(ctx context.Context, body PostConsultersJSONRequestBody, reqEditors ...RequestEditorFn) (*ConsulterCreateResponse, error) {
+	rsp, err := c.PostConsultersWithResponse(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return postConsultersOrError(rsp)
+}
+
+// postConsultersOrError returns the payload of a success response to
+// PostConsulters, or an error for any other response.
+func postConsultersOrError(rsp *PostConsultersResponse) (*ConsulterCreateResponse, error) {
+	if rsp.JSON200 != nil {
+		return rsp.JSON200, nil
+	}
+	if rsp.StatusCode() >= 200 && rsp.StatusCode() < 300 {
+		return nil, nil
+	}
+
+	apiErr := APIError{
+		Operation:    "PostConsulters",
+		StatusCode:   rsp.StatusCode(),
+		Body:         rsp.Body,
+		HTTPResponse: rsp.HTTPResponse,
+	}
+	return nil, &apiErr
+}
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// Post Consulters
@@ -503,6 +1616,20 @@ type ServerInterface interface {
 // ServerInterfaceWrapper converts echo contexts to parameters.
 type ServerInterfaceWrapper struct {
 	Handler ServerInterface
+
+	// ErrorHandlerFunc answers requests whose parameters can't be bound, with
+	// their InvalidParamFormatError, RequiredParamError, UnmarshalingParamError
+	// or TooManyValuesForParamError, of the runtime package. They are answered
+	// with a 400 echo.HTTPError if it is nil.
+	ErrorHandlerFunc func(ctx echo.Context, err error) error
+}
+
+// handleError answers a request whose parameters can't be bound.
+func (w *ServerInterfaceWrapper) handleError(ctx echo.Context, err error) error {
+	if w.ErrorHandlerFunc != nil {
+		return w.ErrorHandlerFunc(ctx, err)
+	}
+	return echo.NewHTTPError(http.StatusBadRequest, err.Error())
 }
 
 // PostConsulters converts echo context to params.
@@ -529,58 +1656,142 @@ type EchoRouter interface {
 	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
 }
 
+// RegisterOptions configures the middlewares and the error handling of the
+// routes added by RegisterHandlersWithOptions.
+type RegisterOptions struct {
+	// The middlewares of operations, by operation ID.
+	OperationMiddlewares map[string][]echo.MiddlewareFunc
+
+	// The middlewares of the operations with a tag, by tag.
+	TagMiddlewares map[string][]echo.MiddlewareFunc
+
+	// The ErrorHandlerFunc of the ServerInterfaceWrapper.
+	ErrorHandlerFunc func(ctx echo.Context, err error) error
+}
+
 // RegisterHandlers adds each server route to the EchoRouter.
 func RegisterHandlers(router EchoRouter, si ServerInterface) {
+	RegisterHandlersWithOptions(router, si, RegisterOptions{})
+}
+
+// RegisterHandlersWithOptions adds each server route to the EchoRouter, with
+// the middlewares of the tags of its operation, in the order of the tags, and
+// then those of the operation. The OperationInfo of the operation is put into
+// the context of the request before any of them runs.
+func RegisterHandlersWithOptions(router EchoRouter, si ServerInterface, opts RegisterOptions) {
 
 	wrapper := ServerInterfaceWrapper{
-		Handler: si,
+		Handler:          si,
+		ErrorHandlerFunc: opts.ErrorHandlerFunc,
 	}
 
-	router.POST("/consulters", wrapper.PostConsulters)
+	router.POST("/consulters", wrapper.PostConsulters, opts.middlewares("PostConsulters")...)
+
+}
+
+// middlewares returns the middlewares of the route of an operation.
+func (opts RegisterOptions) middlewares(operationId string) []echo.MiddlewareFunc {
+	info := Operations[operationId]
+	middlewares := []echo.MiddlewareFunc{
+		func(next echo.HandlerFunc) echo.HandlerFunc {
+			return func(ctx echo.Context) error {
+				r := ctx.Request()
+				ctx.SetRequest(r.WithContext(ContextWithOperationInfo(r.Context(), info)))
+				return next(ctx)
+			}
+		},
+	}
+	for _, tag := range info.Tags {
+		middlewares = append(middlewares, opts.TagMiddlewares[tag]...)
+	}
+	return append(middlewares, opts.OperationMiddlewares[operationId]...)
+}
+
+// OperationInfo describes an operation of the API, for the middlewares serving
+// it, which find it in the context of its requests.
+type OperationInfo struct {
+	OperationId string                     // The operation ID, as the names of the generated code use it
+	Method      string                     // The HTTP method, eg. GET
+	Path        string                     // The path template of the spec, eg. /pets/{id}
+	Tags        []string                   // The tags of the operation
+	Security    []OperationSecurity        // The security providers of the operation
+	Extensions  map[string]json.RawMessage // The x- extensions of the operation, by name
+}
+
+// OperationSecurity is a security provider of an operation, and the scopes it
+// requires.
+type OperationSecurity struct {
+	ProviderName string
+	Scopes       []string
+}
+
+// Operations holds the OperationInfo of every operation, by operation ID.
+var Operations = map[string]OperationInfo{
+	"PostConsulters": {
+		OperationId: "PostConsulters",
+		Method:      "POST",
+		Path:        "/consulters",
+		Tags:        []string{"consulters"},
+	},
+}
+
+// operationInfoKey is the context key of the OperationInfo of a request.
+type operationInfoKey struct{}
+
+// ContextWithOperationInfo returns a copy of ctx holding info, which
+// OperationInfoFromContext returns.
+func ContextWithOperationInfo(ctx context.Context, info OperationInfo) context.Context {
+	return context.WithValue(ctx, operationInfoKey{}, info)
+}
 
+// OperationInfoFromContext returns the OperationInfo of the operation serving
+// a request, from its context, and whether there's one.
+func OperationInfoFromContext(ctx context.Context) (OperationInfo, bool) {
+	info, ok := ctx.Value(operationInfoKey{}).(OperationInfo)
+	return info, ok
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/8wa224bufVXCLYPu8BIcrLpbqGnOk6KGM0mRi5FgawfjmbOaLjmkFOSY1u19e8FL3MV",
-	"JUuK7d0HwyPykOd+I3lHU1lWUqAwms7vqE4LLMF9plLomhtU9keGOlWsMkwKOqdnzRRRqGWtUqQJrZSs",
-	"UBmG/dXgV9zRvyrM6Zz+ZdbhmwVks7xgaoIilbWw6NbJYPWpMizleGZnN0lpqSTg4YjbhibUrCqkcyrq",
-	"cjHY1DP0ID0VGIbC0PU6oYYZjn1k3fZy8TumZrD9JFUIBicK/1ujNrvE5yFJgCQZGNgmSVTuFzNY6ocY",
-	"CAR05K5bekEpWMWZGtO9H4+6kkLjgxS1Cyv7B0scbteO7hCWlQ65YaYgFSyZ8Ka1W1rbbIUzx99+wjxQ",
-	"ii0rMfmNFbODXb+K1BpJLhtTifCboV5pg+VeVm3BJwF+5GdHe+n3ONRYHhGZucWQZQp1RKf/fHf+iZyG",
-	"2U3h1MKolf0s4fY9iqUp6PzFyau//+2XnxMqas5hYQkxqsYWtTaKiSVN6O0E9cTAks6/UYO3hl7asaWc",
-	"pLU2smymLIGf/ZrLdUIrqQ3wM5nh8+J1kM+IsafGgYq2qtAYSIsSY1Hca7ED2KR4w8sNCvPFIRpv9isr",
-	"kVgaiMyJKWxGcNCJDx5pAUqjIWjSKX1U0TidXzZuHlxqSNuXAm0cQ2IKMI64TizkBjTJmdImOHt2KH12",
-	"5x30vQGDX1jpaXS5JkofpKYG7sNtkGCPyAkBom2GEKkT8GJlUCdkARp/fkVsfMgOJ/wBwb52m79mAtTK",
-	"EV+ALuLEp8DTmlvhEQvUMOCYqTUTS/L53enkxZR8wkqhRmEh/YRn4alJ5yCWdTTT/WZ+E5aFoi5BkAZu",
-	"ZMRTYkGugdeWV0EWSECs7ADLwjCkqVSZ5chI8vrsgrz65akMXbP/YVwPvuxqDcR+OB04uy/hCkldEVMw",
-	"3betHxaYS4UDY2JimRCW+4VMk0wK/HH6YXP7ICDyA8tJrTiplLxmGWY/dhGJCYNLl2UOYPar0GwpMDsX",
-	"xsdZH/TGTJ8SDgvkRCpio4vVGt4aq4KM6YrDijBBKg4p9m3SKub5UkSt+CbhXxUjNwUq7BwlGFYua5Ed",
-	"ZTmbyaEf2+P5oV+azO82A36Kldm7Ag47+kUbdVvSJBAvjRxqblwNV1YcXZGFoi4tO+3Q5V5sJ5Rlds9d",
-	"oFe4upEq26Gx8zduKwHl1hLicexBGzC1HgoBUsOu+yIIA/sKoHWPpyJ7nVDboTCFmZ0LPHQqvRyZXt+u",
-	"xrZnsRlYarsRctCGpY6JdqE1+0nP+CJly1kAI2cBLFKiW8oPNF23ZlfHEadxh3cFIuIseH1s9hb2/5Nk",
-	"jtbRH3H3r4pFYk/acLdNMq124+w/SkX4yPEr5JRn9DMnjU3fesjqhIHUTCrJxHb/cTDkwsGMbbCzkyYe",
-	"VYUULj6VwLil6Ij0lFBXKv2R7dJQNNsE2PXbceG9bec3nJeDPjTW+OR1CPd7J7MSfbvxIDUO0LbR7jyP",
-	"VSCGXjPkkomMXbOsBr7X5gpzVLZz6XzqwKg8jLbR+DxW5Nh3+1m3r9CKgxCYkXsCSrFr92UUg6X7YmJS",
-	"Kbm0LTa5J1JwhGsk9yRngunCgaQgUuTcfdvGRmE2YWKCSklF7kktroS8Eb3UHjDShAaM1g49RprQHkaa",
-	"0ICRJrTBaHNug9FtOsRIE9pgvNy3ZowndmfJ49CDPcs/IKnjrUGho725d6h23hXmFz1rezl2sVBOf3fC",
-	"CtHodHBAApx/zOn82x5m2Suu15F4uAX3vy3O/3Toz54o2ydRbOdCm+Daj4iw2TWO81Pr/YfJtxc1ouLt",
-	"yBOoDe6KhmOSQnp4tjw0EszI5axBb/hZzyHiOcqdV0yabiXiU+/cgcYHCzB2oYE77h+Iu2WR+PrcB6EJ",
-	"rf0FSBNWa23zUUJlnrOUwbFFyrhi6Ml5mypYhsKwnG2tF847gO2V1qNX5E8loT9BGcf6Ao0rpSl9Iur4",
-	"Fd2F3+7D7qfwEZsWv1YZ2Gh1jMZtFYA8c4fID4dj1/BhWitmVo/TByfUoXmcra5RWTmdZ9+ZzV2pOzaQ",
-	"0qs4bhrNnVjcOi7C7NggwonMxiI/TnzhRGROqnaDLQpeSMkRxIjNZtSqbevFG2fakDA7xLW/SprNIzpZ",
-	"MGWKN2AibLopf4uyD4/H3pQ49neUi6dZxuwn8Obo+Wg57HTVJYosFs/9eA/lnJTAXUuA4UOaAuOVvwWw",
-	"Tozhw0EOK/aj2kBPLyrgF8qao6U1RryzngBJqj7oIXKLtHOd3HwzO0TLsuNNZu9Wd1cSdnx3AMcaTA9F",
-	"LLgzcbWJ+j0TV8RIAsKbRcDbPuHxNyyus1VCu8sADWV7HVih0q4I3NKJews6ugkf0upub1u4OVHobk6y",
-	"yWJF7ptfumfPPQCa0BbgqMaz8QW38nKPhv7gs414rWxHjzWIXm0YrYg5prKMyNlPHIt1eIb14GF1h+KA",
-	"hl31m7ZIkuyauj+ijhpQ94xvPYLXPPnZuepJd+QHFpaJXDbvMSB1dYw/lp1TqJhBKP+hb2C5RDVlkjaG",
-	"Tz/7MXJ6cU6+IJQ0XE3SwphqPpv11qyTjXtWDWXF0S12IavWqAmQCo02UiEBTUAQvPVgRpIMSym0UbZi",
-	"yBFMrVATJlyI+1ihsDv9ND0husKU5SxtXpZxlmJ42xYIP60gLZC8nJ4MSNbz2ezm5mYKbnoq1XIW1urZ",
-	"+/Oztx8+v528nJ5MC1Ny74+q1B/zz6iumTWdTb5nDmRGO3U0MrsIbNK2bqVz+mJ6Mj2xO8sKBVSMzulP",
-	"biihFZjCGfps+DSuktGnif5B4lkH6vZUTiS2QKYXUpvBdHgu+Fpmq97THFenVhUP0pz9rr3fedfa/7ng",
-	"6E3ieu2jtX916Bh5eXLylHjD+0aHdyirj/9yIU7XZQlqFUQzFF0T1Xqiv3Q7aVTXThHf7oam31jxtGcL",
-	"VqHry/X/AwAA///f7tCcrCsAAA==",
+	"H4sIAAAAAAAC/8wa244bt/VXCLYPCTCS1o6bFHrqeuPCizr2wpeiQLIPRzNnNIw5JEtyVqva+veCHM5V",
+	"lFaStZs8CBiRh+d+4+ULTWWppEBhDZ1/oSYtsAT/mUphKm5Ruz8ZmlQzZZkUdE6vmimi0chKp0gTqrRU",
+	"qC3D/mqoV3yhf9WY0zn9y6yjNwvEZnnB9ARFKivhyG2SwepLbVnK8crNbrPSckmghiMeDU2oXSukcyqq",
+	"cjFAivogfhRYhsLSzSahllmOfWIdern4HVM7QD9JNYLFicb/VmjsPvXVkCRAkgws7NIkav+PWSzNQwIE",
+	"Bjp2Ny2/oDWs40KN+T5MRqOkMPggR+1C5X6wxCG6dnSPspx2yIrZgihYMlG71n5t7fIVzrx8hynzSC22",
+	"osT0NzbMHnHrVaQySHLZuEpE3gzN2lgsD/JqBz4J8KM4OzlKvyWgxvqI6MwvhizTaCI2/efr6/fkMsxu",
+	"K6cSVq/dZwn3b1AsbUHnzy5e/P1vP/2YUFFxDgvHiNUVtqSN1UwsaULvJ2gmFpZ0/iu1eG/prRtbykla",
+	"GSvLZsox+KFec7tJqJLGAr+SGT4tXQ/5hBR7ZhyYaKcJrYW0KFHYXVbsALY53opyi8J+9ITGyH5hJRLH",
+	"A5E5sQWSAJ3UySMtQBu0BG06pWdVjbf5bRPmIaSGvH0s0OUxJLYA65nr1EJWYEjOtLEh2LNj+XOY9/D3",
+	"M1j8yMqaR19rovxBaivgdbqV+ZjJCQFiXIUQqVfwYm3RJGQBBn98QVx+yDA7s2JfeuQvmQC99swXYIo4",
+	"8ynwtOJOecQBNQJ4YSrDxJJ8eH05eTYl71FpNCgcZD1Ri/DYrHMQyypa6X6zvwknQlGVIEgDN3LiKXEg",
+	"d8ArJ6sgCyQg1m6AZWEY0lTqzElkJXl5dUNe/PRYjm7Y/zBuh7rtah3EfXgbeL8v4TOSShFbMNP3re8W",
+	"mEuNA2diYpkQltcLmSGZFPj99O02+qAg8h3LSaU5UVresQyz77uMxITFJerjhP0kDFsKzK6Fpbdd0hsL",
+	"fUk4LJATqYnLLs5qeG+dCTJmFIc1YYIoDin2fdIZ5ulKRKX5NuOfNCOrAjV2gRIcK5eVyE7ynO3i0M/t",
+	"8frQb03mX7YTforKHtwBB4z1oq2+LWkKSK2NHCpufQ9XKo6+yUJRlU6cduj2ILETyjKHcx/oZ1yvpM72",
+	"WOz6Z49KQLmzhTiPPxgLtjJDJUBq2V1fBWHgUAW04fFYbG8S6nYoTGPm5oIMnUlvR67X96ux7zlqFpbG",
+	"IUIOxrLUC9EudG4/6TlfpG25CmDkKoBFWnTH+ZGu69fs23HEedwTXYGJuAi1PbYY95Z8lMrRBvoZsX/S",
+	"LJJ70ka6XZpprRsX/ywd4ZnzV6gpTxhnXhvbsfWQ1wkLqZ0oycTu+PEw5MbDjH2w85MmH6lCCp+fSmDc",
+	"cXRCeUqob5X+yO3SUDW7FNjtt+PKe9XObwUvB3NsrqmL1zHSH1zMSrRwEDce0G2j/XkeUyCGUTOUkomM",
+	"3bGsAn4Qco05ahQpdjF1ZFYeZttofh4bchy7/arbN6jiIARm5CsBrdmd/7KawdJ/MTFRWi41GkO+Eik4",
+	"wh2SryRngpnCg6QgUuTcf6NzCswmTExQa6nJV1KJz0KuRK+0B4o0oYEiTWigSBPao0gTGijShDYUaUJb",
+	"ih7pkCJNaEPx9tCeMV7YvSePUw/2PP+Ioo73FoWJ7s3rgGrnfWN+0/O25+MQC+30NxeskI0uBwckwPm7",
+	"nM5/PcAte831JpIPd9D+t6P5n4781SNV+yRK7VoYG0L7jAQbrHGa79voP06/vawRVW/HnkBjcV82HLMU",
+	"ysOT1aGRYkYh5xx6K856ARGvUf68YtLsViIx9doBkLcOYBxCg3A8PBF3yyL59akPQhNa1RcgTVqtjKtH",
+	"CZV5zlIGpzYp446hp+ddpmAZCstytrNfuO4AdndaZ+/IH0tDf4I2jvUVGjdK0/pEzPEL+gu//YfdjxEj",
+	"rix+UhlYzE6yuOsCkGf+EPnhdOw3fJhWmtn1efbBCfVkzoPqDrXT03X2jdXct7pjBylrE8ddo7kTi3vH",
+	"TZgdO0Q4kdlaVI+TunFyp4yqRbDDwAspOYIYidmMOrPtvHjjzFgSZoe0DjdJgzxikwXTtnAXFtuU/VR9",
+	"i3KIjKfelHjx97SLl1nG3Cfw5uj5ZD3sDdUliiyWz+vxHsk5KYH7LQGGD2kLjHf+DsAFMYYPDzns2A9S",
+	"p2IKORPocHK5Qp2CCYqNbBJraVADv9HOWZ0kqHf4VoAkqg96jFYjm71OqyzbJsuy0x3q4I3wvhLt5e4A",
+	"TnWnHolY6mfi8zbpN0x8dpcVIGqnCXTbBz71/Yvf92ph/FWBgbK9LFSojRR9LocpyyM9fYs+5NWNkhZu",
+	"TjT6e5VssliTr80/0/P2HgBNaAtw0ra0iRS/8vaA7f7RJx/xTtqNnuoQvc4x2i9zTGUZ0XM9cSrV4QnX",
+	"g0fZHYkjtvO6v6WLlNBuy/dHdFkD7p7wJUiImkc/Wdc97Y7iwMEykcvmtQakvsupD23nFBSzCOU/zAqW",
+	"S9RTJmnj+PRDPUYub67JR4SShotLWlir5rNZb80mGRn90mUlxdEv9imrMmgIEIXWWKmRgCEgCN7XYO56",
+	"FkspjNWun8gRbKXRuLtal+LeKRQO0w/TC2IUpixnafPujLMUw8u3wPilgrRA8nx6MWDZzGez1Wo1BT89",
+	"lXo5C2vN7M311au3H15Nnk8vpoUteR2PujTv8g+o71iKAclA7pkHmdHOHI3OboKYtO1q6Zw+m15MLxxm",
+	"qVCAYnROf/BDCVVgC+/os+HDOSWjDxfr54pXHajHqcGG9pneSGMH0+Ex4UuZrXsPd9wnKMWDNme/mzru",
+	"6tA6/DHh6MXiZlNn6/pNohfk+cXFY9KtKdXuPtTVu3/5FGeqsgS9DqoZqq7Jaj3V33pMBrWznj+dGrh+",
+	"48XTni84g25uN/8fAHO5uX3KKwAA",
 }
 
 // GetSwagger returns the Swagger specification corresponding to the generated code
@@ -1,12 +1,14 @@
 package elasticsearch
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/stretchr/testify/require"
 
 	"github.com/indigonote/oapi-codegen/pkg/codegen"
+	"github.com/indigonote/oapi-codegen/pkg/esquery"
 )
 
 const spec = `
@@ -131,3 +133,372 @@ var expect = `{
         }
     }
 }`
+
+func TestElasticSearchFields(t *testing.T) {
+	require.Equal(t, esquery.NestedPath("name"), FhirPatientFields.Name)
+	require.Equal(t, esquery.TextField("name.text"), FhirPatientFields.NameText)
+	require.Equal(t, esquery.KeywordField("name.text.keyword"), FhirPatientFields.NameTextKeyword)
+	require.Equal(t, esquery.KeywordField("gender"), FhirPatientFields.Gender)
+	require.Equal(t, esquery.DateField("birthDate"), FhirPatientFields.BirthDate)
+	// concept refers to itself, so its own concepts are mapped as a bare nested field
+	require.Equal(t, esquery.NestedPath("concept"), FhirCodeSystemFields.Concept)
+	require.Equal(t, esquery.TextField("concept.code"), FhirCodeSystemFields.ConceptCode)
+	require.Equal(t, esquery.NestedPath("concept.concept"), FhirCodeSystemFields.ConceptConcept)
+
+	query := esquery.Bool().
+		Filter(FhirPatientFields.Gender.Term("female")).
+		Must(FhirPatientFields.Name.Query(FhirPatientFields.NameText.Match("doe")))
+	buf, err := json.Marshal(query)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"bool": {
+		"filter": [{"term": {"gender": {"value": "female"}}}],
+		"must": [{"nested": {"path": "name", "query": {"match": {"name.text": {"query": "doe"}}}}}]
+	}}`, string(buf))
+}
+
+const recursiveSpec = `
+openapi: 3.0.2
+info:
+  version: '0.0.1'
+  title: example
+paths: {}
+components:
+  schemas:
+    fhir-code:
+      title: fhir-code
+      type: object
+      properties:
+        system:
+          $ref: '#/components/schemas/fhir-code-system'
+        reference:
+          $ref: '#/components/schemas/fhir-reference'
+      x-tags:
+        - elastic
+    fhir-code-system:
+      title: fhir-code-system
+      type: object
+      properties:
+        id:
+          type: string
+          x-es-tag:
+            - keyword
+    fhir-reference:
+      title: fhir-reference
+      type: object
+      properties:
+        reference:
+          type: string
+          x-es-tag:
+            - keyword
+        extension:
+          type: array
+          items:
+            $ref: '#/components/schemas/fhir-extension'
+    fhir-extension:
+      title: fhir-extension
+      type: object
+      properties:
+        url:
+          type: string
+          x-es-tag:
+            - keyword
+        valueReference:
+          $ref: '#/components/schemas/fhir-reference'
+`
+
+func TestElasticSearchRecursion(t *testing.T) {
+	tests := []struct {
+		name      string
+		recursion string
+		maxDepth  int
+		expected  string
+	}{
+		{"default", "", 0, `"valueReference": {"type": "nested"}`},
+		{"object", "object", 0, `"valueReference": {"type": "object"}`},
+		{"disabled", "disabled", 0, `"valueReference": {"type": "object", "enabled": false}`},
+		{"flattened", "flattened", 0, `"valueReference": {"type": "flattened"}`},
+		{"depth", "", 1, `"valueReference": {
+			"type": "nested",
+			"properties": {
+				"reference": {"type": "keyword"},
+				"extension": {
+					"type": "nested",
+					"properties": {
+						"url": {"type": "keyword"},
+						"valueReference": {"type": "nested"}
+					}
+				}
+			}
+		}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			swagger, err := openapi3.NewSwaggerLoader().LoadSwaggerFromData([]byte(recursiveSpec))
+			require.NoError(t, err)
+			if tt.recursion != "" {
+				reference := swagger.Components.Schemas["fhir-reference"].Value
+				reference.Extensions["x-es-recursion"] = json.RawMessage(`"` + tt.recursion + `"`)
+			}
+
+			opts := codegen.Options{
+				GenerateEsTemplate:  true,
+				EsMaxRecursionDepth: tt.maxDepth,
+				SkipPrune:           true,
+			}
+			_, esCode, err := codegen.Generate(swagger, "elasticsearch", opts)
+			require.NoError(t, err)
+
+			// fhir-code-system must not be mistaken for a reference to fhir-code,
+			// and the recursive fhir-reference must keep its own properties.
+			require.JSONEq(t, `{
+				"FhirCode": {
+					"mappings": {
+						"properties": {
+							"system": {
+								"type": "nested",
+								"properties": {"id": {"type": "keyword"}}
+							},
+							"reference": {
+								"type": "nested",
+								"properties": {
+									"reference": {"type": "keyword"},
+									"extension": {
+										"type": "nested",
+										"properties": {
+											"url": {"type": "keyword"},
+											`+tt.expected+`
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}`, esCode)
+		})
+	}
+}
+
+func TestElasticSearchInvalidRecursion(t *testing.T) {
+	swagger, err := openapi3.NewSwaggerLoader().LoadSwaggerFromData([]byte(recursiveSpec))
+	require.NoError(t, err)
+	reference := swagger.Components.Schemas["fhir-reference"].Value
+	reference.Extensions["x-es-recursion"] = json.RawMessage(`"keyword"`)
+
+	opts := codegen.Options{
+		GenerateEsTemplate: true,
+		SkipPrune:          true,
+	}
+	_, _, err = codegen.Generate(swagger, "elasticsearch", opts)
+	require.Error(t, err)
+}
+
+const dynamicSpec = `
+openapi: 3.0.2
+info:
+  version: '0.0.1'
+  title: example
+paths: {}
+components:
+  schemas:
+    fhir-resource:
+      title: fhir-resource
+      type: object
+      x-es-dynamic: strict
+      properties:
+        id:
+          type: string
+          x-es-tag:
+            - keyword
+        labels:
+          type: object
+          additionalProperties:
+            type: string
+        notes:
+          type: object
+          x-es-dynamic: false
+          additionalProperties:
+            type: string
+            x-es-tag:
+              - text
+        meta:
+          type: object
+          x-es-dynamic: runtime
+          properties:
+            source:
+              type: string
+              x-es-tag:
+                - keyword
+        tags:
+          type: object
+          additionalProperties:
+            type: string
+          x-es-tag:
+            - flattened
+      additionalProperties:
+        type: integer
+      x-tags:
+        - elastic
+`
+
+func TestElasticSearchDynamicTemplates(t *testing.T) {
+	swagger, err := openapi3.NewSwaggerLoader().LoadSwaggerFromData([]byte(dynamicSpec))
+	require.NoError(t, err)
+
+	opts := codegen.Options{
+		GenerateEsTemplate: true,
+		SkipPrune:          true,
+	}
+	_, esCode, err := codegen.Generate(swagger, "elasticsearch", opts)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"FhirResource": {
+			"mappings": {
+				"dynamic": "strict",
+				"dynamic_templates": [
+					{"labels.*": {"path_match": "labels.*", "mapping": {"type": "keyword"}}},
+					{"notes.*": {
+						"path_match": "notes.*",
+						"mapping": {"type": "text", "fields": {"keyword": {"type": "keyword", "ignore_above": 256}}}
+					}},
+					{"*": {"path_match": "*", "path_unmatch": "*.*", "mapping": {"type": "long"}}}
+				],
+				"properties": {
+					"id": {"type": "keyword"},
+					"labels": {"type": "object"},
+					"meta": {
+						"dynamic": "runtime",
+						"properties": {"source": {"type": "keyword"}}
+					},
+					"notes": {"dynamic": false},
+					"tags": {"type": "flattened"}
+				}
+			}
+		}
+	}`, esCode)
+}
+
+func TestElasticSearchInvalidDynamic(t *testing.T) {
+	swagger, err := openapi3.NewSwaggerLoader().LoadSwaggerFromData([]byte(dynamicSpec))
+	require.NoError(t, err)
+	resource := swagger.Components.Schemas["fhir-resource"].Value
+	resource.Extensions["x-es-dynamic"] = json.RawMessage(`"sometimes"`)
+
+	opts := codegen.Options{
+		GenerateEsTemplate: true,
+		SkipPrune:          true,
+	}
+	_, _, err = codegen.Generate(swagger, "elasticsearch", opts)
+	require.Error(t, err)
+}
+
+const pipelineSpec = `
+openapi: 3.0.2
+info:
+  version: '0.0.1'
+  title: example
+paths: {}
+components:
+  schemas:
+    fhir-patient:
+      title: fhir-patient
+      type: object
+      properties:
+        status:
+          type: string
+          x-es-pipeline:
+            - set:
+                value: active
+                override: false
+        ssn:
+          type: string
+          x-es-pipeline:
+            - remove
+        birthDate:
+          type: string
+          x-es-tag:
+            - date
+          x-es-pipeline:
+            - date:
+                formats:
+                  - yyyy-MM-dd
+                target_field: birthDate
+        identifier:
+          type: array
+          items:
+            $ref: '#/components/schemas/fhir-identifier'
+      x-tags:
+        - elastic
+    fhir-identifier:
+      title: fhir-identifier
+      type: object
+      properties:
+        value:
+          type: string
+          x-es-tag:
+            - keyword
+          x-es-pipeline:
+            - trim
+            - lowercase
+        period:
+          type: array
+          items:
+            type: object
+            properties:
+              start:
+                type: string
+                x-es-pipeline:
+                  - uppercase
+`
+
+func TestElasticSearchPipelines(t *testing.T) {
+	swagger, err := openapi3.NewSwaggerLoader().LoadSwaggerFromData([]byte(pipelineSpec))
+	require.NoError(t, err)
+
+	opts := codegen.Options{
+		GenerateEsTemplate: true,
+		SkipPrune:          true,
+	}
+	pipelines, err := codegen.GenerateEsPipelines(swagger, opts)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"FhirPatient": {
+			"description": "Ingest pipeline for fhir-patient",
+			"processors": [
+				{"date": {"field": "birthDate", "formats": ["yyyy-MM-dd"], "target_field": "birthDate"}},
+				{"foreach": {
+					"field": "identifier",
+					"processor": {"foreach": {
+						"field": "_ingest._value.period",
+						"processor": {"uppercase": {"field": "_ingest._value.start"}}
+					}}
+				}},
+				{"foreach": {
+					"field": "identifier",
+					"processor": {"trim": {"field": "_ingest._value.value"}}
+				}},
+				{"foreach": {
+					"field": "identifier",
+					"processor": {"lowercase": {"field": "_ingest._value.value"}}
+				}},
+				{"remove": {"field": "ssn"}},
+				{"set": {"field": "status", "value": "active", "override": false}}
+			]
+		}
+	}`, pipelines)
+}
+
+func TestElasticSearchInvalidPipeline(t *testing.T) {
+	swagger, err := openapi3.NewSwaggerLoader().LoadSwaggerFromData([]byte(pipelineSpec))
+	require.NoError(t, err)
+	status := swagger.Components.Schemas["fhir-patient"].Value.Properties["status"].Value
+	status.Extensions["x-es-pipeline"] = json.RawMessage(`[{"trim": {}, "lowercase": {}}]`)
+
+	opts := codegen.Options{
+		GenerateEsTemplate: true,
+		SkipPrune:          true,
+	}
+	_, err = codegen.GenerateEsPipelines(swagger, opts)
+	require.Error(t, err)
+}
@@ -11,12 +11,18 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
 	"github.com/labstack/echo/v4"
 	"github.com/pkg/errors"
+	"io"
 	"io/ioutil"
+	"math/rand"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 )
 
 // ArrayValue defines model for ArrayValue.
@@ -38,6 +44,42 @@ type Value struct {
 	StringValue *string     `json:"stringValue,omitempty"`
 }
 
+// ExampleGetURL returns the URL of ExampleGet on server, with its parameters
+// serialized as the client serializes them.
+func ExampleGetURL(server string) (*url.URL, error) {
+	var err error
+
+	queryUrl, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	basePath := fmt.Sprintf("/example")
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
+	}
+
+	queryUrl, err = queryUrl.Parse(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return queryUrl, nil
+}
+
+// OperationRoute describes an operation of the API, whatever the router serving
+// it.
+type OperationRoute struct {
+	Method      string // The HTTP method, eg. GET
+	Path        string // The path template of the spec, eg. /pets/{id}
+	OperationId string // The operation ID, as the names of the generated code use it
+}
+
+// OperationRoutes lists the operations of the API, in the order of their paths.
+var OperationRoutes = []OperationRoute{
+	{Method: "GET", Path: "/example", OperationId: "ExampleGet"},
+}
+
 // Getter for additional properties for Document_Fields. Returns the specified
 // element and whether it was found
 func (a Document_Fields) Get(fieldName string) (value Value, found bool) {
@@ -94,6 +136,14 @@ func (a Document_Fields) MarshalJSON() ([]byte, error) {
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
+// ResponseEditorFn is the function signature for the ResponseEditor callback
+// function, which may inspect or replace the response before it is parsed
+type ResponseEditorFn func(ctx context.Context, rsp *http.Response) error
+
+// RequestValidatorFn is the function signature for the callback validating
+// requests before they are sent, given the server they were built against
+type RequestValidatorFn func(ctx context.Context, server string, req *http.Request) error
+
 // Doer performs HTTP requests.
 //
 // The standard http.Client implements this interface.
@@ -111,9 +161,31 @@ type Client struct {
 	// customized settings, such as certificate chains.
 	Client HttpRequestDoer
 
+	// A list of callbacks for modifying requests which are generated before
+	// sending over the network, in the order they are run.
+	RequestEditors []RequestEditorFn
+
 	// A callback for modifying requests which are generated before sending over
-	// the network.
+	// the network, which runs before RequestEditors.
+	//
+	// Deprecated: use RequestEditors, or WithRequestEditorFn.
 	RequestEditor RequestEditorFn
+
+	// A list of callbacks for inspecting responses before they are returned,
+	// in the order they are run.
+	ResponseEditors []ResponseEditorFn
+
+	// A callback validating requests once the request editors ran, requests
+	// failing it aren't sent. See WithRequestValidation.
+	RequestValidator RequestValidatorFn
+
+	// How failed requests are retried, they aren't when nil.
+	RetryPolicy *RetryPolicy
+
+	// The servers of the operations which override the servers of the API,
+	// keyed by operation ID, when they aren't the first of their servers. See
+	// WithOperationServer.
+	OperationServers map[string]string
 }
 
 // ClientOption allows setting custom parameters during construction
@@ -153,59 +225,446 @@ func WithHTTPClient(doer HttpRequestDoer) ClientOption {
 
 // WithRequestEditorFn allows setting up a callback function, which will be
 // called right before sending the request. This can be used to mutate the request.
+// It may be given more than once, the callbacks are called in the same order.
 func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 	return func(c *Client) error {
-		c.RequestEditor = fn
+		c.RequestEditors = append(c.RequestEditors, fn)
 		return nil
 	}
 }
 
+// WithResponseEditorFn allows setting up a callback function, which will be
+// called with every response, before it is returned or parsed. It may be
+// given more than once, the callbacks are called in the same order.
+func WithResponseEditorFn(fn ResponseEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.ResponseEditors = append(c.ResponseEditors, fn)
+		return nil
+	}
+}
+
+// applyEditors runs the request editors of the client, followed by those
+// given for this call only.
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	if c.RequestEditor != nil {
+		if err := c.RequestEditor(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RetryPolicy describes how the client retries requests which failed with a
+// network error, a 5xx or a 429 response.
+type RetryPolicy struct {
+	// The number of retries after the first attempt.
+	MaxRetries int
+
+	// The backoff before the first retry, it doubles for each of the
+	// following ones, with some jitter so that clients don't retry in lockstep.
+	MinBackoff time.Duration
+
+	// The upper bound of any backoff, including those asked for by the server
+	// through a Retry-After header.
+	MaxBackoff time.Duration
+
+	// Whether to retry operations which aren't idempotent. Operations are
+	// idempotent when their method is, or when marked with x-idempotent.
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryPolicy returns a policy retrying idempotent operations three
+// times, backing off from 100ms up to 10s.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries: 3,
+		MinBackoff: 100 * time.Millisecond,
+		MaxBackoff: 10 * time.Second,
+	}
+}
+
+// WithRetryPolicy allows retrying failed requests. Request bodies are buffered
+// so that they can be sent again.
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(c *Client) error {
+		c.RetryPolicy = &policy
+		return nil
+	}
+}
+
+// retryJitter randomizes backoffs, it isn't safe for concurrent use.
+var (
+	retryJitter   = rand.New(rand.NewSource(time.Now().UnixNano()))
+	retryJitterMu sync.Mutex
+)
+
+// do sends the request, and runs the response editors of the client on its
+// response.
+func (c *Client) do(req *http.Request, idempotent bool) (*http.Response, error) {
+	rsp, err := c.send(req, idempotent)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range c.ResponseEditors {
+		if err := r(req.Context(), rsp); err != nil {
+			rsp.Body.Close()
+			return nil, err
+		}
+	}
+	return rsp, nil
+}
+
+// send sends the request, retrying it according to the retry policy of the
+// client.
+func (c *Client) send(req *http.Request, idempotent bool) (*http.Response, error) {
+	policy := c.RetryPolicy
+	if policy == nil || policy.MaxRetries <= 0 || !(idempotent || policy.RetryNonIdempotent) {
+		return c.Client.Do(req)
+	}
+
+	// The body is consumed by each attempt, so we need a fresh one for every
+	// retry.
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		buf, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.GetBody = func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(bytes.NewReader(buf)), nil
+		}
+		req.Body, _ = req.GetBody()
+	}
+
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+		rsp, err := c.Client.Do(req)
+		if attempt >= policy.MaxRetries || !shouldRetry(req, rsp, err) {
+			return rsp, err
+		}
+
+		backoff := policy.backoff(attempt, rsp)
+		if rsp != nil {
+			// Drain the body, so that the connection can be reused.
+			io.Copy(ioutil.Discard, rsp.Body)
+			rsp.Body.Close()
+		}
+		timer := time.NewTimer(backoff)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func shouldRetry(req *http.Request, rsp *http.Response, err error) bool {
+	if err != nil {
+		// Nothing to retry once the caller gave up.
+		return req.Context().Err() == nil
+	}
+	return rsp.StatusCode == http.StatusTooManyRequests || rsp.StatusCode >= 500
+}
+
+// backoff returns how long to wait before the given retry, preferring the
+// delay asked for by the Retry-After header of the response.
+func (p *RetryPolicy) backoff(attempt int, rsp *http.Response) time.Duration {
+	if rsp != nil {
+		if after, ok := retryAfter(rsp.Header.Get("Retry-After")); ok {
+			if p.MaxBackoff > 0 && after > p.MaxBackoff {
+				return p.MaxBackoff
+			}
+			return after
+		}
+	}
+
+	backoff := p.MinBackoff << uint(attempt)
+	if backoff <= 0 || (p.MaxBackoff > 0 && backoff > p.MaxBackoff) {
+		backoff = p.MaxBackoff
+	}
+	if backoff <= 0 {
+		return 0
+	}
+	// Wait somewhere between half and all of the backoff.
+	retryJitterMu.Lock()
+	jitter := time.Duration(retryJitter.Int63n(int64(backoff)/2 + 1))
+	retryJitterMu.Unlock()
+	return backoff/2 + jitter
+}
+
+// retryAfter parses a Retry-After header, which either holds a number of
+// seconds or a date.
+func retryAfter(header string) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(header); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(header); err == nil {
+		after := time.Until(date)
+		if after < 0 {
+			after = 0
+		}
+		return after, true
+	}
+	return 0, false
+}
+
 // The interface specification for the client above.
 type ClientInterface interface {
 	// ExampleGet request
-	ExampleGet(ctx context.Context) (*http.Response, error)
+	ExampleGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
-func (c *Client) ExampleGet(ctx context.Context) (*http.Response, error) {
-	req, err := NewExampleGetRequest(c.Server)
+func (c *Client) ExampleGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server := c.Server
+	req, err := NewExampleGetRequest(server)
 	if err != nil {
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if c.RequestEditor != nil {
-		err = c.RequestEditor(ctx, req)
-		if err != nil {
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
 			return nil, err
 		}
 	}
-	return c.Client.Do(req)
+	return c.do(req, true)
 }
 
 // NewExampleGetRequest generates requests for ExampleGet
 func NewExampleGetRequest(server string) (*http.Request, error) {
-	var err error
+	queryUrl, err := ExampleGetURL(server)
+	if err != nil {
+		return nil, err
+	}
 
-	queryUrl, err := url.Parse(server)
+	req, err := http.NewRequest("GET", queryUrl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	basePath := fmt.Sprintf("/example")
-	if basePath[0] == '/' {
-		basePath = basePath[1:]
+	return req, nil
+}
+
+// serverDefinition is a server of the API, whose URL may hold variables.
+type serverDefinition struct {
+	url       string
+	variables []serverVariable
+}
+
+// serverVariable is a variable of the URL of a server.
+type serverVariable struct {
+	name         string
+	defaultValue string
+	enum         []string
+}
+
+// ServerVariables holds the values of the variables of the URL of a server. It
+// is one of the types generated for the servers with variables, such as those
+// given to WithServer.
+type ServerVariables interface {
+	// serverURL returns the URL of the server whose variables these are.
+	serverURL() string
+	// values returns the values of the variables, by name.
+	values() map[string]string
+}
+
+// resolve replaces the variables in the URL of the server with their values,
+// or their defaults, checking them against their enum. vars must be those of
+// the server, or nil.
+func (s serverDefinition) resolve(vars ServerVariables) (string, error) {
+	var values map[string]string
+	if vars != nil {
+		if vars.serverURL() != s.url {
+			return "", fmt.Errorf("variables of server %s given for server %s", vars.serverURL(), s.url)
+		}
+		values = vars.values()
 	}
 
-	queryUrl, err = queryUrl.Parse(basePath)
-	if err != nil {
-		return nil, err
+	serverURL := s.url
+	for _, v := range s.variables {
+		value := values[v.name]
+		if value == "" {
+			value = v.defaultValue
+		}
+		if value == "" {
+			return "", fmt.Errorf("missing value for variable %s of server %s", v.name, s.url)
+		}
+		valid := len(v.enum) == 0
+		for _, e := range v.enum {
+			valid = valid || value == e
+		}
+		if !valid {
+			return "", fmt.Errorf("invalid value %q for variable %s of server %s, must be one of: %s", value, v.name, s.url, strings.Join(v.enum, ", "))
+		}
+		serverURL = strings.Replace(serverURL, "{"+v.name+"}", value, -1)
 	}
+	return serverURL, nil
+}
 
-	req, err := http.NewRequest("GET", queryUrl.String(), nil)
+// servers lists the servers of the API, in the order of the spec.
+var servers = []serverDefinition{}
+
+// operationServers lists the servers of the operations which override those
+// of the API.
+var operationServers = map[string][]serverDefinition{}
+
+// WithServer sets the server of the client to one of the servers of the API,
+// by its index in the spec. Its variables take the given values, which must
+// be the variables of that server, such as a ServerProductionVariables for a
+// production server. They are checked against their enum, and take their
+// default when empty, or when vars is nil.
+func WithServer(index int, vars ServerVariables) ClientOption {
+	return func(c *Client) error {
+		if index < 0 || index >= len(servers) {
+			return fmt.Errorf("no server at index %d", index)
+		}
+		server, err := servers[index].resolve(vars)
+		if err != nil {
+			return err
+		}
+		c.Server = server
+		return nil
+	}
+}
+
+// WithOperationServer sets the server of an operation which overrides the
+// servers of the API to another of its servers, by its index in the spec. Its
+// variables are handled as by WithServer, and are of the types named after the
+// operation, such as GetPetServer0Variables.
+func WithOperationServer(operationID string, index int, vars ServerVariables) ClientOption {
+	return func(c *Client) error {
+		defs := operationServers[operationID]
+		if index < 0 || index >= len(defs) {
+			return fmt.Errorf("operation %s has no server at index %d", operationID, index)
+		}
+		server, err := defs[index].resolve(vars)
+		if err != nil {
+			return err
+		}
+		if c.OperationServers == nil {
+			c.OperationServers = map[string]string{}
+		}
+		c.OperationServers[operationID] = server
+		return nil
+	}
+}
+
+// operationServer returns the server of an operation which overrides the
+// servers of the API, which is its first one unless set otherwise.
+func (c *Client) operationServer(operationID string) (string, error) {
+	server, found := c.OperationServers[operationID]
+	if !found {
+		var err error
+		server, err = operationServers[operationID][0].resolve(nil)
+		if err != nil {
+			return "", err
+		}
+	}
+	if !strings.HasSuffix(server, "/") {
+		server += "/"
+	}
+	return server, nil
+}
+
+// RequestValidationError is returned by the client for a request which
+// doesn't conform to the spec, it isn't sent.
+type RequestValidationError struct {
+	Method string
+	URL    string
+	// Why the request is invalid, usually an *openapi3filter.RequestError,
+	// or an *openapi3filter.RouteError when it matches no operation.
+	Err error
+}
+
+func (e *RequestValidationError) Error() string {
+	return fmt.Sprintf("invalid request %s %s: %s", e.Method, e.URL, e.Err)
+}
+
+// Cause returns why the request is invalid.
+func (e *RequestValidationError) Cause() error {
+	return e.Err
+}
+
+// Unwrap returns why the request is invalid.
+func (e *RequestValidationError) Unwrap() error {
+	return e.Err
+}
+
+// WithRequestValidation validates the parameters and bodies of requests
+// against the given spec, usually the one returned by GetSwagger, before they
+// are sent. Requests are validated once the request editors ran, so security
+// requirements are assumed to be met.
+func WithRequestValidation(swagger *openapi3.Swagger) ClientOption {
+	return func(c *Client) error {
+		// Requests are matched against their path relative to the server of
+		// the client, which may be none of the servers of the spec.
+		spec := *swagger
+		spec.Servers = nil
+		router := openapi3filter.NewRouter()
+		if err := router.AddSwagger(&spec); err != nil {
+			return fmt.Errorf("error loading spec for request validation: %s", err)
+		}
+		c.RequestValidator = func(ctx context.Context, server string, req *http.Request) error {
+			return validateRequest(ctx, router, server, req)
+		}
+		return nil
+	}
+}
+
+// validateRequest validates a request built against the given server.
+func validateRequest(ctx context.Context, router *openapi3filter.Router, server string, req *http.Request) error {
+	serverURL, err := url.Parse(server)
 	if err != nil {
-		return nil, err
+		return err
+	}
+	invalid := func(err error) error {
+		return &RequestValidationError{Method: req.Method, URL: req.URL.String(), Err: err}
 	}
 
-	return req, nil
+	path := strings.TrimPrefix(req.URL.Path, strings.TrimSuffix(serverURL.Path, "/"))
+	route, pathParams, err := router.FindRoute(req.Method, &url.URL{Path: path})
+	if err != nil {
+		return invalid(err)
+	}
+	input := &openapi3filter.RequestValidationInput{
+		Request:    req,
+		PathParams: pathParams,
+		Route:      route,
+		Options: &openapi3filter.Options{
+			AuthenticationFunc: func(context.Context, *openapi3filter.AuthenticationInput) error {
+				return nil
+			},
+		},
+	}
+	if err := openapi3filter.ValidateRequest(ctx, input); err != nil {
+		return invalid(err)
+	}
+	return nil
 }
 
 // ClientWithResponses builds on ClientInterface to offer response payloads
@@ -238,7 +697,7 @@ func WithBaseURL(baseURL string) ClientOption {
 // ClientWithResponsesInterface is the interface specification for the client with responses above.
 type ClientWithResponsesInterface interface {
 	// ExampleGet request
-	ExampleGetWithResponse(ctx context.Context) (*ExampleGetResponse, error)
+	ExampleGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ExampleGetResponse, error)
 }
 
 type ExampleGetResponse struct {
@@ -264,8 +723,8 @@ func (r ExampleGetResponse) StatusCode() int {
 }
 
 // ExampleGetWithResponse request returning *ExampleGetResponse
-func (c *ClientWithResponses) ExampleGetWithResponse(ctx context.Context) (*ExampleGetResponse, error) {
-	rsp, err := c.ExampleGet(ctx)
+func (c *ClientWithResponses) ExampleGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ExampleGetResponse, error) {
+	rsp, err := c.ExampleGet(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
@@ -298,6 +757,51 @@ func ParseExampleGetResponse(rsp *http.Response) (*ExampleGetResponse, error) {
 	return response, nil
 }
 
+// APIError is an error response from the server, with the payload of the
+// default response of the operation when the API documents one.
+type APIError struct {
+	Operation    string
+	StatusCode   int
+	Body         []byte
+	HTTPResponse *http.Response
+	// The decoded default response, eg. *Error, or nil
+	Model interface{}
+}
+
+// Error describes the response.
+func (e *APIError) Error() string {
+	return fmt.Sprintf("%s returned %d %s", e.Operation, e.StatusCode, http.StatusText(e.StatusCode))
+}
+
+// ExampleGetOrError calls ExampleGetWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) ExampleGetOrError(ctx context.Context, reqEditors ...RequestEditorFn) (*Document, error) {
+	rsp, err := c.ExampleGetWithResponse(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return exampleGetOrError(rsp)
+}
+
+// exampleGetOrError returns the payload of a success response to
+// ExampleGet, or an error for any other response.
+func exampleGetOrError(rsp *ExampleGetResponse) (*Document, error) {
+	if rsp.JSON200 != nil {
+		return rsp.JSON200, nil
+	}
+	if rsp.StatusCode() >= 200 && rsp.StatusCode() < 300 {
+		return nil, nil
+	}
+
+	apiErr := APIError{
+		Operation:    "ExampleGet",
+		StatusCode:   rsp.StatusCode(),
+		Body:         rsp.Body,
+		HTTPResponse: rsp.HTTPResponse,
+	}
+	return nil, &apiErr
+}
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 
@@ -308,6 +812,20 @@ type ServerInterface interface {
 // ServerInterfaceWrapper converts echo contexts to parameters.
 type ServerInterfaceWrapper struct {
 	Handler ServerInterface
+
+	// ErrorHandlerFunc answers requests whose parameters can't be bound, with
+	// their InvalidParamFormatError, RequiredParamError, UnmarshalingParamError
+	// or TooManyValuesForParamError, of the runtime package. They are answered
+	// with a 400 echo.HTTPError if it is nil.
+	ErrorHandlerFunc func(ctx echo.Context, err error) error
+}
+
+// handleError answers a request whose parameters can't be bound.
+func (w *ServerInterfaceWrapper) handleError(ctx echo.Context, err error) error {
+	if w.ErrorHandlerFunc != nil {
+		return w.ErrorHandlerFunc(ctx, err)
+	}
+	return echo.NewHTTPError(http.StatusBadRequest, err.Error())
 }
 
 // ExampleGet converts echo context to params.
@@ -334,26 +852,109 @@ type EchoRouter interface {
 	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
 }
 
+// RegisterOptions configures the middlewares and the error handling of the
+// routes added by RegisterHandlersWithOptions.
+type RegisterOptions struct {
+	// The middlewares of operations, by operation ID.
+	OperationMiddlewares map[string][]echo.MiddlewareFunc
+
+	// The middlewares of the operations with a tag, by tag.
+	TagMiddlewares map[string][]echo.MiddlewareFunc
+
+	// The ErrorHandlerFunc of the ServerInterfaceWrapper.
+	ErrorHandlerFunc func(ctx echo.Context, err error) error
+}
+
 // RegisterHandlers adds each server route to the EchoRouter.
 func RegisterHandlers(router EchoRouter, si ServerInterface) {
+	RegisterHandlersWithOptions(router, si, RegisterOptions{})
+}
+
+// RegisterHandlersWithOptions adds each server route to the EchoRouter, with
+// the middlewares of the tags of its operation, in the order of the tags, and
+// then those of the operation. The OperationInfo of the operation is put into
+// the context of the request before any of them runs.
+func RegisterHandlersWithOptions(router EchoRouter, si ServerInterface, opts RegisterOptions) {
 
 	wrapper := ServerInterfaceWrapper{
-		Handler: si,
+		Handler:          si,
+		ErrorHandlerFunc: opts.ErrorHandlerFunc,
 	}
 
-	router.GET("/example", wrapper.ExampleGet)
+	router.GET("/example", wrapper.ExampleGet, opts.middlewares("ExampleGet")...)
+
+}
+
+// middlewares returns the middlewares of the route of an operation.
+func (opts RegisterOptions) middlewares(operationId string) []echo.MiddlewareFunc {
+	info := Operations[operationId]
+	middlewares := []echo.MiddlewareFunc{
+		func(next echo.HandlerFunc) echo.HandlerFunc {
+			return func(ctx echo.Context) error {
+				r := ctx.Request()
+				ctx.SetRequest(r.WithContext(ContextWithOperationInfo(r.Context(), info)))
+				return next(ctx)
+			}
+		},
+	}
+	for _, tag := range info.Tags {
+		middlewares = append(middlewares, opts.TagMiddlewares[tag]...)
+	}
+	return append(middlewares, opts.OperationMiddlewares[operationId]...)
+}
+
+// OperationInfo describes an operation of the API, for the middlewares serving
+// it, which find it in the context of its requests.
+type OperationInfo struct {
+	OperationId string                     // The operation ID, as the names of the generated code use it
+	Method      string                     // The HTTP method, eg. GET
+	Path        string                     // The path template of the spec, eg. /pets/{id}
+	Tags        []string                   // The tags of the operation
+	Security    []OperationSecurity        // The security providers of the operation
+	Extensions  map[string]json.RawMessage // The x- extensions of the operation, by name
+}
+
+// OperationSecurity is a security provider of an operation, and the scopes it
+// requires.
+type OperationSecurity struct {
+	ProviderName string
+	Scopes       []string
+}
+
+// Operations holds the OperationInfo of every operation, by operation ID.
+var Operations = map[string]OperationInfo{
+	"ExampleGet": {
+		OperationId: "ExampleGet",
+		Method:      "GET",
+		Path:        "/example",
+	},
+}
+
+// operationInfoKey is the context key of the OperationInfo of a request.
+type operationInfoKey struct{}
+
+// ContextWithOperationInfo returns a copy of ctx holding info, which
+// OperationInfoFromContext returns.
+func ContextWithOperationInfo(ctx context.Context, info OperationInfo) context.Context {
+	return context.WithValue(ctx, operationInfoKey{}, info)
+}
 
+// OperationInfoFromContext returns the OperationInfo of the operation serving
+// a request, from its context, and whether there's one.
+func OperationInfoFromContext(ctx context.Context) (OperationInfo, bool) {
+	info, ok := ctx.Value(operationInfoKey{}).(OperationInfo)
+	return info, ok
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/5RSQU/zMAz9K5W/71i1ZdxyQwIhhBCcOHExibdmpEmUuBPT1P+OnG5jEwjEqcmr3/Pz",
-	"i3egwxCDJ88Z1A6y7mnAcrxKCbfP6EaSm2UaCvw/0RIU/Gs/ie2e1c7VUw28jQQKUCTkfh30OJBnEYgp",
-	"REpsqcgtLTlTTmiMZRs8uqezir80DK9r0gzTV6SG4yjnBvBszJ+anQQy1ZA5Wb86EvftZvQ7AwJZvwxS",
+	"H4sIAAAAAAAC/5RSzU7zMBB8lWi/7xglodx8QwIhhBCcOHFZ7G3j4tiWvamoqrw7Wqf0RyAQp9iTndnZ",
+	"8e5AhyEGT54zqB1k3dOA5XiVEm6f0Y0kN8s0FPh/oiUo+Nceie2e1c7VUw28jQQKUCTkfh30OJBnEYgp",
+	"REpsqcgtLTlTTmiMZRs8uqezir80DK9r0gzTV6SGwyjnBvBszJ+anQQy1ZA5Wb86EPftZvQ7AwJZvwxS",
 	"bChnnWyUcUHBA75RlcdEFffIVSI9pmw3VIlErjBR1aM3jkw1e3fbFw81sGUnLegdh+gIathQyrNm13TN",
-	"hfgMkTxGCwoum65ZQA0RuS+jtwei2sGKyuOIOoqtOwMKbub/t8RQQ6Icg89zaouuk48OnvfPijE6qwu3",
-	"XWfxcNim33I9LkfJyNBpNI/3gk7T9BEAAP//t/QkwqkCAAA=",
+	"hfgMkTxGCwoum65ZQA0RuS+jt59EtYMVlccRdRRbdwYU3Mz/b4mhhkQ5Bp/n1BZdJx8dPO+fFWN0Vhdu",
+	"u87BH7fpt1wPy1EyMnQazeO9oNM0fQwAt/QkwqkCAAA=",
 }
 
 // GetSwagger returns the Swagger specification corresponding to the generated code
@@ -0,0 +1,3 @@
+package mock
+
+//go:generate go run github.com/indigonote/oapi-codegen/cmd/oapi-codegen --generate=types,client,mock-server,spec --package=mock -o mock.gen.go mock.yaml
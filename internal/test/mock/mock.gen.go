@@ -0,0 +1,1538 @@
+// Package mock provides primitives to interact the openapi HTTP API.
+//
+// Code generated by github.com/indigonote/oapi-codegen DO NOT EDIT.
+package mock
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/indigonote/oapi-codegen/pkg/middleware"
+	"github.com/indigonote/oapi-codegen/pkg/runtime"
+	openapi_types "github.com/indigonote/oapi-codegen/pkg/types"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Error defines model for Error.
+type Error struct {
+	Message string `json:"message"`
+}
+
+// NewPet defines model for NewPet.
+type NewPet struct {
+	Name string  `json:"name" validate:"min=1"`
+	Tag  *string `json:"tag,omitempty"`
+}
+
+// Pet defines model for Pet.
+type Pet struct {
+	// Embedded struct due to allOf(#/components/schemas/NewPet)
+	NewPet
+	// Embedded fields due to inline allOf schema
+	Born   *openapi_types.Date `json:"born,omitempty"`
+	Id     int64               `json:"id" validate:"min=1"`
+	Parent *Pet                `json:"parent,omitempty"`
+}
+
+// ListPetsParams defines parameters for ListPets.
+type ListPetsParams struct {
+	Limit *int `json:"limit,omitempty"`
+}
+
+// AddPetJSONBody defines parameters for AddPet.
+type AddPetJSONBody NewPet
+
+// AddPetRequestBody defines body for AddPet for application/json ContentType.
+type AddPetJSONRequestBody AddPetJSONBody
+
+// ListPetsURL returns the URL of ListPets on server, with its parameters
+// serialized as the client serializes them.
+func ListPetsURL(server string, params *ListPetsParams) (*url.URL, error) {
+	var err error
+
+	queryUrl, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	basePath := fmt.Sprintf("/pets")
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
+	}
+
+	queryUrl, err = queryUrl.Parse(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryUrl.Query()
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParam("form", true, "limit", *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryUrl.RawQuery = queryValues.Encode()
+
+	return queryUrl, nil
+}
+
+// AddPetURL returns the URL of AddPet on server, with its parameters
+// serialized as the client serializes them.
+func AddPetURL(server string) (*url.URL, error) {
+	var err error
+
+	queryUrl, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	basePath := fmt.Sprintf("/pets")
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
+	}
+
+	queryUrl, err = queryUrl.Parse(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return queryUrl, nil
+}
+
+// DeletePetURL returns the URL of DeletePet on server, with its parameters
+// serialized as the client serializes them.
+func DeletePetURL(server string, id int) (*url.URL, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParam("simple", false, "id", id)
+	if err != nil {
+		return nil, err
+	}
+
+	queryUrl, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	basePath := fmt.Sprintf("/pets/%s", pathParam0)
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
+	}
+
+	queryUrl, err = queryUrl.Parse(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return queryUrl, nil
+}
+
+// GetPetURL returns the URL of GetPet on server, with its parameters
+// serialized as the client serializes them.
+func GetPetURL(server string, id int) (*url.URL, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParam("simple", false, "id", id)
+	if err != nil {
+		return nil, err
+	}
+
+	queryUrl, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	basePath := fmt.Sprintf("/pets/%s", pathParam0)
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
+	}
+
+	queryUrl, err = queryUrl.Parse(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return queryUrl, nil
+}
+
+// OperationRoute describes an operation of the API, whatever the router serving
+// it.
+type OperationRoute struct {
+	Method      string // The HTTP method, eg. GET
+	Path        string // The path template of the spec, eg. /pets/{id}
+	OperationId string // The operation ID, as the names of the generated code use it
+}
+
+// OperationRoutes lists the operations of the API, in the order of their paths.
+var OperationRoutes = []OperationRoute{
+	{Method: "GET", Path: "/pets", OperationId: "ListPets"},
+	{Method: "POST", Path: "/pets", OperationId: "AddPet"},
+	{Method: "DELETE", Path: "/pets/{id}", OperationId: "DeletePet"},
+	{Method: "GET", Path: "/pets/{id}", OperationId: "GetPet"},
+}
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// ResponseEditorFn is the function signature for the ResponseEditor callback
+// function, which may inspect or replace the response before it is parsed
+type ResponseEditorFn func(ctx context.Context, rsp *http.Response) error
+
+// RequestValidatorFn is the function signature for the callback validating
+// requests before they are sent, given the server they were built against
+type RequestValidatorFn func(ctx context.Context, server string, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before
+	// sending over the network, in the order they are run.
+	RequestEditors []RequestEditorFn
+
+	// A callback for modifying requests which are generated before sending over
+	// the network, which runs before RequestEditors.
+	//
+	// Deprecated: use RequestEditors, or WithRequestEditorFn.
+	RequestEditor RequestEditorFn
+
+	// A list of callbacks for inspecting responses before they are returned,
+	// in the order they are run.
+	ResponseEditors []ResponseEditorFn
+
+	// A callback validating requests once the request editors ran, requests
+	// failing it aren't sent. See WithRequestValidation.
+	RequestValidator RequestValidatorFn
+
+	// How failed requests are retried, they aren't when nil.
+	RetryPolicy *RetryPolicy
+
+	// The servers of the operations which override the servers of the API,
+	// keyed by operation ID, when they aren't the first of their servers. See
+	// WithOperationServer.
+	OperationServers map[string]string
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = http.DefaultClient
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+// It may be given more than once, the callbacks are called in the same order.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// WithResponseEditorFn allows setting up a callback function, which will be
+// called with every response, before it is returned or parsed. It may be
+// given more than once, the callbacks are called in the same order.
+func WithResponseEditorFn(fn ResponseEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.ResponseEditors = append(c.ResponseEditors, fn)
+		return nil
+	}
+}
+
+// applyEditors runs the request editors of the client, followed by those
+// given for this call only.
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	if c.RequestEditor != nil {
+		if err := c.RequestEditor(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RetryPolicy describes how the client retries requests which failed with a
+// network error, a 5xx or a 429 response.
+type RetryPolicy struct {
+	// The number of retries after the first attempt.
+	MaxRetries int
+
+	// The backoff before the first retry, it doubles for each of the
+	// following ones, with some jitter so that clients don't retry in lockstep.
+	MinBackoff time.Duration
+
+	// The upper bound of any backoff, including those asked for by the server
+	// through a Retry-After header.
+	MaxBackoff time.Duration
+
+	// Whether to retry operations which aren't idempotent. Operations are
+	// idempotent when their method is, or when marked with x-idempotent.
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryPolicy returns a policy retrying idempotent operations three
+// times, backing off from 100ms up to 10s.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries: 3,
+		MinBackoff: 100 * time.Millisecond,
+		MaxBackoff: 10 * time.Second,
+	}
+}
+
+// WithRetryPolicy allows retrying failed requests. Request bodies are buffered
+// so that they can be sent again.
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(c *Client) error {
+		c.RetryPolicy = &policy
+		return nil
+	}
+}
+
+// retryJitter randomizes backoffs, it isn't safe for concurrent use.
+var (
+	retryJitter   = rand.New(rand.NewSource(time.Now().UnixNano()))
+	retryJitterMu sync.Mutex
+)
+
+// do sends the request, and runs the response editors of the client on its
+// response.
+func (c *Client) do(req *http.Request, idempotent bool) (*http.Response, error) {
+	rsp, err := c.send(req, idempotent)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range c.ResponseEditors {
+		if err := r(req.Context(), rsp); err != nil {
+			rsp.Body.Close()
+			return nil, err
+		}
+	}
+	return rsp, nil
+}
+
+// send sends the request, retrying it according to the retry policy of the
+// client.
+func (c *Client) send(req *http.Request, idempotent bool) (*http.Response, error) {
+	policy := c.RetryPolicy
+	if policy == nil || policy.MaxRetries <= 0 || !(idempotent || policy.RetryNonIdempotent) {
+		return c.Client.Do(req)
+	}
+
+	// The body is consumed by each attempt, so we need a fresh one for every
+	// retry.
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		buf, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.GetBody = func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(bytes.NewReader(buf)), nil
+		}
+		req.Body, _ = req.GetBody()
+	}
+
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+		rsp, err := c.Client.Do(req)
+		if attempt >= policy.MaxRetries || !shouldRetry(req, rsp, err) {
+			return rsp, err
+		}
+
+		backoff := policy.backoff(attempt, rsp)
+		if rsp != nil {
+			// Drain the body, so that the connection can be reused.
+			io.Copy(ioutil.Discard, rsp.Body)
+			rsp.Body.Close()
+		}
+		timer := time.NewTimer(backoff)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func shouldRetry(req *http.Request, rsp *http.Response, err error) bool {
+	if err != nil {
+		// Nothing to retry once the caller gave up.
+		return req.Context().Err() == nil
+	}
+	return rsp.StatusCode == http.StatusTooManyRequests || rsp.StatusCode >= 500
+}
+
+// backoff returns how long to wait before the given retry, preferring the
+// delay asked for by the Retry-After header of the response.
+func (p *RetryPolicy) backoff(attempt int, rsp *http.Response) time.Duration {
+	if rsp != nil {
+		if after, ok := retryAfter(rsp.Header.Get("Retry-After")); ok {
+			if p.MaxBackoff > 0 && after > p.MaxBackoff {
+				return p.MaxBackoff
+			}
+			return after
+		}
+	}
+
+	backoff := p.MinBackoff << uint(attempt)
+	if backoff <= 0 || (p.MaxBackoff > 0 && backoff > p.MaxBackoff) {
+		backoff = p.MaxBackoff
+	}
+	if backoff <= 0 {
+		return 0
+	}
+	// Wait somewhere between half and all of the backoff.
+	retryJitterMu.Lock()
+	jitter := time.Duration(retryJitter.Int63n(int64(backoff)/2 + 1))
+	retryJitterMu.Unlock()
+	return backoff/2 + jitter
+}
+
+// retryAfter parses a Retry-After header, which either holds a number of
+// seconds or a date.
+func retryAfter(header string) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(header); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(header); err == nil {
+		after := time.Until(date)
+		if after < 0 {
+			after = 0
+		}
+		return after, true
+	}
+	return 0, false
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// ListPets request
+	ListPets(ctx context.Context, params *ListPetsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddPet request  with any body
+	AddPetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AddPet(ctx context.Context, body AddPetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeletePet request
+	DeletePet(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPet request
+	GetPet(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) ListPets(ctx context.Context, params *ListPetsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server := c.Server
+	req, err := NewListPetsRequest(server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
+			return nil, err
+		}
+	}
+	return c.do(req, true)
+}
+
+func (c *Client) AddPetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server := c.Server
+	req, err := NewAddPetRequestWithBody(server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
+			return nil, err
+		}
+	}
+	return c.do(req, false)
+}
+
+func (c *Client) AddPet(ctx context.Context, body AddPetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server := c.Server
+	req, err := NewAddPetRequest(server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
+			return nil, err
+		}
+	}
+	return c.do(req, false)
+}
+
+func (c *Client) DeletePet(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server := c.Server
+	req, err := NewDeletePetRequest(server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
+			return nil, err
+		}
+	}
+	return c.do(req, true)
+}
+
+func (c *Client) GetPet(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server := c.Server
+	req, err := NewGetPetRequest(server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
+			return nil, err
+		}
+	}
+	return c.do(req, true)
+}
+
+// NewListPetsRequest generates requests for ListPets
+func NewListPetsRequest(server string, params *ListPetsParams) (*http.Request, error) {
+	queryUrl, err := ListPetsURL(server, params)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryUrl.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddPetRequest calls the generic AddPet builder with application/json body
+func NewAddPetRequest(server string, body AddPetJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddPetRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAddPetRequestWithBody generates requests for AddPet with any type of body
+func NewAddPetRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	queryUrl, err := AddPetURL(server)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryUrl.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+	return req, nil
+}
+
+// NewDeletePetRequest generates requests for DeletePet
+func NewDeletePetRequest(server string, id int) (*http.Request, error) {
+	queryUrl, err := DeletePetURL(server, id)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryUrl.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetPetRequest generates requests for GetPet
+func NewGetPetRequest(server string, id int) (*http.Request, error) {
+	queryUrl, err := GetPetURL(server, id)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryUrl.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// serverDefinition is a server of the API, whose URL may hold variables.
+type serverDefinition struct {
+	url       string
+	variables []serverVariable
+}
+
+// serverVariable is a variable of the URL of a server.
+type serverVariable struct {
+	name         string
+	defaultValue string
+	enum         []string
+}
+
+// ServerVariables holds the values of the variables of the URL of a server. It
+// is one of the types generated for the servers with variables, such as those
+// given to WithServer.
+type ServerVariables interface {
+	// serverURL returns the URL of the server whose variables these are.
+	serverURL() string
+	// values returns the values of the variables, by name.
+	values() map[string]string
+}
+
+// resolve replaces the variables in the URL of the server with their values,
+// or their defaults, checking them against their enum. vars must be those of
+// the server, or nil.
+func (s serverDefinition) resolve(vars ServerVariables) (string, error) {
+	var values map[string]string
+	if vars != nil {
+		if vars.serverURL() != s.url {
+			return "", fmt.Errorf("variables of server %s given for server %s", vars.serverURL(), s.url)
+		}
+		values = vars.values()
+	}
+
+	serverURL := s.url
+	for _, v := range s.variables {
+		value := values[v.name]
+		if value == "" {
+			value = v.defaultValue
+		}
+		if value == "" {
+			return "", fmt.Errorf("missing value for variable %s of server %s", v.name, s.url)
+		}
+		valid := len(v.enum) == 0
+		for _, e := range v.enum {
+			valid = valid || value == e
+		}
+		if !valid {
+			return "", fmt.Errorf("invalid value %q for variable %s of server %s, must be one of: %s", value, v.name, s.url, strings.Join(v.enum, ", "))
+		}
+		serverURL = strings.Replace(serverURL, "{"+v.name+"}", value, -1)
+	}
+	return serverURL, nil
+}
+
+// servers lists the servers of the API, in the order of the spec.
+var servers = []serverDefinition{
+	{url: "https://api.example.com/v1"},
+}
+
+// operationServers lists the servers of the operations which override those
+// of the API.
+var operationServers = map[string][]serverDefinition{}
+
+// ServerURL0 is the URL of server 0.
+const ServerURL0 = "https://api.example.com/v1"
+
+// WithServer sets the server of the client to one of the servers of the API,
+// by its index in the spec. Its variables take the given values, which must
+// be the variables of that server, such as a ServerProductionVariables for a
+// production server. They are checked against their enum, and take their
+// default when empty, or when vars is nil.
+func WithServer(index int, vars ServerVariables) ClientOption {
+	return func(c *Client) error {
+		if index < 0 || index >= len(servers) {
+			return fmt.Errorf("no server at index %d", index)
+		}
+		server, err := servers[index].resolve(vars)
+		if err != nil {
+			return err
+		}
+		c.Server = server
+		return nil
+	}
+}
+
+// WithOperationServer sets the server of an operation which overrides the
+// servers of the API to another of its servers, by its index in the spec. Its
+// variables are handled as by WithServer, and are of the types named after the
+// operation, such as GetPetServer0Variables.
+func WithOperationServer(operationID string, index int, vars ServerVariables) ClientOption {
+	return func(c *Client) error {
+		defs := operationServers[operationID]
+		if index < 0 || index >= len(defs) {
+			return fmt.Errorf("operation %s has no server at index %d", operationID, index)
+		}
+		server, err := defs[index].resolve(vars)
+		if err != nil {
+			return err
+		}
+		if c.OperationServers == nil {
+			c.OperationServers = map[string]string{}
+		}
+		c.OperationServers[operationID] = server
+		return nil
+	}
+}
+
+// operationServer returns the server of an operation which overrides the
+// servers of the API, which is its first one unless set otherwise.
+func (c *Client) operationServer(operationID string) (string, error) {
+	server, found := c.OperationServers[operationID]
+	if !found {
+		var err error
+		server, err = operationServers[operationID][0].resolve(nil)
+		if err != nil {
+			return "", err
+		}
+	}
+	if !strings.HasSuffix(server, "/") {
+		server += "/"
+	}
+	return server, nil
+}
+
+// RequestValidationError is returned by the client for a request which
+// doesn't conform to the spec, it isn't sent.
+type RequestValidationError struct {
+	Method string
+	URL    string
+	// Why the request is invalid, usually an *openapi3filter.RequestError,
+	// or an *openapi3filter.RouteError when it matches no operation.
+	Err error
+}
+
+func (e *RequestValidationError) Error() string {
+	return fmt.Sprintf("invalid request %s %s: %s", e.Method, e.URL, e.Err)
+}
+
+// Cause returns why the request is invalid.
+func (e *RequestValidationError) Cause() error {
+	return e.Err
+}
+
+// Unwrap returns why the request is invalid.
+func (e *RequestValidationError) Unwrap() error {
+	return e.Err
+}
+
+// WithRequestValidation validates the parameters and bodies of requests
+// against the given spec, usually the one returned by GetSwagger, before they
+// are sent. Requests are validated once the request editors ran, so security
+// requirements are assumed to be met.
+func WithRequestValidation(swagger *openapi3.Swagger) ClientOption {
+	return func(c *Client) error {
+		// Requests are matched against their path relative to the server of
+		// the client, which may be none of the servers of the spec.
+		spec := *swagger
+		spec.Servers = nil
+		router := openapi3filter.NewRouter()
+		if err := router.AddSwagger(&spec); err != nil {
+			return fmt.Errorf("error loading spec for request validation: %s", err)
+		}
+		c.RequestValidator = func(ctx context.Context, server string, req *http.Request) error {
+			return validateRequest(ctx, router, server, req)
+		}
+		return nil
+	}
+}
+
+// validateRequest validates a request built against the given server.
+func validateRequest(ctx context.Context, router *openapi3filter.Router, server string, req *http.Request) error {
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return err
+	}
+	invalid := func(err error) error {
+		return &RequestValidationError{Method: req.Method, URL: req.URL.String(), Err: err}
+	}
+
+	path := strings.TrimPrefix(req.URL.Path, strings.TrimSuffix(serverURL.Path, "/"))
+	route, pathParams, err := router.FindRoute(req.Method, &url.URL{Path: path})
+	if err != nil {
+		return invalid(err)
+	}
+	input := &openapi3filter.RequestValidationInput{
+		Request:    req,
+		PathParams: pathParams,
+		Route:      route,
+		Options: &openapi3filter.Options{
+			AuthenticationFunc: func(context.Context, *openapi3filter.AuthenticationInput) error {
+				return nil
+			},
+		},
+	}
+	if err := openapi3filter.ValidateRequest(ctx, input); err != nil {
+		return invalid(err)
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// ListPets request
+	ListPetsWithResponse(ctx context.Context, params *ListPetsParams, reqEditors ...RequestEditorFn) (*ListPetsResponse, error)
+
+	// AddPet request  with any body
+	AddPetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddPetResponse, error)
+
+	AddPetWithResponse(ctx context.Context, body AddPetJSONRequestBody, reqEditors ...RequestEditorFn) (*AddPetResponse, error)
+
+	// DeletePet request
+	DeletePetWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeletePetResponse, error)
+
+	// GetPet request
+	GetPetWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetPetResponse, error)
+}
+
+type ListPetsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Pet
+}
+
+// Status returns HTTPResponse.Status
+func (r ListPetsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListPetsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddPetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Pet
+}
+
+// Status returns HTTPResponse.Status
+func (r AddPetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddPetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeletePetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeletePetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeletePetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Pet
+	JSON404      *Error
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ListPetsWithResponse request returning *ListPetsResponse
+func (c *ClientWithResponses) ListPetsWithResponse(ctx context.Context, params *ListPetsParams, reqEditors ...RequestEditorFn) (*ListPetsResponse, error) {
+	rsp, err := c.ListPets(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListPetsResponse(rsp)
+}
+
+// AddPetWithBodyWithResponse request with arbitrary body returning *AddPetResponse
+func (c *ClientWithResponses) AddPetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddPetResponse, error) {
+	rsp, err := c.AddPetWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddPetResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddPetWithResponse(ctx context.Context, body AddPetJSONRequestBody, reqEditors ...RequestEditorFn) (*AddPetResponse, error) {
+	rsp, err := c.AddPet(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddPetResponse(rsp)
+}
+
+// DeletePetWithResponse request returning *DeletePetResponse
+func (c *ClientWithResponses) DeletePetWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeletePetResponse, error) {
+	rsp, err := c.DeletePet(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeletePetResponse(rsp)
+}
+
+// GetPetWithResponse request returning *GetPetResponse
+func (c *ClientWithResponses) GetPetWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetPetResponse, error) {
+	rsp, err := c.GetPet(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPetResponse(rsp)
+}
+
+// ParseListPetsResponse parses an HTTP response from a ListPetsWithResponse call
+func ParseListPetsResponse(rsp *http.Response) (*ListPetsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListPetsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Pet
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAddPetResponse parses an HTTP response from a AddPetWithResponse call
+func ParseAddPetResponse(rsp *http.Response) (*AddPetResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AddPetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Pet
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeletePetResponse parses an HTTP response from a DeletePetWithResponse call
+func ParseDeletePetResponse(rsp *http.Response) (*DeletePetResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeletePetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	}
+
+	return response, nil
+}
+
+// ParseGetPetResponse parses an HTTP response from a GetPetWithResponse call
+func ParseGetPetResponse(rsp *http.Response) (*GetPetResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Pet
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json"):
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/plain) unsupported
+
+	}
+
+	return response, nil
+}
+
+// APIError is an error response from the server, with the payload of the
+// default response of the operation when the API documents one.
+type APIError struct {
+	Operation    string
+	StatusCode   int
+	Body         []byte
+	HTTPResponse *http.Response
+	// The decoded default response, eg. *Error, or nil
+	Model interface{}
+}
+
+// Error describes the response.
+func (e *APIError) Error() string {
+	return fmt.Sprintf("%s returned %d %s", e.Operation, e.StatusCode, http.StatusText(e.StatusCode))
+}
+
+// ListPetsOrError calls ListPetsWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) ListPetsOrError(ctx context.Context, params *ListPetsParams, reqEditors ...RequestEditorFn) (*[]Pet, error) {
+	rsp, err := c.ListPetsWithResponse(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return listPetsOrError(rsp)
+}
+
+// listPetsOrError returns the payload of a success response to
+// ListPets, or an error for any other response.
+func listPetsOrError(rsp *ListPetsResponse) (*[]Pet, error) {
+	if rsp.JSON200 != nil {
+		return rsp.JSON200, nil
+	}
+	if rsp.StatusCode() >= 200 && rsp.StatusCode() < 300 {
+		return nil, nil
+	}
+
+	apiErr := APIError{
+		Operation:    "ListPets",
+		StatusCode:   rsp.StatusCode(),
+		Body:         rsp.Body,
+		HTTPResponse: rsp.HTTPResponse,
+	}
+	return nil, &apiErr
+}
+
+// AddPetWithBodyOrError calls AddPetWithBodyWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) AddPetWithBodyOrError(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*Pet, error) {
+	rsp, err := c.AddPetWithBodyWithResponse(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return addPetOrError(rsp)
+}
+
+// AddPetOrError calls AddPetWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) AddPetOrError(ctx context.Context, body AddPetJSONRequestBody, reqEditors ...RequestEditorFn) (*Pet, error) {
+	rsp, err := c.AddPetWithResponse(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return addPetOrError(rsp)
+}
+
+// addPetOrError returns the payload of a success response to
+// AddPet, or an error for any other response.
+func addPetOrError(rsp *AddPetResponse) (*Pet, error) {
+	if rsp.JSON201 != nil {
+		return rsp.JSON201, nil
+	}
+	if rsp.StatusCode() >= 200 && rsp.StatusCode() < 300 {
+		return nil, nil
+	}
+
+	apiErr := APIError{
+		Operation:    "AddPet",
+		StatusCode:   rsp.StatusCode(),
+		Body:         rsp.Body,
+		HTTPResponse: rsp.HTTPResponse,
+	}
+	return nil, &apiErr
+}
+
+// DeletePetOrError calls DeletePetWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) DeletePetOrError(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeletePetResponse, error) {
+	rsp, err := c.DeletePetWithResponse(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return deletePetOrError(rsp)
+}
+
+// deletePetOrError returns the payload of a success response to
+// DeletePet, or an error for any other response.
+func deletePetOrError(rsp *DeletePetResponse) (*DeletePetResponse, error) {
+	if rsp.StatusCode() >= 200 && rsp.StatusCode() < 300 {
+		return rsp, nil
+	}
+
+	apiErr := APIError{
+		Operation:    "DeletePet",
+		StatusCode:   rsp.StatusCode(),
+		Body:         rsp.Body,
+		HTTPResponse: rsp.HTTPResponse,
+	}
+	return nil, &apiErr
+}
+
+// GetPetError is returned by the OrError methods of GetPet for the documented error
+// responses of GetPet, with their decoded payload.
+type GetPetError struct {
+	APIError
+	JSON404 *Error
+}
+
+// GetPetOrError calls GetPetWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) GetPetOrError(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*Pet, error) {
+	rsp, err := c.GetPetWithResponse(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return getPetOrError(rsp)
+}
+
+// getPetOrError returns the payload of a success response to
+// GetPet, or an error for any other response.
+func getPetOrError(rsp *GetPetResponse) (*Pet, error) {
+	if rsp.JSON200 != nil {
+		return rsp.JSON200, nil
+	}
+	if rsp.StatusCode() >= 200 && rsp.StatusCode() < 300 {
+		return nil, nil
+	}
+
+	apiErr := APIError{
+		Operation:    "GetPet",
+		StatusCode:   rsp.StatusCode(),
+		Body:         rsp.Body,
+		HTTPResponse: rsp.HTTPResponse,
+	}
+	switch rsp.StatusCode() {
+	case 404:
+		return nil, &GetPetError{
+			APIError: apiErr,
+			JSON404:  rsp.JSON404,
+		}
+	}
+	if rsp.JSONDefault != nil {
+		apiErr.Model = rsp.JSONDefault
+	}
+	return nil, &apiErr
+}
+
+// mockResponse is a response which the mock server answers with, for one of
+// the media types of the response.
+type mockResponse struct {
+	statusCode  int // 0 for the default response
+	contentType string
+	examples    []mockExample
+}
+
+// mockExample is a body of a mock response, unnamed for the example of the
+// media type or one made up from its schema.
+type mockExample struct {
+	name string
+	body string
+}
+
+// mockResponses holds the responses of each operation, in the order they are
+// preferred.
+var mockResponses = map[string][]mockResponse{
+	"ListPets": {
+		{statusCode: 200, contentType: "application/json", examples: []mockExample{
+			{name: "", body: "[{\"born\":\"2020-01-01\",\"id\":1,\"name\":\"string\",\"tag\":\"string\"}]"},
+		}},
+	},
+	"AddPet": {
+		{statusCode: 201, contentType: "application/json", examples: []mockExample{
+			{name: "", body: "{\"born\":\"2020-01-01\",\"id\":1,\"name\":\"string\",\"tag\":\"string\"}"},
+		}},
+	},
+	"DeletePet": {
+		{statusCode: 204, contentType: "", examples: []mockExample{}},
+	},
+	"GetPet": {
+		{statusCode: 200, contentType: "application/json", examples: []mockExample{
+			{name: "cat", body: "{\"id\":1,\"name\":\"Tom\",\"tag\":\"cat\"}"},
+			{name: "dog", body: "{\"id\":2,\"name\":\"Rex\"}"},
+		}},
+		{statusCode: 200, contentType: "text/plain", examples: []mockExample{
+			{name: "", body: "Tom"},
+		}},
+		{statusCode: 404, contentType: "application/json", examples: []mockExample{
+			{name: "", body: "{\"message\":\"no such pet\"}"},
+		}},
+		{statusCode: 0, contentType: "application/json", examples: []mockExample{
+			{name: "", body: "{\"message\":\"string\"}"},
+		}},
+	},
+}
+
+// NewMockServer returns a server answering every operation of the spec with
+// the examples of its responses, or with bodies made up from their schemas.
+// Requests pick a response with the Prefer header, eg.
+// "Prefer: code=404, example=notFound", they get the first success response
+// otherwise. Requests are validated against the given spec, usually the one
+// returned by GetSwagger, unless it is nil.
+func NewMockServer(swagger *openapi3.Swagger) (http.Handler, error) {
+	e := echo.New()
+	if swagger != nil {
+		// Requests are matched against the paths of the spec, whatever its
+		// servers.
+		spec := *swagger
+		spec.Servers = nil
+		if err := spec.Validate(context.Background()); err != nil {
+			return nil, fmt.Errorf("error validating spec: %s", err)
+		}
+		e.Use(middleware.OapiRequestValidator(&spec))
+	}
+	e.GET("/pets", mockHandler("ListPets"))
+	e.POST("/pets", mockHandler("AddPet"))
+	e.DELETE("/pets/:id", mockHandler("DeletePet"))
+	e.GET("/pets/:id", mockHandler("GetPet"))
+
+	return e, nil
+}
+
+// mockHandler answers an operation with the response which the request
+// prefers.
+func mockHandler(operationID string) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		req := ctx.Request()
+		code, example := mockPreferences(req.Header.Get("Prefer"))
+		rsp, statusCode, err := selectMockResponse(mockResponses[operationID], code, req.Header.Get("Accept"))
+		if err != nil {
+			return echo.NewHTTPError(http.StatusNotImplemented, fmt.Sprintf("%s %s", operationID, err))
+		}
+		if rsp.contentType == "" {
+			return ctx.NoContent(statusCode)
+		}
+		if len(rsp.examples) == 0 {
+			return ctx.Blob(statusCode, rsp.contentType, nil)
+		}
+		if example == "" {
+			return ctx.Blob(statusCode, rsp.contentType, []byte(rsp.examples[0].body))
+		}
+		var names []string
+		for _, ex := range rsp.examples {
+			if ex.name == example {
+				return ctx.Blob(statusCode, rsp.contentType, []byte(ex.body))
+			}
+			names = append(names, ex.name)
+		}
+		return echo.NewHTTPError(http.StatusNotImplemented, fmt.Sprintf("%s has no example %s for %d, but: %s", operationID, example, statusCode, strings.Join(names, ", ")))
+	}
+}
+
+// mockPreferences parses the code and example preferences of a Prefer
+// header.
+func mockPreferences(header string) (code int, example string) {
+	for _, pref := range strings.Split(header, ",") {
+		parts := strings.SplitN(strings.TrimSpace(pref), "=", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		value := strings.Trim(strings.TrimSpace(parts[1]), "\"")
+		switch strings.TrimSpace(parts[0]) {
+		case "code":
+			code, _ = strconv.Atoi(value)
+		case "example":
+			example = value
+		}
+	}
+	return code, example
+}
+
+// selectMockResponse returns the response with the given status code, the
+// first one when 0, in the accepted media type if there is one, along with the
+// status code to answer with. The default response stands in for the status
+// codes which aren't described, 200 unless asked for another.
+func selectMockResponse(responses []mockResponse, code int, accept string) (mockResponse, int, error) {
+	if len(responses) == 0 {
+		return mockResponse{}, 0, errors.New("has no responses")
+	}
+	statusCode := code
+	if statusCode == 0 {
+		statusCode = responses[0].statusCode
+	}
+
+	var candidates []mockResponse
+	for _, rsp := range responses {
+		if rsp.statusCode == statusCode {
+			candidates = append(candidates, rsp)
+		}
+	}
+	if len(candidates) == 0 {
+		for _, rsp := range responses {
+			if rsp.statusCode == 0 {
+				candidates = append(candidates, rsp)
+			}
+		}
+	}
+	if len(candidates) == 0 {
+		return mockResponse{}, 0, fmt.Errorf("has no response for %d", code)
+	}
+	if statusCode == 0 {
+		statusCode = http.StatusOK
+	}
+
+	for _, accepted := range strings.Split(accept, ",") {
+		accepted = strings.TrimSpace(strings.SplitN(accepted, ";", 2)[0])
+		for _, rsp := range candidates {
+			if accepted == rsp.contentType || accepted == "*/*" ||
+				(strings.HasSuffix(accepted, "/*") && strings.HasPrefix(rsp.contentType, strings.TrimSuffix(accepted, "*"))) {
+				return rsp, statusCode, nil
+			}
+		}
+	}
+	return candidates[0], statusCode, nil
+}
+
+// Base64 encoded, gzipped, json marshaled Swagger object
+var swaggerSpec = []string{
+
+	"H4sIAAAAAAAC/7xVzW7bPBB8FWG/7yhYcmr0oFuLFkWBtA3a3NIcGHFtbSr+hFz5B4bevSApO7GttA4Q",
+	"9CRaHu3Mzs5KW6iNskajZg/VFnzdoBLx+NE548LBOmPRMWG8rdB7scBw5I1FqMCzI72Avs/B4UNHDiVU",
+	"N3vgbb4Dmrt7rBn6HL7i6gr5tLgWKlZWpC9RL7iBapof8+TAYvF3/lhrjHxgFm37bQ7VzRb+dziHCv4r",
+	"Hr0oBiOKQWmfH0u9M06H69w4JRgqkIIRRsSSPICR5rczyEOLpDr1tEHSjAt04SErHOoo80/iorKjtkmO",
+	"NH3bBxjpuQklJfrakWUyGiq4bshnjJ59xg1mytS/Mo9uiS7PVg3VTSa0X6Hz2Yq4iRhcC2Vb9JmZx9/e",
+	"Yv1Th+aJ28D7JRT5EYtADkt0PnFNJ+WkDP0Zi1pYggreTMrJFELH3ERjC4spi4s0p2C6CFo/S6jgkjxf",
+	"BUD0SChkdD5OkQLBQ4duA/kQJGhJEUM+xDomS6wH28vy1Pj+NpjprdE+TfmiLMOlNpqHeQhrW6qjoOLe",
+	"G/24NeFEjMqfNbY9uXBObCAO6HAwwdnoRfzPGj9ixzspQ7UUAfT83sjNiwSfk/3DiLHrsD+xafpqrHvK",
+	"UzeElCgzmwB9nqJSbEn2KdYtMp569CHeTzaNZSYk7zEyJOG426f5OSMxs9MdS9Jk6ms015+Q/53CczK9",
+	"2/EIFRG2FG0X/Q2vtOlez7VRMLyTIzLOzixOnrjYP/Ed18mKlyQiB8Y1F7YV9FTgIODZ9QmbNitn53d8",
+	"8JEDbTLf1c2u0nmC06dzJMRH1STORdfyq63Os7ydxrXFmlFmuMNE/+MrOiWtcy1U0DBbXxWFsDQZDJnU",
+	"RhXLafiK/B4AuVhpQi8IAAA=",
+}
+
+// GetSwagger returns the Swagger specification corresponding to the generated code
+// in this file.
+func GetSwagger() (*openapi3.Swagger, error) {
+	zipped, err := base64.StdEncoding.DecodeString(strings.Join(swaggerSpec, ""))
+	if err != nil {
+		return nil, fmt.Errorf("error base64 decoding spec: %s", err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(zipped))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %s", err)
+	}
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(zr)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %s", err)
+	}
+
+	swagger, err := openapi3.NewSwaggerLoader().LoadSwaggerFromData(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("error loading Swagger: %s", err)
+	}
+	return swagger, nil
+}
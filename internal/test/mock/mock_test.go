@@ -0,0 +1,129 @@
+package mock
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	openapi_types "github.com/indigonote/oapi-codegen/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// prefer sets the Prefer header of requests.
+func prefer(value string) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("Prefer", value)
+		return nil
+	}
+}
+
+func TestMockServer(t *testing.T) {
+	swagger, err := GetSwagger()
+	require.NoError(t, err)
+	handler, err := NewMockServer(swagger)
+	require.NoError(t, err)
+	ts := httptest.NewServer(handler)
+	defer ts.Close()
+
+	client, err := NewClientWithResponses(ts.URL)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	// Without examples, the bodies are made up from the schemas
+	pets, err := client.ListPetsWithResponse(ctx, &ListPetsParams{})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, pets.StatusCode())
+	require.NotNil(t, pets.JSON200)
+	require.Len(t, *pets.JSON200, 1)
+	pet := (*pets.JSON200)[0]
+	assert.Equal(t, int64(1), pet.Id)
+	assert.Equal(t, "string", pet.Name)
+	assert.Equal(t, "2020-01-01", pet.Born.Format(openapi_types.DateFormat))
+	assert.Nil(t, pet.Parent)
+
+	added, err := client.AddPetWithResponse(ctx, AddPetJSONRequestBody{Name: "Tom"})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, added.StatusCode())
+	require.NotNil(t, added.JSON201)
+
+	// The first example, unless the request prefers another one
+	got, err := client.GetPetWithResponse(ctx, 1)
+	require.NoError(t, err)
+	require.NotNil(t, got.JSON200)
+	assert.Equal(t, "Tom", got.JSON200.Name)
+
+	got, err = client.GetPetWithResponse(ctx, 1, prefer("example=dog"))
+	require.NoError(t, err)
+	require.NotNil(t, got.JSON200)
+	assert.Equal(t, "Rex", got.JSON200.Name)
+
+	got, err = client.GetPetWithResponse(ctx, 1, prefer("code=404"))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, got.StatusCode())
+	require.NotNil(t, got.JSON404)
+	assert.Equal(t, "no such pet", got.JSON404.Message)
+
+	// The default response stands in for other status codes
+	got, err = client.GetPetWithResponse(ctx, 1, prefer(`code=503, example=""`))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, got.StatusCode())
+	require.NotNil(t, got.JSONDefault)
+
+	// Other media types are picked with the Accept header
+	rsp, err := client.GetPet(ctx, 1, func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("Accept", "text/*")
+		return nil
+	})
+	require.NoError(t, err)
+	body, err := ioutil.ReadAll(rsp.Body)
+	rsp.Body.Close()
+	require.NoError(t, err)
+	assert.Equal(t, "text/plain", rsp.Header.Get("Content-Type"))
+	assert.Equal(t, "Tom", string(body))
+
+	deleted, err := client.DeletePetWithResponse(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, deleted.StatusCode())
+	assert.Empty(t, deleted.Body)
+
+	// Responses and examples which aren't in the spec
+	deleted, err = client.DeletePetWithResponse(ctx, 1, prefer("code=404"))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotImplemented, deleted.StatusCode())
+	got, err = client.GetPetWithResponse(ctx, 1, prefer("example=bird"))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotImplemented, got.StatusCode())
+	assert.Contains(t, string(got.Body), "cat, dog")
+}
+
+func TestMockServerValidation(t *testing.T) {
+	swagger, err := GetSwagger()
+	require.NoError(t, err)
+	handler, err := NewMockServer(swagger)
+	require.NoError(t, err)
+
+	for _, tt := range []struct {
+		method, target, body string
+	}{
+		{"GET", "/pets?limit=1000", ""},
+		{"GET", "/pets/tom", ""},
+		{"POST", "/pets", `{"tag":"cat"}`},
+	} {
+		req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusBadRequest, rec.Code, "%s %s", tt.method, tt.target)
+	}
+
+	// Without the spec, any request gets an answer
+	handler, err = NewMockServer(nil)
+	require.NoError(t, err)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/pets?limit=1000", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
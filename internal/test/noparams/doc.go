@@ -0,0 +1,6 @@
+// Package noparams checks that the servers of a spec whose operations have no
+// parameters compile, since they use none of the binding helpers.
+package noparams
+
+//go:generate go run github.com/indigonote/oapi-codegen/cmd/oapi-codegen --package=echoserver --generate=types,server -o echoserver/echoserver.gen.go noparams.yaml
+//go:generate go run github.com/indigonote/oapi-codegen/cmd/oapi-codegen --package=chiserver --generate=types,chi-server -o chiserver/chiserver.gen.go noparams.yaml
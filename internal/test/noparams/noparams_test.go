@@ -0,0 +1,36 @@
+package noparams
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indigonote/oapi-codegen/internal/test/noparams/chiserver"
+	"github.com/indigonote/oapi-codegen/internal/test/noparams/echoserver"
+)
+
+type echoServer struct{}
+
+func (echoServer) GetHealth(ctx echo.Context) error {
+	return ctx.JSON(http.StatusOK, echoserver.Health{})
+}
+
+type chiServer struct{}
+
+func (chiServer) GetHealth(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+}
+
+func TestServers(t *testing.T) {
+	e := echo.New()
+	echoserver.RegisterHandlers(e, echoServer{})
+
+	for name, handler := range map[string]http.Handler{"echo": e, "chi": chiserver.Handler(chiServer{})} {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/health", nil))
+		assert.Equal(t, http.StatusOK, rec.Code, name)
+	}
+}
@@ -0,0 +1,5 @@
+// Package nullable checks the types generated for nullable properties, which
+// tell null properties from unspecified ones.
+package nullable
+
+//go:generate go run github.com/indigonote/oapi-codegen/cmd/oapi-codegen --package=nullable --generate=types,client,server,nullable -o nullable.gen.go nullable.yaml
@@ -0,0 +1,1800 @@
+// Package nullable provides primitives to interact the openapi HTTP API.
+//
+// Code generated by github.com/indigonote/oapi-codegen DO NOT EDIT.
+package nullable
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/indigonote/oapi-codegen/pkg/runtime"
+	openapi_types "github.com/indigonote/oapi-codegen/pkg/types"
+	"github.com/labstack/echo/v4"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Filter defines model for Filter.
+type Filter struct {
+	MinAge NullableInt    `json:"minAge,omitempty"`
+	Name   NullableString `json:"name,omitempty"`
+}
+
+// Owner defines model for Owner.
+type Owner struct {
+	Name *string `json:"name,omitempty"`
+}
+
+// PetPatch defines model for PetPatch.
+type PetPatch struct {
+	Age        NullableInt32       `json:"age,omitempty"`
+	Birthday   NullableDate        `json:"birthday,omitempty"`
+	LastSeen   NullableTime        `json:"lastSeen,omitempty"`
+	Name       NullableString      `json:"name,omitempty"`
+	Nickname   *string             `json:"nickname,omitempty"`
+	Owner      *Owner              `json:"owner,omitempty"`
+	Tags       NullableStringArray `json:"tags,omitempty"`
+	Vaccinated NullableBool        `json:"vaccinated,omitempty"`
+	Version    NullableInt64       `json:"version"`
+	Weight     NullableFloat32     `json:"weight,omitempty"`
+}
+
+// PatchPetJSONBody defines parameters for PatchPet.
+type PatchPetJSONBody PetPatch
+
+// PatchPetParams defines parameters for PatchPet.
+type PatchPetParams struct {
+	Filter *Filter `json:"filter,omitempty"`
+}
+
+// PatchPetRequestBody defines body for PatchPet for application/json ContentType.
+type PatchPetJSONRequestBody PatchPetJSONBody
+
+// PatchPetURL returns the URL of PatchPet on server, with its parameters
+// serialized as the client serializes them.
+func PatchPetURL(server string, id int64, params *PatchPetParams) (*url.URL, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParam("simple", false, "id", id)
+	if err != nil {
+		return nil, err
+	}
+
+	queryUrl, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	basePath := fmt.Sprintf("/pets/%s", pathParam0)
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
+	}
+
+	queryUrl, err = queryUrl.Parse(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryUrl.Query()
+
+	if params.Filter != nil {
+
+		if queryFrag, err := runtime.StyleParam("deepObject", true, "filter", *params.Filter); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryUrl.RawQuery = queryValues.Encode()
+
+	return queryUrl, nil
+}
+
+// OperationRoute describes an operation of the API, whatever the router serving
+// it.
+type OperationRoute struct {
+	Method      string // The HTTP method, eg. GET
+	Path        string // The path template of the spec, eg. /pets/{id}
+	OperationId string // The operation ID, as the names of the generated code use it
+}
+
+// OperationRoutes lists the operations of the API, in the order of their paths.
+var OperationRoutes = []OperationRoute{
+	{Method: "PATCH", Path: "/pets/{id}", OperationId: "PatchPet"},
+}
+
+// NullableBool is a nullable bool, which is either unspecified, null
+// or set to a value. It is a map, so that encoding/json omits it when it is
+// unspecified, as its zero value is.
+type NullableBool map[bool]bool
+
+// NewNullableBool returns a NullableBool set to value.
+func NewNullableBool(value bool) NullableBool {
+	return NullableBool{true: value}
+}
+
+// NewNullNullableBool returns a null NullableBool.
+func NewNullNullableBool() NullableBool {
+	var zero bool
+	return NullableBool{false: zero}
+}
+
+// Get returns the value of n, and whether it is set to one, rather than null
+// or unspecified.
+func (n NullableBool) Get() (bool, bool) {
+	value, set := n[true]
+	return value, set
+}
+
+// IsNull tells whether n is explicitly null.
+func (n NullableBool) IsNull() bool {
+	_, null := n[false]
+	return null
+}
+
+// IsSpecified tells whether n is either null or set to a value.
+func (n NullableBool) IsSpecified() bool {
+	return len(n) != 0
+}
+
+// Set sets n to value.
+func (n *NullableBool) Set(value bool) {
+	*n = NullableBool{true: value}
+}
+
+// SetNull sets n to null.
+func (n *NullableBool) SetNull() {
+	*n = NewNullNullableBool()
+}
+
+// SetUnspecified makes n unspecified.
+func (n *NullableBool) SetUnspecified() {
+	*n = nil
+}
+
+// MarshalJSON marshals the value of n, or null.
+func (n NullableBool) MarshalJSON() ([]byte, error) {
+	if value, set := n[true]; set {
+		return json.Marshal(value)
+	}
+	return []byte("null"), nil
+}
+
+// UnmarshalJSON sets n to null, or to the value in data.
+func (n *NullableBool) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		n.SetNull()
+		return nil
+	}
+	var value bool
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	n.Set(value)
+	return nil
+}
+
+// Bind sets n to the value of a parameter, as runtime.BindStringToObject binds
+// it, or to null when the value is empty.
+func (n *NullableBool) Bind(src string) error {
+	if src == "" {
+		n.SetNull()
+		return nil
+	}
+	var value bool
+	if err := runtime.BindStringToObject(src, &value); err != nil {
+		return err
+	}
+	n.Set(value)
+	return nil
+}
+
+// MarshalText styles the value of n as runtime.StyleParam does, for parameters
+// holding it. A null value is empty, which binds back to null.
+func (n NullableBool) MarshalText() ([]byte, error) {
+	value, set := n[true]
+	if !set {
+		return nil, nil
+	}
+	text, err := runtime.StyleParam("simple", false, "", value)
+	return []byte(text), err
+}
+
+// NullableDate is a nullable openapi_types.Date, which is either unspecified, null
+// or set to a value. It is a map, so that encoding/json omits it when it is
+// unspecified, as its zero value is.
+type NullableDate map[bool]openapi_types.Date
+
+// NewNullableDate returns a NullableDate set to value.
+func NewNullableDate(value openapi_types.Date) NullableDate {
+	return NullableDate{true: value}
+}
+
+// NewNullNullableDate returns a null NullableDate.
+func NewNullNullableDate() NullableDate {
+	var zero openapi_types.Date
+	return NullableDate{false: zero}
+}
+
+// Get returns the value of n, and whether it is set to one, rather than null
+// or unspecified.
+func (n NullableDate) Get() (openapi_types.Date, bool) {
+	value, set := n[true]
+	return value, set
+}
+
+// IsNull tells whether n is explicitly null.
+func (n NullableDate) IsNull() bool {
+	_, null := n[false]
+	return null
+}
+
+// IsSpecified tells whether n is either null or set to a value.
+func (n NullableDate) IsSpecified() bool {
+	return len(n) != 0
+}
+
+// Set sets n to value.
+func (n *NullableDate) Set(value openapi_types.Date) {
+	*n = NullableDate{true: value}
+}
+
+// SetNull sets n to null.
+func (n *NullableDate) SetNull() {
+	*n = NewNullNullableDate()
+}
+
+// SetUnspecified makes n unspecified.
+func (n *NullableDate) SetUnspecified() {
+	*n = nil
+}
+
+// MarshalJSON marshals the value of n, or null.
+func (n NullableDate) MarshalJSON() ([]byte, error) {
+	if value, set := n[true]; set {
+		return json.Marshal(value)
+	}
+	return []byte("null"), nil
+}
+
+// UnmarshalJSON sets n to null, or to the value in data.
+func (n *NullableDate) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		n.SetNull()
+		return nil
+	}
+	var value openapi_types.Date
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	n.Set(value)
+	return nil
+}
+
+// Bind sets n to the value of a parameter, as runtime.BindStringToObject binds
+// it, or to null when the value is empty.
+func (n *NullableDate) Bind(src string) error {
+	if src == "" {
+		n.SetNull()
+		return nil
+	}
+	var value openapi_types.Date
+	if err := runtime.BindStringToObject(src, &value); err != nil {
+		return err
+	}
+	n.Set(value)
+	return nil
+}
+
+// MarshalText styles the value of n as runtime.StyleParam does, for parameters
+// holding it. A null value is empty, which binds back to null.
+func (n NullableDate) MarshalText() ([]byte, error) {
+	value, set := n[true]
+	if !set {
+		return nil, nil
+	}
+	text, err := runtime.StyleParam("simple", false, "", value)
+	return []byte(text), err
+}
+
+// NullableFloat32 is a nullable float32, which is either unspecified, null
+// or set to a value. It is a map, so that encoding/json omits it when it is
+// unspecified, as its zero value is.
+type NullableFloat32 map[bool]float32
+
+// NewNullableFloat32 returns a NullableFloat32 set to value.
+func NewNullableFloat32(value float32) NullableFloat32 {
+	return NullableFloat32{true: value}
+}
+
+// NewNullNullableFloat32 returns a null NullableFloat32.
+func NewNullNullableFloat32() NullableFloat32 {
+	var zero float32
+	return NullableFloat32{false: zero}
+}
+
+// Get returns the value of n, and whether it is set to one, rather than null
+// or unspecified.
+func (n NullableFloat32) Get() (float32, bool) {
+	value, set := n[true]
+	return value, set
+}
+
+// IsNull tells whether n is explicitly null.
+func (n NullableFloat32) IsNull() bool {
+	_, null := n[false]
+	return null
+}
+
+// IsSpecified tells whether n is either null or set to a value.
+func (n NullableFloat32) IsSpecified() bool {
+	return len(n) != 0
+}
+
+// Set sets n to value.
+func (n *NullableFloat32) Set(value float32) {
+	*n = NullableFloat32{true: value}
+}
+
+// SetNull sets n to null.
+func (n *NullableFloat32) SetNull() {
+	*n = NewNullNullableFloat32()
+}
+
+// SetUnspecified makes n unspecified.
+func (n *NullableFloat32) SetUnspecified() {
+	*n = nil
+}
+
+// MarshalJSON marshals the value of n, or null.
+func (n NullableFloat32) MarshalJSON() ([]byte, error) {
+	if value, set := n[true]; set {
+		return json.Marshal(value)
+	}
+	return []byte("null"), nil
+}
+
+// UnmarshalJSON sets n to null, or to the value in data.
+func (n *NullableFloat32) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		n.SetNull()
+		return nil
+	}
+	var value float32
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	n.Set(value)
+	return nil
+}
+
+// Bind sets n to the value of a parameter, as runtime.BindStringToObject binds
+// it, or to null when the value is empty.
+func (n *NullableFloat32) Bind(src string) error {
+	if src == "" {
+		n.SetNull()
+		return nil
+	}
+	var value float32
+	if err := runtime.BindStringToObject(src, &value); err != nil {
+		return err
+	}
+	n.Set(value)
+	return nil
+}
+
+// MarshalText styles the value of n as runtime.StyleParam does, for parameters
+// holding it. A null value is empty, which binds back to null.
+func (n NullableFloat32) MarshalText() ([]byte, error) {
+	value, set := n[true]
+	if !set {
+		return nil, nil
+	}
+	text, err := runtime.StyleParam("simple", false, "", value)
+	return []byte(text), err
+}
+
+// NullableInt is a nullable int, which is either unspecified, null
+// or set to a value. It is a map, so that encoding/json omits it when it is
+// unspecified, as its zero value is.
+type NullableInt map[bool]int
+
+// NewNullableInt returns a NullableInt set to value.
+func NewNullableInt(value int) NullableInt {
+	return NullableInt{true: value}
+}
+
+// NewNullNullableInt returns a null NullableInt.
+func NewNullNullableInt() NullableInt {
+	var zero int
+	return NullableInt{false: zero}
+}
+
+// Get returns the value of n, and whether it is set to one, rather than null
+// or unspecified.
+func (n NullableInt) Get() (int, bool) {
+	value, set := n[true]
+	return value, set
+}
+
+// IsNull tells whether n is explicitly null.
+func (n NullableInt) IsNull() bool {
+	_, null := n[false]
+	return null
+}
+
+// IsSpecified tells whether n is either null or set to a value.
+func (n NullableInt) IsSpecified() bool {
+	return len(n) != 0
+}
+
+// Set sets n to value.
+func (n *NullableInt) Set(value int) {
+	*n = NullableInt{true: value}
+}
+
+// SetNull sets n to null.
+func (n *NullableInt) SetNull() {
+	*n = NewNullNullableInt()
+}
+
+// SetUnspecified makes n unspecified.
+func (n *NullableInt) SetUnspecified() {
+	*n = nil
+}
+
+// MarshalJSON marshals the value of n, or null.
+func (n NullableInt) MarshalJSON() ([]byte, error) {
+	if value, set := n[true]; set {
+		return json.Marshal(value)
+	}
+	return []byte("null"), nil
+}
+
+// UnmarshalJSON sets n to null, or to the value in data.
+func (n *NullableInt) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		n.SetNull()
+		return nil
+	}
+	var value int
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	n.Set(value)
+	return nil
+}
+
+// Bind sets n to the value of a parameter, as runtime.BindStringToObject binds
+// it, or to null when the value is empty.
+func (n *NullableInt) Bind(src string) error {
+	if src == "" {
+		n.SetNull()
+		return nil
+	}
+	var value int
+	if err := runtime.BindStringToObject(src, &value); err != nil {
+		return err
+	}
+	n.Set(value)
+	return nil
+}
+
+// MarshalText styles the value of n as runtime.StyleParam does, for parameters
+// holding it. A null value is empty, which binds back to null.
+func (n NullableInt) MarshalText() ([]byte, error) {
+	value, set := n[true]
+	if !set {
+		return nil, nil
+	}
+	text, err := runtime.StyleParam("simple", false, "", value)
+	return []byte(text), err
+}
+
+// NullableInt32 is a nullable int32, which is either unspecified, null
+// or set to a value. It is a map, so that encoding/json omits it when it is
+// unspecified, as its zero value is.
+type NullableInt32 map[bool]int32
+
+// NewNullableInt32 returns a NullableInt32 set to value.
+func NewNullableInt32(value int32) NullableInt32 {
+	return NullableInt32{true: value}
+}
+
+// NewNullNullableInt32 returns a null NullableInt32.
+func NewNullNullableInt32() NullableInt32 {
+	var zero int32
+	return NullableInt32{false: zero}
+}
+
+// Get returns the value of n, and whether it is set to one, rather than null
+// or unspecified.
+func (n NullableInt32) Get() (int32, bool) {
+	value, set := n[true]
+	return value, set
+}
+
+// IsNull tells whether n is explicitly null.
+func (n NullableInt32) IsNull() bool {
+	_, null := n[false]
+	return null
+}
+
+// IsSpecified tells whether n is either null or set to a value.
+func (n NullableInt32) IsSpecified() bool {
+	return len(n) != 0
+}
+
+// Set sets n to value.
+func (n *NullableInt32) Set(value int32) {
+	*n = NullableInt32{true: value}
+}
+
+// SetNull sets n to null.
+func (n *NullableInt32) SetNull() {
+	*n = NewNullNullableInt32()
+}
+
+// SetUnspecified makes n unspecified.
+func (n *NullableInt32) SetUnspecified() {
+	*n = nil
+}
+
+// MarshalJSON marshals the value of n, or null.
+func (n NullableInt32) MarshalJSON() ([]byte, error) {
+	if value, set := n[true]; set {
+		return json.Marshal(value)
+	}
+	return []byte("null"), nil
+}
+
+// UnmarshalJSON sets n to null, or to the value in data.
+func (n *NullableInt32) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		n.SetNull()
+		return nil
+	}
+	var value int32
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	n.Set(value)
+	return nil
+}
+
+// Bind sets n to the value of a parameter, as runtime.BindStringToObject binds
+// it, or to null when the value is empty.
+func (n *NullableInt32) Bind(src string) error {
+	if src == "" {
+		n.SetNull()
+		return nil
+	}
+	var value int32
+	if err := runtime.BindStringToObject(src, &value); err != nil {
+		return err
+	}
+	n.Set(value)
+	return nil
+}
+
+// MarshalText styles the value of n as runtime.StyleParam does, for parameters
+// holding it. A null value is empty, which binds back to null.
+func (n NullableInt32) MarshalText() ([]byte, error) {
+	value, set := n[true]
+	if !set {
+		return nil, nil
+	}
+	text, err := runtime.StyleParam("simple", false, "", value)
+	return []byte(text), err
+}
+
+// NullableInt64 is a nullable int64, which is either unspecified, null
+// or set to a value. It is a map, so that encoding/json omits it when it is
+// unspecified, as its zero value is.
+type NullableInt64 map[bool]int64
+
+// NewNullableInt64 returns a NullableInt64 set to value.
+func NewNullableInt64(value int64) NullableInt64 {
+	return NullableInt64{true: value}
+}
+
+// NewNullNullableInt64 returns a null NullableInt64.
+func NewNullNullableInt64() NullableInt64 {
+	var zero int64
+	return NullableInt64{false: zero}
+}
+
+// Get returns the value of n, and whether it is set to one, rather than null
+// or unspecified.
+func (n NullableInt64) Get() (int64, bool) {
+	value, set := n[true]
+	return value, set
+}
+
+// IsNull tells whether n is explicitly null.
+func (n NullableInt64) IsNull() bool {
+	_, null := n[false]
+	return null
+}
+
+// IsSpecified tells whether n is either null or set to a value.
+func (n NullableInt64) IsSpecified() bool {
+	return len(n) != 0
+}
+
+// Set sets n to value.
+func (n *NullableInt64) Set(value int64) {
+	*n = NullableInt64{true: value}
+}
+
+// SetNull sets n to null.
+func (n *NullableInt64) SetNull() {
+	*n = NewNullNullableInt64()
+}
+
+// SetUnspecified makes n unspecified.
+func (n *NullableInt64) SetUnspecified() {
+	*n = nil
+}
+
+// MarshalJSON marshals the value of n, or null.
+func (n NullableInt64) MarshalJSON() ([]byte, error) {
+	if value, set := n[true]; set {
+		return json.Marshal(value)
+	}
+	return []byte("null"), nil
+}
+
+// UnmarshalJSON sets n to null, or to the value in data.
+func (n *NullableInt64) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		n.SetNull()
+		return nil
+	}
+	var value int64
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	n.Set(value)
+	return nil
+}
+
+// Bind sets n to the value of a parameter, as runtime.BindStringToObject binds
+// it, or to null when the value is empty.
+func (n *NullableInt64) Bind(src string) error {
+	if src == "" {
+		n.SetNull()
+		return nil
+	}
+	var value int64
+	if err := runtime.BindStringToObject(src, &value); err != nil {
+		return err
+	}
+	n.Set(value)
+	return nil
+}
+
+// MarshalText styles the value of n as runtime.StyleParam does, for parameters
+// holding it. A null value is empty, which binds back to null.
+func (n NullableInt64) MarshalText() ([]byte, error) {
+	value, set := n[true]
+	if !set {
+		return nil, nil
+	}
+	text, err := runtime.StyleParam("simple", false, "", value)
+	return []byte(text), err
+}
+
+// NullableString is a nullable string, which is either unspecified, null
+// or set to a value. It is a map, so that encoding/json omits it when it is
+// unspecified, as its zero value is.
+type NullableString map[bool]string
+
+// NewNullableString returns a NullableString set to value.
+func NewNullableString(value string) NullableString {
+	return NullableString{true: value}
+}
+
+// NewNullNullableString returns a null NullableString.
+func NewNullNullableString() NullableString {
+	var zero string
+	return NullableString{false: zero}
+}
+
+// Get returns the value of n, and whether it is set to one, rather than null
+// or unspecified.
+func (n NullableString) Get() (string, bool) {
+	value, set := n[true]
+	return value, set
+}
+
+// IsNull tells whether n is explicitly null.
+func (n NullableString) IsNull() bool {
+	_, null := n[false]
+	return null
+}
+
+// IsSpecified tells whether n is either null or set to a value.
+func (n NullableString) IsSpecified() bool {
+	return len(n) != 0
+}
+
+// Set sets n to value.
+func (n *NullableString) Set(value string) {
+	*n = NullableString{true: value}
+}
+
+// SetNull sets n to null.
+func (n *NullableString) SetNull() {
+	*n = NewNullNullableString()
+}
+
+// SetUnspecified makes n unspecified.
+func (n *NullableString) SetUnspecified() {
+	*n = nil
+}
+
+// MarshalJSON marshals the value of n, or null.
+func (n NullableString) MarshalJSON() ([]byte, error) {
+	if value, set := n[true]; set {
+		return json.Marshal(value)
+	}
+	return []byte("null"), nil
+}
+
+// UnmarshalJSON sets n to null, or to the value in data.
+func (n *NullableString) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		n.SetNull()
+		return nil
+	}
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	n.Set(value)
+	return nil
+}
+
+// Bind sets n to the value of a parameter, as runtime.BindStringToObject binds
+// it, or to null when the value is empty.
+func (n *NullableString) Bind(src string) error {
+	if src == "" {
+		n.SetNull()
+		return nil
+	}
+	var value string
+	if err := runtime.BindStringToObject(src, &value); err != nil {
+		return err
+	}
+	n.Set(value)
+	return nil
+}
+
+// MarshalText styles the value of n as runtime.StyleParam does, for parameters
+// holding it. A null value is empty, which binds back to null.
+func (n NullableString) MarshalText() ([]byte, error) {
+	value, set := n[true]
+	if !set {
+		return nil, nil
+	}
+	text, err := runtime.StyleParam("simple", false, "", value)
+	return []byte(text), err
+}
+
+// NullableStringArray is a nullable []string, which is either unspecified, null
+// or set to a value. It is a map, so that encoding/json omits it when it is
+// unspecified, as its zero value is.
+type NullableStringArray map[bool][]string
+
+// NewNullableStringArray returns a NullableStringArray set to value.
+func NewNullableStringArray(value []string) NullableStringArray {
+	return NullableStringArray{true: value}
+}
+
+// NewNullNullableStringArray returns a null NullableStringArray.
+func NewNullNullableStringArray() NullableStringArray {
+	var zero []string
+	return NullableStringArray{false: zero}
+}
+
+// Get returns the value of n, and whether it is set to one, rather than null
+// or unspecified.
+func (n NullableStringArray) Get() ([]string, bool) {
+	value, set := n[true]
+	return value, set
+}
+
+// IsNull tells whether n is explicitly null.
+func (n NullableStringArray) IsNull() bool {
+	_, null := n[false]
+	return null
+}
+
+// IsSpecified tells whether n is either null or set to a value.
+func (n NullableStringArray) IsSpecified() bool {
+	return len(n) != 0
+}
+
+// Set sets n to value.
+func (n *NullableStringArray) Set(value []string) {
+	*n = NullableStringArray{true: value}
+}
+
+// SetNull sets n to null.
+func (n *NullableStringArray) SetNull() {
+	*n = NewNullNullableStringArray()
+}
+
+// SetUnspecified makes n unspecified.
+func (n *NullableStringArray) SetUnspecified() {
+	*n = nil
+}
+
+// MarshalJSON marshals the value of n, or null.
+func (n NullableStringArray) MarshalJSON() ([]byte, error) {
+	if value, set := n[true]; set {
+		return json.Marshal(value)
+	}
+	return []byte("null"), nil
+}
+
+// UnmarshalJSON sets n to null, or to the value in data.
+func (n *NullableStringArray) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		n.SetNull()
+		return nil
+	}
+	var value []string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	n.Set(value)
+	return nil
+}
+
+// Bind sets n to the value of a parameter, as runtime.BindStringToObject binds
+// it, or to null when the value is empty.
+func (n *NullableStringArray) Bind(src string) error {
+	if src == "" {
+		n.SetNull()
+		return nil
+	}
+	var value []string
+	if err := runtime.BindStringToObject(src, &value); err != nil {
+		return err
+	}
+	n.Set(value)
+	return nil
+}
+
+// MarshalText styles the value of n as runtime.StyleParam does, for parameters
+// holding it. A null value is empty, which binds back to null.
+func (n NullableStringArray) MarshalText() ([]byte, error) {
+	value, set := n[true]
+	if !set {
+		return nil, nil
+	}
+	text, err := runtime.StyleParam("simple", false, "", value)
+	return []byte(text), err
+}
+
+// NullableTime is a nullable time.Time, which is either unspecified, null
+// or set to a value. It is a map, so that encoding/json omits it when it is
+// unspecified, as its zero value is.
+type NullableTime map[bool]time.Time
+
+// NewNullableTime returns a NullableTime set to value.
+func NewNullableTime(value time.Time) NullableTime {
+	return NullableTime{true: value}
+}
+
+// NewNullNullableTime returns a null NullableTime.
+func NewNullNullableTime() NullableTime {
+	var zero time.Time
+	return NullableTime{false: zero}
+}
+
+// Get returns the value of n, and whether it is set to one, rather than null
+// or unspecified.
+func (n NullableTime) Get() (time.Time, bool) {
+	value, set := n[true]
+	return value, set
+}
+
+// IsNull tells whether n is explicitly null.
+func (n NullableTime) IsNull() bool {
+	_, null := n[false]
+	return null
+}
+
+// IsSpecified tells whether n is either null or set to a value.
+func (n NullableTime) IsSpecified() bool {
+	return len(n) != 0
+}
+
+// Set sets n to value.
+func (n *NullableTime) Set(value time.Time) {
+	*n = NullableTime{true: value}
+}
+
+// SetNull sets n to null.
+func (n *NullableTime) SetNull() {
+	*n = NewNullNullableTime()
+}
+
+// SetUnspecified makes n unspecified.
+func (n *NullableTime) SetUnspecified() {
+	*n = nil
+}
+
+// MarshalJSON marshals the value of n, or null.
+func (n NullableTime) MarshalJSON() ([]byte, error) {
+	if value, set := n[true]; set {
+		return json.Marshal(value)
+	}
+	return []byte("null"), nil
+}
+
+// UnmarshalJSON sets n to null, or to the value in data.
+func (n *NullableTime) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		n.SetNull()
+		return nil
+	}
+	var value time.Time
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	n.Set(value)
+	return nil
+}
+
+// Bind sets n to the value of a parameter, as runtime.BindStringToObject binds
+// it, or to null when the value is empty.
+func (n *NullableTime) Bind(src string) error {
+	if src == "" {
+		n.SetNull()
+		return nil
+	}
+	var value time.Time
+	if err := runtime.BindStringToObject(src, &value); err != nil {
+		return err
+	}
+	n.Set(value)
+	return nil
+}
+
+// MarshalText styles the value of n as runtime.StyleParam does, for parameters
+// holding it. A null value is empty, which binds back to null.
+func (n NullableTime) MarshalText() ([]byte, error) {
+	value, set := n[true]
+	if !set {
+		return nil, nil
+	}
+	text, err := runtime.StyleParam("simple", false, "", value)
+	return []byte(text), err
+}
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// ResponseEditorFn is the function signature for the ResponseEditor callback
+// function, which may inspect or replace the response before it is parsed
+type ResponseEditorFn func(ctx context.Context, rsp *http.Response) error
+
+// RequestValidatorFn is the function signature for the callback validating
+// requests before they are sent, given the server they were built against
+type RequestValidatorFn func(ctx context.Context, server string, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before
+	// sending over the network, in the order they are run.
+	RequestEditors []RequestEditorFn
+
+	// A callback for modifying requests which are generated before sending over
+	// the network, which runs before RequestEditors.
+	//
+	// Deprecated: use RequestEditors, or WithRequestEditorFn.
+	RequestEditor RequestEditorFn
+
+	// A list of callbacks for inspecting responses before they are returned,
+	// in the order they are run.
+	ResponseEditors []ResponseEditorFn
+
+	// A callback validating requests once the request editors ran, requests
+	// failing it aren't sent. See WithRequestValidation.
+	RequestValidator RequestValidatorFn
+
+	// How failed requests are retried, they aren't when nil.
+	RetryPolicy *RetryPolicy
+
+	// The servers of the operations which override the servers of the API,
+	// keyed by operation ID, when they aren't the first of their servers. See
+	// WithOperationServer.
+	OperationServers map[string]string
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = http.DefaultClient
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+// It may be given more than once, the callbacks are called in the same order.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// WithResponseEditorFn allows setting up a callback function, which will be
+// called with every response, before it is returned or parsed. It may be
+// given more than once, the callbacks are called in the same order.
+func WithResponseEditorFn(fn ResponseEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.ResponseEditors = append(c.ResponseEditors, fn)
+		return nil
+	}
+}
+
+// applyEditors runs the request editors of the client, followed by those
+// given for this call only.
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	if c.RequestEditor != nil {
+		if err := c.RequestEditor(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RetryPolicy describes how the client retries requests which failed with a
+// network error, a 5xx or a 429 response.
+type RetryPolicy struct {
+	// The number of retries after the first attempt.
+	MaxRetries int
+
+	// The backoff before the first retry, it doubles for each of the
+	// following ones, with some jitter so that clients don't retry in lockstep.
+	MinBackoff time.Duration
+
+	// The upper bound of any backoff, including those asked for by the server
+	// through a Retry-After header.
+	MaxBackoff time.Duration
+
+	// Whether to retry operations which aren't idempotent. Operations are
+	// idempotent when their method is, or when marked with x-idempotent.
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryPolicy returns a policy retrying idempotent operations three
+// times, backing off from 100ms up to 10s.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries: 3,
+		MinBackoff: 100 * time.Millisecond,
+		MaxBackoff: 10 * time.Second,
+	}
+}
+
+// WithRetryPolicy allows retrying failed requests. Request bodies are buffered
+// so that they can be sent again.
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(c *Client) error {
+		c.RetryPolicy = &policy
+		return nil
+	}
+}
+
+// retryJitter randomizes backoffs, it isn't safe for concurrent use.
+var (
+	retryJitter   = rand.New(rand.NewSource(time.Now().UnixNano()))
+	retryJitterMu sync.Mutex
+)
+
+// do sends the request, and runs the response editors of the client on its
+// response.
+func (c *Client) do(req *http.Request, idempotent bool) (*http.Response, error) {
+	rsp, err := c.send(req, idempotent)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range c.ResponseEditors {
+		if err := r(req.Context(), rsp); err != nil {
+			rsp.Body.Close()
+			return nil, err
+		}
+	}
+	return rsp, nil
+}
+
+// send sends the request, retrying it according to the retry policy of the
+// client.
+func (c *Client) send(req *http.Request, idempotent bool) (*http.Response, error) {
+	policy := c.RetryPolicy
+	if policy == nil || policy.MaxRetries <= 0 || !(idempotent || policy.RetryNonIdempotent) {
+		return c.Client.Do(req)
+	}
+
+	// The body is consumed by each attempt, so we need a fresh one for every
+	// retry.
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		buf, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.GetBody = func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(bytes.NewReader(buf)), nil
+		}
+		req.Body, _ = req.GetBody()
+	}
+
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+		rsp, err := c.Client.Do(req)
+		if attempt >= policy.MaxRetries || !shouldRetry(req, rsp, err) {
+			return rsp, err
+		}
+
+		backoff := policy.backoff(attempt, rsp)
+		if rsp != nil {
+			// Drain the body, so that the connection can be reused.
+			io.Copy(ioutil.Discard, rsp.Body)
+			rsp.Body.Close()
+		}
+		timer := time.NewTimer(backoff)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func shouldRetry(req *http.Request, rsp *http.Response, err error) bool {
+	if err != nil {
+		// Nothing to retry once the caller gave up.
+		return req.Context().Err() == nil
+	}
+	return rsp.StatusCode == http.StatusTooManyRequests || rsp.StatusCode >= 500
+}
+
+// backoff returns how long to wait before the given retry, preferring the
+// delay asked for by the Retry-After header of the response.
+func (p *RetryPolicy) backoff(attempt int, rsp *http.Response) time.Duration {
+	if rsp != nil {
+		if after, ok := retryAfter(rsp.Header.Get("Retry-After")); ok {
+			if p.MaxBackoff > 0 && after > p.MaxBackoff {
+				return p.MaxBackoff
+			}
+			return after
+		}
+	}
+
+	backoff := p.MinBackoff << uint(attempt)
+	if backoff <= 0 || (p.MaxBackoff > 0 && backoff > p.MaxBackoff) {
+		backoff = p.MaxBackoff
+	}
+	if backoff <= 0 {
+		return 0
+	}
+	// Wait somewhere between half and all of the backoff.
+	retryJitterMu.Lock()
+	jitter := time.Duration(retryJitter.Int63n(int64(backoff)/2 + 1))
+	retryJitterMu.Unlock()
+	return backoff/2 + jitter
+}
+
+// retryAfter parses a Retry-After header, which either holds a number of
+// seconds or a date.
+func retryAfter(header string) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(header); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(header); err == nil {
+		after := time.Until(date)
+		if after < 0 {
+			after = 0
+		}
+		return after, true
+	}
+	return 0, false
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// PatchPet request  with any body
+	PatchPetWithBody(ctx context.Context, id int64, params *PatchPetParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchPet(ctx context.Context, id int64, params *PatchPetParams, body PatchPetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) PatchPetWithBody(ctx context.Context, id int64, params *PatchPetParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server := c.Server
+	req, err := NewPatchPetRequestWithBody(server, id, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
+			return nil, err
+		}
+	}
+	return c.do(req, false)
+}
+
+func (c *Client) PatchPet(ctx context.Context, id int64, params *PatchPetParams, body PatchPetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server := c.Server
+	req, err := NewPatchPetRequest(server, id, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
+			return nil, err
+		}
+	}
+	return c.do(req, false)
+}
+
+// NewPatchPetRequest calls the generic PatchPet builder with application/json body
+func NewPatchPetRequest(server string, id int64, params *PatchPetParams, body PatchPetJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchPetRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewPatchPetRequestWithBody generates requests for PatchPet with any type of body
+func NewPatchPetRequestWithBody(server string, id int64, params *PatchPetParams, contentType string, body io.Reader) (*http.Request, error) {
+	queryUrl, err := PatchPetURL(server, id, params)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryUrl.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+	return req, nil
+}
+
+// serverDefinition is a server of the API, whose URL may hold variables.
+type serverDefinition struct {
+	url       string
+	variables []serverVariable
+}
+
+// serverVariable is a variable of the URL of a server.
+type serverVariable struct {
+	name         string
+	defaultValue string
+	enum         []string
+}
+
+// ServerVariables holds the values of the variables of the URL of a server. It
+// is one of the types generated for the servers with variables, such as those
+// given to WithServer.
+type ServerVariables interface {
+	// serverURL returns the URL of the server whose variables these are.
+	serverURL() string
+	// values returns the values of the variables, by name.
+	values() map[string]string
+}
+
+// resolve replaces the variables in the URL of the server with their values,
+// or their defaults, checking them against their enum. vars must be those of
+// the server, or nil.
+func (s serverDefinition) resolve(vars ServerVariables) (string, error) {
+	var values map[string]string
+	if vars != nil {
+		if vars.serverURL() != s.url {
+			return "", fmt.Errorf("variables of server %s given for server %s", vars.serverURL(), s.url)
+		}
+		values = vars.values()
+	}
+
+	serverURL := s.url
+	for _, v := range s.variables {
+		value := values[v.name]
+		if value == "" {
+			value = v.defaultValue
+		}
+		if value == "" {
+			return "", fmt.Errorf("missing value for variable %s of server %s", v.name, s.url)
+		}
+		valid := len(v.enum) == 0
+		for _, e := range v.enum {
+			valid = valid || value == e
+		}
+		if !valid {
+			return "", fmt.Errorf("invalid value %q for variable %s of server %s, must be one of: %s", value, v.name, s.url, strings.Join(v.enum, ", "))
+		}
+		serverURL = strings.Replace(serverURL, "{"+v.name+"}", value, -1)
+	}
+	return serverURL, nil
+}
+
+// servers lists the servers of the API, in the order of the spec.
+var servers = []serverDefinition{}
+
+// operationServers lists the servers of the operations which override those
+// of the API.
+var operationServers = map[string][]serverDefinition{}
+
+// WithServer sets the server of the client to one of the servers of the API,
+// by its index in the spec. Its variables take the given values, which must
+// be the variables of that server, such as a ServerProductionVariables for a
+// production server. They are checked against their enum, and take their
+// default when empty, or when vars is nil.
+func WithServer(index int, vars ServerVariables) ClientOption {
+	return func(c *Client) error {
+		if index < 0 || index >= len(servers) {
+			return fmt.Errorf("no server at index %d", index)
+		}
+		server, err := servers[index].resolve(vars)
+		if err != nil {
+			return err
+		}
+		c.Server = server
+		return nil
+	}
+}
+
+// WithOperationServer sets the server of an operation which overrides the
+// servers of the API to another of its servers, by its index in the spec. Its
+// variables are handled as by WithServer, and are of the types named after the
+// operation, such as GetPetServer0Variables.
+func WithOperationServer(operationID string, index int, vars ServerVariables) ClientOption {
+	return func(c *Client) error {
+		defs := operationServers[operationID]
+		if index < 0 || index >= len(defs) {
+			return fmt.Errorf("operation %s has no server at index %d", operationID, index)
+		}
+		server, err := defs[index].resolve(vars)
+		if err != nil {
+			return err
+		}
+		if c.OperationServers == nil {
+			c.OperationServers = map[string]string{}
+		}
+		c.OperationServers[operationID] = server
+		return nil
+	}
+}
+
+// operationServer returns the server of an operation which overrides the
+// servers of the API, which is its first one unless set otherwise.
+func (c *Client) operationServer(operationID string) (string, error) {
+	server, found := c.OperationServers[operationID]
+	if !found {
+		var err error
+		server, err = operationServers[operationID][0].resolve(nil)
+		if err != nil {
+			return "", err
+		}
+	}
+	if !strings.HasSuffix(server, "/") {
+		server += "/"
+	}
+	return server, nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// PatchPet request  with any body
+	PatchPetWithBodyWithResponse(ctx context.Context, id int64, params *PatchPetParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchPetResponse, error)
+
+	PatchPetWithResponse(ctx context.Context, id int64, params *PatchPetParams, body PatchPetJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchPetResponse, error)
+}
+
+type PatchPetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PetPatch
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchPetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchPetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// PatchPetWithBodyWithResponse request with arbitrary body returning *PatchPetResponse
+func (c *ClientWithResponses) PatchPetWithBodyWithResponse(ctx context.Context, id int64, params *PatchPetParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchPetResponse, error) {
+	rsp, err := c.PatchPetWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchPetResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchPetWithResponse(ctx context.Context, id int64, params *PatchPetParams, body PatchPetJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchPetResponse, error) {
+	rsp, err := c.PatchPet(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchPetResponse(rsp)
+}
+
+// ParsePatchPetResponse parses an HTTP response from a PatchPetWithResponse call
+func ParsePatchPetResponse(rsp *http.Response) (*PatchPetResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchPetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PetPatch
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// APIError is an error response from the server, with the payload of the
+// default response of the operation when the API documents one.
+type APIError struct {
+	Operation    string
+	StatusCode   int
+	Body         []byte
+	HTTPResponse *http.Response
+	// The decoded default response, eg. *Error, or nil
+	Model interface{}
+}
+
+// Error describes the response.
+func (e *APIError) Error() string {
+	return fmt.Sprintf("%s returned %d %s", e.Operation, e.StatusCode, http.StatusText(e.StatusCode))
+}
+
+// PatchPetWithBodyOrError calls PatchPetWithBodyWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) PatchPetWithBodyOrError(ctx context.Context, id int64, params *PatchPetParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PetPatch, error) {
+	rsp, err := c.PatchPetWithBodyWithResponse(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return patchPetOrError(rsp)
+}
+
+// PatchPetOrError calls PatchPetWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) PatchPetOrError(ctx context.Context, id int64, params *PatchPetParams, body PatchPetJSONRequestBody, reqEditors ...RequestEditorFn) (*PetPatch, error) {
+	rsp, err := c.PatchPetWithResponse(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return patchPetOrError(rsp)
+}
+
+// patchPetOrError returns the payload of a success response to
+// PatchPet, or an error for any other response.
+func patchPetOrError(rsp *PatchPetResponse) (*PetPatch, error) {
+	if rsp.JSON200 != nil {
+		return rsp.JSON200, nil
+	}
+	if rsp.StatusCode() >= 200 && rsp.StatusCode() < 300 {
+		return nil, nil
+	}
+
+	apiErr := APIError{
+		Operation:    "PatchPet",
+		StatusCode:   rsp.StatusCode(),
+		Body:         rsp.Body,
+		HTTPResponse: rsp.HTTPResponse,
+	}
+	return nil, &apiErr
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (PATCH /pets/{id})
+	PatchPet(ctx echo.Context, id int64, params PatchPetParams) error
+}
+
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+
+	// ErrorHandlerFunc answers requests whose parameters can't be bound, with
+	// their InvalidParamFormatError, RequiredParamError, UnmarshalingParamError
+	// or TooManyValuesForParamError, of the runtime package. They are answered
+	// with a 400 echo.HTTPError if it is nil.
+	ErrorHandlerFunc func(ctx echo.Context, err error) error
+}
+
+// handleError answers a request whose parameters can't be bound.
+func (w *ServerInterfaceWrapper) handleError(ctx echo.Context, err error) error {
+	if w.ErrorHandlerFunc != nil {
+		return w.ErrorHandlerFunc(ctx, err)
+	}
+	return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+}
+
+// PatchPet converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchPet(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameter("simple", false, "id", ctx.Param("id"), &id)
+	if err != nil {
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationPath, "id", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchPetParams
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("deepObject", true, false, "filter", ctx.QueryParams(), &params.Filter)
+	if err != nil {
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationQuery, "filter", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PatchPet(ctx, id, params)
+	return err
+}
+
+// This is a simple interface which specifies echo.Route addition functions which
+// are present on both echo.Echo and echo.Group, since we want to allow using
+// either of them for path registration
+type EchoRouter interface {
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+}
+
+// RegisterOptions configures the middlewares and the error handling of the
+// routes added by RegisterHandlersWithOptions.
+type RegisterOptions struct {
+	// The middlewares of operations, by operation ID.
+	OperationMiddlewares map[string][]echo.MiddlewareFunc
+
+	// The middlewares of the operations with a tag, by tag.
+	TagMiddlewares map[string][]echo.MiddlewareFunc
+
+	// The ErrorHandlerFunc of the ServerInterfaceWrapper.
+	ErrorHandlerFunc func(ctx echo.Context, err error) error
+}
+
+// RegisterHandlers adds each server route to the EchoRouter.
+func RegisterHandlers(router EchoRouter, si ServerInterface) {
+	RegisterHandlersWithOptions(router, si, RegisterOptions{})
+}
+
+// RegisterHandlersWithOptions adds each server route to the EchoRouter, with
+// the middlewares of the tags of its operation, in the order of the tags, and
+// then those of the operation. The OperationInfo of the operation is put into
+// the context of the request before any of them runs.
+func RegisterHandlersWithOptions(router EchoRouter, si ServerInterface, opts RegisterOptions) {
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:          si,
+		ErrorHandlerFunc: opts.ErrorHandlerFunc,
+	}
+
+	router.PATCH("/pets/:id", wrapper.PatchPet, opts.middlewares("PatchPet")...)
+
+}
+
+// middlewares returns the middlewares of the route of an operation.
+func (opts RegisterOptions) middlewares(operationId string) []echo.MiddlewareFunc {
+	info := Operations[operationId]
+	middlewares := []echo.MiddlewareFunc{
+		func(next echo.HandlerFunc) echo.HandlerFunc {
+			return func(ctx echo.Context) error {
+				r := ctx.Request()
+				ctx.SetRequest(r.WithContext(ContextWithOperationInfo(r.Context(), info)))
+				return next(ctx)
+			}
+		},
+	}
+	for _, tag := range info.Tags {
+		middlewares = append(middlewares, opts.TagMiddlewares[tag]...)
+	}
+	return append(middlewares, opts.OperationMiddlewares[operationId]...)
+}
+
+// OperationInfo describes an operation of the API, for the middlewares serving
+// it, which find it in the context of its requests.
+type OperationInfo struct {
+	OperationId string                     // The operation ID, as the names of the generated code use it
+	Method      string                     // The HTTP method, eg. GET
+	Path        string                     // The path template of the spec, eg. /pets/{id}
+	Tags        []string                   // The tags of the operation
+	Security    []OperationSecurity        // The security providers of the operation
+	Extensions  map[string]json.RawMessage // The x- extensions of the operation, by name
+}
+
+// OperationSecurity is a security provider of an operation, and the scopes it
+// requires.
+type OperationSecurity struct {
+	ProviderName string
+	Scopes       []string
+}
+
+// Operations holds the OperationInfo of every operation, by operation ID.
+var Operations = map[string]OperationInfo{
+	"PatchPet": {
+		OperationId: "PatchPet",
+		Method:      "PATCH",
+		Path:        "/pets/{id}",
+	},
+}
+
+// operationInfoKey is the context key of the OperationInfo of a request.
+type operationInfoKey struct{}
+
+// ContextWithOperationInfo returns a copy of ctx holding info, which
+// OperationInfoFromContext returns.
+func ContextWithOperationInfo(ctx context.Context, info OperationInfo) context.Context {
+	return context.WithValue(ctx, operationInfoKey{}, info)
+}
+
+// OperationInfoFromContext returns the OperationInfo of the operation serving
+// a request, from its context, and whether there's one.
+func OperationInfoFromContext(ctx context.Context) (OperationInfo, bool) {
+	info, ok := ctx.Value(operationInfoKey{}).(OperationInfo)
+	return info, ok
+}
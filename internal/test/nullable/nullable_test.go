@@ -0,0 +1,147 @@
+package nullable
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/indigonote/oapi-codegen/pkg/runtime"
+	openapi_types "github.com/indigonote/oapi-codegen/pkg/types"
+)
+
+func TestNullableStates(t *testing.T) {
+	var patch PetPatch
+	err := json.Unmarshal([]byte(`{"version": 3, "name": null, "age": 4}`), &patch)
+	require.NoError(t, err)
+
+	// The name is null, the age is set, and the weight is unspecified
+	assert.True(t, patch.Name.IsSpecified())
+	assert.True(t, patch.Name.IsNull())
+	_, set := patch.Name.Get()
+	assert.False(t, set)
+
+	age, set := patch.Age.Get()
+	assert.True(t, set)
+	assert.Equal(t, int32(4), age)
+	assert.False(t, patch.Age.IsNull())
+
+	assert.False(t, patch.Weight.IsSpecified())
+	assert.False(t, patch.Weight.IsNull())
+	assert.Nil(t, patch.Weight)
+
+	// Unspecified properties are omitted, and null ones kept
+	buf, err := json.Marshal(patch)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"version": 3, "name": null, "age": 4}`, string(buf))
+
+	patch.Name.Set("Rex")
+	patch.Age.SetUnspecified()
+	patch.Weight.SetNull()
+	buf, err = json.Marshal(patch)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"version": 3, "name": "Rex", "weight": null}`, string(buf))
+
+	// Required properties are always there, even unspecified
+	buf, err = json.Marshal(PetPatch{})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"version": null}`, string(buf))
+}
+
+func TestNullableTypes(t *testing.T) {
+	lastSeen := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	patch := PetPatch{
+		Birthday:   NewNullableDate(openapi_types.Date{Time: time.Date(2019, 5, 6, 0, 0, 0, 0, time.UTC)}),
+		LastSeen:   NewNullableTime(lastSeen),
+		Tags:       NewNullableStringArray([]string{"a", "b"}),
+		Vaccinated: NewNullNullableBool(),
+		Version:    NewNullableInt64(1),
+	}
+	buf, err := json.Marshal(patch)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"birthday": "2019-05-06", "lastSeen": "2020-01-02T03:04:05Z",
+		"tags": ["a", "b"], "vaccinated": null, "version": 1}`, string(buf))
+
+	var got PetPatch
+	require.NoError(t, json.Unmarshal(buf, &got))
+	assert.Equal(t, patch, got)
+}
+
+func TestNullableParameters(t *testing.T) {
+	// Nullable properties of parameters are styled and bound as their values
+	filter := Filter{
+		Name:   NewNullableString("rex"),
+		MinAge: NewNullNullableInt(),
+	}
+	styled, err := runtime.StyleParam("simple", false, "filter", filter)
+	require.NoError(t, err)
+	assert.Equal(t, "minAge,,name,rex", styled)
+
+	// Null values are empty, which bind back to null
+	var bound Filter
+	err = runtime.BindStyledParameter("simple", false, "filter", styled, &bound)
+	require.NoError(t, err)
+	assert.Equal(t, filter, bound)
+
+	styled, err = runtime.StyleParam("deepObject", true, "filter", filter)
+	require.NoError(t, err)
+	assert.Equal(t, "filter[minAge]=&filter[name]=rex", styled)
+
+	// Unspecified values are left out
+	styled, err = runtime.StyleParam("form", true, "filter", Filter{MinAge: NewNullableInt(5)})
+	require.NoError(t, err)
+	assert.Equal(t, "minAge=5", styled)
+}
+
+type server struct {
+	id     int64
+	params PatchPetParams
+}
+
+func (s *server) PatchPet(ctx echo.Context, id int64, params PatchPetParams) error {
+	s.id = id
+	s.params = params
+	var patch PetPatch
+	if err := ctx.Bind(&patch); err != nil {
+		return err
+	}
+	return ctx.JSON(http.StatusOK, patch)
+}
+
+func TestNullableRoundTrip(t *testing.T) {
+	var s server
+	e := echo.New()
+	RegisterHandlers(e, &s)
+	ts := httptest.NewServer(e)
+	defer ts.Close()
+
+	client, err := NewClientWithResponses(ts.URL)
+	require.NoError(t, err)
+
+	patch := PetPatch{
+		Name:    NewNullNullableString(),
+		Age:     NewNullableInt32(5),
+		Version: NewNullableInt64(2),
+	}
+	body, err := json.Marshal(patch)
+	require.NoError(t, err)
+	params := PatchPetParams{Filter: &Filter{Name: NewNullableString("rex")}}
+
+	rsp, err := client.PatchPetWithBodyWithResponse(context.Background(), 7, &params,
+		"application/json", bytes.NewReader(body))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rsp.StatusCode(), string(rsp.Body))
+	require.NotNil(t, rsp.JSON200)
+	assert.Equal(t, patch, *rsp.JSON200)
+
+	assert.Equal(t, int64(7), s.id)
+	require.NotNil(t, s.params.Filter)
+	assert.Equal(t, Filter{Name: NewNullableString("rex")}, *s.params.Filter)
+}
@@ -10,13 +10,19 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
-	"github.com/deepmap/oapi-codegen/pkg/runtime"
 	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/indigonote/oapi-codegen/pkg/runtime"
 	"github.com/labstack/echo/v4"
+	"io"
 	"io/ioutil"
+	"math/rand"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 )
 
 // ComplexObject defines model for ComplexObject.
@@ -114,439 +120,631 @@ type GetQueryFormParams struct {
 	Co *ComplexObject `json:"co,omitempty"`
 }
 
-// RequestEditorFn  is the function signature for the RequestEditor callback function
-type RequestEditorFn func(ctx context.Context, req *http.Request) error
+// GetContentObjectURL returns the URL of GetContentObject on server, with its parameters
+// serialized as the client serializes them.
+func GetContentObjectURL(server string, param ComplexObject) (*url.URL, error) {
+	var err error
 
-// Doer performs HTTP requests.
-//
-// The standard http.Client implements this interface.
-type HttpRequestDoer interface {
-	Do(req *http.Request) (*http.Response, error)
+	var pathParam0 string
+
+	var pathParamBuf0 []byte
+	pathParamBuf0, err = json.Marshal(param)
+	if err != nil {
+		return nil, err
+	}
+	pathParam0 = string(pathParamBuf0)
+
+	queryUrl, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	basePath := fmt.Sprintf("/contentObject/%s", pathParam0)
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
+	}
+
+	queryUrl, err = queryUrl.Parse(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return queryUrl, nil
 }
 
-// Client which conforms to the OpenAPI3 specification for this service.
-type Client struct {
-	// The endpoint of the server conforming to this interface, with scheme,
-	// https://api.deepmap.com for example.
-	Server string
+// GetCookieURL returns the URL of GetCookie on server, with its parameters
+// serialized as the client serializes them.
+func GetCookieURL(server string) (*url.URL, error) {
+	var err error
 
-	// Doer for performing requests, typically a *http.Client with any
-	// customized settings, such as certificate chains.
-	Client HttpRequestDoer
+	queryUrl, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// A callback for modifying requests which are generated before sending over
-	// the network.
-	RequestEditor RequestEditorFn
+	basePath := fmt.Sprintf("/cookie")
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
+	}
+
+	queryUrl, err = queryUrl.Parse(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return queryUrl, nil
 }
 
-// ClientOption allows setting custom parameters during construction
-type ClientOption func(*Client) error
+// GetHeaderURL returns the URL of GetHeader on server, with its parameters
+// serialized as the client serializes them.
+func GetHeaderURL(server string) (*url.URL, error) {
+	var err error
 
-// Creates a new Client, with reasonable defaults
-func NewClient(server string, opts ...ClientOption) (*Client, error) {
-	// create a client with sane default values
-	client := Client{
-		Server: server,
+	queryUrl, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	// mutate client and add all optional params
-	for _, o := range opts {
-		if err := o(&client); err != nil {
-			return nil, err
-		}
+
+	basePath := fmt.Sprintf("/header")
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
 	}
-	// ensure the server URL always has a trailing slash
-	if !strings.HasSuffix(client.Server, "/") {
-		client.Server += "/"
+
+	queryUrl, err = queryUrl.Parse(basePath)
+	if err != nil {
+		return nil, err
 	}
-	// create httpClient, if not already present
-	if client.Client == nil {
-		client.Client = http.DefaultClient
+
+	return queryUrl, nil
+}
+
+// GetLabelExplodeArrayURL returns the URL of GetLabelExplodeArray on server, with its parameters
+// serialized as the client serializes them.
+func GetLabelExplodeArrayURL(server string, param []int32) (*url.URL, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParam("label", true, "param", param)
+	if err != nil {
+		return nil, err
 	}
-	return &client, nil
+
+	queryUrl, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	basePath := fmt.Sprintf("/labelExplodeArray/%s", pathParam0)
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
+	}
+
+	queryUrl, err = queryUrl.Parse(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return queryUrl, nil
 }
 
-// WithHTTPClient allows overriding the default Doer, which is
-// automatically created using http.Client. This is useful for tests.
-func WithHTTPClient(doer HttpRequestDoer) ClientOption {
-	return func(c *Client) error {
-		c.Client = doer
-		return nil
+// GetLabelExplodeObjectURL returns the URL of GetLabelExplodeObject on server, with its parameters
+// serialized as the client serializes them.
+func GetLabelExplodeObjectURL(server string, param Object) (*url.URL, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParam("label", true, "param", param)
+	if err != nil {
+		return nil, err
+	}
+
+	queryUrl, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	basePath := fmt.Sprintf("/labelExplodeObject/%s", pathParam0)
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
 	}
+
+	queryUrl, err = queryUrl.Parse(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return queryUrl, nil
 }
 
-// WithRequestEditorFn allows setting up a callback function, which will be
-// called right before sending the request. This can be used to mutate the request.
-func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
-	return func(c *Client) error {
-		c.RequestEditor = fn
-		return nil
+// GetLabelNoExplodeArrayURL returns the URL of GetLabelNoExplodeArray on server, with its parameters
+// serialized as the client serializes them.
+func GetLabelNoExplodeArrayURL(server string, param []int32) (*url.URL, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParam("label", false, "param", param)
+	if err != nil {
+		return nil, err
+	}
+
+	queryUrl, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	basePath := fmt.Sprintf("/labelNoExplodeArray/%s", pathParam0)
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
 	}
+
+	queryUrl, err = queryUrl.Parse(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return queryUrl, nil
 }
 
-// The interface specification for the client above.
-type ClientInterface interface {
-	// GetContentObject request
-	GetContentObject(ctx context.Context, param ComplexObject) (*http.Response, error)
+// GetLabelNoExplodeObjectURL returns the URL of GetLabelNoExplodeObject on server, with its parameters
+// serialized as the client serializes them.
+func GetLabelNoExplodeObjectURL(server string, param Object) (*url.URL, error) {
+	var err error
 
-	// GetCookie request
-	GetCookie(ctx context.Context, params *GetCookieParams) (*http.Response, error)
+	var pathParam0 string
 
-	// GetHeader request
-	GetHeader(ctx context.Context, params *GetHeaderParams) (*http.Response, error)
+	pathParam0, err = runtime.StyleParam("label", false, "param", param)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetLabelExplodeArray request
-	GetLabelExplodeArray(ctx context.Context, param []int32) (*http.Response, error)
+	queryUrl, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetLabelExplodeObject request
-	GetLabelExplodeObject(ctx context.Context, param Object) (*http.Response, error)
+	basePath := fmt.Sprintf("/labelNoExplodeObject/%s", pathParam0)
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
+	}
 
-	// GetLabelNoExplodeArray request
-	GetLabelNoExplodeArray(ctx context.Context, param []int32) (*http.Response, error)
+	queryUrl, err = queryUrl.Parse(basePath)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetLabelNoExplodeObject request
-	GetLabelNoExplodeObject(ctx context.Context, param Object) (*http.Response, error)
+	return queryUrl, nil
+}
 
-	// GetMatrixExplodeArray request
-	GetMatrixExplodeArray(ctx context.Context, id []int32) (*http.Response, error)
+// GetMatrixExplodeArrayURL returns the URL of GetMatrixExplodeArray on server, with its parameters
+// serialized as the client serializes them.
+func GetMatrixExplodeArrayURL(server string, id []int32) (*url.URL, error) {
+	var err error
 
-	// GetMatrixExplodeObject request
-	GetMatrixExplodeObject(ctx context.Context, id Object) (*http.Response, error)
+	var pathParam0 string
 
-	// GetMatrixNoExplodeArray request
-	GetMatrixNoExplodeArray(ctx context.Context, id []int32) (*http.Response, error)
+	pathParam0, err = runtime.StyleParam("matrix", true, "id", id)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetMatrixNoExplodeObject request
-	GetMatrixNoExplodeObject(ctx context.Context, id Object) (*http.Response, error)
+	queryUrl, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetPassThrough request
-	GetPassThrough(ctx context.Context, param string) (*http.Response, error)
+	basePath := fmt.Sprintf("/matrixExplodeArray/%s", pathParam0)
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
+	}
 
-	// GetDeepObject request
-	GetDeepObject(ctx context.Context, params *GetDeepObjectParams) (*http.Response, error)
+	queryUrl, err = queryUrl.Parse(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return queryUrl, nil
+}
+
+// GetMatrixExplodeObjectURL returns the URL of GetMatrixExplodeObject on server, with its parameters
+// serialized as the client serializes them.
+func GetMatrixExplodeObjectURL(server string, id Object) (*url.URL, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParam("matrix", true, "id", id)
+	if err != nil {
+		return nil, err
+	}
+
+	queryUrl, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	basePath := fmt.Sprintf("/matrixExplodeObject/%s", pathParam0)
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
+	}
+
+	queryUrl, err = queryUrl.Parse(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return queryUrl, nil
+}
+
+// GetMatrixNoExplodeArrayURL returns the URL of GetMatrixNoExplodeArray on server, with its parameters
+// serialized as the client serializes them.
+func GetMatrixNoExplodeArrayURL(server string, id []int32) (*url.URL, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParam("matrix", false, "id", id)
+	if err != nil {
+		return nil, err
+	}
+
+	queryUrl, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	basePath := fmt.Sprintf("/matrixNoExplodeArray/%s", pathParam0)
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
+	}
+
+	queryUrl, err = queryUrl.Parse(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return queryUrl, nil
+}
+
+// GetMatrixNoExplodeObjectURL returns the URL of GetMatrixNoExplodeObject on server, with its parameters
+// serialized as the client serializes them.
+func GetMatrixNoExplodeObjectURL(server string, id Object) (*url.URL, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParam("matrix", false, "id", id)
+	if err != nil {
+		return nil, err
+	}
+
+	queryUrl, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetQueryForm request
-	GetQueryForm(ctx context.Context, params *GetQueryFormParams) (*http.Response, error)
+	basePath := fmt.Sprintf("/matrixNoExplodeObject/%s", pathParam0)
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
+	}
 
-	// GetSimpleExplodeArray request
-	GetSimpleExplodeArray(ctx context.Context, param []int32) (*http.Response, error)
+	queryUrl, err = queryUrl.Parse(basePath)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetSimpleExplodeObject request
-	GetSimpleExplodeObject(ctx context.Context, param Object) (*http.Response, error)
+	return queryUrl, nil
+}
 
-	// GetSimpleNoExplodeArray request
-	GetSimpleNoExplodeArray(ctx context.Context, param []int32) (*http.Response, error)
+// GetPassThroughURL returns the URL of GetPassThrough on server, with its parameters
+// serialized as the client serializes them.
+func GetPassThroughURL(server string, param string) (*url.URL, error) {
+	var err error
 
-	// GetSimpleNoExplodeObject request
-	GetSimpleNoExplodeObject(ctx context.Context, param Object) (*http.Response, error)
+	var pathParam0 string
 
-	// GetSimplePrimitive request
-	GetSimplePrimitive(ctx context.Context, param int32) (*http.Response, error)
-}
+	pathParam0 = param
 
-func (c *Client) GetContentObject(ctx context.Context, param ComplexObject) (*http.Response, error) {
-	req, err := NewGetContentObjectRequest(c.Server, param)
+	queryUrl, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if c.RequestEditor != nil {
-		err = c.RequestEditor(ctx, req)
-		if err != nil {
-			return nil, err
-		}
+
+	basePath := fmt.Sprintf("/passThrough/%s", pathParam0)
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
 	}
-	return c.Client.Do(req)
-}
 
-func (c *Client) GetCookie(ctx context.Context, params *GetCookieParams) (*http.Response, error) {
-	req, err := NewGetCookieRequest(c.Server, params)
+	queryUrl, err = queryUrl.Parse(basePath)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if c.RequestEditor != nil {
-		err = c.RequestEditor(ctx, req)
-		if err != nil {
-			return nil, err
-		}
-	}
-	return c.Client.Do(req)
+
+	return queryUrl, nil
 }
 
-func (c *Client) GetHeader(ctx context.Context, params *GetHeaderParams) (*http.Response, error) {
-	req, err := NewGetHeaderRequest(c.Server, params)
+// GetDeepObjectURL returns the URL of GetDeepObject on server, with its parameters
+// serialized as the client serializes them.
+func GetDeepObjectURL(server string, params *GetDeepObjectParams) (*url.URL, error) {
+	var err error
+
+	queryUrl, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if c.RequestEditor != nil {
-		err = c.RequestEditor(ctx, req)
-		if err != nil {
-			return nil, err
-		}
+
+	basePath := fmt.Sprintf("/queryDeepObject")
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
 	}
-	return c.Client.Do(req)
-}
 
-func (c *Client) GetLabelExplodeArray(ctx context.Context, param []int32) (*http.Response, error) {
-	req, err := NewGetLabelExplodeArrayRequest(c.Server, param)
+	queryUrl, err = queryUrl.Parse(basePath)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if c.RequestEditor != nil {
-		err = c.RequestEditor(ctx, req)
-		if err != nil {
-			return nil, err
+
+	queryValues := queryUrl.Query()
+
+	if queryFrag, err := runtime.StyleParam("deepObject", true, "deepObj", params.DeepObj); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
 		}
 	}
-	return c.Client.Do(req)
+
+	queryUrl.RawQuery = queryValues.Encode()
+
+	return queryUrl, nil
 }
 
-func (c *Client) GetLabelExplodeObject(ctx context.Context, param Object) (*http.Response, error) {
-	req, err := NewGetLabelExplodeObjectRequest(c.Server, param)
+// GetQueryFormURL returns the URL of GetQueryForm on server, with its parameters
+// serialized as the client serializes them.
+func GetQueryFormURL(server string, params *GetQueryFormParams) (*url.URL, error) {
+	var err error
+
+	queryUrl, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if c.RequestEditor != nil {
-		err = c.RequestEditor(ctx, req)
-		if err != nil {
-			return nil, err
-		}
+
+	basePath := fmt.Sprintf("/queryForm")
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
 	}
-	return c.Client.Do(req)
-}
 
-func (c *Client) GetLabelNoExplodeArray(ctx context.Context, param []int32) (*http.Response, error) {
-	req, err := NewGetLabelNoExplodeArrayRequest(c.Server, param)
+	queryUrl, err = queryUrl.Parse(basePath)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if c.RequestEditor != nil {
-		err = c.RequestEditor(ctx, req)
-		if err != nil {
+
+	queryValues := queryUrl.Query()
+
+	if params.Ea != nil {
+
+		if queryFrag, err := runtime.StyleParam("form", true, "ea", *params.Ea); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
 		}
-	}
-	return c.Client.Do(req)
-}
 
-func (c *Client) GetLabelNoExplodeObject(ctx context.Context, param Object) (*http.Response, error) {
-	req, err := NewGetLabelNoExplodeObjectRequest(c.Server, param)
-	if err != nil {
-		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if c.RequestEditor != nil {
-		err = c.RequestEditor(ctx, req)
-		if err != nil {
+
+	if params.A != nil {
+
+		if queryFrag, err := runtime.StyleParam("form", false, "a", *params.A); err != nil {
 			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
 		}
-	}
-	return c.Client.Do(req)
-}
 
-func (c *Client) GetMatrixExplodeArray(ctx context.Context, id []int32) (*http.Response, error) {
-	req, err := NewGetMatrixExplodeArrayRequest(c.Server, id)
-	if err != nil {
-		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if c.RequestEditor != nil {
-		err = c.RequestEditor(ctx, req)
-		if err != nil {
+
+	if params.Eo != nil {
+
+		if queryFrag, err := runtime.StyleParam("form", true, "eo", *params.Eo); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
 		}
+
 	}
-	return c.Client.Do(req)
-}
 
-func (c *Client) GetMatrixExplodeObject(ctx context.Context, id Object) (*http.Response, error) {
-	req, err := NewGetMatrixExplodeObjectRequest(c.Server, id)
-	if err != nil {
-		return nil, err
+	if params.O != nil {
+
+		if queryFrag, err := runtime.StyleParam("form", false, "o", *params.O); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
 	}
-	req = req.WithContext(ctx)
-	if c.RequestEditor != nil {
-		err = c.RequestEditor(ctx, req)
-		if err != nil {
+
+	if params.Ep != nil {
+
+		if queryFrag, err := runtime.StyleParam("form", true, "ep", *params.Ep); err != nil {
 			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
 		}
+
 	}
-	return c.Client.Do(req)
-}
 
-func (c *Client) GetMatrixNoExplodeArray(ctx context.Context, id []int32) (*http.Response, error) {
-	req, err := NewGetMatrixNoExplodeArrayRequest(c.Server, id)
-	if err != nil {
-		return nil, err
+	if params.P != nil {
+
+		if queryFrag, err := runtime.StyleParam("form", false, "p", *params.P); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
 	}
-	req = req.WithContext(ctx)
-	if c.RequestEditor != nil {
-		err = c.RequestEditor(ctx, req)
-		if err != nil {
+
+	if params.Co != nil {
+
+		if queryParamBuf, err := json.Marshal(*params.Co); err != nil {
 			return nil, err
+		} else {
+			queryValues.Add("co", string(queryParamBuf))
 		}
+
 	}
-	return c.Client.Do(req)
+
+	queryUrl.RawQuery = queryValues.Encode()
+
+	return queryUrl, nil
 }
 
-func (c *Client) GetMatrixNoExplodeObject(ctx context.Context, id Object) (*http.Response, error) {
-	req, err := NewGetMatrixNoExplodeObjectRequest(c.Server, id)
+// GetSimpleExplodeArrayURL returns the URL of GetSimpleExplodeArray on server, with its parameters
+// serialized as the client serializes them.
+func GetSimpleExplodeArrayURL(server string, param []int32) (*url.URL, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParam("simple", true, "param", param)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if c.RequestEditor != nil {
-		err = c.RequestEditor(ctx, req)
-		if err != nil {
-			return nil, err
-		}
-	}
-	return c.Client.Do(req)
-}
 
-func (c *Client) GetPassThrough(ctx context.Context, param string) (*http.Response, error) {
-	req, err := NewGetPassThroughRequest(c.Server, param)
+	queryUrl, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if c.RequestEditor != nil {
-		err = c.RequestEditor(ctx, req)
-		if err != nil {
-			return nil, err
-		}
+
+	basePath := fmt.Sprintf("/simpleExplodeArray/%s", pathParam0)
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
 	}
-	return c.Client.Do(req)
-}
 
-func (c *Client) GetDeepObject(ctx context.Context, params *GetDeepObjectParams) (*http.Response, error) {
-	req, err := NewGetDeepObjectRequest(c.Server, params)
+	queryUrl, err = queryUrl.Parse(basePath)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if c.RequestEditor != nil {
-		err = c.RequestEditor(ctx, req)
-		if err != nil {
-			return nil, err
-		}
-	}
-	return c.Client.Do(req)
+
+	return queryUrl, nil
 }
 
-func (c *Client) GetQueryForm(ctx context.Context, params *GetQueryFormParams) (*http.Response, error) {
-	req, err := NewGetQueryFormRequest(c.Server, params)
+// GetSimpleExplodeObjectURL returns the URL of GetSimpleExplodeObject on server, with its parameters
+// serialized as the client serializes them.
+func GetSimpleExplodeObjectURL(server string, param Object) (*url.URL, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParam("simple", true, "param", param)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if c.RequestEditor != nil {
-		err = c.RequestEditor(ctx, req)
-		if err != nil {
-			return nil, err
-		}
-	}
-	return c.Client.Do(req)
-}
 
-func (c *Client) GetSimpleExplodeArray(ctx context.Context, param []int32) (*http.Response, error) {
-	req, err := NewGetSimpleExplodeArrayRequest(c.Server, param)
+	queryUrl, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if c.RequestEditor != nil {
-		err = c.RequestEditor(ctx, req)
-		if err != nil {
-			return nil, err
-		}
+
+	basePath := fmt.Sprintf("/simpleExplodeObject/%s", pathParam0)
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
 	}
-	return c.Client.Do(req)
-}
 
-func (c *Client) GetSimpleExplodeObject(ctx context.Context, param Object) (*http.Response, error) {
-	req, err := NewGetSimpleExplodeObjectRequest(c.Server, param)
+	queryUrl, err = queryUrl.Parse(basePath)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if c.RequestEditor != nil {
-		err = c.RequestEditor(ctx, req)
-		if err != nil {
-			return nil, err
-		}
-	}
-	return c.Client.Do(req)
+
+	return queryUrl, nil
 }
 
-func (c *Client) GetSimpleNoExplodeArray(ctx context.Context, param []int32) (*http.Response, error) {
-	req, err := NewGetSimpleNoExplodeArrayRequest(c.Server, param)
+// GetSimpleNoExplodeArrayURL returns the URL of GetSimpleNoExplodeArray on server, with its parameters
+// serialized as the client serializes them.
+func GetSimpleNoExplodeArrayURL(server string, param []int32) (*url.URL, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParam("simple", false, "param", param)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if c.RequestEditor != nil {
-		err = c.RequestEditor(ctx, req)
-		if err != nil {
-			return nil, err
-		}
-	}
-	return c.Client.Do(req)
-}
 
-func (c *Client) GetSimpleNoExplodeObject(ctx context.Context, param Object) (*http.Response, error) {
-	req, err := NewGetSimpleNoExplodeObjectRequest(c.Server, param)
+	queryUrl, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if c.RequestEditor != nil {
-		err = c.RequestEditor(ctx, req)
-		if err != nil {
-			return nil, err
-		}
+
+	basePath := fmt.Sprintf("/simpleNoExplodeArray/%s", pathParam0)
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
 	}
-	return c.Client.Do(req)
-}
 
-func (c *Client) GetSimplePrimitive(ctx context.Context, param int32) (*http.Response, error) {
-	req, err := NewGetSimplePrimitiveRequest(c.Server, param)
+	queryUrl, err = queryUrl.Parse(basePath)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if c.RequestEditor != nil {
-		err = c.RequestEditor(ctx, req)
-		if err != nil {
-			return nil, err
-		}
-	}
-	return c.Client.Do(req)
+
+	return queryUrl, nil
 }
 
-// NewGetContentObjectRequest generates requests for GetContentObject
-func NewGetContentObjectRequest(server string, param ComplexObject) (*http.Request, error) {
+// GetSimpleNoExplodeObjectURL returns the URL of GetSimpleNoExplodeObject on server, with its parameters
+// serialized as the client serializes them.
+func GetSimpleNoExplodeObjectURL(server string, param Object) (*url.URL, error) {
 	var err error
 
 	var pathParam0 string
 
-	var pathParamBuf0 []byte
-	pathParamBuf0, err = json.Marshal(param)
+	pathParam0, err = runtime.StyleParam("simple", false, "param", param)
 	if err != nil {
 		return nil, err
 	}
-	pathParam0 = string(pathParamBuf0)
 
 	queryUrl, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	basePath := fmt.Sprintf("/contentObject/%s", pathParam0)
+	basePath := fmt.Sprintf("/simpleNoExplodeObject/%s", pathParam0)
 	if basePath[0] == '/' {
 		basePath = basePath[1:]
 	}
@@ -556,24 +754,27 @@ func NewGetContentObjectRequest(server string, param ComplexObject) (*http.Reque
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryUrl.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
+	return queryUrl, nil
 }
 
-// NewGetCookieRequest generates requests for GetCookie
-func NewGetCookieRequest(server string, params *GetCookieParams) (*http.Request, error) {
+// GetSimplePrimitiveURL returns the URL of GetSimplePrimitive on server, with its parameters
+// serialized as the client serializes them.
+func GetSimplePrimitiveURL(server string, param int32) (*url.URL, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParam("simple", false, "param", param)
+	if err != nil {
+		return nil, err
+	}
+
 	queryUrl, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	basePath := fmt.Sprintf("/cookie")
+	basePath := fmt.Sprintf("/simplePrimitive/%s", pathParam0)
 	if basePath[0] == '/' {
 		basePath = basePath[1:]
 	}
@@ -583,453 +784,743 @@ func NewGetCookieRequest(server string, params *GetCookieParams) (*http.Request,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryUrl.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	return queryUrl, nil
+}
 
-	if params.P != nil {
-		var cookieParam0 string
+// OperationRoute describes an operation of the API, whatever the router serving
+// it.
+type OperationRoute struct {
+	Method      string // The HTTP method, eg. GET
+	Path        string // The path template of the spec, eg. /pets/{id}
+	OperationId string // The operation ID, as the names of the generated code use it
+}
 
-		cookieParam0, err = runtime.StyleParam("simple", false, "p", *params.P)
-		if err != nil {
+// OperationRoutes lists the operations of the API, in the order of their paths.
+var OperationRoutes = []OperationRoute{
+	{Method: "GET", Path: "/contentObject/{param}", OperationId: "GetContentObject"},
+	{Method: "GET", Path: "/cookie", OperationId: "GetCookie"},
+	{Method: "GET", Path: "/header", OperationId: "GetHeader"},
+	{Method: "GET", Path: "/labelExplodeArray/{.param*}", OperationId: "GetLabelExplodeArray"},
+	{Method: "GET", Path: "/labelExplodeObject/{.param*}", OperationId: "GetLabelExplodeObject"},
+	{Method: "GET", Path: "/labelNoExplodeArray/{.param}", OperationId: "GetLabelNoExplodeArray"},
+	{Method: "GET", Path: "/labelNoExplodeObject/{.param}", OperationId: "GetLabelNoExplodeObject"},
+	{Method: "GET", Path: "/matrixExplodeArray/{.id*}", OperationId: "GetMatrixExplodeArray"},
+	{Method: "GET", Path: "/matrixExplodeObject/{.id*}", OperationId: "GetMatrixExplodeObject"},
+	{Method: "GET", Path: "/matrixNoExplodeArray/{.id}", OperationId: "GetMatrixNoExplodeArray"},
+	{Method: "GET", Path: "/matrixNoExplodeObject/{.id}", OperationId: "GetMatrixNoExplodeObject"},
+	{Method: "GET", Path: "/passThrough/{param}", OperationId: "GetPassThrough"},
+	{Method: "GET", Path: "/queryDeepObject", OperationId: "GetDeepObject"},
+	{Method: "GET", Path: "/queryForm", OperationId: "GetQueryForm"},
+	{Method: "GET", Path: "/simpleExplodeArray/{param*}", OperationId: "GetSimpleExplodeArray"},
+	{Method: "GET", Path: "/simpleExplodeObject/{param*}", OperationId: "GetSimpleExplodeObject"},
+	{Method: "GET", Path: "/simpleNoExplodeArray/{param}", OperationId: "GetSimpleNoExplodeArray"},
+	{Method: "GET", Path: "/simpleNoExplodeObject/{param}", OperationId: "GetSimpleNoExplodeObject"},
+	{Method: "GET", Path: "/simplePrimitive/{param}", OperationId: "GetSimplePrimitive"},
+}
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// ResponseEditorFn is the function signature for the ResponseEditor callback
+// function, which may inspect or replace the response before it is parsed
+type ResponseEditorFn func(ctx context.Context, rsp *http.Response) error
+
+// RequestValidatorFn is the function signature for the callback validating
+// requests before they are sent, given the server they were built against
+type RequestValidatorFn func(ctx context.Context, server string, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before
+	// sending over the network, in the order they are run.
+	RequestEditors []RequestEditorFn
+
+	// A callback for modifying requests which are generated before sending over
+	// the network, which runs before RequestEditors.
+	//
+	// Deprecated: use RequestEditors, or WithRequestEditorFn.
+	RequestEditor RequestEditorFn
+
+	// A list of callbacks for inspecting responses before they are returned,
+	// in the order they are run.
+	ResponseEditors []ResponseEditorFn
+
+	// A callback validating requests once the request editors ran, requests
+	// failing it aren't sent. See WithRequestValidation.
+	RequestValidator RequestValidatorFn
+
+	// How failed requests are retried, they aren't when nil.
+	RetryPolicy *RetryPolicy
+
+	// The servers of the operations which override the servers of the API,
+	// keyed by operation ID, when they aren't the first of their servers. See
+	// WithOperationServer.
+	OperationServers map[string]string
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
 			return nil, err
 		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = http.DefaultClient
+	}
+	return &client, nil
+}
 
-		cookie0 := &http.Cookie{
-			Name:  "p",
-			Value: cookieParam0,
-		}
-		req.AddCookie(cookie0)
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
 	}
+}
 
-	if params.Ep != nil {
-		var cookieParam1 string
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+// It may be given more than once, the callbacks are called in the same order.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
 
-		cookieParam1, err = runtime.StyleParam("simple", true, "ep", *params.Ep)
-		if err != nil {
-			return nil, err
-		}
+// WithResponseEditorFn allows setting up a callback function, which will be
+// called with every response, before it is returned or parsed. It may be
+// given more than once, the callbacks are called in the same order.
+func WithResponseEditorFn(fn ResponseEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.ResponseEditors = append(c.ResponseEditors, fn)
+		return nil
+	}
+}
 
-		cookie1 := &http.Cookie{
-			Name:  "ep",
-			Value: cookieParam1,
+// applyEditors runs the request editors of the client, followed by those
+// given for this call only.
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	if c.RequestEditor != nil {
+		if err := c.RequestEditor(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
 		}
-		req.AddCookie(cookie1)
 	}
+	return nil
+}
 
-	if params.Ea != nil {
-		var cookieParam2 string
+// RetryPolicy describes how the client retries requests which failed with a
+// network error, a 5xx or a 429 response.
+type RetryPolicy struct {
+	// The number of retries after the first attempt.
+	MaxRetries int
 
-		cookieParam2, err = runtime.StyleParam("simple", true, "ea", *params.Ea)
-		if err != nil {
-			return nil, err
-		}
+	// The backoff before the first retry, it doubles for each of the
+	// following ones, with some jitter so that clients don't retry in lockstep.
+	MinBackoff time.Duration
 
-		cookie2 := &http.Cookie{
-			Name:  "ea",
-			Value: cookieParam2,
-		}
-		req.AddCookie(cookie2)
+	// The upper bound of any backoff, including those asked for by the server
+	// through a Retry-After header.
+	MaxBackoff time.Duration
+
+	// Whether to retry operations which aren't idempotent. Operations are
+	// idempotent when their method is, or when marked with x-idempotent.
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryPolicy returns a policy retrying idempotent operations three
+// times, backing off from 100ms up to 10s.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries: 3,
+		MinBackoff: 100 * time.Millisecond,
+		MaxBackoff: 10 * time.Second,
 	}
+}
 
-	if params.A != nil {
-		var cookieParam3 string
+// WithRetryPolicy allows retrying failed requests. Request bodies are buffered
+// so that they can be sent again.
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(c *Client) error {
+		c.RetryPolicy = &policy
+		return nil
+	}
+}
 
-		cookieParam3, err = runtime.StyleParam("simple", false, "a", *params.A)
-		if err != nil {
-			return nil, err
-		}
+// retryJitter randomizes backoffs, it isn't safe for concurrent use.
+var (
+	retryJitter   = rand.New(rand.NewSource(time.Now().UnixNano()))
+	retryJitterMu sync.Mutex
+)
 
-		cookie3 := &http.Cookie{
-			Name:  "a",
-			Value: cookieParam3,
+// do sends the request, and runs the response editors of the client on its
+// response.
+func (c *Client) do(req *http.Request, idempotent bool) (*http.Response, error) {
+	rsp, err := c.send(req, idempotent)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range c.ResponseEditors {
+		if err := r(req.Context(), rsp); err != nil {
+			rsp.Body.Close()
+			return nil, err
 		}
-		req.AddCookie(cookie3)
 	}
+	return rsp, nil
+}
 
-	if params.Eo != nil {
-		var cookieParam4 string
+// send sends the request, retrying it according to the retry policy of the
+// client.
+func (c *Client) send(req *http.Request, idempotent bool) (*http.Response, error) {
+	policy := c.RetryPolicy
+	if policy == nil || policy.MaxRetries <= 0 || !(idempotent || policy.RetryNonIdempotent) {
+		return c.Client.Do(req)
+	}
 
-		cookieParam4, err = runtime.StyleParam("simple", true, "eo", *params.Eo)
+	// The body is consumed by each attempt, so we need a fresh one for every
+	// retry.
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		buf, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
 		if err != nil {
 			return nil, err
 		}
-
-		cookie4 := &http.Cookie{
-			Name:  "eo",
-			Value: cookieParam4,
+		req.GetBody = func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(bytes.NewReader(buf)), nil
 		}
-		req.AddCookie(cookie4)
+		req.Body, _ = req.GetBody()
 	}
 
-	if params.O != nil {
-		var cookieParam5 string
-
-		cookieParam5, err = runtime.StyleParam("simple", false, "o", *params.O)
-		if err != nil {
-			return nil, err
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+		rsp, err := c.Client.Do(req)
+		if attempt >= policy.MaxRetries || !shouldRetry(req, rsp, err) {
+			return rsp, err
 		}
 
-		cookie5 := &http.Cookie{
-			Name:  "o",
-			Value: cookieParam5,
+		backoff := policy.backoff(attempt, rsp)
+		if rsp != nil {
+			// Drain the body, so that the connection can be reused.
+			io.Copy(ioutil.Discard, rsp.Body)
+			rsp.Body.Close()
+		}
+		timer := time.NewTimer(backoff)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
 		}
-		req.AddCookie(cookie5)
 	}
+}
 
-	if params.Co != nil {
-		var cookieParam6 string
+func shouldRetry(req *http.Request, rsp *http.Response, err error) bool {
+	if err != nil {
+		// Nothing to retry once the caller gave up.
+		return req.Context().Err() == nil
+	}
+	return rsp.StatusCode == http.StatusTooManyRequests || rsp.StatusCode >= 500
+}
 
-		var cookieParamBuf6 []byte
-		cookieParamBuf6, err = json.Marshal(*params.Co)
-		if err != nil {
-			return nil, err
+// backoff returns how long to wait before the given retry, preferring the
+// delay asked for by the Retry-After header of the response.
+func (p *RetryPolicy) backoff(attempt int, rsp *http.Response) time.Duration {
+	if rsp != nil {
+		if after, ok := retryAfter(rsp.Header.Get("Retry-After")); ok {
+			if p.MaxBackoff > 0 && after > p.MaxBackoff {
+				return p.MaxBackoff
+			}
+			return after
 		}
-		cookieParam6 = url.QueryEscape(string(cookieParamBuf6))
+	}
 
-		cookie6 := &http.Cookie{
-			Name:  "co",
-			Value: cookieParam6,
+	backoff := p.MinBackoff << uint(attempt)
+	if backoff <= 0 || (p.MaxBackoff > 0 && backoff > p.MaxBackoff) {
+		backoff = p.MaxBackoff
+	}
+	if backoff <= 0 {
+		return 0
+	}
+	// Wait somewhere between half and all of the backoff.
+	retryJitterMu.Lock()
+	jitter := time.Duration(retryJitter.Int63n(int64(backoff)/2 + 1))
+	retryJitterMu.Unlock()
+	return backoff/2 + jitter
+}
+
+// retryAfter parses a Retry-After header, which either holds a number of
+// seconds or a date.
+func retryAfter(header string) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(header); err == nil {
+		if seconds < 0 {
+			return 0, false
 		}
-		req.AddCookie(cookie6)
+		return time.Duration(seconds) * time.Second, true
 	}
+	if date, err := http.ParseTime(header); err == nil {
+		after := time.Until(date)
+		if after < 0 {
+			after = 0
+		}
+		return after, true
+	}
+	return 0, false
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// GetContentObject request
+	GetContentObject(ctx context.Context, param ComplexObject, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCookie request
+	GetCookie(ctx context.Context, params *GetCookieParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetHeader request
+	GetHeader(ctx context.Context, params *GetHeaderParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetLabelExplodeArray request
+	GetLabelExplodeArray(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetLabelExplodeObject request
+	GetLabelExplodeObject(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetLabelNoExplodeArray request
+	GetLabelNoExplodeArray(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetLabelNoExplodeObject request
+	GetLabelNoExplodeObject(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetMatrixExplodeArray request
+	GetMatrixExplodeArray(ctx context.Context, id []int32, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetMatrixExplodeObject request
+	GetMatrixExplodeObject(ctx context.Context, id Object, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetMatrixNoExplodeArray request
+	GetMatrixNoExplodeArray(ctx context.Context, id []int32, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetMatrixNoExplodeObject request
+	GetMatrixNoExplodeObject(ctx context.Context, id Object, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPassThrough request
+	GetPassThrough(ctx context.Context, param string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDeepObject request
+	GetDeepObject(ctx context.Context, params *GetDeepObjectParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetQueryForm request
+	GetQueryForm(ctx context.Context, params *GetQueryFormParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSimpleExplodeArray request
+	GetSimpleExplodeArray(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSimpleExplodeObject request
+	GetSimpleExplodeObject(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	return req, nil
-}
+	// GetSimpleNoExplodeArray request
+	GetSimpleNoExplodeArray(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-// NewGetHeaderRequest generates requests for GetHeader
-func NewGetHeaderRequest(server string, params *GetHeaderParams) (*http.Request, error) {
-	var err error
+	// GetSimpleNoExplodeObject request
+	GetSimpleNoExplodeObject(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	queryUrl, err := url.Parse(server)
+	// GetSimplePrimitive request
+	GetSimplePrimitive(ctx context.Context, param int32, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) GetContentObject(ctx context.Context, param ComplexObject, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server := c.Server
+	req, err := NewGetContentObjectRequest(server, param)
 	if err != nil {
 		return nil, err
 	}
-
-	basePath := fmt.Sprintf("/header")
-	if basePath[0] == '/' {
-		basePath = basePath[1:]
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
+			return nil, err
+		}
 	}
+	return c.do(req, true)
+}
 
-	queryUrl, err = queryUrl.Parse(basePath)
+func (c *Client) GetCookie(ctx context.Context, params *GetCookieParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server := c.Server
+	req, err := NewGetCookieRequest(server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryUrl.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	if params.XPrimitive != nil {
-		var headerParam0 string
-
-		headerParam0, err = runtime.StyleParam("simple", false, "X-Primitive", *params.XPrimitive)
-		if err != nil {
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
 			return nil, err
 		}
-
-		req.Header.Add("X-Primitive", headerParam0)
 	}
+	return c.do(req, true)
+}
 
-	if params.XPrimitiveExploded != nil {
-		var headerParam1 string
-
-		headerParam1, err = runtime.StyleParam("simple", true, "X-Primitive-Exploded", *params.XPrimitiveExploded)
-		if err != nil {
-			return nil, err
-		}
-
-		req.Header.Add("X-Primitive-Exploded", headerParam1)
+func (c *Client) GetHeader(ctx context.Context, params *GetHeaderParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server := c.Server
+	req, err := NewGetHeaderRequest(server, params)
+	if err != nil {
+		return nil, err
 	}
-
-	if params.XArrayExploded != nil {
-		var headerParam2 string
-
-		headerParam2, err = runtime.StyleParam("simple", true, "X-Array-Exploded", *params.XArrayExploded)
-		if err != nil {
-			return nil, err
-		}
-
-		req.Header.Add("X-Array-Exploded", headerParam2)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-
-	if params.XArray != nil {
-		var headerParam3 string
-
-		headerParam3, err = runtime.StyleParam("simple", false, "X-Array", *params.XArray)
-		if err != nil {
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
 			return nil, err
 		}
-
-		req.Header.Add("X-Array", headerParam3)
 	}
+	return c.do(req, true)
+}
 
-	if params.XObjectExploded != nil {
-		var headerParam4 string
-
-		headerParam4, err = runtime.StyleParam("simple", true, "X-Object-Exploded", *params.XObjectExploded)
-		if err != nil {
-			return nil, err
-		}
-
-		req.Header.Add("X-Object-Exploded", headerParam4)
+func (c *Client) GetLabelExplodeArray(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server := c.Server
+	req, err := NewGetLabelExplodeArrayRequest(server, param)
+	if err != nil {
+		return nil, err
 	}
-
-	if params.XObject != nil {
-		var headerParam5 string
-
-		headerParam5, err = runtime.StyleParam("simple", false, "X-Object", *params.XObject)
-		if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
 			return nil, err
 		}
-
-		req.Header.Add("X-Object", headerParam5)
 	}
+	return c.do(req, true)
+}
 
-	if params.XComplexObject != nil {
-		var headerParam6 string
-
-		var headerParamBuf6 []byte
-		headerParamBuf6, err = json.Marshal(*params.XComplexObject)
-		if err != nil {
+func (c *Client) GetLabelExplodeObject(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server := c.Server
+	req, err := NewGetLabelExplodeObjectRequest(server, param)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
 			return nil, err
 		}
-		headerParam6 = string(headerParamBuf6)
-
-		req.Header.Add("X-Complex-Object", headerParam6)
 	}
-
-	return req, nil
+	return c.do(req, true)
 }
 
-// NewGetLabelExplodeArrayRequest generates requests for GetLabelExplodeArray
-func NewGetLabelExplodeArrayRequest(server string, param []int32) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParam("label", true, "param", param)
+func (c *Client) GetLabelNoExplodeArray(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server := c.Server
+	req, err := NewGetLabelNoExplodeArrayRequest(server, param)
 	if err != nil {
 		return nil, err
 	}
-
-	queryUrl, err := url.Parse(server)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	basePath := fmt.Sprintf("/labelExplodeArray/%s", pathParam0)
-	if basePath[0] == '/' {
-		basePath = basePath[1:]
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
+			return nil, err
+		}
 	}
+	return c.do(req, true)
+}
 
-	queryUrl, err = queryUrl.Parse(basePath)
+func (c *Client) GetLabelNoExplodeObject(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server := c.Server
+	req, err := NewGetLabelNoExplodeObjectRequest(server, param)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryUrl.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
+			return nil, err
+		}
+	}
+	return c.do(req, true)
 }
 
-// NewGetLabelExplodeObjectRequest generates requests for GetLabelExplodeObject
-func NewGetLabelExplodeObjectRequest(server string, param Object) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParam("label", true, "param", param)
+func (c *Client) GetMatrixExplodeArray(ctx context.Context, id []int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server := c.Server
+	req, err := NewGetMatrixExplodeArrayRequest(server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	queryUrl, err := url.Parse(server)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	basePath := fmt.Sprintf("/labelExplodeObject/%s", pathParam0)
-	if basePath[0] == '/' {
-		basePath = basePath[1:]
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
+			return nil, err
+		}
 	}
+	return c.do(req, true)
+}
 
-	queryUrl, err = queryUrl.Parse(basePath)
+func (c *Client) GetMatrixExplodeObject(ctx context.Context, id Object, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server := c.Server
+	req, err := NewGetMatrixExplodeObjectRequest(server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryUrl.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
+			return nil, err
+		}
+	}
+	return c.do(req, true)
 }
 
-// NewGetLabelNoExplodeArrayRequest generates requests for GetLabelNoExplodeArray
-func NewGetLabelNoExplodeArrayRequest(server string, param []int32) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParam("label", false, "param", param)
+func (c *Client) GetMatrixNoExplodeArray(ctx context.Context, id []int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server := c.Server
+	req, err := NewGetMatrixNoExplodeArrayRequest(server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	queryUrl, err := url.Parse(server)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	basePath := fmt.Sprintf("/labelNoExplodeArray/%s", pathParam0)
-	if basePath[0] == '/' {
-		basePath = basePath[1:]
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
+			return nil, err
+		}
 	}
+	return c.do(req, true)
+}
 
-	queryUrl, err = queryUrl.Parse(basePath)
+func (c *Client) GetMatrixNoExplodeObject(ctx context.Context, id Object, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server := c.Server
+	req, err := NewGetMatrixNoExplodeObjectRequest(server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryUrl.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
+			return nil, err
+		}
+	}
+	return c.do(req, true)
 }
 
-// NewGetLabelNoExplodeObjectRequest generates requests for GetLabelNoExplodeObject
-func NewGetLabelNoExplodeObjectRequest(server string, param Object) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParam("label", false, "param", param)
+func (c *Client) GetPassThrough(ctx context.Context, param string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server := c.Server
+	req, err := NewGetPassThroughRequest(server, param)
 	if err != nil {
 		return nil, err
 	}
-
-	queryUrl, err := url.Parse(server)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	basePath := fmt.Sprintf("/labelNoExplodeObject/%s", pathParam0)
-	if basePath[0] == '/' {
-		basePath = basePath[1:]
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
+			return nil, err
+		}
 	}
+	return c.do(req, true)
+}
 
-	queryUrl, err = queryUrl.Parse(basePath)
+func (c *Client) GetDeepObject(ctx context.Context, params *GetDeepObjectParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server := c.Server
+	req, err := NewGetDeepObjectRequest(server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryUrl.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
+			return nil, err
+		}
+	}
+	return c.do(req, true)
 }
 
-// NewGetMatrixExplodeArrayRequest generates requests for GetMatrixExplodeArray
-func NewGetMatrixExplodeArrayRequest(server string, id []int32) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParam("matrix", true, "id", id)
+func (c *Client) GetQueryForm(ctx context.Context, params *GetQueryFormParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server := c.Server
+	req, err := NewGetQueryFormRequest(server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	queryUrl, err := url.Parse(server)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	basePath := fmt.Sprintf("/matrixExplodeArray/%s", pathParam0)
-	if basePath[0] == '/' {
-		basePath = basePath[1:]
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
+			return nil, err
+		}
 	}
+	return c.do(req, true)
+}
 
-	queryUrl, err = queryUrl.Parse(basePath)
+func (c *Client) GetSimpleExplodeArray(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server := c.Server
+	req, err := NewGetSimpleExplodeArrayRequest(server, param)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryUrl.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
+			return nil, err
+		}
+	}
+	return c.do(req, true)
 }
 
-// NewGetMatrixExplodeObjectRequest generates requests for GetMatrixExplodeObject
-func NewGetMatrixExplodeObjectRequest(server string, id Object) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParam("matrix", true, "id", id)
+func (c *Client) GetSimpleExplodeObject(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server := c.Server
+	req, err := NewGetSimpleExplodeObjectRequest(server, param)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
+			return nil, err
+		}
+	}
+	return c.do(req, true)
+}
 
-	queryUrl, err := url.Parse(server)
+func (c *Client) GetSimpleNoExplodeArray(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server := c.Server
+	req, err := NewGetSimpleNoExplodeArrayRequest(server, param)
 	if err != nil {
 		return nil, err
 	}
-
-	basePath := fmt.Sprintf("/matrixExplodeObject/%s", pathParam0)
-	if basePath[0] == '/' {
-		basePath = basePath[1:]
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
+			return nil, err
+		}
 	}
+	return c.do(req, true)
+}
 
-	queryUrl, err = queryUrl.Parse(basePath)
+func (c *Client) GetSimpleNoExplodeObject(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server := c.Server
+	req, err := NewGetSimpleNoExplodeObjectRequest(server, param)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryUrl.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
+			return nil, err
+		}
+	}
+	return c.do(req, true)
 }
 
-// NewGetMatrixNoExplodeArrayRequest generates requests for GetMatrixNoExplodeArray
-func NewGetMatrixNoExplodeArrayRequest(server string, id []int32) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParam("matrix", false, "id", id)
+func (c *Client) GetSimplePrimitive(ctx context.Context, param int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server := c.Server
+	req, err := NewGetSimplePrimitiveRequest(server, param)
 	if err != nil {
 		return nil, err
 	}
-
-	queryUrl, err := url.Parse(server)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	basePath := fmt.Sprintf("/matrixNoExplodeArray/%s", pathParam0)
-	if basePath[0] == '/' {
-		basePath = basePath[1:]
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
+			return nil, err
+		}
 	}
+	return c.do(req, true)
+}
 
-	queryUrl, err = queryUrl.Parse(basePath)
+// NewGetContentObjectRequest generates requests for GetContentObject
+func NewGetContentObjectRequest(server string, param ComplexObject) (*http.Request, error) {
+	queryUrl, err := GetContentObjectURL(server, param)
 	if err != nil {
 		return nil, err
 	}
@@ -1042,242 +1533,218 @@ func NewGetMatrixNoExplodeArrayRequest(server string, id []int32) (*http.Request
 	return req, nil
 }
 
-// NewGetMatrixNoExplodeObjectRequest generates requests for GetMatrixNoExplodeObject
-func NewGetMatrixNoExplodeObjectRequest(server string, id Object) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParam("matrix", false, "id", id)
+// NewGetCookieRequest generates requests for GetCookie
+func NewGetCookieRequest(server string, params *GetCookieParams) (*http.Request, error) {
+	queryUrl, err := GetCookieURL(server)
 	if err != nil {
 		return nil, err
 	}
 
-	queryUrl, err := url.Parse(server)
+	req, err := http.NewRequest("GET", queryUrl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	basePath := fmt.Sprintf("/matrixNoExplodeObject/%s", pathParam0)
-	if basePath[0] == '/' {
-		basePath = basePath[1:]
-	}
+	if params.P != nil {
 
-	queryUrl, err = queryUrl.Parse(basePath)
-	if err != nil {
-		return nil, err
-	}
+		var cookies0 []*http.Cookie
+		cookies0, err = runtime.StyleCookieParam("form", false, "p", *params.P)
+		if err != nil {
+			return nil, err
+		}
+		for _, cookie := range cookies0 {
+			req.AddCookie(cookie)
+		}
 
-	req, err := http.NewRequest("GET", queryUrl.String(), nil)
-	if err != nil {
-		return nil, err
 	}
 
-	return req, nil
-}
+	if params.Ep != nil {
 
-// NewGetPassThroughRequest generates requests for GetPassThrough
-func NewGetPassThroughRequest(server string, param string) (*http.Request, error) {
-	var err error
+		var cookies1 []*http.Cookie
+		cookies1, err = runtime.StyleCookieParam("form", true, "ep", *params.Ep)
+		if err != nil {
+			return nil, err
+		}
+		for _, cookie := range cookies1 {
+			req.AddCookie(cookie)
+		}
 
-	var pathParam0 string
+	}
 
-	pathParam0 = param
+	if params.Ea != nil {
 
-	queryUrl, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+		var cookies2 []*http.Cookie
+		cookies2, err = runtime.StyleCookieParam("form", true, "ea", *params.Ea)
+		if err != nil {
+			return nil, err
+		}
+		for _, cookie := range cookies2 {
+			req.AddCookie(cookie)
+		}
 
-	basePath := fmt.Sprintf("/passThrough/%s", pathParam0)
-	if basePath[0] == '/' {
-		basePath = basePath[1:]
 	}
 
-	queryUrl, err = queryUrl.Parse(basePath)
-	if err != nil {
-		return nil, err
-	}
+	if params.A != nil {
+
+		var cookies3 []*http.Cookie
+		cookies3, err = runtime.StyleCookieParam("form", false, "a", *params.A)
+		if err != nil {
+			return nil, err
+		}
+		for _, cookie := range cookies3 {
+			req.AddCookie(cookie)
+		}
 
-	req, err := http.NewRequest("GET", queryUrl.String(), nil)
-	if err != nil {
-		return nil, err
 	}
 
-	return req, nil
-}
+	if params.Eo != nil {
 
-// NewGetDeepObjectRequest generates requests for GetDeepObject
-func NewGetDeepObjectRequest(server string, params *GetDeepObjectParams) (*http.Request, error) {
-	var err error
+		var cookies4 []*http.Cookie
+		cookies4, err = runtime.StyleCookieParam("form", true, "eo", *params.Eo)
+		if err != nil {
+			return nil, err
+		}
+		for _, cookie := range cookies4 {
+			req.AddCookie(cookie)
+		}
 
-	queryUrl, err := url.Parse(server)
-	if err != nil {
-		return nil, err
 	}
 
-	basePath := fmt.Sprintf("/queryDeepObject")
-	if basePath[0] == '/' {
-		basePath = basePath[1:]
-	}
+	if params.O != nil {
+
+		var cookies5 []*http.Cookie
+		cookies5, err = runtime.StyleCookieParam("form", false, "o", *params.O)
+		if err != nil {
+			return nil, err
+		}
+		for _, cookie := range cookies5 {
+			req.AddCookie(cookie)
+		}
 
-	queryUrl, err = queryUrl.Parse(basePath)
-	if err != nil {
-		return nil, err
 	}
 
-	queryValues := queryUrl.Query()
+	if params.Co != nil {
 
-	if queryFrag, err := runtime.StyleParam("deepObject", true, "deepObj", params.DeepObj); err != nil {
-		return nil, err
-	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-		return nil, err
-	} else {
-		for k, v := range parsed {
-			for _, v2 := range v {
-				queryValues.Add(k, v2)
-			}
+		var cookieParam6 string
+
+		var cookieParamBuf6 []byte
+		cookieParamBuf6, err = json.Marshal(*params.Co)
+		if err != nil {
+			return nil, err
 		}
-	}
+		cookieParam6 = url.QueryEscape(string(cookieParamBuf6))
 
-	queryUrl.RawQuery = queryValues.Encode()
+		cookie6 := &http.Cookie{
+			Name:  "co",
+			Value: cookieParam6,
+		}
+		req.AddCookie(cookie6)
 
-	req, err := http.NewRequest("GET", queryUrl.String(), nil)
-	if err != nil {
-		return nil, err
 	}
 
 	return req, nil
 }
 
-// NewGetQueryFormRequest generates requests for GetQueryForm
-func NewGetQueryFormRequest(server string, params *GetQueryFormParams) (*http.Request, error) {
-	var err error
-
-	queryUrl, err := url.Parse(server)
+// NewGetHeaderRequest generates requests for GetHeader
+func NewGetHeaderRequest(server string, params *GetHeaderParams) (*http.Request, error) {
+	queryUrl, err := GetHeaderURL(server)
 	if err != nil {
 		return nil, err
 	}
 
-	basePath := fmt.Sprintf("/queryForm")
-	if basePath[0] == '/' {
-		basePath = basePath[1:]
-	}
-
-	queryUrl, err = queryUrl.Parse(basePath)
+	req, err := http.NewRequest("GET", queryUrl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	queryValues := queryUrl.Query()
-
-	if params.Ea != nil {
+	if params.XPrimitive != nil {
+		var headerParam0 string
 
-		if queryFrag, err := runtime.StyleParam("form", true, "ea", *params.Ea); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		headerParam0, err = runtime.StyleParam("simple", false, "X-Primitive", *params.XPrimitive)
+		if err != nil {
 			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
 		}
 
+		req.Header.Add("X-Primitive", headerParam0)
 	}
 
-	if params.A != nil {
+	if params.XPrimitiveExploded != nil {
+		var headerParam1 string
 
-		if queryFrag, err := runtime.StyleParam("form", false, "a", *params.A); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		headerParam1, err = runtime.StyleParam("simple", true, "X-Primitive-Exploded", *params.XPrimitiveExploded)
+		if err != nil {
 			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
 		}
 
+		req.Header.Add("X-Primitive-Exploded", headerParam1)
 	}
 
-	if params.Eo != nil {
+	if params.XArrayExploded != nil {
+		var headerParam2 string
 
-		if queryFrag, err := runtime.StyleParam("form", true, "eo", *params.Eo); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		headerParam2, err = runtime.StyleParam("simple", true, "X-Array-Exploded", *params.XArrayExploded)
+		if err != nil {
 			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
 		}
 
+		req.Header.Add("X-Array-Exploded", headerParam2)
 	}
 
-	if params.O != nil {
+	if params.XArray != nil {
+		var headerParam3 string
 
-		if queryFrag, err := runtime.StyleParam("form", false, "o", *params.O); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		headerParam3, err = runtime.StyleParam("simple", false, "X-Array", *params.XArray)
+		if err != nil {
 			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
 		}
 
+		req.Header.Add("X-Array", headerParam3)
 	}
 
-	if params.Ep != nil {
+	if params.XObjectExploded != nil {
+		var headerParam4 string
 
-		if queryFrag, err := runtime.StyleParam("form", true, "ep", *params.Ep); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
+		headerParam4, err = runtime.StyleParam("simple", true, "X-Object-Exploded", *params.XObjectExploded)
+		if err != nil {
+			return nil, err
 		}
 
+		req.Header.Add("X-Object-Exploded", headerParam4)
 	}
 
-	if params.P != nil {
+	if params.XObject != nil {
+		var headerParam5 string
 
-		if queryFrag, err := runtime.StyleParam("form", false, "p", *params.P); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		headerParam5, err = runtime.StyleParam("simple", false, "X-Object", *params.XObject)
+		if err != nil {
 			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
 		}
 
+		req.Header.Add("X-Object", headerParam5)
 	}
 
-	if params.Co != nil {
+	if params.XComplexObject != nil {
+		var headerParam6 string
 
-		if queryParamBuf, err := json.Marshal(*params.Co); err != nil {
+		var headerParamBuf6 []byte
+		headerParamBuf6, err = json.Marshal(*params.XComplexObject)
+		if err != nil {
 			return nil, err
-		} else {
-			queryValues.Add("co", string(queryParamBuf))
 		}
+		headerParam6 = string(headerParamBuf6)
 
+		req.Header.Add("X-Complex-Object", headerParam6)
 	}
 
-	queryUrl.RawQuery = queryValues.Encode()
+	return req, nil
+}
+
+// NewGetLabelExplodeArrayRequest generates requests for GetLabelExplodeArray
+func NewGetLabelExplodeArrayRequest(server string, param []int32) (*http.Request, error) {
+	queryUrl, err := GetLabelExplodeArrayURL(server, param)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := http.NewRequest("GET", queryUrl.String(), nil)
 	if err != nil {
@@ -1287,28 +1754,39 @@ func NewGetQueryFormRequest(server string, params *GetQueryFormParams) (*http.Re
 	return req, nil
 }
 
-// NewGetSimpleExplodeArrayRequest generates requests for GetSimpleExplodeArray
-func NewGetSimpleExplodeArrayRequest(server string, param []int32) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
+// NewGetLabelExplodeObjectRequest generates requests for GetLabelExplodeObject
+func NewGetLabelExplodeObjectRequest(server string, param Object) (*http.Request, error) {
+	queryUrl, err := GetLabelExplodeObjectURL(server, param)
+	if err != nil {
+		return nil, err
+	}
 
-	pathParam0, err = runtime.StyleParam("simple", true, "param", param)
+	req, err := http.NewRequest("GET", queryUrl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	queryUrl, err := url.Parse(server)
+	return req, nil
+}
+
+// NewGetLabelNoExplodeArrayRequest generates requests for GetLabelNoExplodeArray
+func NewGetLabelNoExplodeArrayRequest(server string, param []int32) (*http.Request, error) {
+	queryUrl, err := GetLabelNoExplodeArrayURL(server, param)
 	if err != nil {
 		return nil, err
 	}
 
-	basePath := fmt.Sprintf("/simpleExplodeArray/%s", pathParam0)
-	if basePath[0] == '/' {
-		basePath = basePath[1:]
+	req, err := http.NewRequest("GET", queryUrl.String(), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	queryUrl, err = queryUrl.Parse(basePath)
+	return req, nil
+}
+
+// NewGetLabelNoExplodeObjectRequest generates requests for GetLabelNoExplodeObject
+func NewGetLabelNoExplodeObjectRequest(server string, param Object) (*http.Request, error) {
+	queryUrl, err := GetLabelNoExplodeObjectURL(server, param)
 	if err != nil {
 		return nil, err
 	}
@@ -1321,28 +1799,39 @@ func NewGetSimpleExplodeArrayRequest(server string, param []int32) (*http.Reques
 	return req, nil
 }
 
-// NewGetSimpleExplodeObjectRequest generates requests for GetSimpleExplodeObject
-func NewGetSimpleExplodeObjectRequest(server string, param Object) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
+// NewGetMatrixExplodeArrayRequest generates requests for GetMatrixExplodeArray
+func NewGetMatrixExplodeArrayRequest(server string, id []int32) (*http.Request, error) {
+	queryUrl, err := GetMatrixExplodeArrayURL(server, id)
+	if err != nil {
+		return nil, err
+	}
 
-	pathParam0, err = runtime.StyleParam("simple", true, "param", param)
+	req, err := http.NewRequest("GET", queryUrl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	queryUrl, err := url.Parse(server)
+	return req, nil
+}
+
+// NewGetMatrixExplodeObjectRequest generates requests for GetMatrixExplodeObject
+func NewGetMatrixExplodeObjectRequest(server string, id Object) (*http.Request, error) {
+	queryUrl, err := GetMatrixExplodeObjectURL(server, id)
 	if err != nil {
 		return nil, err
 	}
 
-	basePath := fmt.Sprintf("/simpleExplodeObject/%s", pathParam0)
-	if basePath[0] == '/' {
-		basePath = basePath[1:]
+	req, err := http.NewRequest("GET", queryUrl.String(), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	queryUrl, err = queryUrl.Parse(basePath)
+	return req, nil
+}
+
+// NewGetMatrixNoExplodeArrayRequest generates requests for GetMatrixNoExplodeArray
+func NewGetMatrixNoExplodeArrayRequest(server string, id []int32) (*http.Request, error) {
+	queryUrl, err := GetMatrixNoExplodeArrayURL(server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -1355,28 +1844,54 @@ func NewGetSimpleExplodeObjectRequest(server string, param Object) (*http.Reques
 	return req, nil
 }
 
-// NewGetSimpleNoExplodeArrayRequest generates requests for GetSimpleNoExplodeArray
-func NewGetSimpleNoExplodeArrayRequest(server string, param []int32) (*http.Request, error) {
-	var err error
+// NewGetMatrixNoExplodeObjectRequest generates requests for GetMatrixNoExplodeObject
+func NewGetMatrixNoExplodeObjectRequest(server string, id Object) (*http.Request, error) {
+	queryUrl, err := GetMatrixNoExplodeObjectURL(server, id)
+	if err != nil {
+		return nil, err
+	}
 
-	var pathParam0 string
+	req, err := http.NewRequest("GET", queryUrl.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	pathParam0, err = runtime.StyleParam("simple", false, "param", param)
+	return req, nil
+}
+
+// NewGetPassThroughRequest generates requests for GetPassThrough
+func NewGetPassThroughRequest(server string, param string) (*http.Request, error) {
+	queryUrl, err := GetPassThroughURL(server, param)
 	if err != nil {
 		return nil, err
 	}
 
-	queryUrl, err := url.Parse(server)
+	req, err := http.NewRequest("GET", queryUrl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	basePath := fmt.Sprintf("/simpleNoExplodeArray/%s", pathParam0)
-	if basePath[0] == '/' {
-		basePath = basePath[1:]
+	return req, nil
+}
+
+// NewGetDeepObjectRequest generates requests for GetDeepObject
+func NewGetDeepObjectRequest(server string, params *GetDeepObjectParams) (*http.Request, error) {
+	queryUrl, err := GetDeepObjectURL(server, params)
+	if err != nil {
+		return nil, err
 	}
 
-	queryUrl, err = queryUrl.Parse(basePath)
+	req, err := http.NewRequest("GET", queryUrl.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetQueryFormRequest generates requests for GetQueryForm
+func NewGetQueryFormRequest(server string, params *GetQueryFormParams) (*http.Request, error) {
+	queryUrl, err := GetQueryFormURL(server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1389,28 +1904,54 @@ func NewGetSimpleNoExplodeArrayRequest(server string, param []int32) (*http.Requ
 	return req, nil
 }
 
-// NewGetSimpleNoExplodeObjectRequest generates requests for GetSimpleNoExplodeObject
-func NewGetSimpleNoExplodeObjectRequest(server string, param Object) (*http.Request, error) {
-	var err error
+// NewGetSimpleExplodeArrayRequest generates requests for GetSimpleExplodeArray
+func NewGetSimpleExplodeArrayRequest(server string, param []int32) (*http.Request, error) {
+	queryUrl, err := GetSimpleExplodeArrayURL(server, param)
+	if err != nil {
+		return nil, err
+	}
 
-	var pathParam0 string
+	req, err := http.NewRequest("GET", queryUrl.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	pathParam0, err = runtime.StyleParam("simple", false, "param", param)
+	return req, nil
+}
+
+// NewGetSimpleExplodeObjectRequest generates requests for GetSimpleExplodeObject
+func NewGetSimpleExplodeObjectRequest(server string, param Object) (*http.Request, error) {
+	queryUrl, err := GetSimpleExplodeObjectURL(server, param)
 	if err != nil {
 		return nil, err
 	}
 
-	queryUrl, err := url.Parse(server)
+	req, err := http.NewRequest("GET", queryUrl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	basePath := fmt.Sprintf("/simpleNoExplodeObject/%s", pathParam0)
-	if basePath[0] == '/' {
-		basePath = basePath[1:]
+	return req, nil
+}
+
+// NewGetSimpleNoExplodeArrayRequest generates requests for GetSimpleNoExplodeArray
+func NewGetSimpleNoExplodeArrayRequest(server string, param []int32) (*http.Request, error) {
+	queryUrl, err := GetSimpleNoExplodeArrayURL(server, param)
+	if err != nil {
+		return nil, err
 	}
 
-	queryUrl, err = queryUrl.Parse(basePath)
+	req, err := http.NewRequest("GET", queryUrl.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSimpleNoExplodeObjectRequest generates requests for GetSimpleNoExplodeObject
+func NewGetSimpleNoExplodeObjectRequest(server string, param Object) (*http.Request, error) {
+	queryUrl, err := GetSimpleNoExplodeObjectURL(server, param)
 	if err != nil {
 		return nil, err
 	}
@@ -1425,36 +1966,219 @@ func NewGetSimpleNoExplodeObjectRequest(server string, param Object) (*http.Requ
 
 // NewGetSimplePrimitiveRequest generates requests for GetSimplePrimitive
 func NewGetSimplePrimitiveRequest(server string, param int32) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParam("simple", false, "param", param)
+	queryUrl, err := GetSimplePrimitiveURL(server, param)
 	if err != nil {
 		return nil, err
 	}
 
-	queryUrl, err := url.Parse(server)
+	req, err := http.NewRequest("GET", queryUrl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	basePath := fmt.Sprintf("/simplePrimitive/%s", pathParam0)
-	if basePath[0] == '/' {
-		basePath = basePath[1:]
+	return req, nil
+}
+
+// serverDefinition is a server of the API, whose URL may hold variables.
+type serverDefinition struct {
+	url       string
+	variables []serverVariable
+}
+
+// serverVariable is a variable of the URL of a server.
+type serverVariable struct {
+	name         string
+	defaultValue string
+	enum         []string
+}
+
+// ServerVariables holds the values of the variables of the URL of a server. It
+// is one of the types generated for the servers with variables, such as those
+// given to WithServer.
+type ServerVariables interface {
+	// serverURL returns the URL of the server whose variables these are.
+	serverURL() string
+	// values returns the values of the variables, by name.
+	values() map[string]string
+}
+
+// resolve replaces the variables in the URL of the server with their values,
+// or their defaults, checking them against their enum. vars must be those of
+// the server, or nil.
+func (s serverDefinition) resolve(vars ServerVariables) (string, error) {
+	var values map[string]string
+	if vars != nil {
+		if vars.serverURL() != s.url {
+			return "", fmt.Errorf("variables of server %s given for server %s", vars.serverURL(), s.url)
+		}
+		values = vars.values()
 	}
 
-	queryUrl, err = queryUrl.Parse(basePath)
+	serverURL := s.url
+	for _, v := range s.variables {
+		value := values[v.name]
+		if value == "" {
+			value = v.defaultValue
+		}
+		if value == "" {
+			return "", fmt.Errorf("missing value for variable %s of server %s", v.name, s.url)
+		}
+		valid := len(v.enum) == 0
+		for _, e := range v.enum {
+			valid = valid || value == e
+		}
+		if !valid {
+			return "", fmt.Errorf("invalid value %q for variable %s of server %s, must be one of: %s", value, v.name, s.url, strings.Join(v.enum, ", "))
+		}
+		serverURL = strings.Replace(serverURL, "{"+v.name+"}", value, -1)
+	}
+	return serverURL, nil
+}
+
+// servers lists the servers of the API, in the order of the spec.
+var servers = []serverDefinition{
+	{url: "http://openapitest.deepmap.ai"},
+}
+
+// operationServers lists the servers of the operations which override those
+// of the API.
+var operationServers = map[string][]serverDefinition{}
+
+// ServerURL0 is the URL of server 0.
+const ServerURL0 = "http://openapitest.deepmap.ai"
+
+// WithServer sets the server of the client to one of the servers of the API,
+// by its index in the spec. Its variables take the given values, which must
+// be the variables of that server, such as a ServerProductionVariables for a
+// production server. They are checked against their enum, and take their
+// default when empty, or when vars is nil.
+func WithServer(index int, vars ServerVariables) ClientOption {
+	return func(c *Client) error {
+		if index < 0 || index >= len(servers) {
+			return fmt.Errorf("no server at index %d", index)
+		}
+		server, err := servers[index].resolve(vars)
+		if err != nil {
+			return err
+		}
+		c.Server = server
+		return nil
+	}
+}
+
+// WithOperationServer sets the server of an operation which overrides the
+// servers of the API to another of its servers, by its index in the spec. Its
+// variables are handled as by WithServer, and are of the types named after the
+// operation, such as GetPetServer0Variables.
+func WithOperationServer(operationID string, index int, vars ServerVariables) ClientOption {
+	return func(c *Client) error {
+		defs := operationServers[operationID]
+		if index < 0 || index >= len(defs) {
+			return fmt.Errorf("operation %s has no server at index %d", operationID, index)
+		}
+		server, err := defs[index].resolve(vars)
+		if err != nil {
+			return err
+		}
+		if c.OperationServers == nil {
+			c.OperationServers = map[string]string{}
+		}
+		c.OperationServers[operationID] = server
+		return nil
+	}
+}
+
+// operationServer returns the server of an operation which overrides the
+// servers of the API, which is its first one unless set otherwise.
+func (c *Client) operationServer(operationID string) (string, error) {
+	server, found := c.OperationServers[operationID]
+	if !found {
+		var err error
+		server, err = operationServers[operationID][0].resolve(nil)
+		if err != nil {
+			return "", err
+		}
+	}
+	if !strings.HasSuffix(server, "/") {
+		server += "/"
+	}
+	return server, nil
+}
+
+// RequestValidationError is returned by the client for a request which
+// doesn't conform to the spec, it isn't sent.
+type RequestValidationError struct {
+	Method string
+	URL    string
+	// Why the request is invalid, usually an *openapi3filter.RequestError,
+	// or an *openapi3filter.RouteError when it matches no operation.
+	Err error
+}
+
+func (e *RequestValidationError) Error() string {
+	return fmt.Sprintf("invalid request %s %s: %s", e.Method, e.URL, e.Err)
+}
+
+// Cause returns why the request is invalid.
+func (e *RequestValidationError) Cause() error {
+	return e.Err
+}
+
+// Unwrap returns why the request is invalid.
+func (e *RequestValidationError) Unwrap() error {
+	return e.Err
+}
+
+// WithRequestValidation validates the parameters and bodies of requests
+// against the given spec, usually the one returned by GetSwagger, before they
+// are sent. Requests are validated once the request editors ran, so security
+// requirements are assumed to be met.
+func WithRequestValidation(swagger *openapi3.Swagger) ClientOption {
+	return func(c *Client) error {
+		// Requests are matched against their path relative to the server of
+		// the client, which may be none of the servers of the spec.
+		spec := *swagger
+		spec.Servers = nil
+		router := openapi3filter.NewRouter()
+		if err := router.AddSwagger(&spec); err != nil {
+			return fmt.Errorf("error loading spec for request validation: %s", err)
+		}
+		c.RequestValidator = func(ctx context.Context, server string, req *http.Request) error {
+			return validateRequest(ctx, router, server, req)
+		}
+		return nil
+	}
+}
+
+// validateRequest validates a request built against the given server.
+func validateRequest(ctx context.Context, router *openapi3filter.Router, server string, req *http.Request) error {
+	serverURL, err := url.Parse(server)
 	if err != nil {
-		return nil, err
+		return err
+	}
+	invalid := func(err error) error {
+		return &RequestValidationError{Method: req.Method, URL: req.URL.String(), Err: err}
 	}
 
-	req, err := http.NewRequest("GET", queryUrl.String(), nil)
+	path := strings.TrimPrefix(req.URL.Path, strings.TrimSuffix(serverURL.Path, "/"))
+	route, pathParams, err := router.FindRoute(req.Method, &url.URL{Path: path})
 	if err != nil {
-		return nil, err
+		return invalid(err)
 	}
-
-	return req, nil
+	input := &openapi3filter.RequestValidationInput{
+		Request:    req,
+		PathParams: pathParams,
+		Route:      route,
+		Options: &openapi3filter.Options{
+			AuthenticationFunc: func(context.Context, *openapi3filter.AuthenticationInput) error {
+				return nil
+			},
+		},
+	}
+	if err := openapi3filter.ValidateRequest(ctx, input); err != nil {
+		return invalid(err)
+	}
+	return nil
 }
 
 // ClientWithResponses builds on ClientInterface to offer response payloads
@@ -1487,61 +2211,61 @@ func WithBaseURL(baseURL string) ClientOption {
 // ClientWithResponsesInterface is the interface specification for the client with responses above.
 type ClientWithResponsesInterface interface {
 	// GetContentObject request
-	GetContentObjectWithResponse(ctx context.Context, param ComplexObject) (*GetContentObjectResponse, error)
+	GetContentObjectWithResponse(ctx context.Context, param ComplexObject, reqEditors ...RequestEditorFn) (*GetContentObjectResponse, error)
 
 	// GetCookie request
-	GetCookieWithResponse(ctx context.Context, params *GetCookieParams) (*GetCookieResponse, error)
+	GetCookieWithResponse(ctx context.Context, params *GetCookieParams, reqEditors ...RequestEditorFn) (*GetCookieResponse, error)
 
 	// GetHeader request
-	GetHeaderWithResponse(ctx context.Context, params *GetHeaderParams) (*GetHeaderResponse, error)
+	GetHeaderWithResponse(ctx context.Context, params *GetHeaderParams, reqEditors ...RequestEditorFn) (*GetHeaderResponse, error)
 
 	// GetLabelExplodeArray request
-	GetLabelExplodeArrayWithResponse(ctx context.Context, param []int32) (*GetLabelExplodeArrayResponse, error)
+	GetLabelExplodeArrayWithResponse(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*GetLabelExplodeArrayResponse, error)
 
 	// GetLabelExplodeObject request
-	GetLabelExplodeObjectWithResponse(ctx context.Context, param Object) (*GetLabelExplodeObjectResponse, error)
+	GetLabelExplodeObjectWithResponse(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*GetLabelExplodeObjectResponse, error)
 
 	// GetLabelNoExplodeArray request
-	GetLabelNoExplodeArrayWithResponse(ctx context.Context, param []int32) (*GetLabelNoExplodeArrayResponse, error)
+	GetLabelNoExplodeArrayWithResponse(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*GetLabelNoExplodeArrayResponse, error)
 
 	// GetLabelNoExplodeObject request
-	GetLabelNoExplodeObjectWithResponse(ctx context.Context, param Object) (*GetLabelNoExplodeObjectResponse, error)
+	GetLabelNoExplodeObjectWithResponse(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*GetLabelNoExplodeObjectResponse, error)
 
 	// GetMatrixExplodeArray request
-	GetMatrixExplodeArrayWithResponse(ctx context.Context, id []int32) (*GetMatrixExplodeArrayResponse, error)
+	GetMatrixExplodeArrayWithResponse(ctx context.Context, id []int32, reqEditors ...RequestEditorFn) (*GetMatrixExplodeArrayResponse, error)
 
 	// GetMatrixExplodeObject request
-	GetMatrixExplodeObjectWithResponse(ctx context.Context, id Object) (*GetMatrixExplodeObjectResponse, error)
+	GetMatrixExplodeObjectWithResponse(ctx context.Context, id Object, reqEditors ...RequestEditorFn) (*GetMatrixExplodeObjectResponse, error)
 
 	// GetMatrixNoExplodeArray request
-	GetMatrixNoExplodeArrayWithResponse(ctx context.Context, id []int32) (*GetMatrixNoExplodeArrayResponse, error)
+	GetMatrixNoExplodeArrayWithResponse(ctx context.Context, id []int32, reqEditors ...RequestEditorFn) (*GetMatrixNoExplodeArrayResponse, error)
 
 	// GetMatrixNoExplodeObject request
-	GetMatrixNoExplodeObjectWithResponse(ctx context.Context, id Object) (*GetMatrixNoExplodeObjectResponse, error)
+	GetMatrixNoExplodeObjectWithResponse(ctx context.Context, id Object, reqEditors ...RequestEditorFn) (*GetMatrixNoExplodeObjectResponse, error)
 
 	// GetPassThrough request
-	GetPassThroughWithResponse(ctx context.Context, param string) (*GetPassThroughResponse, error)
+	GetPassThroughWithResponse(ctx context.Context, param string, reqEditors ...RequestEditorFn) (*GetPassThroughResponse, error)
 
 	// GetDeepObject request
-	GetDeepObjectWithResponse(ctx context.Context, params *GetDeepObjectParams) (*GetDeepObjectResponse, error)
+	GetDeepObjectWithResponse(ctx context.Context, params *GetDeepObjectParams, reqEditors ...RequestEditorFn) (*GetDeepObjectResponse, error)
 
 	// GetQueryForm request
-	GetQueryFormWithResponse(ctx context.Context, params *GetQueryFormParams) (*GetQueryFormResponse, error)
+	GetQueryFormWithResponse(ctx context.Context, params *GetQueryFormParams, reqEditors ...RequestEditorFn) (*GetQueryFormResponse, error)
 
 	// GetSimpleExplodeArray request
-	GetSimpleExplodeArrayWithResponse(ctx context.Context, param []int32) (*GetSimpleExplodeArrayResponse, error)
+	GetSimpleExplodeArrayWithResponse(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*GetSimpleExplodeArrayResponse, error)
 
 	// GetSimpleExplodeObject request
-	GetSimpleExplodeObjectWithResponse(ctx context.Context, param Object) (*GetSimpleExplodeObjectResponse, error)
+	GetSimpleExplodeObjectWithResponse(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*GetSimpleExplodeObjectResponse, error)
 
 	// GetSimpleNoExplodeArray request
-	GetSimpleNoExplodeArrayWithResponse(ctx context.Context, param []int32) (*GetSimpleNoExplodeArrayResponse, error)
+	GetSimpleNoExplodeArrayWithResponse(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*GetSimpleNoExplodeArrayResponse, error)
 
 	// GetSimpleNoExplodeObject request
-	GetSimpleNoExplodeObjectWithResponse(ctx context.Context, param Object) (*GetSimpleNoExplodeObjectResponse, error)
+	GetSimpleNoExplodeObjectWithResponse(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*GetSimpleNoExplodeObjectResponse, error)
 
 	// GetSimplePrimitive request
-	GetSimplePrimitiveWithResponse(ctx context.Context, param int32) (*GetSimplePrimitiveResponse, error)
+	GetSimplePrimitiveWithResponse(ctx context.Context, param int32, reqEditors ...RequestEditorFn) (*GetSimplePrimitiveResponse, error)
 }
 
 type GetContentObjectResponse struct {
@@ -1932,197 +2656,482 @@ func (r GetSimplePrimitiveResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
-}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSimplePrimitiveResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetContentObjectWithResponse request returning *GetContentObjectResponse
+func (c *ClientWithResponses) GetContentObjectWithResponse(ctx context.Context, param ComplexObject, reqEditors ...RequestEditorFn) (*GetContentObjectResponse, error) {
+	rsp, err := c.GetContentObject(ctx, param, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetContentObjectResponse(rsp)
+}
+
+// GetCookieWithResponse request returning *GetCookieResponse
+func (c *ClientWithResponses) GetCookieWithResponse(ctx context.Context, params *GetCookieParams, reqEditors ...RequestEditorFn) (*GetCookieResponse, error) {
+	rsp, err := c.GetCookie(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCookieResponse(rsp)
+}
+
+// GetHeaderWithResponse request returning *GetHeaderResponse
+func (c *ClientWithResponses) GetHeaderWithResponse(ctx context.Context, params *GetHeaderParams, reqEditors ...RequestEditorFn) (*GetHeaderResponse, error) {
+	rsp, err := c.GetHeader(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetHeaderResponse(rsp)
+}
+
+// GetLabelExplodeArrayWithResponse request returning *GetLabelExplodeArrayResponse
+func (c *ClientWithResponses) GetLabelExplodeArrayWithResponse(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*GetLabelExplodeArrayResponse, error) {
+	rsp, err := c.GetLabelExplodeArray(ctx, param, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLabelExplodeArrayResponse(rsp)
+}
+
+// GetLabelExplodeObjectWithResponse request returning *GetLabelExplodeObjectResponse
+func (c *ClientWithResponses) GetLabelExplodeObjectWithResponse(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*GetLabelExplodeObjectResponse, error) {
+	rsp, err := c.GetLabelExplodeObject(ctx, param, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLabelExplodeObjectResponse(rsp)
+}
+
+// GetLabelNoExplodeArrayWithResponse request returning *GetLabelNoExplodeArrayResponse
+func (c *ClientWithResponses) GetLabelNoExplodeArrayWithResponse(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*GetLabelNoExplodeArrayResponse, error) {
+	rsp, err := c.GetLabelNoExplodeArray(ctx, param, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLabelNoExplodeArrayResponse(rsp)
+}
+
+// GetLabelNoExplodeObjectWithResponse request returning *GetLabelNoExplodeObjectResponse
+func (c *ClientWithResponses) GetLabelNoExplodeObjectWithResponse(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*GetLabelNoExplodeObjectResponse, error) {
+	rsp, err := c.GetLabelNoExplodeObject(ctx, param, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLabelNoExplodeObjectResponse(rsp)
+}
+
+// GetMatrixExplodeArrayWithResponse request returning *GetMatrixExplodeArrayResponse
+func (c *ClientWithResponses) GetMatrixExplodeArrayWithResponse(ctx context.Context, id []int32, reqEditors ...RequestEditorFn) (*GetMatrixExplodeArrayResponse, error) {
+	rsp, err := c.GetMatrixExplodeArray(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMatrixExplodeArrayResponse(rsp)
+}
+
+// GetMatrixExplodeObjectWithResponse request returning *GetMatrixExplodeObjectResponse
+func (c *ClientWithResponses) GetMatrixExplodeObjectWithResponse(ctx context.Context, id Object, reqEditors ...RequestEditorFn) (*GetMatrixExplodeObjectResponse, error) {
+	rsp, err := c.GetMatrixExplodeObject(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMatrixExplodeObjectResponse(rsp)
+}
+
+// GetMatrixNoExplodeArrayWithResponse request returning *GetMatrixNoExplodeArrayResponse
+func (c *ClientWithResponses) GetMatrixNoExplodeArrayWithResponse(ctx context.Context, id []int32, reqEditors ...RequestEditorFn) (*GetMatrixNoExplodeArrayResponse, error) {
+	rsp, err := c.GetMatrixNoExplodeArray(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMatrixNoExplodeArrayResponse(rsp)
+}
+
+// GetMatrixNoExplodeObjectWithResponse request returning *GetMatrixNoExplodeObjectResponse
+func (c *ClientWithResponses) GetMatrixNoExplodeObjectWithResponse(ctx context.Context, id Object, reqEditors ...RequestEditorFn) (*GetMatrixNoExplodeObjectResponse, error) {
+	rsp, err := c.GetMatrixNoExplodeObject(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMatrixNoExplodeObjectResponse(rsp)
+}
+
+// GetPassThroughWithResponse request returning *GetPassThroughResponse
+func (c *ClientWithResponses) GetPassThroughWithResponse(ctx context.Context, param string, reqEditors ...RequestEditorFn) (*GetPassThroughResponse, error) {
+	rsp, err := c.GetPassThrough(ctx, param, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPassThroughResponse(rsp)
+}
+
+// GetDeepObjectWithResponse request returning *GetDeepObjectResponse
+func (c *ClientWithResponses) GetDeepObjectWithResponse(ctx context.Context, params *GetDeepObjectParams, reqEditors ...RequestEditorFn) (*GetDeepObjectResponse, error) {
+	rsp, err := c.GetDeepObject(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDeepObjectResponse(rsp)
+}
+
+// GetQueryFormWithResponse request returning *GetQueryFormResponse
+func (c *ClientWithResponses) GetQueryFormWithResponse(ctx context.Context, params *GetQueryFormParams, reqEditors ...RequestEditorFn) (*GetQueryFormResponse, error) {
+	rsp, err := c.GetQueryForm(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetQueryFormResponse(rsp)
+}
+
+// GetSimpleExplodeArrayWithResponse request returning *GetSimpleExplodeArrayResponse
+func (c *ClientWithResponses) GetSimpleExplodeArrayWithResponse(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*GetSimpleExplodeArrayResponse, error) {
+	rsp, err := c.GetSimpleExplodeArray(ctx, param, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSimpleExplodeArrayResponse(rsp)
+}
+
+// GetSimpleExplodeObjectWithResponse request returning *GetSimpleExplodeObjectResponse
+func (c *ClientWithResponses) GetSimpleExplodeObjectWithResponse(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*GetSimpleExplodeObjectResponse, error) {
+	rsp, err := c.GetSimpleExplodeObject(ctx, param, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSimpleExplodeObjectResponse(rsp)
+}
+
+// GetSimpleNoExplodeArrayWithResponse request returning *GetSimpleNoExplodeArrayResponse
+func (c *ClientWithResponses) GetSimpleNoExplodeArrayWithResponse(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*GetSimpleNoExplodeArrayResponse, error) {
+	rsp, err := c.GetSimpleNoExplodeArray(ctx, param, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSimpleNoExplodeArrayResponse(rsp)
+}
+
+// GetSimpleNoExplodeObjectWithResponse request returning *GetSimpleNoExplodeObjectResponse
+func (c *ClientWithResponses) GetSimpleNoExplodeObjectWithResponse(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*GetSimpleNoExplodeObjectResponse, error) {
+	rsp, err := c.GetSimpleNoExplodeObject(ctx, param, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSimpleNoExplodeObjectResponse(rsp)
+}
+
+// GetSimplePrimitiveWithResponse request returning *GetSimplePrimitiveResponse
+func (c *ClientWithResponses) GetSimplePrimitiveWithResponse(ctx context.Context, param int32, reqEditors ...RequestEditorFn) (*GetSimplePrimitiveResponse, error) {
+	rsp, err := c.GetSimplePrimitive(ctx, param, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSimplePrimitiveResponse(rsp)
+}
+
+// ParseGetContentObjectResponse parses an HTTP response from a GetContentObjectWithResponse call
+func ParseGetContentObjectResponse(rsp *http.Response) (*GetContentObjectResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetContentObjectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	}
+
+	return response, nil
+}
+
+// ParseGetCookieResponse parses an HTTP response from a GetCookieWithResponse call
+func ParseGetCookieResponse(rsp *http.Response) (*GetCookieResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCookieResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	}
+
+	return response, nil
+}
+
+// ParseGetHeaderResponse parses an HTTP response from a GetHeaderWithResponse call
+func ParseGetHeaderResponse(rsp *http.Response) (*GetHeaderResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetHeaderResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	}
+
+	return response, nil
+}
+
+// ParseGetLabelExplodeArrayResponse parses an HTTP response from a GetLabelExplodeArrayWithResponse call
+func ParseGetLabelExplodeArrayResponse(rsp *http.Response) (*GetLabelExplodeArrayResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetLabelExplodeArrayResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	}
+
+	return response, nil
+}
+
+// ParseGetLabelExplodeObjectResponse parses an HTTP response from a GetLabelExplodeObjectWithResponse call
+func ParseGetLabelExplodeObjectResponse(rsp *http.Response) (*GetLabelExplodeObjectResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetLabelExplodeObjectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	}
+
+	return response, nil
+}
+
+// ParseGetLabelNoExplodeArrayResponse parses an HTTP response from a GetLabelNoExplodeArrayWithResponse call
+func ParseGetLabelNoExplodeArrayResponse(rsp *http.Response) (*GetLabelNoExplodeArrayResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetLabelNoExplodeArrayResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetSimplePrimitiveResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	switch {
 	}
-	return 0
+
+	return response, nil
 }
 
-// GetContentObjectWithResponse request returning *GetContentObjectResponse
-func (c *ClientWithResponses) GetContentObjectWithResponse(ctx context.Context, param ComplexObject) (*GetContentObjectResponse, error) {
-	rsp, err := c.GetContentObject(ctx, param)
+// ParseGetLabelNoExplodeObjectResponse parses an HTTP response from a GetLabelNoExplodeObjectWithResponse call
+func ParseGetLabelNoExplodeObjectResponse(rsp *http.Response) (*GetLabelNoExplodeObjectResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetContentObjectResponse(rsp)
-}
 
-// GetCookieWithResponse request returning *GetCookieResponse
-func (c *ClientWithResponses) GetCookieWithResponse(ctx context.Context, params *GetCookieParams) (*GetCookieResponse, error) {
-	rsp, err := c.GetCookie(ctx, params)
-	if err != nil {
-		return nil, err
+	response := &GetLabelNoExplodeObjectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetCookieResponse(rsp)
-}
 
-// GetHeaderWithResponse request returning *GetHeaderResponse
-func (c *ClientWithResponses) GetHeaderWithResponse(ctx context.Context, params *GetHeaderParams) (*GetHeaderResponse, error) {
-	rsp, err := c.GetHeader(ctx, params)
-	if err != nil {
-		return nil, err
+	switch {
 	}
-	return ParseGetHeaderResponse(rsp)
+
+	return response, nil
 }
 
-// GetLabelExplodeArrayWithResponse request returning *GetLabelExplodeArrayResponse
-func (c *ClientWithResponses) GetLabelExplodeArrayWithResponse(ctx context.Context, param []int32) (*GetLabelExplodeArrayResponse, error) {
-	rsp, err := c.GetLabelExplodeArray(ctx, param)
+// ParseGetMatrixExplodeArrayResponse parses an HTTP response from a GetMatrixExplodeArrayWithResponse call
+func ParseGetMatrixExplodeArrayResponse(rsp *http.Response) (*GetMatrixExplodeArrayResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetLabelExplodeArrayResponse(rsp)
-}
 
-// GetLabelExplodeObjectWithResponse request returning *GetLabelExplodeObjectResponse
-func (c *ClientWithResponses) GetLabelExplodeObjectWithResponse(ctx context.Context, param Object) (*GetLabelExplodeObjectResponse, error) {
-	rsp, err := c.GetLabelExplodeObject(ctx, param)
-	if err != nil {
-		return nil, err
+	response := &GetMatrixExplodeArrayResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetLabelExplodeObjectResponse(rsp)
-}
 
-// GetLabelNoExplodeArrayWithResponse request returning *GetLabelNoExplodeArrayResponse
-func (c *ClientWithResponses) GetLabelNoExplodeArrayWithResponse(ctx context.Context, param []int32) (*GetLabelNoExplodeArrayResponse, error) {
-	rsp, err := c.GetLabelNoExplodeArray(ctx, param)
-	if err != nil {
-		return nil, err
+	switch {
 	}
-	return ParseGetLabelNoExplodeArrayResponse(rsp)
+
+	return response, nil
 }
 
-// GetLabelNoExplodeObjectWithResponse request returning *GetLabelNoExplodeObjectResponse
-func (c *ClientWithResponses) GetLabelNoExplodeObjectWithResponse(ctx context.Context, param Object) (*GetLabelNoExplodeObjectResponse, error) {
-	rsp, err := c.GetLabelNoExplodeObject(ctx, param)
+// ParseGetMatrixExplodeObjectResponse parses an HTTP response from a GetMatrixExplodeObjectWithResponse call
+func ParseGetMatrixExplodeObjectResponse(rsp *http.Response) (*GetMatrixExplodeObjectResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetLabelNoExplodeObjectResponse(rsp)
-}
 
-// GetMatrixExplodeArrayWithResponse request returning *GetMatrixExplodeArrayResponse
-func (c *ClientWithResponses) GetMatrixExplodeArrayWithResponse(ctx context.Context, id []int32) (*GetMatrixExplodeArrayResponse, error) {
-	rsp, err := c.GetMatrixExplodeArray(ctx, id)
-	if err != nil {
-		return nil, err
+	response := &GetMatrixExplodeObjectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetMatrixExplodeArrayResponse(rsp)
-}
 
-// GetMatrixExplodeObjectWithResponse request returning *GetMatrixExplodeObjectResponse
-func (c *ClientWithResponses) GetMatrixExplodeObjectWithResponse(ctx context.Context, id Object) (*GetMatrixExplodeObjectResponse, error) {
-	rsp, err := c.GetMatrixExplodeObject(ctx, id)
-	if err != nil {
-		return nil, err
+	switch {
 	}
-	return ParseGetMatrixExplodeObjectResponse(rsp)
+
+	return response, nil
 }
 
-// GetMatrixNoExplodeArrayWithResponse request returning *GetMatrixNoExplodeArrayResponse
-func (c *ClientWithResponses) GetMatrixNoExplodeArrayWithResponse(ctx context.Context, id []int32) (*GetMatrixNoExplodeArrayResponse, error) {
-	rsp, err := c.GetMatrixNoExplodeArray(ctx, id)
+// ParseGetMatrixNoExplodeArrayResponse parses an HTTP response from a GetMatrixNoExplodeArrayWithResponse call
+func ParseGetMatrixNoExplodeArrayResponse(rsp *http.Response) (*GetMatrixNoExplodeArrayResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetMatrixNoExplodeArrayResponse(rsp)
-}
 
-// GetMatrixNoExplodeObjectWithResponse request returning *GetMatrixNoExplodeObjectResponse
-func (c *ClientWithResponses) GetMatrixNoExplodeObjectWithResponse(ctx context.Context, id Object) (*GetMatrixNoExplodeObjectResponse, error) {
-	rsp, err := c.GetMatrixNoExplodeObject(ctx, id)
-	if err != nil {
-		return nil, err
+	response := &GetMatrixNoExplodeArrayResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetMatrixNoExplodeObjectResponse(rsp)
-}
 
-// GetPassThroughWithResponse request returning *GetPassThroughResponse
-func (c *ClientWithResponses) GetPassThroughWithResponse(ctx context.Context, param string) (*GetPassThroughResponse, error) {
-	rsp, err := c.GetPassThrough(ctx, param)
-	if err != nil {
-		return nil, err
+	switch {
 	}
-	return ParseGetPassThroughResponse(rsp)
+
+	return response, nil
 }
 
-// GetDeepObjectWithResponse request returning *GetDeepObjectResponse
-func (c *ClientWithResponses) GetDeepObjectWithResponse(ctx context.Context, params *GetDeepObjectParams) (*GetDeepObjectResponse, error) {
-	rsp, err := c.GetDeepObject(ctx, params)
+// ParseGetMatrixNoExplodeObjectResponse parses an HTTP response from a GetMatrixNoExplodeObjectWithResponse call
+func ParseGetMatrixNoExplodeObjectResponse(rsp *http.Response) (*GetMatrixNoExplodeObjectResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetDeepObjectResponse(rsp)
-}
 
-// GetQueryFormWithResponse request returning *GetQueryFormResponse
-func (c *ClientWithResponses) GetQueryFormWithResponse(ctx context.Context, params *GetQueryFormParams) (*GetQueryFormResponse, error) {
-	rsp, err := c.GetQueryForm(ctx, params)
-	if err != nil {
-		return nil, err
+	response := &GetMatrixNoExplodeObjectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetQueryFormResponse(rsp)
-}
 
-// GetSimpleExplodeArrayWithResponse request returning *GetSimpleExplodeArrayResponse
-func (c *ClientWithResponses) GetSimpleExplodeArrayWithResponse(ctx context.Context, param []int32) (*GetSimpleExplodeArrayResponse, error) {
-	rsp, err := c.GetSimpleExplodeArray(ctx, param)
-	if err != nil {
-		return nil, err
+	switch {
 	}
-	return ParseGetSimpleExplodeArrayResponse(rsp)
+
+	return response, nil
 }
 
-// GetSimpleExplodeObjectWithResponse request returning *GetSimpleExplodeObjectResponse
-func (c *ClientWithResponses) GetSimpleExplodeObjectWithResponse(ctx context.Context, param Object) (*GetSimpleExplodeObjectResponse, error) {
-	rsp, err := c.GetSimpleExplodeObject(ctx, param)
+// ParseGetPassThroughResponse parses an HTTP response from a GetPassThroughWithResponse call
+func ParseGetPassThroughResponse(rsp *http.Response) (*GetPassThroughResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetSimpleExplodeObjectResponse(rsp)
+
+	response := &GetPassThroughResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	}
+
+	return response, nil
 }
 
-// GetSimpleNoExplodeArrayWithResponse request returning *GetSimpleNoExplodeArrayResponse
-func (c *ClientWithResponses) GetSimpleNoExplodeArrayWithResponse(ctx context.Context, param []int32) (*GetSimpleNoExplodeArrayResponse, error) {
-	rsp, err := c.GetSimpleNoExplodeArray(ctx, param)
+// ParseGetDeepObjectResponse parses an HTTP response from a GetDeepObjectWithResponse call
+func ParseGetDeepObjectResponse(rsp *http.Response) (*GetDeepObjectResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetSimpleNoExplodeArrayResponse(rsp)
+
+	response := &GetDeepObjectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	}
+
+	return response, nil
 }
 
-// GetSimpleNoExplodeObjectWithResponse request returning *GetSimpleNoExplodeObjectResponse
-func (c *ClientWithResponses) GetSimpleNoExplodeObjectWithResponse(ctx context.Context, param Object) (*GetSimpleNoExplodeObjectResponse, error) {
-	rsp, err := c.GetSimpleNoExplodeObject(ctx, param)
+// ParseGetQueryFormResponse parses an HTTP response from a GetQueryFormWithResponse call
+func ParseGetQueryFormResponse(rsp *http.Response) (*GetQueryFormResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetSimpleNoExplodeObjectResponse(rsp)
+
+	response := &GetQueryFormResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	}
+
+	return response, nil
 }
 
-// GetSimplePrimitiveWithResponse request returning *GetSimplePrimitiveResponse
-func (c *ClientWithResponses) GetSimplePrimitiveWithResponse(ctx context.Context, param int32) (*GetSimplePrimitiveResponse, error) {
-	rsp, err := c.GetSimplePrimitive(ctx, param)
+// ParseGetSimpleExplodeArrayResponse parses an HTTP response from a GetSimpleExplodeArrayWithResponse call
+func ParseGetSimpleExplodeArrayResponse(rsp *http.Response) (*GetSimpleExplodeArrayResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetSimplePrimitiveResponse(rsp)
+
+	response := &GetSimpleExplodeArrayResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	}
+
+	return response, nil
 }
 
-// ParseGetContentObjectResponse parses an HTTP response from a GetContentObjectWithResponse call
-func ParseGetContentObjectResponse(rsp *http.Response) (*GetContentObjectResponse, error) {
+// ParseGetSimpleExplodeObjectResponse parses an HTTP response from a GetSimpleExplodeObjectWithResponse call
+func ParseGetSimpleExplodeObjectResponse(rsp *http.Response) (*GetSimpleExplodeObjectResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetContentObjectResponse{
+	response := &GetSimpleExplodeObjectResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -2133,15 +3142,15 @@ func ParseGetContentObjectResponse(rsp *http.Response) (*GetContentObjectRespons
 	return response, nil
 }
 
-// ParseGetCookieResponse parses an HTTP response from a GetCookieWithResponse call
-func ParseGetCookieResponse(rsp *http.Response) (*GetCookieResponse, error) {
+// ParseGetSimpleNoExplodeArrayResponse parses an HTTP response from a GetSimpleNoExplodeArrayWithResponse call
+func ParseGetSimpleNoExplodeArrayResponse(rsp *http.Response) (*GetSimpleNoExplodeArrayResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetCookieResponse{
+	response := &GetSimpleNoExplodeArrayResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -2152,15 +3161,15 @@ func ParseGetCookieResponse(rsp *http.Response) (*GetCookieResponse, error) {
 	return response, nil
 }
 
-// ParseGetHeaderResponse parses an HTTP response from a GetHeaderWithResponse call
-func ParseGetHeaderResponse(rsp *http.Response) (*GetHeaderResponse, error) {
+// ParseGetSimpleNoExplodeObjectResponse parses an HTTP response from a GetSimpleNoExplodeObjectWithResponse call
+func ParseGetSimpleNoExplodeObjectResponse(rsp *http.Response) (*GetSimpleNoExplodeObjectResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetHeaderResponse{
+	response := &GetSimpleNoExplodeObjectResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -2171,15 +3180,15 @@ func ParseGetHeaderResponse(rsp *http.Response) (*GetHeaderResponse, error) {
 	return response, nil
 }
 
-// ParseGetLabelExplodeArrayResponse parses an HTTP response from a GetLabelExplodeArrayWithResponse call
-func ParseGetLabelExplodeArrayResponse(rsp *http.Response) (*GetLabelExplodeArrayResponse, error) {
+// ParseGetSimplePrimitiveResponse parses an HTTP response from a GetSimplePrimitiveWithResponse call
+func ParseGetSimplePrimitiveResponse(rsp *http.Response) (*GetSimplePrimitiveResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetLabelExplodeArrayResponse{
+	response := &GetSimplePrimitiveResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -2190,289 +3199,514 @@ func ParseGetLabelExplodeArrayResponse(rsp *http.Response) (*GetLabelExplodeArra
 	return response, nil
 }
 
-// ParseGetLabelExplodeObjectResponse parses an HTTP response from a GetLabelExplodeObjectWithResponse call
-func ParseGetLabelExplodeObjectResponse(rsp *http.Response) (*GetLabelExplodeObjectResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer rsp.Body.Close()
+// APIError is an error response from the server, with the payload of the
+// default response of the operation when the API documents one.
+type APIError struct {
+	Operation    string
+	StatusCode   int
+	Body         []byte
+	HTTPResponse *http.Response
+	// The decoded default response, eg. *Error, or nil
+	Model interface{}
+}
+
+// Error describes the response.
+func (e *APIError) Error() string {
+	return fmt.Sprintf("%s returned %d %s", e.Operation, e.StatusCode, http.StatusText(e.StatusCode))
+}
+
+// GetContentObjectOrError calls GetContentObjectWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) GetContentObjectOrError(ctx context.Context, param ComplexObject, reqEditors ...RequestEditorFn) (*GetContentObjectResponse, error) {
+	rsp, err := c.GetContentObjectWithResponse(ctx, param, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return getContentObjectOrError(rsp)
+}
+
+// getContentObjectOrError returns the payload of a success response to
+// GetContentObject, or an error for any other response.
+func getContentObjectOrError(rsp *GetContentObjectResponse) (*GetContentObjectResponse, error) {
+	if rsp.StatusCode() >= 200 && rsp.StatusCode() < 300 {
+		return rsp, nil
+	}
+
+	apiErr := APIError{
+		Operation:    "GetContentObject",
+		StatusCode:   rsp.StatusCode(),
+		Body:         rsp.Body,
+		HTTPResponse: rsp.HTTPResponse,
+	}
+	return nil, &apiErr
+}
+
+// GetCookieOrError calls GetCookieWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) GetCookieOrError(ctx context.Context, params *GetCookieParams, reqEditors ...RequestEditorFn) (*GetCookieResponse, error) {
+	rsp, err := c.GetCookieWithResponse(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return getCookieOrError(rsp)
+}
+
+// getCookieOrError returns the payload of a success response to
+// GetCookie, or an error for any other response.
+func getCookieOrError(rsp *GetCookieResponse) (*GetCookieResponse, error) {
+	if rsp.StatusCode() >= 200 && rsp.StatusCode() < 300 {
+		return rsp, nil
+	}
+
+	apiErr := APIError{
+		Operation:    "GetCookie",
+		StatusCode:   rsp.StatusCode(),
+		Body:         rsp.Body,
+		HTTPResponse: rsp.HTTPResponse,
+	}
+	return nil, &apiErr
+}
+
+// GetHeaderOrError calls GetHeaderWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) GetHeaderOrError(ctx context.Context, params *GetHeaderParams, reqEditors ...RequestEditorFn) (*GetHeaderResponse, error) {
+	rsp, err := c.GetHeaderWithResponse(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return getHeaderOrError(rsp)
+}
+
+// getHeaderOrError returns the payload of a success response to
+// GetHeader, or an error for any other response.
+func getHeaderOrError(rsp *GetHeaderResponse) (*GetHeaderResponse, error) {
+	if rsp.StatusCode() >= 200 && rsp.StatusCode() < 300 {
+		return rsp, nil
+	}
+
+	apiErr := APIError{
+		Operation:    "GetHeader",
+		StatusCode:   rsp.StatusCode(),
+		Body:         rsp.Body,
+		HTTPResponse: rsp.HTTPResponse,
+	}
+	return nil, &apiErr
+}
+
+// GetLabelExplodeArrayOrError calls GetLabelExplodeArrayWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) GetLabelExplodeArrayOrError(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*GetLabelExplodeArrayResponse, error) {
+	rsp, err := c.GetLabelExplodeArrayWithResponse(ctx, param, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return getLabelExplodeArrayOrError(rsp)
+}
+
+// getLabelExplodeArrayOrError returns the payload of a success response to
+// GetLabelExplodeArray, or an error for any other response.
+func getLabelExplodeArrayOrError(rsp *GetLabelExplodeArrayResponse) (*GetLabelExplodeArrayResponse, error) {
+	if rsp.StatusCode() >= 200 && rsp.StatusCode() < 300 {
+		return rsp, nil
+	}
+
+	apiErr := APIError{
+		Operation:    "GetLabelExplodeArray",
+		StatusCode:   rsp.StatusCode(),
+		Body:         rsp.Body,
+		HTTPResponse: rsp.HTTPResponse,
+	}
+	return nil, &apiErr
+}
+
+// GetLabelExplodeObjectOrError calls GetLabelExplodeObjectWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) GetLabelExplodeObjectOrError(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*GetLabelExplodeObjectResponse, error) {
+	rsp, err := c.GetLabelExplodeObjectWithResponse(ctx, param, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return getLabelExplodeObjectOrError(rsp)
+}
 
-	response := &GetLabelExplodeObjectResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// getLabelExplodeObjectOrError returns the payload of a success response to
+// GetLabelExplodeObject, or an error for any other response.
+func getLabelExplodeObjectOrError(rsp *GetLabelExplodeObjectResponse) (*GetLabelExplodeObjectResponse, error) {
+	if rsp.StatusCode() >= 200 && rsp.StatusCode() < 300 {
+		return rsp, nil
 	}
 
-	switch {
+	apiErr := APIError{
+		Operation:    "GetLabelExplodeObject",
+		StatusCode:   rsp.StatusCode(),
+		Body:         rsp.Body,
+		HTTPResponse: rsp.HTTPResponse,
 	}
-
-	return response, nil
+	return nil, &apiErr
 }
 
-// ParseGetLabelNoExplodeArrayResponse parses an HTTP response from a GetLabelNoExplodeArrayWithResponse call
-func ParseGetLabelNoExplodeArrayResponse(rsp *http.Response) (*GetLabelNoExplodeArrayResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer rsp.Body.Close()
+// GetLabelNoExplodeArrayOrError calls GetLabelNoExplodeArrayWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) GetLabelNoExplodeArrayOrError(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*GetLabelNoExplodeArrayResponse, error) {
+	rsp, err := c.GetLabelNoExplodeArrayWithResponse(ctx, param, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return getLabelNoExplodeArrayOrError(rsp)
+}
 
-	response := &GetLabelNoExplodeArrayResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// getLabelNoExplodeArrayOrError returns the payload of a success response to
+// GetLabelNoExplodeArray, or an error for any other response.
+func getLabelNoExplodeArrayOrError(rsp *GetLabelNoExplodeArrayResponse) (*GetLabelNoExplodeArrayResponse, error) {
+	if rsp.StatusCode() >= 200 && rsp.StatusCode() < 300 {
+		return rsp, nil
 	}
 
-	switch {
+	apiErr := APIError{
+		Operation:    "GetLabelNoExplodeArray",
+		StatusCode:   rsp.StatusCode(),
+		Body:         rsp.Body,
+		HTTPResponse: rsp.HTTPResponse,
 	}
-
-	return response, nil
+	return nil, &apiErr
 }
 
-// ParseGetLabelNoExplodeObjectResponse parses an HTTP response from a GetLabelNoExplodeObjectWithResponse call
-func ParseGetLabelNoExplodeObjectResponse(rsp *http.Response) (*GetLabelNoExplodeObjectResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer rsp.Body.Close()
+// GetLabelNoExplodeObjectOrError calls GetLabelNoExplodeObjectWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) GetLabelNoExplodeObjectOrError(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*GetLabelNoExplodeObjectResponse, error) {
+	rsp, err := c.GetLabelNoExplodeObjectWithResponse(ctx, param, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return getLabelNoExplodeObjectOrError(rsp)
+}
 
-	response := &GetLabelNoExplodeObjectResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// getLabelNoExplodeObjectOrError returns the payload of a success response to
+// GetLabelNoExplodeObject, or an error for any other response.
+func getLabelNoExplodeObjectOrError(rsp *GetLabelNoExplodeObjectResponse) (*GetLabelNoExplodeObjectResponse, error) {
+	if rsp.StatusCode() >= 200 && rsp.StatusCode() < 300 {
+		return rsp, nil
 	}
 
-	switch {
+	apiErr := APIError{
+		Operation:    "GetLabelNoExplodeObject",
+		StatusCode:   rsp.StatusCode(),
+		Body:         rsp.Body,
+		HTTPResponse: rsp.HTTPResponse,
 	}
-
-	return response, nil
+	return nil, &apiErr
 }
 
-// ParseGetMatrixExplodeArrayResponse parses an HTTP response from a GetMatrixExplodeArrayWithResponse call
-func ParseGetMatrixExplodeArrayResponse(rsp *http.Response) (*GetMatrixExplodeArrayResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer rsp.Body.Close()
+// GetMatrixExplodeArrayOrError calls GetMatrixExplodeArrayWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) GetMatrixExplodeArrayOrError(ctx context.Context, id []int32, reqEditors ...RequestEditorFn) (*GetMatrixExplodeArrayResponse, error) {
+	rsp, err := c.GetMatrixExplodeArrayWithResponse(ctx, id, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return getMatrixExplodeArrayOrError(rsp)
+}
 
-	response := &GetMatrixExplodeArrayResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// getMatrixExplodeArrayOrError returns the payload of a success response to
+// GetMatrixExplodeArray, or an error for any other response.
+func getMatrixExplodeArrayOrError(rsp *GetMatrixExplodeArrayResponse) (*GetMatrixExplodeArrayResponse, error) {
+	if rsp.StatusCode() >= 200 && rsp.StatusCode() < 300 {
+		return rsp, nil
 	}
 
-	switch {
+	apiErr := APIError{
+		Operation:    "GetMatrixExplodeArray",
+		StatusCode:   rsp.StatusCode(),
+		Body:         rsp.Body,
+		HTTPResponse: rsp.HTTPResponse,
 	}
-
-	return response, nil
+	return nil, &apiErr
 }
 
-// ParseGetMatrixExplodeObjectResponse parses an HTTP response from a GetMatrixExplodeObjectWithResponse call
-func ParseGetMatrixExplodeObjectResponse(rsp *http.Response) (*GetMatrixExplodeObjectResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer rsp.Body.Close()
+// GetMatrixExplodeObjectOrError calls GetMatrixExplodeObjectWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) GetMatrixExplodeObjectOrError(ctx context.Context, id Object, reqEditors ...RequestEditorFn) (*GetMatrixExplodeObjectResponse, error) {
+	rsp, err := c.GetMatrixExplodeObjectWithResponse(ctx, id, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return getMatrixExplodeObjectOrError(rsp)
+}
 
-	response := &GetMatrixExplodeObjectResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// getMatrixExplodeObjectOrError returns the payload of a success response to
+// GetMatrixExplodeObject, or an error for any other response.
+func getMatrixExplodeObjectOrError(rsp *GetMatrixExplodeObjectResponse) (*GetMatrixExplodeObjectResponse, error) {
+	if rsp.StatusCode() >= 200 && rsp.StatusCode() < 300 {
+		return rsp, nil
 	}
 
-	switch {
+	apiErr := APIError{
+		Operation:    "GetMatrixExplodeObject",
+		StatusCode:   rsp.StatusCode(),
+		Body:         rsp.Body,
+		HTTPResponse: rsp.HTTPResponse,
 	}
-
-	return response, nil
+	return nil, &apiErr
 }
 
-// ParseGetMatrixNoExplodeArrayResponse parses an HTTP response from a GetMatrixNoExplodeArrayWithResponse call
-func ParseGetMatrixNoExplodeArrayResponse(rsp *http.Response) (*GetMatrixNoExplodeArrayResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer rsp.Body.Close()
+// GetMatrixNoExplodeArrayOrError calls GetMatrixNoExplodeArrayWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) GetMatrixNoExplodeArrayOrError(ctx context.Context, id []int32, reqEditors ...RequestEditorFn) (*GetMatrixNoExplodeArrayResponse, error) {
+	rsp, err := c.GetMatrixNoExplodeArrayWithResponse(ctx, id, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return getMatrixNoExplodeArrayOrError(rsp)
+}
 
-	response := &GetMatrixNoExplodeArrayResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// getMatrixNoExplodeArrayOrError returns the payload of a success response to
+// GetMatrixNoExplodeArray, or an error for any other response.
+func getMatrixNoExplodeArrayOrError(rsp *GetMatrixNoExplodeArrayResponse) (*GetMatrixNoExplodeArrayResponse, error) {
+	if rsp.StatusCode() >= 200 && rsp.StatusCode() < 300 {
+		return rsp, nil
 	}
 
-	switch {
+	apiErr := APIError{
+		Operation:    "GetMatrixNoExplodeArray",
+		StatusCode:   rsp.StatusCode(),
+		Body:         rsp.Body,
+		HTTPResponse: rsp.HTTPResponse,
 	}
-
-	return response, nil
+	return nil, &apiErr
 }
 
-// ParseGetMatrixNoExplodeObjectResponse parses an HTTP response from a GetMatrixNoExplodeObjectWithResponse call
-func ParseGetMatrixNoExplodeObjectResponse(rsp *http.Response) (*GetMatrixNoExplodeObjectResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer rsp.Body.Close()
+// GetMatrixNoExplodeObjectOrError calls GetMatrixNoExplodeObjectWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) GetMatrixNoExplodeObjectOrError(ctx context.Context, id Object, reqEditors ...RequestEditorFn) (*GetMatrixNoExplodeObjectResponse, error) {
+	rsp, err := c.GetMatrixNoExplodeObjectWithResponse(ctx, id, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return getMatrixNoExplodeObjectOrError(rsp)
+}
 
-	response := &GetMatrixNoExplodeObjectResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// getMatrixNoExplodeObjectOrError returns the payload of a success response to
+// GetMatrixNoExplodeObject, or an error for any other response.
+func getMatrixNoExplodeObjectOrError(rsp *GetMatrixNoExplodeObjectResponse) (*GetMatrixNoExplodeObjectResponse, error) {
+	if rsp.StatusCode() >= 200 && rsp.StatusCode() < 300 {
+		return rsp, nil
 	}
 
-	switch {
+	apiErr := APIError{
+		Operation:    "GetMatrixNoExplodeObject",
+		StatusCode:   rsp.StatusCode(),
+		Body:         rsp.Body,
+		HTTPResponse: rsp.HTTPResponse,
 	}
-
-	return response, nil
+	return nil, &apiErr
 }
 
-// ParseGetPassThroughResponse parses an HTTP response from a GetPassThroughWithResponse call
-func ParseGetPassThroughResponse(rsp *http.Response) (*GetPassThroughResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer rsp.Body.Close()
+// GetPassThroughOrError calls GetPassThroughWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) GetPassThroughOrError(ctx context.Context, param string, reqEditors ...RequestEditorFn) (*GetPassThroughResponse, error) {
+	rsp, err := c.GetPassThroughWithResponse(ctx, param, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return getPassThroughOrError(rsp)
+}
 
-	response := &GetPassThroughResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// getPassThroughOrError returns the payload of a success response to
+// GetPassThrough, or an error for any other response.
+func getPassThroughOrError(rsp *GetPassThroughResponse) (*GetPassThroughResponse, error) {
+	if rsp.StatusCode() >= 200 && rsp.StatusCode() < 300 {
+		return rsp, nil
 	}
 
-	switch {
+	apiErr := APIError{
+		Operation:    "GetPassThrough",
+		StatusCode:   rsp.StatusCode(),
+		Body:         rsp.Body,
+		HTTPResponse: rsp.HTTPResponse,
 	}
-
-	return response, nil
+	return nil, &apiErr
 }
 
-// ParseGetDeepObjectResponse parses an HTTP response from a GetDeepObjectWithResponse call
-func ParseGetDeepObjectResponse(rsp *http.Response) (*GetDeepObjectResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer rsp.Body.Close()
+// GetDeepObjectOrError calls GetDeepObjectWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) GetDeepObjectOrError(ctx context.Context, params *GetDeepObjectParams, reqEditors ...RequestEditorFn) (*GetDeepObjectResponse, error) {
+	rsp, err := c.GetDeepObjectWithResponse(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return getDeepObjectOrError(rsp)
+}
 
-	response := &GetDeepObjectResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// getDeepObjectOrError returns the payload of a success response to
+// GetDeepObject, or an error for any other response.
+func getDeepObjectOrError(rsp *GetDeepObjectResponse) (*GetDeepObjectResponse, error) {
+	if rsp.StatusCode() >= 200 && rsp.StatusCode() < 300 {
+		return rsp, nil
 	}
 
-	switch {
+	apiErr := APIError{
+		Operation:    "GetDeepObject",
+		StatusCode:   rsp.StatusCode(),
+		Body:         rsp.Body,
+		HTTPResponse: rsp.HTTPResponse,
 	}
-
-	return response, nil
+	return nil, &apiErr
 }
 
-// ParseGetQueryFormResponse parses an HTTP response from a GetQueryFormWithResponse call
-func ParseGetQueryFormResponse(rsp *http.Response) (*GetQueryFormResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer rsp.Body.Close()
+// GetQueryFormOrError calls GetQueryFormWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) GetQueryFormOrError(ctx context.Context, params *GetQueryFormParams, reqEditors ...RequestEditorFn) (*GetQueryFormResponse, error) {
+	rsp, err := c.GetQueryFormWithResponse(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return getQueryFormOrError(rsp)
+}
 
-	response := &GetQueryFormResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// getQueryFormOrError returns the payload of a success response to
+// GetQueryForm, or an error for any other response.
+func getQueryFormOrError(rsp *GetQueryFormResponse) (*GetQueryFormResponse, error) {
+	if rsp.StatusCode() >= 200 && rsp.StatusCode() < 300 {
+		return rsp, nil
 	}
 
-	switch {
+	apiErr := APIError{
+		Operation:    "GetQueryForm",
+		StatusCode:   rsp.StatusCode(),
+		Body:         rsp.Body,
+		HTTPResponse: rsp.HTTPResponse,
 	}
-
-	return response, nil
+	return nil, &apiErr
 }
 
-// ParseGetSimpleExplodeArrayResponse parses an HTTP response from a GetSimpleExplodeArrayWithResponse call
-func ParseGetSimpleExplodeArrayResponse(rsp *http.Response) (*GetSimpleExplodeArrayResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer rsp.Body.Close()
+// GetSimpleExplodeArrayOrError calls GetSimpleExplodeArrayWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) GetSimpleExplodeArrayOrError(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*GetSimpleExplodeArrayResponse, error) {
+	rsp, err := c.GetSimpleExplodeArrayWithResponse(ctx, param, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return getSimpleExplodeArrayOrError(rsp)
+}
 
-	response := &GetSimpleExplodeArrayResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// getSimpleExplodeArrayOrError returns the payload of a success response to
+// GetSimpleExplodeArray, or an error for any other response.
+func getSimpleExplodeArrayOrError(rsp *GetSimpleExplodeArrayResponse) (*GetSimpleExplodeArrayResponse, error) {
+	if rsp.StatusCode() >= 200 && rsp.StatusCode() < 300 {
+		return rsp, nil
 	}
 
-	switch {
+	apiErr := APIError{
+		Operation:    "GetSimpleExplodeArray",
+		StatusCode:   rsp.StatusCode(),
+		Body:         rsp.Body,
+		HTTPResponse: rsp.HTTPResponse,
 	}
-
-	return response, nil
+	return nil, &apiErr
 }
 
-// ParseGetSimpleExplodeObjectResponse parses an HTTP response from a GetSimpleExplodeObjectWithResponse call
-func ParseGetSimpleExplodeObjectResponse(rsp *http.Response) (*GetSimpleExplodeObjectResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer rsp.Body.Close()
+// GetSimpleExplodeObjectOrError calls GetSimpleExplodeObjectWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) GetSimpleExplodeObjectOrError(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*GetSimpleExplodeObjectResponse, error) {
+	rsp, err := c.GetSimpleExplodeObjectWithResponse(ctx, param, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return getSimpleExplodeObjectOrError(rsp)
+}
 
-	response := &GetSimpleExplodeObjectResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// getSimpleExplodeObjectOrError returns the payload of a success response to
+// GetSimpleExplodeObject, or an error for any other response.
+func getSimpleExplodeObjectOrError(rsp *GetSimpleExplodeObjectResponse) (*GetSimpleExplodeObjectResponse, error) {
+	if rsp.StatusCode() >= 200 && rsp.StatusCode() < 300 {
+		return rsp, nil
 	}
 
-	switch {
+	apiErr := APIError{
+		Operation:    "GetSimpleExplodeObject",
+		StatusCode:   rsp.StatusCode(),
+		Body:         rsp.Body,
+		HTTPResponse: rsp.HTTPResponse,
 	}
-
-	return response, nil
+	return nil, &apiErr
 }
 
-// ParseGetSimpleNoExplodeArrayResponse parses an HTTP response from a GetSimpleNoExplodeArrayWithResponse call
-func ParseGetSimpleNoExplodeArrayResponse(rsp *http.Response) (*GetSimpleNoExplodeArrayResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer rsp.Body.Close()
+// GetSimpleNoExplodeArrayOrError calls GetSimpleNoExplodeArrayWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) GetSimpleNoExplodeArrayOrError(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*GetSimpleNoExplodeArrayResponse, error) {
+	rsp, err := c.GetSimpleNoExplodeArrayWithResponse(ctx, param, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return getSimpleNoExplodeArrayOrError(rsp)
+}
 
-	response := &GetSimpleNoExplodeArrayResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// getSimpleNoExplodeArrayOrError returns the payload of a success response to
+// GetSimpleNoExplodeArray, or an error for any other response.
+func getSimpleNoExplodeArrayOrError(rsp *GetSimpleNoExplodeArrayResponse) (*GetSimpleNoExplodeArrayResponse, error) {
+	if rsp.StatusCode() >= 200 && rsp.StatusCode() < 300 {
+		return rsp, nil
 	}
 
-	switch {
+	apiErr := APIError{
+		Operation:    "GetSimpleNoExplodeArray",
+		StatusCode:   rsp.StatusCode(),
+		Body:         rsp.Body,
+		HTTPResponse: rsp.HTTPResponse,
 	}
-
-	return response, nil
+	return nil, &apiErr
 }
 
-// ParseGetSimpleNoExplodeObjectResponse parses an HTTP response from a GetSimpleNoExplodeObjectWithResponse call
-func ParseGetSimpleNoExplodeObjectResponse(rsp *http.Response) (*GetSimpleNoExplodeObjectResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer rsp.Body.Close()
+// GetSimpleNoExplodeObjectOrError calls GetSimpleNoExplodeObjectWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) GetSimpleNoExplodeObjectOrError(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*GetSimpleNoExplodeObjectResponse, error) {
+	rsp, err := c.GetSimpleNoExplodeObjectWithResponse(ctx, param, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return getSimpleNoExplodeObjectOrError(rsp)
+}
 
-	response := &GetSimpleNoExplodeObjectResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// getSimpleNoExplodeObjectOrError returns the payload of a success response to
+// GetSimpleNoExplodeObject, or an error for any other response.
+func getSimpleNoExplodeObjectOrError(rsp *GetSimpleNoExplodeObjectResponse) (*GetSimpleNoExplodeObjectResponse, error) {
+	if rsp.StatusCode() >= 200 && rsp.StatusCode() < 300 {
+		return rsp, nil
 	}
 
-	switch {
+	apiErr := APIError{
+		Operation:    "GetSimpleNoExplodeObject",
+		StatusCode:   rsp.StatusCode(),
+		Body:         rsp.Body,
+		HTTPResponse: rsp.HTTPResponse,
 	}
-
-	return response, nil
+	return nil, &apiErr
 }
 
-// ParseGetSimplePrimitiveResponse parses an HTTP response from a GetSimplePrimitiveWithResponse call
-func ParseGetSimplePrimitiveResponse(rsp *http.Response) (*GetSimplePrimitiveResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer rsp.Body.Close()
+// GetSimplePrimitiveOrError calls GetSimplePrimitiveWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) GetSimplePrimitiveOrError(ctx context.Context, param int32, reqEditors ...RequestEditorFn) (*GetSimplePrimitiveResponse, error) {
+	rsp, err := c.GetSimplePrimitiveWithResponse(ctx, param, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return getSimplePrimitiveOrError(rsp)
+}
 
-	response := &GetSimplePrimitiveResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// getSimplePrimitiveOrError returns the payload of a success response to
+// GetSimplePrimitive, or an error for any other response.
+func getSimplePrimitiveOrError(rsp *GetSimplePrimitiveResponse) (*GetSimplePrimitiveResponse, error) {
+	if rsp.StatusCode() >= 200 && rsp.StatusCode() < 300 {
+		return rsp, nil
 	}
 
-	switch {
+	apiErr := APIError{
+		Operation:    "GetSimplePrimitive",
+		StatusCode:   rsp.StatusCode(),
+		Body:         rsp.Body,
+		HTTPResponse: rsp.HTTPResponse,
 	}
-
-	return response, nil
+	return nil, &apiErr
 }
 
 // ServerInterface represents all server handlers.
@@ -2539,6 +3773,20 @@ type ServerInterface interface {
 // ServerInterfaceWrapper converts echo contexts to parameters.
 type ServerInterfaceWrapper struct {
 	Handler ServerInterface
+
+	// ErrorHandlerFunc answers requests whose parameters can't be bound, with
+	// their InvalidParamFormatError, RequiredParamError, UnmarshalingParamError
+	// or TooManyValuesForParamError, of the runtime package. They are answered
+	// with a 400 echo.HTTPError if it is nil.
+	ErrorHandlerFunc func(ctx echo.Context, err error) error
+}
+
+// handleError answers a request whose parameters can't be bound.
+func (w *ServerInterfaceWrapper) handleError(ctx echo.Context, err error) error {
+	if w.ErrorHandlerFunc != nil {
+		return w.ErrorHandlerFunc(ctx, err)
+	}
+	return echo.NewHTTPError(http.StatusBadRequest, err.Error())
 }
 
 // GetContentObject converts echo context to params.
@@ -2549,7 +3797,7 @@ func (w *ServerInterfaceWrapper) GetContentObject(ctx echo.Context) error {
 
 	err = json.Unmarshal([]byte(ctx.Param("param")), &param)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, "Error unmarshaling parameter 'param' as JSON")
+		return w.handleError(ctx, &runtime.UnmarshalingParamError{ParamName: "param", ParamLocation: runtime.ParamLocationPath, Err: err})
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
@@ -2564,70 +3812,34 @@ func (w *ServerInterfaceWrapper) GetCookie(ctx echo.Context) error {
 	// Parameter object where we will unmarshal all parameters from the context
 	var params GetCookieParams
 
-	if cookie, err := ctx.Cookie("p"); err == nil {
-
-		var value int32
-		err = runtime.BindStyledParameter("simple", false, "p", cookie.Value, &value)
-		if err != nil {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter p: %s", err))
-		}
-		params.P = &value
-
+	err = runtime.BindCookieParameter("form", false, false, "p", ctx.Cookies(), &params.P)
+	if err != nil {
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationCookie, "p", err))
 	}
 
-	if cookie, err := ctx.Cookie("ep"); err == nil {
-
-		var value int32
-		err = runtime.BindStyledParameter("simple", true, "ep", cookie.Value, &value)
-		if err != nil {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ep: %s", err))
-		}
-		params.Ep = &value
-
+	err = runtime.BindCookieParameter("form", true, false, "ep", ctx.Cookies(), &params.Ep)
+	if err != nil {
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationCookie, "ep", err))
 	}
 
-	if cookie, err := ctx.Cookie("ea"); err == nil {
-
-		var value []int32
-		err = runtime.BindStyledParameter("simple", true, "ea", cookie.Value, &value)
-		if err != nil {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ea: %s", err))
-		}
-		params.Ea = &value
-
+	err = runtime.BindCookieParameter("form", true, false, "ea", ctx.Cookies(), &params.Ea)
+	if err != nil {
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationCookie, "ea", err))
 	}
 
-	if cookie, err := ctx.Cookie("a"); err == nil {
-
-		var value []int32
-		err = runtime.BindStyledParameter("simple", false, "a", cookie.Value, &value)
-		if err != nil {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter a: %s", err))
-		}
-		params.A = &value
-
+	err = runtime.BindCookieParameter("form", false, false, "a", ctx.Cookies(), &params.A)
+	if err != nil {
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationCookie, "a", err))
 	}
 
-	if cookie, err := ctx.Cookie("eo"); err == nil {
-
-		var value Object
-		err = runtime.BindStyledParameter("simple", true, "eo", cookie.Value, &value)
-		if err != nil {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter eo: %s", err))
-		}
-		params.Eo = &value
-
+	err = runtime.BindCookieParameter("form", true, false, "eo", ctx.Cookies(), &params.Eo)
+	if err != nil {
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationCookie, "eo", err))
 	}
 
-	if cookie, err := ctx.Cookie("o"); err == nil {
-
-		var value Object
-		err = runtime.BindStyledParameter("simple", false, "o", cookie.Value, &value)
-		if err != nil {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter o: %s", err))
-		}
-		params.O = &value
-
+	err = runtime.BindCookieParameter("form", false, false, "o", ctx.Cookies(), &params.O)
+	if err != nil {
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationCookie, "o", err))
 	}
 
 	if cookie, err := ctx.Cookie("co"); err == nil {
@@ -2636,11 +3848,11 @@ func (w *ServerInterfaceWrapper) GetCookie(ctx echo.Context) error {
 		var decoded string
 		decoded, err := url.QueryUnescape(cookie.Value)
 		if err != nil {
-			return echo.NewHTTPError(http.StatusBadRequest, "Error unescaping cookie parameter 'co'")
+			return w.handleError(ctx, &runtime.InvalidParamFormatError{ParamName: "co", ParamLocation: runtime.ParamLocationCookie, Err: err})
 		}
 		err = json.Unmarshal([]byte(decoded), &value)
 		if err != nil {
-			return echo.NewHTTPError(http.StatusBadRequest, "Error unmarshaling parameter 'co' as JSON")
+			return w.handleError(ctx, &runtime.UnmarshalingParamError{ParamName: "co", ParamLocation: runtime.ParamLocationCookie, Err: err})
 		}
 		params.Co = &value
 
@@ -2660,106 +3872,59 @@ func (w *ServerInterfaceWrapper) GetHeader(ctx echo.Context) error {
 
 	headers := ctx.Request().Header
 	// ------------- Optional header parameter "X-Primitive" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("X-Primitive")]; found {
-		var XPrimitive int32
-		n := len(valueList)
-		if n != 1 {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Primitive, got %d", n))
-		}
-
-		err = runtime.BindStyledParameter("simple", false, "X-Primitive", valueList[0], &XPrimitive)
-		if err != nil {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Primitive: %s", err))
-		}
 
-		params.XPrimitive = &XPrimitive
+	err = runtime.BindHeaderParameter("simple", false, false, "X-Primitive", headers, &params.XPrimitive)
+	if err != nil {
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationHeader, "X-Primitive", err))
 	}
-	// ------------- Optional header parameter "X-Primitive-Exploded" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("X-Primitive-Exploded")]; found {
-		var XPrimitiveExploded int32
-		n := len(valueList)
-		if n != 1 {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Primitive-Exploded, got %d", n))
-		}
 
-		err = runtime.BindStyledParameter("simple", true, "X-Primitive-Exploded", valueList[0], &XPrimitiveExploded)
-		if err != nil {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Primitive-Exploded: %s", err))
-		}
+	// ------------- Optional header parameter "X-Primitive-Exploded" -------------
 
-		params.XPrimitiveExploded = &XPrimitiveExploded
+	err = runtime.BindHeaderParameter("simple", true, false, "X-Primitive-Exploded", headers, &params.XPrimitiveExploded)
+	if err != nil {
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationHeader, "X-Primitive-Exploded", err))
 	}
-	// ------------- Optional header parameter "X-Array-Exploded" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("X-Array-Exploded")]; found {
-		var XArrayExploded []int32
-		n := len(valueList)
-		if n != 1 {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Array-Exploded, got %d", n))
-		}
 
-		err = runtime.BindStyledParameter("simple", true, "X-Array-Exploded", valueList[0], &XArrayExploded)
-		if err != nil {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Array-Exploded: %s", err))
-		}
+	// ------------- Optional header parameter "X-Array-Exploded" -------------
 
-		params.XArrayExploded = &XArrayExploded
+	err = runtime.BindHeaderParameter("simple", true, false, "X-Array-Exploded", headers, &params.XArrayExploded)
+	if err != nil {
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationHeader, "X-Array-Exploded", err))
 	}
-	// ------------- Optional header parameter "X-Array" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("X-Array")]; found {
-		var XArray []int32
-		n := len(valueList)
-		if n != 1 {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Array, got %d", n))
-		}
 
-		err = runtime.BindStyledParameter("simple", false, "X-Array", valueList[0], &XArray)
-		if err != nil {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Array: %s", err))
-		}
+	// ------------- Optional header parameter "X-Array" -------------
 
-		params.XArray = &XArray
+	err = runtime.BindHeaderParameter("simple", false, false, "X-Array", headers, &params.XArray)
+	if err != nil {
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationHeader, "X-Array", err))
 	}
-	// ------------- Optional header parameter "X-Object-Exploded" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("X-Object-Exploded")]; found {
-		var XObjectExploded Object
-		n := len(valueList)
-		if n != 1 {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Object-Exploded, got %d", n))
-		}
 
-		err = runtime.BindStyledParameter("simple", true, "X-Object-Exploded", valueList[0], &XObjectExploded)
-		if err != nil {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Object-Exploded: %s", err))
-		}
+	// ------------- Optional header parameter "X-Object-Exploded" -------------
 
-		params.XObjectExploded = &XObjectExploded
+	err = runtime.BindHeaderParameter("simple", true, false, "X-Object-Exploded", headers, &params.XObjectExploded)
+	if err != nil {
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationHeader, "X-Object-Exploded", err))
 	}
-	// ------------- Optional header parameter "X-Object" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("X-Object")]; found {
-		var XObject Object
-		n := len(valueList)
-		if n != 1 {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Object, got %d", n))
-		}
 
-		err = runtime.BindStyledParameter("simple", false, "X-Object", valueList[0], &XObject)
-		if err != nil {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Object: %s", err))
-		}
+	// ------------- Optional header parameter "X-Object" -------------
 
-		params.XObject = &XObject
+	err = runtime.BindHeaderParameter("simple", false, false, "X-Object", headers, &params.XObject)
+	if err != nil {
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationHeader, "X-Object", err))
 	}
+
 	// ------------- Optional header parameter "X-Complex-Object" -------------
+
 	if valueList, found := headers[http.CanonicalHeaderKey("X-Complex-Object")]; found {
 		var XComplexObject ComplexObject
 		n := len(valueList)
 		if n != 1 {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Complex-Object, got %d", n))
+			return w.handleError(ctx, &runtime.TooManyValuesForParamError{ParamName: "X-Complex-Object", ParamLocation: runtime.ParamLocationHeader, Count: n})
 		}
 
 		err = json.Unmarshal([]byte(valueList[0]), &XComplexObject)
 		if err != nil {
-			return echo.NewHTTPError(http.StatusBadRequest, "Error unmarshaling parameter 'X-Complex-Object' as JSON")
+			return w.handleError(ctx, &runtime.UnmarshalingParamError{ParamName: "X-Complex-Object", ParamLocation: runtime.ParamLocationHeader, Err: err})
 		}
 
 		params.XComplexObject = &XComplexObject
@@ -2778,7 +3943,7 @@ func (w *ServerInterfaceWrapper) GetLabelExplodeArray(ctx echo.Context) error {
 
 	err = runtime.BindStyledParameter("label", true, "param", ctx.Param("param"), &param)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter param: %s", err))
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationPath, "param", err))
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
@@ -2794,7 +3959,7 @@ func (w *ServerInterfaceWrapper) GetLabelExplodeObject(ctx echo.Context) error {
 
 	err = runtime.BindStyledParameter("label", true, "param", ctx.Param("param"), &param)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter param: %s", err))
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationPath, "param", err))
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
@@ -2810,7 +3975,7 @@ func (w *ServerInterfaceWrapper) GetLabelNoExplodeArray(ctx echo.Context) error
 
 	err = runtime.BindStyledParameter("label", false, "param", ctx.Param("param"), &param)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter param: %s", err))
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationPath, "param", err))
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
@@ -2826,7 +3991,7 @@ func (w *ServerInterfaceWrapper) GetLabelNoExplodeObject(ctx echo.Context) error
 
 	err = runtime.BindStyledParameter("label", false, "param", ctx.Param("param"), &param)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter param: %s", err))
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationPath, "param", err))
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
@@ -2842,7 +4007,7 @@ func (w *ServerInterfaceWrapper) GetMatrixExplodeArray(ctx echo.Context) error {
 
 	err = runtime.BindStyledParameter("matrix", true, "id", ctx.Param("id"), &id)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationPath, "id", err))
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
@@ -2858,7 +4023,7 @@ func (w *ServerInterfaceWrapper) GetMatrixExplodeObject(ctx echo.Context) error
 
 	err = runtime.BindStyledParameter("matrix", true, "id", ctx.Param("id"), &id)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationPath, "id", err))
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
@@ -2874,7 +4039,7 @@ func (w *ServerInterfaceWrapper) GetMatrixNoExplodeArray(ctx echo.Context) error
 
 	err = runtime.BindStyledParameter("matrix", false, "id", ctx.Param("id"), &id)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationPath, "id", err))
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
@@ -2890,7 +4055,7 @@ func (w *ServerInterfaceWrapper) GetMatrixNoExplodeObject(ctx echo.Context) erro
 
 	err = runtime.BindStyledParameter("matrix", false, "id", ctx.Param("id"), &id)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationPath, "id", err))
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
@@ -2921,7 +4086,7 @@ func (w *ServerInterfaceWrapper) GetDeepObject(ctx echo.Context) error {
 
 	err = runtime.BindQueryParameter("deepObject", true, true, "deepObj", ctx.QueryParams(), &params.DeepObj)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter deepObj: %s", err))
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationQuery, "deepObj", err))
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
@@ -2939,42 +4104,42 @@ func (w *ServerInterfaceWrapper) GetQueryForm(ctx echo.Context) error {
 
 	err = runtime.BindQueryParameter("form", true, false, "ea", ctx.QueryParams(), &params.Ea)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ea: %s", err))
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationQuery, "ea", err))
 	}
 
 	// ------------- Optional query parameter "a" -------------
 
 	err = runtime.BindQueryParameter("form", false, false, "a", ctx.QueryParams(), &params.A)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter a: %s", err))
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationQuery, "a", err))
 	}
 
 	// ------------- Optional query parameter "eo" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "eo", ctx.QueryParams(), &params.Eo)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter eo: %s", err))
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationQuery, "eo", err))
 	}
 
 	// ------------- Optional query parameter "o" -------------
 
 	err = runtime.BindQueryParameter("form", false, false, "o", ctx.QueryParams(), &params.O)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter o: %s", err))
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationQuery, "o", err))
 	}
 
 	// ------------- Optional query parameter "ep" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "ep", ctx.QueryParams(), &params.Ep)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ep: %s", err))
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationQuery, "ep", err))
 	}
 
 	// ------------- Optional query parameter "p" -------------
 
 	err = runtime.BindQueryParameter("form", false, false, "p", ctx.QueryParams(), &params.P)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter p: %s", err))
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationQuery, "p", err))
 	}
 
 	// ------------- Optional query parameter "co" -------------
@@ -2984,7 +4149,7 @@ func (w *ServerInterfaceWrapper) GetQueryForm(ctx echo.Context) error {
 		var value ComplexObject
 		err = json.Unmarshal([]byte(paramValue), &value)
 		if err != nil {
-			return echo.NewHTTPError(http.StatusBadRequest, "Error unmarshaling parameter 'co' as JSON")
+			return w.handleError(ctx, &runtime.UnmarshalingParamError{ParamName: "co", ParamLocation: runtime.ParamLocationQuery, Err: err})
 		}
 		params.Co = &value
 
@@ -3003,7 +4168,7 @@ func (w *ServerInterfaceWrapper) GetSimpleExplodeArray(ctx echo.Context) error {
 
 	err = runtime.BindStyledParameter("simple", true, "param", ctx.Param("param"), &param)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter param: %s", err))
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationPath, "param", err))
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
@@ -3019,7 +4184,7 @@ func (w *ServerInterfaceWrapper) GetSimpleExplodeObject(ctx echo.Context) error
 
 	err = runtime.BindStyledParameter("simple", true, "param", ctx.Param("param"), &param)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter param: %s", err))
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationPath, "param", err))
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
@@ -3035,7 +4200,7 @@ func (w *ServerInterfaceWrapper) GetSimpleNoExplodeArray(ctx echo.Context) error
 
 	err = runtime.BindStyledParameter("simple", false, "param", ctx.Param("param"), &param)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter param: %s", err))
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationPath, "param", err))
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
@@ -3051,7 +4216,7 @@ func (w *ServerInterfaceWrapper) GetSimpleNoExplodeObject(ctx echo.Context) erro
 
 	err = runtime.BindStyledParameter("simple", false, "param", ctx.Param("param"), &param)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter param: %s", err))
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationPath, "param", err))
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
@@ -3067,7 +4232,7 @@ func (w *ServerInterfaceWrapper) GetSimplePrimitive(ctx echo.Context) error {
 
 	err = runtime.BindStyledParameter("simple", false, "param", ctx.Param("param"), &param)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter param: %s", err))
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationPath, "param", err))
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
@@ -3090,55 +4255,228 @@ type EchoRouter interface {
 	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
 }
 
+// RegisterOptions configures the middlewares and the error handling of the
+// routes added by RegisterHandlersWithOptions.
+type RegisterOptions struct {
+	// The middlewares of operations, by operation ID.
+	OperationMiddlewares map[string][]echo.MiddlewareFunc
+
+	// The middlewares of the operations with a tag, by tag.
+	TagMiddlewares map[string][]echo.MiddlewareFunc
+
+	// The ErrorHandlerFunc of the ServerInterfaceWrapper.
+	ErrorHandlerFunc func(ctx echo.Context, err error) error
+}
+
 // RegisterHandlers adds each server route to the EchoRouter.
 func RegisterHandlers(router EchoRouter, si ServerInterface) {
+	RegisterHandlersWithOptions(router, si, RegisterOptions{})
+}
 
-	wrapper := ServerInterfaceWrapper{
-		Handler: si,
-	}
-
-	router.GET("/contentObject/:param", wrapper.GetContentObject)
-	router.GET("/cookie", wrapper.GetCookie)
-	router.GET("/header", wrapper.GetHeader)
-	router.GET("/labelExplodeArray/:param", wrapper.GetLabelExplodeArray)
-	router.GET("/labelExplodeObject/:param", wrapper.GetLabelExplodeObject)
-	router.GET("/labelNoExplodeArray/:param", wrapper.GetLabelNoExplodeArray)
-	router.GET("/labelNoExplodeObject/:param", wrapper.GetLabelNoExplodeObject)
-	router.GET("/matrixExplodeArray/:id", wrapper.GetMatrixExplodeArray)
-	router.GET("/matrixExplodeObject/:id", wrapper.GetMatrixExplodeObject)
-	router.GET("/matrixNoExplodeArray/:id", wrapper.GetMatrixNoExplodeArray)
-	router.GET("/matrixNoExplodeObject/:id", wrapper.GetMatrixNoExplodeObject)
-	router.GET("/passThrough/:param", wrapper.GetPassThrough)
-	router.GET("/queryDeepObject", wrapper.GetDeepObject)
-	router.GET("/queryForm", wrapper.GetQueryForm)
-	router.GET("/simpleExplodeArray/:param", wrapper.GetSimpleExplodeArray)
-	router.GET("/simpleExplodeObject/:param", wrapper.GetSimpleExplodeObject)
-	router.GET("/simpleNoExplodeArray/:param", wrapper.GetSimpleNoExplodeArray)
-	router.GET("/simpleNoExplodeObject/:param", wrapper.GetSimpleNoExplodeObject)
-	router.GET("/simplePrimitive/:param", wrapper.GetSimplePrimitive)
+// RegisterHandlersWithOptions adds each server route to the EchoRouter, with
+// the middlewares of the tags of its operation, in the order of the tags, and
+// then those of the operation. The OperationInfo of the operation is put into
+// the context of the request before any of them runs.
+func RegisterHandlersWithOptions(router EchoRouter, si ServerInterface, opts RegisterOptions) {
 
+	wrapper := ServerInterfaceWrapper{
+		Handler:          si,
+		ErrorHandlerFunc: opts.ErrorHandlerFunc,
+	}
+
+	router.GET("/contentObject/:param", wrapper.GetContentObject, opts.middlewares("GetContentObject")...)
+	router.GET("/cookie", wrapper.GetCookie, opts.middlewares("GetCookie")...)
+	router.GET("/header", wrapper.GetHeader, opts.middlewares("GetHeader")...)
+	router.GET("/labelExplodeArray/:param", wrapper.GetLabelExplodeArray, opts.middlewares("GetLabelExplodeArray")...)
+	router.GET("/labelExplodeObject/:param", wrapper.GetLabelExplodeObject, opts.middlewares("GetLabelExplodeObject")...)
+	router.GET("/labelNoExplodeArray/:param", wrapper.GetLabelNoExplodeArray, opts.middlewares("GetLabelNoExplodeArray")...)
+	router.GET("/labelNoExplodeObject/:param", wrapper.GetLabelNoExplodeObject, opts.middlewares("GetLabelNoExplodeObject")...)
+	router.GET("/matrixExplodeArray/:id", wrapper.GetMatrixExplodeArray, opts.middlewares("GetMatrixExplodeArray")...)
+	router.GET("/matrixExplodeObject/:id", wrapper.GetMatrixExplodeObject, opts.middlewares("GetMatrixExplodeObject")...)
+	router.GET("/matrixNoExplodeArray/:id", wrapper.GetMatrixNoExplodeArray, opts.middlewares("GetMatrixNoExplodeArray")...)
+	router.GET("/matrixNoExplodeObject/:id", wrapper.GetMatrixNoExplodeObject, opts.middlewares("GetMatrixNoExplodeObject")...)
+	router.GET("/passThrough/:param", wrapper.GetPassThrough, opts.middlewares("GetPassThrough")...)
+	router.GET("/queryDeepObject", wrapper.GetDeepObject, opts.middlewares("GetDeepObject")...)
+	router.GET("/queryForm", wrapper.GetQueryForm, opts.middlewares("GetQueryForm")...)
+	router.GET("/simpleExplodeArray/:param", wrapper.GetSimpleExplodeArray, opts.middlewares("GetSimpleExplodeArray")...)
+	router.GET("/simpleExplodeObject/:param", wrapper.GetSimpleExplodeObject, opts.middlewares("GetSimpleExplodeObject")...)
+	router.GET("/simpleNoExplodeArray/:param", wrapper.GetSimpleNoExplodeArray, opts.middlewares("GetSimpleNoExplodeArray")...)
+	router.GET("/simpleNoExplodeObject/:param", wrapper.GetSimpleNoExplodeObject, opts.middlewares("GetSimpleNoExplodeObject")...)
+	router.GET("/simplePrimitive/:param", wrapper.GetSimplePrimitive, opts.middlewares("GetSimplePrimitive")...)
+
+}
+
+// middlewares returns the middlewares of the route of an operation.
+func (opts RegisterOptions) middlewares(operationId string) []echo.MiddlewareFunc {
+	info := Operations[operationId]
+	middlewares := []echo.MiddlewareFunc{
+		func(next echo.HandlerFunc) echo.HandlerFunc {
+			return func(ctx echo.Context) error {
+				r := ctx.Request()
+				ctx.SetRequest(r.WithContext(ContextWithOperationInfo(r.Context(), info)))
+				return next(ctx)
+			}
+		},
+	}
+	for _, tag := range info.Tags {
+		middlewares = append(middlewares, opts.TagMiddlewares[tag]...)
+	}
+	return append(middlewares, opts.OperationMiddlewares[operationId]...)
+}
+
+// OperationInfo describes an operation of the API, for the middlewares serving
+// it, which find it in the context of its requests.
+type OperationInfo struct {
+	OperationId string                     // The operation ID, as the names of the generated code use it
+	Method      string                     // The HTTP method, eg. GET
+	Path        string                     // The path template of the spec, eg. /pets/{id}
+	Tags        []string                   // The tags of the operation
+	Security    []OperationSecurity        // The security providers of the operation
+	Extensions  map[string]json.RawMessage // The x- extensions of the operation, by name
+}
+
+// OperationSecurity is a security provider of an operation, and the scopes it
+// requires.
+type OperationSecurity struct {
+	ProviderName string
+	Scopes       []string
+}
+
+// Operations holds the OperationInfo of every operation, by operation ID.
+var Operations = map[string]OperationInfo{
+	"GetContentObject": {
+		OperationId: "GetContentObject",
+		Method:      "GET",
+		Path:        "/contentObject/{param}",
+	},
+	"GetCookie": {
+		OperationId: "GetCookie",
+		Method:      "GET",
+		Path:        "/cookie",
+	},
+	"GetHeader": {
+		OperationId: "GetHeader",
+		Method:      "GET",
+		Path:        "/header",
+	},
+	"GetLabelExplodeArray": {
+		OperationId: "GetLabelExplodeArray",
+		Method:      "GET",
+		Path:        "/labelExplodeArray/{.param*}",
+	},
+	"GetLabelExplodeObject": {
+		OperationId: "GetLabelExplodeObject",
+		Method:      "GET",
+		Path:        "/labelExplodeObject/{.param*}",
+	},
+	"GetLabelNoExplodeArray": {
+		OperationId: "GetLabelNoExplodeArray",
+		Method:      "GET",
+		Path:        "/labelNoExplodeArray/{.param}",
+	},
+	"GetLabelNoExplodeObject": {
+		OperationId: "GetLabelNoExplodeObject",
+		Method:      "GET",
+		Path:        "/labelNoExplodeObject/{.param}",
+	},
+	"GetMatrixExplodeArray": {
+		OperationId: "GetMatrixExplodeArray",
+		Method:      "GET",
+		Path:        "/matrixExplodeArray/{.id*}",
+	},
+	"GetMatrixExplodeObject": {
+		OperationId: "GetMatrixExplodeObject",
+		Method:      "GET",
+		Path:        "/matrixExplodeObject/{.id*}",
+	},
+	"GetMatrixNoExplodeArray": {
+		OperationId: "GetMatrixNoExplodeArray",
+		Method:      "GET",
+		Path:        "/matrixNoExplodeArray/{.id}",
+	},
+	"GetMatrixNoExplodeObject": {
+		OperationId: "GetMatrixNoExplodeObject",
+		Method:      "GET",
+		Path:        "/matrixNoExplodeObject/{.id}",
+	},
+	"GetPassThrough": {
+		OperationId: "GetPassThrough",
+		Method:      "GET",
+		Path:        "/passThrough/{param}",
+	},
+	"GetDeepObject": {
+		OperationId: "GetDeepObject",
+		Method:      "GET",
+		Path:        "/queryDeepObject",
+	},
+	"GetQueryForm": {
+		OperationId: "GetQueryForm",
+		Method:      "GET",
+		Path:        "/queryForm",
+	},
+	"GetSimpleExplodeArray": {
+		OperationId: "GetSimpleExplodeArray",
+		Method:      "GET",
+		Path:        "/simpleExplodeArray/{param*}",
+	},
+	"GetSimpleExplodeObject": {
+		OperationId: "GetSimpleExplodeObject",
+		Method:      "GET",
+		Path:        "/simpleExplodeObject/{param*}",
+	},
+	"GetSimpleNoExplodeArray": {
+		OperationId: "GetSimpleNoExplodeArray",
+		Method:      "GET",
+		Path:        "/simpleNoExplodeArray/{param}",
+	},
+	"GetSimpleNoExplodeObject": {
+		OperationId: "GetSimpleNoExplodeObject",
+		Method:      "GET",
+		Path:        "/simpleNoExplodeObject/{param}",
+	},
+	"GetSimplePrimitive": {
+		OperationId: "GetSimplePrimitive",
+		Method:      "GET",
+		Path:        "/simplePrimitive/{param}",
+	},
+}
+
+// operationInfoKey is the context key of the OperationInfo of a request.
+type operationInfoKey struct{}
+
+// ContextWithOperationInfo returns a copy of ctx holding info, which
+// OperationInfoFromContext returns.
+func ContextWithOperationInfo(ctx context.Context, info OperationInfo) context.Context {
+	return context.WithValue(ctx, operationInfoKey{}, info)
+}
+
+// OperationInfoFromContext returns the OperationInfo of the operation serving
+// a request, from its context, and whether there's one.
+func OperationInfoFromContext(ctx context.Context) (OperationInfo, bool) {
+	info, ok := ctx.Value(operationInfoKey{}).(OperationInfo)
+	return info, ok
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9xZS2/jNhD+K8a0p0JreXdvugXbV4Duo3UOBRY5MNI45lYSuSQdJDD03wuSelIPS7aV",
-	"OHuzpeF8832cGQypPYQs4SzFVEkI9iBQcpZKNH/WNOEx/pM/0k9ClipMlf6p8FH5PCY01f9kuMWEmOdP",
-	"HCEAqQRN7yHLMg8ilKGgXFGWQgBXC2n8LgqsBbv7hqECbWr9GPQPTFs9frYvgz1wwTgKRW1w11ENjaYK",
-	"71FA5sG1vIoSG1T+8o6xGEmqX1bOfha4gQB+8iv+fg7uf67iEfh9RwVGEHwtFnsausK5bbhtxrihQqpP",
-	"JMEOYTwQLO564aAaK6/m6tZoStMN04tjGmK+OakBgo/XN9q7okq7hxuUarFG8YACPHhAIe02vF2ulitt",
-	"yDimhFMI4P1ytXwLHnCitiZ+P99vy8/fcyJIkuk392joarJE76veDfgD1Yf6AuNKkAQVCgnB10b+EM5j",
-	"GprF/jfJnCwa2p5mYuRqQGDCBq+QwSBDXUsldpjdes0cf7da9eGVdr5TCJnB9EPG/qM4rIaxaMnQLAgu",
-	"aEIVfdCG+MhjFiEEGxJLzImFhZuCGng1qTZMJETZInj/DrxWTWTeKEQtTw8gnoyYo0QLIgR5GgtLGrBU",
-	"YSJH4ZdPLFpHPK0whvSeL4xSFlYUzChdWCOgca3MhW4jDklwHOJc5d5kElqDSsNOBiEDp/jTXRybQt4i",
-	"iVAMFfKf1uLUQt4WbvKY/n3zpbZk1pIegH7zW56Fz1Lk7UCutHV3EM9W8j1RvXDht6OyVdAt1hx9oC+C",
-	"V9cO2kRyRwWhvuYQkzuMc71NTvj7pekCvwwOQn+5y9rNo2vHx8ww58lJD6R6MhOiYQjnnIzqmhWz41TR",
-	"+kbIc6g2JmFn1+cT68qqw/o01w0IVK/jHyivSv7NzJog3MHUOkW5l86thChBH53UotFw4X1sLTqm8Gg0",
-	"e05ZdvMJVubUJMWO71UHJJuWTLOJ02pVNBohzhka1WvOqHafmqbaCV3q0rOKEylvtoLt7rdjLpW+VOaD",
-	"V0oTriRf5MLo+w7F06+IvLov7KNcszpw6IwQ+fApwsBWPCPr+ugMcQbwKlGiKua+YdqE8jsTyRD3v0uj",
-	"A9RHnTcd9me7U6p466Uw8bzpRPVsQY07d7qazX/f5CCeA7CkeuhqxGU7z/XqANsjAF/yKO1E375YO6lJ",
-	"2o9DzXljxNFx3Vp2uQduS3E+1RqfaybIdjlH7tkUcifZw8PHumPdBR+651du/MfAddfCizh2z6ZSeak+",
-	"Xp/6JwBHmaOUGJE8c8mgXZsvzTb8nYghgK1SPPD9/DOzQqmWelJMCF8SCtlt9n8AAAD//xeCHE2EIAAA",
+	"H4sIAAAAAAAC/9xZS4/bNhD+K8a0p0KxnOSm2yJ9LdA8Wu+hQLAHrjS2mUoiQ9ILG4b+e0HqTT0s2dba",
+	"m5stzcw338eZAUkdwGcRZzHGSoJ3AIGSs1ii+bOkEQ/xn+yRfuKzWGGs9E+FO+XykNBY/5P+BiNinu85",
+	"ggdSCRqvIUkSBwKUvqBcURaDB3czaeLOcqwZe/qGvgJtmsYx6B+Yttp9Tl96B+CCcRSKpsndBxU0Gitc",
+	"o4DEgXt5F0Q0rrx8YixEEuuXZbCfBa7Ag5/ckr+bgbufy3wEft9SgQF4X3NnR0OXOI+1sPUcV1RI9YlE",
+	"2CKMA4KFbS8sVGPlVEI9Gk1pvGLaOaQ+ZosTGyD4eP+goyuqdHh4QKlmSxTPKMCBZxQyXYa388V8oQ0Z",
+	"x5hwCh68ny/mb8EBTtTG5O9m653ycw+cCBIl+s0aDV1Nluh11asBf6D6UHUwoQSJUKGQ4H2t1Q/hPKS+",
+	"cXa/SWZVUd/y1AsjUwM8kzY4uQwGGapaKrHF5NGp1/i7xaILr7BzrUZIDKbrM/YfxX41jEVDhnpDcEEj",
+	"quizNsQdD1mA4K1IKDEj5udhcmrgVKRaMRERlTbB+3fgNHoicQYhank6APFsxAwlmBEhyH4oLKnBUoWR",
+	"HIRfPEnRWvJppNGn93RpFLKwvGEG6cJqCQ0bZTZ0E7FPgtMQp2r3OhM/NSg1bGXgM7CaP96GoWnkDZIA",
+	"RV8j/5lanNvImzxMltO/b75UXCZt6R7oN79lVfgiTd5M5E5btyfxYi3fkdWVG7+ZVdoF7WJNMQe6Mnh1",
+	"46BJJAuUE+oaDiF5wjDT29SEe5ibKfBL70boL9utOTzaVnzIHuYyNemAVHuzQzQM4ZI7o6pm+d5xrGhd",
+	"W8hLqDakYCfX5xNrq6rj+tT9egSq9vEPVFcF/3pljRDuaGmdo9y1aysiStCdVVo06G+8jw2nUxqPBpPX",
+	"VMpuOsGKmhql2Omz6ohk44ppMnEao4oGA8S5wKB6zRXVnFPjVDtjSt16VXEi5cNGsO16M+RS6Utp3nul",
+	"NOJK8ioXRt+3KPa/IvLyvrCLcsXqyKEzQOT9pwgDW/IM0tAnV4i1AS8LJShz7tpMm1R+ZyLq4/53YXSE",
+	"+qDzpsX+YndKJW/tCiPPm1ZWL5bUsHOnrdn0900W4iUAC6rHrkZsttNcr/awPQHwmkdpK/vmxdpZQzL9",
+	"OFTfbww4Oi4bbrd74E4pTqda7XPNCNlu58g9mUL2Tvb45mPZ4nfDh+7plRv+MXDZ5ngTx+7JVCou1Yfr",
+	"U/0EYClzkhIDimcqGXRo86U5TX8rQvBgoxT3XDf7zKxQqnmAyCPC54RC8pj8PwAXghxNhCAAAA==",
 }
 
 // GetSwagger returns the Swagger specification corresponding to the generated code
@@ -661,3 +661,28 @@ func TestClientQueryParams(t *testing.T) {
 	assert.EqualValues(t, hParams, *ts.headerParams)
 	ts.reset()
 }
+
+func TestOperationURLs(t *testing.T) {
+	// URLs are those of the requests of the client
+	u, err := GetMatrixExplodeObjectURL("http://example.com/api/", Object{FirstName: "Alex", Role: "admin"})
+	require.NoError(t, err)
+	assert.Equal(t, "http://example.com/api/matrixExplodeObject/;firstName=Alex;role=admin", u.String())
+
+	ep := int32(5)
+	params := GetQueryFormParams{Ep: &ep, A: &[]int32{1, 2}}
+	u, err = GetQueryFormURL("http://example.com/api/", &params)
+	require.NoError(t, err)
+	req, err := NewGetQueryFormRequest("http://example.com/api/", &params)
+	require.NoError(t, err)
+	assert.Equal(t, req.URL.String(), u.String())
+	assert.Equal(t, "/api/queryForm?a=1%2C2&ep=5", u.RequestURI())
+
+	// A path as server gives links to the server itself
+	u, err = GetSimplePrimitiveURL("/", 7)
+	require.NoError(t, err)
+	assert.Equal(t, "/simplePrimitive/7", u.String())
+
+	// Every operation is routed
+	require.Len(t, OperationRoutes, 19)
+	assert.Equal(t, OperationRoute{Method: http.MethodGet, Path: "/simplePrimitive/{param}", OperationId: "GetSimplePrimitive"}, OperationRoutes[18])
+}
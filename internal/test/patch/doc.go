@@ -0,0 +1,5 @@
+// Package patch checks the types generated for the JSON merge patches and JSON
+// patches of request bodies.
+package patch
+
+//go:generate go run github.com/indigonote/oapi-codegen/cmd/oapi-codegen --package=patch --generate=types,client,server -o patch.gen.go patch.yaml
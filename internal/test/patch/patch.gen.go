@@ -0,0 +1,1563 @@
+// Package patch provides primitives to interact the openapi HTTP API.
+//
+// Code generated by github.com/indigonote/oapi-codegen DO NOT EDIT.
+package patch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/indigonote/oapi-codegen/pkg/runtime"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Address defines model for Address.
+type Address struct {
+	City   *string `json:"city,omitempty"`
+	Street *string `json:"street,omitempty"`
+}
+
+// Owner defines model for Owner.
+type Owner struct {
+	Address *Address `json:"address,omitempty"`
+	Name    string   `json:"name"`
+}
+
+// Pet defines model for Pet.
+type Pet struct {
+	Age      *int32       `json:"age,omitempty"`
+	Extra    *interface{} `json:"extra,omitempty"`
+	Name     string       `json:"name"`
+	Nickname *string      `json:"nickname"`
+	Owner    Owner        `json:"owner"`
+	Tags     *[]string    `json:"tags,omitempty"`
+	Vet      *Owner       `json:"vet,omitempty"`
+}
+
+// PatchPetRequestBody defines body for PatchPet for application/json-patch+json ContentType.
+type PatchPetJSONPatchRequestBody PetJSONPatch
+
+// PatchPetRequestBody defines body for PatchPet for application/merge-patch+json ContentType.
+type PatchPetMergePatchRequestBody PetPatch
+
+// PatchPetURL returns the URL of PatchPet on server, with its parameters
+// serialized as the client serializes them.
+func PatchPetURL(server string, id int64) (*url.URL, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParam("simple", false, "id", id)
+	if err != nil {
+		return nil, err
+	}
+
+	queryUrl, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	basePath := fmt.Sprintf("/pets/%s", pathParam0)
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
+	}
+
+	queryUrl, err = queryUrl.Parse(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return queryUrl, nil
+}
+
+// OperationRoute describes an operation of the API, whatever the router serving
+// it.
+type OperationRoute struct {
+	Method      string // The HTTP method, eg. GET
+	Path        string // The path template of the spec, eg. /pets/{id}
+	OperationId string // The operation ID, as the names of the generated code use it
+}
+
+// OperationRoutes lists the operations of the API, in the order of their paths.
+var OperationRoutes = []OperationRoute{
+	{Method: "PATCH", Path: "/pets/{id}", OperationId: "PatchPet"},
+}
+
+// AddressPatch is a JSON merge patch of a Address, as of RFC 7396. Its
+// unspecified properties are left alone, its null ones are removed, and the
+// others are set, or merged into objects.
+type AddressPatch struct {
+	City   NullableString `json:"city,omitempty"`
+	Street NullableString `json:"street,omitempty"`
+}
+
+// Apply applies p to target, which is left alone if it fails.
+func (p AddressPatch) Apply(target *Address) error {
+	patched := *target
+	if p.City.IsNull() {
+		patched.City = nil
+	} else if value, set := p.City.Get(); set {
+		patched.City = &value
+	}
+	if p.Street.IsNull() {
+		patched.Street = nil
+	} else if value, set := p.Street.Get(); set {
+		patched.Street = &value
+	}
+	*target = patched
+	return nil
+}
+
+// OwnerPatch is a JSON merge patch of a Owner, as of RFC 7396. Its
+// unspecified properties are left alone, its null ones are removed, and the
+// others are set, or merged into objects.
+type OwnerPatch struct {
+	Address NullableAddressPatch `json:"address,omitempty"`
+	Name    NullableString       `json:"name,omitempty"`
+}
+
+// Apply applies p to target, which is left alone if it fails.
+func (p OwnerPatch) Apply(target *Owner) error {
+	patched := *target
+	if p.Address.IsNull() {
+		patched.Address = nil
+	} else if value, set := p.Address.Get(); set {
+		var merged Address
+		if patched.Address != nil {
+			merged = *patched.Address
+		}
+		if err := value.Apply(&merged); err != nil {
+			return errors.Wrap(err, "error applying property 'address'")
+		}
+		patched.Address = &merged
+	}
+	if p.Name.IsNull() {
+		return errors.New("property 'name' of Owner is required, and can't be removed")
+	} else if value, set := p.Name.Get(); set {
+		patched.Name = value
+	}
+	*target = patched
+	return nil
+}
+
+// PetJSONPatch is a JSON patch of a Pet, as of RFC 6902.
+type PetJSONPatch []runtime.PatchOperation
+
+// Apply applies the operations of p to target, once their paths are checked
+// against the properties of Pet. target is left alone if any fails.
+func (p PetJSONPatch) Apply(target *Pet) error {
+	return runtime.ApplyJSONPatch(target, p)
+}
+
+// PetPatch is a JSON merge patch of a Pet, as of RFC 7396. Its
+// unspecified properties are left alone, its null ones are removed, and the
+// others are set, or merged into objects.
+type PetPatch struct {
+	Age      NullableInt32       `json:"age,omitempty"`
+	Extra    json.RawMessage     `json:"extra,omitempty"`
+	Name     NullableString      `json:"name,omitempty"`
+	Nickname NullableString      `json:"nickname,omitempty"`
+	Owner    NullableOwnerPatch  `json:"owner,omitempty"`
+	Tags     NullableStringArray `json:"tags,omitempty"`
+	Vet      NullableOwnerPatch  `json:"vet,omitempty"`
+}
+
+// Apply applies p to target, which is left alone if it fails.
+func (p PetPatch) Apply(target *Pet) error {
+	patched := *target
+	if p.Age.IsNull() {
+		patched.Age = nil
+	} else if value, set := p.Age.Get(); set {
+		patched.Age = &value
+	}
+	if len(p.Extra) != 0 {
+		if string(p.Extra) == "null" {
+			patched.Extra = nil
+		} else {
+			var value *interface{}
+			if err := json.Unmarshal(p.Extra, &value); err != nil {
+				return errors.Wrap(err, "error applying property 'extra'")
+			}
+			patched.Extra = value
+		}
+	}
+	if p.Name.IsNull() {
+		return errors.New("property 'name' of Pet is required, and can't be removed")
+	} else if value, set := p.Name.Get(); set {
+		patched.Name = value
+	}
+	if p.Nickname.IsNull() {
+		patched.Nickname = nil
+	} else if value, set := p.Nickname.Get(); set {
+		patched.Nickname = &value
+	}
+	if p.Owner.IsNull() {
+		return errors.New("property 'owner' of Pet is required, and can't be removed")
+	} else if value, set := p.Owner.Get(); set {
+		if err := value.Apply(&patched.Owner); err != nil {
+			return errors.Wrap(err, "error applying property 'owner'")
+		}
+	}
+	if p.Tags.IsNull() {
+		patched.Tags = nil
+	} else if value, set := p.Tags.Get(); set {
+		patched.Tags = &value
+	}
+	if p.Vet.IsNull() {
+		patched.Vet = nil
+	} else if value, set := p.Vet.Get(); set {
+		var merged Owner
+		if patched.Vet != nil {
+			merged = *patched.Vet
+		}
+		if err := value.Apply(&merged); err != nil {
+			return errors.Wrap(err, "error applying property 'vet'")
+		}
+		patched.Vet = &merged
+	}
+	*target = patched
+	return nil
+}
+
+// NullableAddressPatch is a nullable AddressPatch, which is either unspecified, null
+// or set to a value. It is a map, so that encoding/json omits it when it is
+// unspecified, as its zero value is.
+type NullableAddressPatch map[bool]AddressPatch
+
+// NewNullableAddressPatch returns a NullableAddressPatch set to value.
+func NewNullableAddressPatch(value AddressPatch) NullableAddressPatch {
+	return NullableAddressPatch{true: value}
+}
+
+// NewNullNullableAddressPatch returns a null NullableAddressPatch.
+func NewNullNullableAddressPatch() NullableAddressPatch {
+	var zero AddressPatch
+	return NullableAddressPatch{false: zero}
+}
+
+// Get returns the value of n, and whether it is set to one, rather than null
+// or unspecified.
+func (n NullableAddressPatch) Get() (AddressPatch, bool) {
+	value, set := n[true]
+	return value, set
+}
+
+// IsNull tells whether n is explicitly null.
+func (n NullableAddressPatch) IsNull() bool {
+	_, null := n[false]
+	return null
+}
+
+// IsSpecified tells whether n is either null or set to a value.
+func (n NullableAddressPatch) IsSpecified() bool {
+	return len(n) != 0
+}
+
+// Set sets n to value.
+func (n *NullableAddressPatch) Set(value AddressPatch) {
+	*n = NullableAddressPatch{true: value}
+}
+
+// SetNull sets n to null.
+func (n *NullableAddressPatch) SetNull() {
+	*n = NewNullNullableAddressPatch()
+}
+
+// SetUnspecified makes n unspecified.
+func (n *NullableAddressPatch) SetUnspecified() {
+	*n = nil
+}
+
+// MarshalJSON marshals the value of n, or null.
+func (n NullableAddressPatch) MarshalJSON() ([]byte, error) {
+	if value, set := n[true]; set {
+		return json.Marshal(value)
+	}
+	return []byte("null"), nil
+}
+
+// UnmarshalJSON sets n to null, or to the value in data.
+func (n *NullableAddressPatch) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		n.SetNull()
+		return nil
+	}
+	var value AddressPatch
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	n.Set(value)
+	return nil
+}
+
+// Bind sets n to the value of a parameter, as runtime.BindStringToObject binds
+// it, or to null when the value is empty.
+func (n *NullableAddressPatch) Bind(src string) error {
+	if src == "" {
+		n.SetNull()
+		return nil
+	}
+	var value AddressPatch
+	if err := runtime.BindStringToObject(src, &value); err != nil {
+		return err
+	}
+	n.Set(value)
+	return nil
+}
+
+// MarshalText styles the value of n as runtime.StyleParam does, for parameters
+// holding it. A null value is empty, which binds back to null.
+func (n NullableAddressPatch) MarshalText() ([]byte, error) {
+	value, set := n[true]
+	if !set {
+		return nil, nil
+	}
+	text, err := runtime.StyleParam("simple", false, "", value)
+	return []byte(text), err
+}
+
+// NullableInt32 is a nullable int32, which is either unspecified, null
+// or set to a value. It is a map, so that encoding/json omits it when it is
+// unspecified, as its zero value is.
+type NullableInt32 map[bool]int32
+
+// NewNullableInt32 returns a NullableInt32 set to value.
+func NewNullableInt32(value int32) NullableInt32 {
+	return NullableInt32{true: value}
+}
+
+// NewNullNullableInt32 returns a null NullableInt32.
+func NewNullNullableInt32() NullableInt32 {
+	var zero int32
+	return NullableInt32{false: zero}
+}
+
+// Get returns the value of n, and whether it is set to one, rather than null
+// or unspecified.
+func (n NullableInt32) Get() (int32, bool) {
+	value, set := n[true]
+	return value, set
+}
+
+// IsNull tells whether n is explicitly null.
+func (n NullableInt32) IsNull() bool {
+	_, null := n[false]
+	return null
+}
+
+// IsSpecified tells whether n is either null or set to a value.
+func (n NullableInt32) IsSpecified() bool {
+	return len(n) != 0
+}
+
+// Set sets n to value.
+func (n *NullableInt32) Set(value int32) {
+	*n = NullableInt32{true: value}
+}
+
+// SetNull sets n to null.
+func (n *NullableInt32) SetNull() {
+	*n = NewNullNullableInt32()
+}
+
+// SetUnspecified makes n unspecified.
+func (n *NullableInt32) SetUnspecified() {
+	*n = nil
+}
+
+// MarshalJSON marshals the value of n, or null.
+func (n NullableInt32) MarshalJSON() ([]byte, error) {
+	if value, set := n[true]; set {
+		return json.Marshal(value)
+	}
+	return []byte("null"), nil
+}
+
+// UnmarshalJSON sets n to null, or to the value in data.
+func (n *NullableInt32) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		n.SetNull()
+		return nil
+	}
+	var value int32
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	n.Set(value)
+	return nil
+}
+
+// Bind sets n to the value of a parameter, as runtime.BindStringToObject binds
+// it, or to null when the value is empty.
+func (n *NullableInt32) Bind(src string) error {
+	if src == "" {
+		n.SetNull()
+		return nil
+	}
+	var value int32
+	if err := runtime.BindStringToObject(src, &value); err != nil {
+		return err
+	}
+	n.Set(value)
+	return nil
+}
+
+// MarshalText styles the value of n as runtime.StyleParam does, for parameters
+// holding it. A null value is empty, which binds back to null.
+func (n NullableInt32) MarshalText() ([]byte, error) {
+	value, set := n[true]
+	if !set {
+		return nil, nil
+	}
+	text, err := runtime.StyleParam("simple", false, "", value)
+	return []byte(text), err
+}
+
+// NullableOwnerPatch is a nullable OwnerPatch, which is either unspecified, null
+// or set to a value. It is a map, so that encoding/json omits it when it is
+// unspecified, as its zero value is.
+type NullableOwnerPatch map[bool]OwnerPatch
+
+// NewNullableOwnerPatch returns a NullableOwnerPatch set to value.
+func NewNullableOwnerPatch(value OwnerPatch) NullableOwnerPatch {
+	return NullableOwnerPatch{true: value}
+}
+
+// NewNullNullableOwnerPatch returns a null NullableOwnerPatch.
+func NewNullNullableOwnerPatch() NullableOwnerPatch {
+	var zero OwnerPatch
+	return NullableOwnerPatch{false: zero}
+}
+
+// Get returns the value of n, and whether it is set to one, rather than null
+// or unspecified.
+func (n NullableOwnerPatch) Get() (OwnerPatch, bool) {
+	value, set := n[true]
+	return value, set
+}
+
+// IsNull tells whether n is explicitly null.
+func (n NullableOwnerPatch) IsNull() bool {
+	_, null := n[false]
+	return null
+}
+
+// IsSpecified tells whether n is either null or set to a value.
+func (n NullableOwnerPatch) IsSpecified() bool {
+	return len(n) != 0
+}
+
+// Set sets n to value.
+func (n *NullableOwnerPatch) Set(value OwnerPatch) {
+	*n = NullableOwnerPatch{true: value}
+}
+
+// SetNull sets n to null.
+func (n *NullableOwnerPatch) SetNull() {
+	*n = NewNullNullableOwnerPatch()
+}
+
+// SetUnspecified makes n unspecified.
+func (n *NullableOwnerPatch) SetUnspecified() {
+	*n = nil
+}
+
+// MarshalJSON marshals the value of n, or null.
+func (n NullableOwnerPatch) MarshalJSON() ([]byte, error) {
+	if value, set := n[true]; set {
+		return json.Marshal(value)
+	}
+	return []byte("null"), nil
+}
+
+// UnmarshalJSON sets n to null, or to the value in data.
+func (n *NullableOwnerPatch) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		n.SetNull()
+		return nil
+	}
+	var value OwnerPatch
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	n.Set(value)
+	return nil
+}
+
+// Bind sets n to the value of a parameter, as runtime.BindStringToObject binds
+// it, or to null when the value is empty.
+func (n *NullableOwnerPatch) Bind(src string) error {
+	if src == "" {
+		n.SetNull()
+		return nil
+	}
+	var value OwnerPatch
+	if err := runtime.BindStringToObject(src, &value); err != nil {
+		return err
+	}
+	n.Set(value)
+	return nil
+}
+
+// MarshalText styles the value of n as runtime.StyleParam does, for parameters
+// holding it. A null value is empty, which binds back to null.
+func (n NullableOwnerPatch) MarshalText() ([]byte, error) {
+	value, set := n[true]
+	if !set {
+		return nil, nil
+	}
+	text, err := runtime.StyleParam("simple", false, "", value)
+	return []byte(text), err
+}
+
+// NullableString is a nullable string, which is either unspecified, null
+// or set to a value. It is a map, so that encoding/json omits it when it is
+// unspecified, as its zero value is.
+type NullableString map[bool]string
+
+// NewNullableString returns a NullableString set to value.
+func NewNullableString(value string) NullableString {
+	return NullableString{true: value}
+}
+
+// NewNullNullableString returns a null NullableString.
+func NewNullNullableString() NullableString {
+	var zero string
+	return NullableString{false: zero}
+}
+
+// Get returns the value of n, and whether it is set to one, rather than null
+// or unspecified.
+func (n NullableString) Get() (string, bool) {
+	value, set := n[true]
+	return value, set
+}
+
+// IsNull tells whether n is explicitly null.
+func (n NullableString) IsNull() bool {
+	_, null := n[false]
+	return null
+}
+
+// IsSpecified tells whether n is either null or set to a value.
+func (n NullableString) IsSpecified() bool {
+	return len(n) != 0
+}
+
+// Set sets n to value.
+func (n *NullableString) Set(value string) {
+	*n = NullableString{true: value}
+}
+
+// SetNull sets n to null.
+func (n *NullableString) SetNull() {
+	*n = NewNullNullableString()
+}
+
+// SetUnspecified makes n unspecified.
+func (n *NullableString) SetUnspecified() {
+	*n = nil
+}
+
+// MarshalJSON marshals the value of n, or null.
+func (n NullableString) MarshalJSON() ([]byte, error) {
+	if value, set := n[true]; set {
+		return json.Marshal(value)
+	}
+	return []byte("null"), nil
+}
+
+// UnmarshalJSON sets n to null, or to the value in data.
+func (n *NullableString) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		n.SetNull()
+		return nil
+	}
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	n.Set(value)
+	return nil
+}
+
+// Bind sets n to the value of a parameter, as runtime.BindStringToObject binds
+// it, or to null when the value is empty.
+func (n *NullableString) Bind(src string) error {
+	if src == "" {
+		n.SetNull()
+		return nil
+	}
+	var value string
+	if err := runtime.BindStringToObject(src, &value); err != nil {
+		return err
+	}
+	n.Set(value)
+	return nil
+}
+
+// MarshalText styles the value of n as runtime.StyleParam does, for parameters
+// holding it. A null value is empty, which binds back to null.
+func (n NullableString) MarshalText() ([]byte, error) {
+	value, set := n[true]
+	if !set {
+		return nil, nil
+	}
+	text, err := runtime.StyleParam("simple", false, "", value)
+	return []byte(text), err
+}
+
+// NullableStringArray is a nullable []string, which is either unspecified, null
+// or set to a value. It is a map, so that encoding/json omits it when it is
+// unspecified, as its zero value is.
+type NullableStringArray map[bool][]string
+
+// NewNullableStringArray returns a NullableStringArray set to value.
+func NewNullableStringArray(value []string) NullableStringArray {
+	return NullableStringArray{true: value}
+}
+
+// NewNullNullableStringArray returns a null NullableStringArray.
+func NewNullNullableStringArray() NullableStringArray {
+	var zero []string
+	return NullableStringArray{false: zero}
+}
+
+// Get returns the value of n, and whether it is set to one, rather than null
+// or unspecified.
+func (n NullableStringArray) Get() ([]string, bool) {
+	value, set := n[true]
+	return value, set
+}
+
+// IsNull tells whether n is explicitly null.
+func (n NullableStringArray) IsNull() bool {
+	_, null := n[false]
+	return null
+}
+
+// IsSpecified tells whether n is either null or set to a value.
+func (n NullableStringArray) IsSpecified() bool {
+	return len(n) != 0
+}
+
+// Set sets n to value.
+func (n *NullableStringArray) Set(value []string) {
+	*n = NullableStringArray{true: value}
+}
+
+// SetNull sets n to null.
+func (n *NullableStringArray) SetNull() {
+	*n = NewNullNullableStringArray()
+}
+
+// SetUnspecified makes n unspecified.
+func (n *NullableStringArray) SetUnspecified() {
+	*n = nil
+}
+
+// MarshalJSON marshals the value of n, or null.
+func (n NullableStringArray) MarshalJSON() ([]byte, error) {
+	if value, set := n[true]; set {
+		return json.Marshal(value)
+	}
+	return []byte("null"), nil
+}
+
+// UnmarshalJSON sets n to null, or to the value in data.
+func (n *NullableStringArray) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		n.SetNull()
+		return nil
+	}
+	var value []string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	n.Set(value)
+	return nil
+}
+
+// Bind sets n to the value of a parameter, as runtime.BindStringToObject binds
+// it, or to null when the value is empty.
+func (n *NullableStringArray) Bind(src string) error {
+	if src == "" {
+		n.SetNull()
+		return nil
+	}
+	var value []string
+	if err := runtime.BindStringToObject(src, &value); err != nil {
+		return err
+	}
+	n.Set(value)
+	return nil
+}
+
+// MarshalText styles the value of n as runtime.StyleParam does, for parameters
+// holding it. A null value is empty, which binds back to null.
+func (n NullableStringArray) MarshalText() ([]byte, error) {
+	value, set := n[true]
+	if !set {
+		return nil, nil
+	}
+	text, err := runtime.StyleParam("simple", false, "", value)
+	return []byte(text), err
+}
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// ResponseEditorFn is the function signature for the ResponseEditor callback
+// function, which may inspect or replace the response before it is parsed
+type ResponseEditorFn func(ctx context.Context, rsp *http.Response) error
+
+// RequestValidatorFn is the function signature for the callback validating
+// requests before they are sent, given the server they were built against
+type RequestValidatorFn func(ctx context.Context, server string, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before
+	// sending over the network, in the order they are run.
+	RequestEditors []RequestEditorFn
+
+	// A callback for modifying requests which are generated before sending over
+	// the network, which runs before RequestEditors.
+	//
+	// Deprecated: use RequestEditors, or WithRequestEditorFn.
+	RequestEditor RequestEditorFn
+
+	// A list of callbacks for inspecting responses before they are returned,
+	// in the order they are run.
+	ResponseEditors []ResponseEditorFn
+
+	// A callback validating requests once the request editors ran, requests
+	// failing it aren't sent. See WithRequestValidation.
+	RequestValidator RequestValidatorFn
+
+	// How failed requests are retried, they aren't when nil.
+	RetryPolicy *RetryPolicy
+
+	// The servers of the operations which override the servers of the API,
+	// keyed by operation ID, when they aren't the first of their servers. See
+	// WithOperationServer.
+	OperationServers map[string]string
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = http.DefaultClient
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+// It may be given more than once, the callbacks are called in the same order.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// WithResponseEditorFn allows setting up a callback function, which will be
+// called with every response, before it is returned or parsed. It may be
+// given more than once, the callbacks are called in the same order.
+func WithResponseEditorFn(fn ResponseEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.ResponseEditors = append(c.ResponseEditors, fn)
+		return nil
+	}
+}
+
+// applyEditors runs the request editors of the client, followed by those
+// given for this call only.
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	if c.RequestEditor != nil {
+		if err := c.RequestEditor(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RetryPolicy describes how the client retries requests which failed with a
+// network error, a 5xx or a 429 response.
+type RetryPolicy struct {
+	// The number of retries after the first attempt.
+	MaxRetries int
+
+	// The backoff before the first retry, it doubles for each of the
+	// following ones, with some jitter so that clients don't retry in lockstep.
+	MinBackoff time.Duration
+
+	// The upper bound of any backoff, including those asked for by the server
+	// through a Retry-After header.
+	MaxBackoff time.Duration
+
+	// Whether to retry operations which aren't idempotent. Operations are
+	// idempotent when their method is, or when marked with x-idempotent.
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryPolicy returns a policy retrying idempotent operations three
+// times, backing off from 100ms up to 10s.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries: 3,
+		MinBackoff: 100 * time.Millisecond,
+		MaxBackoff: 10 * time.Second,
+	}
+}
+
+// WithRetryPolicy allows retrying failed requests. Request bodies are buffered
+// so that they can be sent again.
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(c *Client) error {
+		c.RetryPolicy = &policy
+		return nil
+	}
+}
+
+// retryJitter randomizes backoffs, it isn't safe for concurrent use.
+var (
+	retryJitter   = rand.New(rand.NewSource(time.Now().UnixNano()))
+	retryJitterMu sync.Mutex
+)
+
+// do sends the request, and runs the response editors of the client on its
+// response.
+func (c *Client) do(req *http.Request, idempotent bool) (*http.Response, error) {
+	rsp, err := c.send(req, idempotent)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range c.ResponseEditors {
+		if err := r(req.Context(), rsp); err != nil {
+			rsp.Body.Close()
+			return nil, err
+		}
+	}
+	return rsp, nil
+}
+
+// send sends the request, retrying it according to the retry policy of the
+// client.
+func (c *Client) send(req *http.Request, idempotent bool) (*http.Response, error) {
+	policy := c.RetryPolicy
+	if policy == nil || policy.MaxRetries <= 0 || !(idempotent || policy.RetryNonIdempotent) {
+		return c.Client.Do(req)
+	}
+
+	// The body is consumed by each attempt, so we need a fresh one for every
+	// retry.
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		buf, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.GetBody = func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(bytes.NewReader(buf)), nil
+		}
+		req.Body, _ = req.GetBody()
+	}
+
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+		rsp, err := c.Client.Do(req)
+		if attempt >= policy.MaxRetries || !shouldRetry(req, rsp, err) {
+			return rsp, err
+		}
+
+		backoff := policy.backoff(attempt, rsp)
+		if rsp != nil {
+			// Drain the body, so that the connection can be reused.
+			io.Copy(ioutil.Discard, rsp.Body)
+			rsp.Body.Close()
+		}
+		timer := time.NewTimer(backoff)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func shouldRetry(req *http.Request, rsp *http.Response, err error) bool {
+	if err != nil {
+		// Nothing to retry once the caller gave up.
+		return req.Context().Err() == nil
+	}
+	return rsp.StatusCode == http.StatusTooManyRequests || rsp.StatusCode >= 500
+}
+
+// backoff returns how long to wait before the given retry, preferring the
+// delay asked for by the Retry-After header of the response.
+func (p *RetryPolicy) backoff(attempt int, rsp *http.Response) time.Duration {
+	if rsp != nil {
+		if after, ok := retryAfter(rsp.Header.Get("Retry-After")); ok {
+			if p.MaxBackoff > 0 && after > p.MaxBackoff {
+				return p.MaxBackoff
+			}
+			return after
+		}
+	}
+
+	backoff := p.MinBackoff << uint(attempt)
+	if backoff <= 0 || (p.MaxBackoff > 0 && backoff > p.MaxBackoff) {
+		backoff = p.MaxBackoff
+	}
+	if backoff <= 0 {
+		return 0
+	}
+	// Wait somewhere between half and all of the backoff.
+	retryJitterMu.Lock()
+	jitter := time.Duration(retryJitter.Int63n(int64(backoff)/2 + 1))
+	retryJitterMu.Unlock()
+	return backoff/2 + jitter
+}
+
+// retryAfter parses a Retry-After header, which either holds a number of
+// seconds or a date.
+func retryAfter(header string) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(header); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(header); err == nil {
+		after := time.Until(date)
+		if after < 0 {
+			after = 0
+		}
+		return after, true
+	}
+	return 0, false
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// PatchPet request  with any body
+	PatchPetWithBody(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchPetWithJSONPatchBody(ctx context.Context, id int64, body PatchPetJSONPatchRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchPetWithMergePatchBody(ctx context.Context, id int64, body PatchPetMergePatchRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) PatchPetWithBody(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server := c.Server
+	req, err := NewPatchPetRequestWithBody(server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
+			return nil, err
+		}
+	}
+	return c.do(req, false)
+}
+
+func (c *Client) PatchPetWithJSONPatchBody(ctx context.Context, id int64, body PatchPetJSONPatchRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server := c.Server
+	req, err := NewPatchPetRequestWithJSONPatchBody(server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
+			return nil, err
+		}
+	}
+	return c.do(req, false)
+}
+
+func (c *Client) PatchPetWithMergePatchBody(ctx context.Context, id int64, body PatchPetMergePatchRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	server := c.Server
+	req, err := NewPatchPetRequestWithMergePatchBody(server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	if c.RequestValidator != nil {
+		if err := c.RequestValidator(ctx, server, req); err != nil {
+			return nil, err
+		}
+	}
+	return c.do(req, false)
+}
+
+// NewPatchPetRequestWithJSONPatchBody calls the generic PatchPet builder with application/json-patch+json body
+func NewPatchPetRequestWithJSONPatchBody(server string, id int64, body PatchPetJSONPatchRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchPetRequestWithBody(server, id, "application/json-patch+json", bodyReader)
+}
+
+// NewPatchPetRequestWithMergePatchBody calls the generic PatchPet builder with application/merge-patch+json body
+func NewPatchPetRequestWithMergePatchBody(server string, id int64, body PatchPetMergePatchRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchPetRequestWithBody(server, id, "application/merge-patch+json", bodyReader)
+}
+
+// NewPatchPetRequestWithBody generates requests for PatchPet with any type of body
+func NewPatchPetRequestWithBody(server string, id int64, contentType string, body io.Reader) (*http.Request, error) {
+	queryUrl, err := PatchPetURL(server, id)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryUrl.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+	return req, nil
+}
+
+// serverDefinition is a server of the API, whose URL may hold variables.
+type serverDefinition struct {
+	url       string
+	variables []serverVariable
+}
+
+// serverVariable is a variable of the URL of a server.
+type serverVariable struct {
+	name         string
+	defaultValue string
+	enum         []string
+}
+
+// ServerVariables holds the values of the variables of the URL of a server. It
+// is one of the types generated for the servers with variables, such as those
+// given to WithServer.
+type ServerVariables interface {
+	// serverURL returns the URL of the server whose variables these are.
+	serverURL() string
+	// values returns the values of the variables, by name.
+	values() map[string]string
+}
+
+// resolve replaces the variables in the URL of the server with their values,
+// or their defaults, checking them against their enum. vars must be those of
+// the server, or nil.
+func (s serverDefinition) resolve(vars ServerVariables) (string, error) {
+	var values map[string]string
+	if vars != nil {
+		if vars.serverURL() != s.url {
+			return "", fmt.Errorf("variables of server %s given for server %s", vars.serverURL(), s.url)
+		}
+		values = vars.values()
+	}
+
+	serverURL := s.url
+	for _, v := range s.variables {
+		value := values[v.name]
+		if value == "" {
+			value = v.defaultValue
+		}
+		if value == "" {
+			return "", fmt.Errorf("missing value for variable %s of server %s", v.name, s.url)
+		}
+		valid := len(v.enum) == 0
+		for _, e := range v.enum {
+			valid = valid || value == e
+		}
+		if !valid {
+			return "", fmt.Errorf("invalid value %q for variable %s of server %s, must be one of: %s", value, v.name, s.url, strings.Join(v.enum, ", "))
+		}
+		serverURL = strings.Replace(serverURL, "{"+v.name+"}", value, -1)
+	}
+	return serverURL, nil
+}
+
+// servers lists the servers of the API, in the order of the spec.
+var servers = []serverDefinition{}
+
+// operationServers lists the servers of the operations which override those
+// of the API.
+var operationServers = map[string][]serverDefinition{}
+
+// WithServer sets the server of the client to one of the servers of the API,
+// by its index in the spec. Its variables take the given values, which must
+// be the variables of that server, such as a ServerProductionVariables for a
+// production server. They are checked against their enum, and take their
+// default when empty, or when vars is nil.
+func WithServer(index int, vars ServerVariables) ClientOption {
+	return func(c *Client) error {
+		if index < 0 || index >= len(servers) {
+			return fmt.Errorf("no server at index %d", index)
+		}
+		server, err := servers[index].resolve(vars)
+		if err != nil {
+			return err
+		}
+		c.Server = server
+		return nil
+	}
+}
+
+// WithOperationServer sets the server of an operation which overrides the
+// servers of the API to another of its servers, by its index in the spec. Its
+// variables are handled as by WithServer, and are of the types named after the
+// operation, such as GetPetServer0Variables.
+func WithOperationServer(operationID string, index int, vars ServerVariables) ClientOption {
+	return func(c *Client) error {
+		defs := operationServers[operationID]
+		if index < 0 || index >= len(defs) {
+			return fmt.Errorf("operation %s has no server at index %d", operationID, index)
+		}
+		server, err := defs[index].resolve(vars)
+		if err != nil {
+			return err
+		}
+		if c.OperationServers == nil {
+			c.OperationServers = map[string]string{}
+		}
+		c.OperationServers[operationID] = server
+		return nil
+	}
+}
+
+// operationServer returns the server of an operation which overrides the
+// servers of the API, which is its first one unless set otherwise.
+func (c *Client) operationServer(operationID string) (string, error) {
+	server, found := c.OperationServers[operationID]
+	if !found {
+		var err error
+		server, err = operationServers[operationID][0].resolve(nil)
+		if err != nil {
+			return "", err
+		}
+	}
+	if !strings.HasSuffix(server, "/") {
+		server += "/"
+	}
+	return server, nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// PatchPet request  with any body
+	PatchPetWithBodyWithResponse(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchPetResponse, error)
+
+	PatchPetWithJSONPatchBodyWithResponse(ctx context.Context, id int64, body PatchPetJSONPatchRequestBody, reqEditors ...RequestEditorFn) (*PatchPetResponse, error)
+
+	PatchPetWithMergePatchBodyWithResponse(ctx context.Context, id int64, body PatchPetMergePatchRequestBody, reqEditors ...RequestEditorFn) (*PatchPetResponse, error)
+}
+
+type PatchPetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Pet
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchPetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchPetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// PatchPetWithBodyWithResponse request with arbitrary body returning *PatchPetResponse
+func (c *ClientWithResponses) PatchPetWithBodyWithResponse(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchPetResponse, error) {
+	rsp, err := c.PatchPetWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchPetResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchPetWithJSONPatchBodyWithResponse(ctx context.Context, id int64, body PatchPetJSONPatchRequestBody, reqEditors ...RequestEditorFn) (*PatchPetResponse, error) {
+	rsp, err := c.PatchPetWithJSONPatchBody(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchPetResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchPetWithMergePatchBodyWithResponse(ctx context.Context, id int64, body PatchPetMergePatchRequestBody, reqEditors ...RequestEditorFn) (*PatchPetResponse, error) {
+	rsp, err := c.PatchPetWithMergePatchBody(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchPetResponse(rsp)
+}
+
+// ParsePatchPetResponse parses an HTTP response from a PatchPetWithResponse call
+func ParsePatchPetResponse(rsp *http.Response) (*PatchPetResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchPetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Pet
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// APIError is an error response from the server, with the payload of the
+// default response of the operation when the API documents one.
+type APIError struct {
+	Operation    string
+	StatusCode   int
+	Body         []byte
+	HTTPResponse *http.Response
+	// The decoded default response, eg. *Error, or nil
+	Model interface{}
+}
+
+// Error describes the response.
+func (e *APIError) Error() string {
+	return fmt.Sprintf("%s returned %d %s", e.Operation, e.StatusCode, http.StatusText(e.StatusCode))
+}
+
+// PatchPetWithBodyOrError calls PatchPetWithBodyWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) PatchPetWithBodyOrError(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*Pet, error) {
+	rsp, err := c.PatchPetWithBodyWithResponse(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return patchPetOrError(rsp)
+}
+
+// PatchPetWithJSONPatchBodyOrError calls PatchPetWithJSONPatchBodyWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) PatchPetWithJSONPatchBodyOrError(ctx context.Context, id int64, body PatchPetJSONPatchRequestBody, reqEditors ...RequestEditorFn) (*Pet, error) {
+	rsp, err := c.PatchPetWithJSONPatchBodyWithResponse(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return patchPetOrError(rsp)
+}
+
+// PatchPetWithMergePatchBodyOrError calls PatchPetWithMergePatchBodyWithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) PatchPetWithMergePatchBodyOrError(ctx context.Context, id int64, body PatchPetMergePatchRequestBody, reqEditors ...RequestEditorFn) (*Pet, error) {
+	rsp, err := c.PatchPetWithMergePatchBodyWithResponse(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return patchPetOrError(rsp)
+}
+
+// patchPetOrError returns the payload of a success response to
+// PatchPet, or an error for any other response.
+func patchPetOrError(rsp *PatchPetResponse) (*Pet, error) {
+	if rsp.JSON200 != nil {
+		return rsp.JSON200, nil
+	}
+	if rsp.StatusCode() >= 200 && rsp.StatusCode() < 300 {
+		return nil, nil
+	}
+
+	apiErr := APIError{
+		Operation:    "PatchPet",
+		StatusCode:   rsp.StatusCode(),
+		Body:         rsp.Body,
+		HTTPResponse: rsp.HTTPResponse,
+	}
+	return nil, &apiErr
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (PATCH /pets/{id})
+	PatchPet(ctx echo.Context, id int64) error
+}
+
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+
+	// ErrorHandlerFunc answers requests whose parameters can't be bound, with
+	// their InvalidParamFormatError, RequiredParamError, UnmarshalingParamError
+	// or TooManyValuesForParamError, of the runtime package. They are answered
+	// with a 400 echo.HTTPError if it is nil.
+	ErrorHandlerFunc func(ctx echo.Context, err error) error
+}
+
+// handleError answers a request whose parameters can't be bound.
+func (w *ServerInterfaceWrapper) handleError(ctx echo.Context, err error) error {
+	if w.ErrorHandlerFunc != nil {
+		return w.ErrorHandlerFunc(ctx, err)
+	}
+	return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+}
+
+// PatchPet converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchPet(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameter("simple", false, "id", ctx.Param("id"), &id)
+	if err != nil {
+		return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationPath, "id", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PatchPet(ctx, id)
+	return err
+}
+
+// This is a simple interface which specifies echo.Route addition functions which
+// are present on both echo.Echo and echo.Group, since we want to allow using
+// either of them for path registration
+type EchoRouter interface {
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+}
+
+// RegisterOptions configures the middlewares and the error handling of the
+// routes added by RegisterHandlersWithOptions.
+type RegisterOptions struct {
+	// The middlewares of operations, by operation ID.
+	OperationMiddlewares map[string][]echo.MiddlewareFunc
+
+	// The middlewares of the operations with a tag, by tag.
+	TagMiddlewares map[string][]echo.MiddlewareFunc
+
+	// The ErrorHandlerFunc of the ServerInterfaceWrapper.
+	ErrorHandlerFunc func(ctx echo.Context, err error) error
+}
+
+// RegisterHandlers adds each server route to the EchoRouter.
+func RegisterHandlers(router EchoRouter, si ServerInterface) {
+	RegisterHandlersWithOptions(router, si, RegisterOptions{})
+}
+
+// RegisterHandlersWithOptions adds each server route to the EchoRouter, with
+// the middlewares of the tags of its operation, in the order of the tags, and
+// then those of the operation. The OperationInfo of the operation is put into
+// the context of the request before any of them runs.
+func RegisterHandlersWithOptions(router EchoRouter, si ServerInterface, opts RegisterOptions) {
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:          si,
+		ErrorHandlerFunc: opts.ErrorHandlerFunc,
+	}
+
+	router.PATCH("/pets/:id", wrapper.PatchPet, opts.middlewares("PatchPet")...)
+
+}
+
+// middlewares returns the middlewares of the route of an operation.
+func (opts RegisterOptions) middlewares(operationId string) []echo.MiddlewareFunc {
+	info := Operations[operationId]
+	middlewares := []echo.MiddlewareFunc{
+		func(next echo.HandlerFunc) echo.HandlerFunc {
+			return func(ctx echo.Context) error {
+				r := ctx.Request()
+				ctx.SetRequest(r.WithContext(ContextWithOperationInfo(r.Context(), info)))
+				return next(ctx)
+			}
+		},
+	}
+	for _, tag := range info.Tags {
+		middlewares = append(middlewares, opts.TagMiddlewares[tag]...)
+	}
+	return append(middlewares, opts.OperationMiddlewares[operationId]...)
+}
+
+// OperationInfo describes an operation of the API, for the middlewares serving
+// it, which find it in the context of its requests.
+type OperationInfo struct {
+	OperationId string                     // The operation ID, as the names of the generated code use it
+	Method      string                     // The HTTP method, eg. GET
+	Path        string                     // The path template of the spec, eg. /pets/{id}
+	Tags        []string                   // The tags of the operation
+	Security    []OperationSecurity        // The security providers of the operation
+	Extensions  map[string]json.RawMessage // The x- extensions of the operation, by name
+}
+
+// OperationSecurity is a security provider of an operation, and the scopes it
+// requires.
+type OperationSecurity struct {
+	ProviderName string
+	Scopes       []string
+}
+
+// Operations holds the OperationInfo of every operation, by operation ID.
+var Operations = map[string]OperationInfo{
+	"PatchPet": {
+		OperationId: "PatchPet",
+		Method:      "PATCH",
+		Path:        "/pets/{id}",
+	},
+}
+
+// operationInfoKey is the context key of the OperationInfo of a request.
+type operationInfoKey struct{}
+
+// ContextWithOperationInfo returns a copy of ctx holding info, which
+// OperationInfoFromContext returns.
+func ContextWithOperationInfo(ctx context.Context, info OperationInfo) context.Context {
+	return context.WithValue(ctx, operationInfoKey{}, info)
+}
+
+// OperationInfoFromContext returns the OperationInfo of the operation serving
+// a request, from its context, and whether there's one.
+func OperationInfoFromContext(ctx context.Context) (OperationInfo, bool) {
+	info, ok := ctx.Value(operationInfoKey{}).(OperationInfo)
+	return info, ok
+}
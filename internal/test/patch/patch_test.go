@@ -0,0 +1,185 @@
+package patch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newPet() Pet {
+	age := int32(3)
+	nickname := "Rexie"
+	return Pet{
+		Name:     "Rex",
+		Age:      &age,
+		Nickname: &nickname,
+		Owner: Owner{
+			Name:    "Ann",
+			Address: &Address{Street: strPtr("Main St"), City: strPtr("Springfield")},
+		},
+	}
+}
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func TestMergePatch(t *testing.T) {
+	var patch PetPatch
+	err := json.Unmarshal([]byte(`{
+		"age": null,
+		"tags": ["good"],
+		"owner": {"address": {"city": "Shelbyville", "street": null}},
+		"vet": {"name": "Bob"},
+		"extra": {"any": "thing"}
+	}`), &patch)
+	require.NoError(t, err)
+
+	pet := newPet()
+	require.NoError(t, patch.Apply(&pet))
+
+	// Null properties are removed, objects are merged, and the others are
+	// replaced or left alone
+	expected := newPet()
+	expected.Age = nil
+	expected.Tags = &[]string{"good"}
+	expected.Owner.Address = &Address{City: strPtr("Shelbyville")}
+	expected.Vet = &Owner{Name: "Bob"}
+	var extra interface{} = map[string]interface{}{"any": "thing"}
+	expected.Extra = &extra
+	assert.Equal(t, expected, pet)
+
+	// Patches marshal back to what they were
+	buf, err := json.Marshal(patch)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"age": null,
+		"tags": ["good"],
+		"owner": {"address": {"city": "Shelbyville", "street": null}},
+		"vet": {"name": "Bob"},
+		"extra": {"any": "thing"}
+	}`, string(buf))
+
+	// Required properties can't be removed
+	err = PetPatch{Name: NewNullNullableString()}.Apply(&pet)
+	assert.Error(t, err)
+	err = PetPatch{Owner: NewNullableOwnerPatch(OwnerPatch{Name: NewNullNullableString()})}.Apply(&pet)
+	assert.Error(t, err)
+
+	// Failed patches leave the target alone, even the objects it points to
+	var failing PetPatch
+	err = json.Unmarshal([]byte(`{
+		"age": 7,
+		"extra": {"other": "thing"},
+		"vet": {"address": {"city": "Shelbyville"}, "name": null}
+	}`), &failing)
+	require.NoError(t, err)
+	pet = newPet()
+	pet.Vet = &Owner{Name: "Bob"}
+	vet := pet.Vet
+	expected = pet
+	assert.Error(t, failing.Apply(&pet))
+	assert.Equal(t, expected, pet)
+	assert.Same(t, vet, pet.Vet)
+	assert.Equal(t, Owner{Name: "Bob"}, *vet)
+}
+
+func TestJSONPatch(t *testing.T) {
+	var patch PetJSONPatch
+	err := json.Unmarshal([]byte(`[
+		{"op": "test", "path": "/owner/name", "value": "Ann"},
+		{"op": "remove", "path": "/age"},
+		{"op": "add", "path": "/tags", "value": ["good"]},
+		{"op": "replace", "path": "/owner/address/city", "value": "Shelbyville"},
+		{"op": "copy", "from": "/owner", "path": "/vet"}
+	]`), &patch)
+	require.NoError(t, err)
+
+	pet := newPet()
+	require.NoError(t, patch.Apply(&pet))
+
+	expected := newPet()
+	expected.Age = nil
+	expected.Tags = &[]string{"good"}
+	expected.Owner.Address.City = strPtr("Shelbyville")
+	expected.Vet = &Owner{
+		Name:    "Ann",
+		Address: &Address{Street: strPtr("Main St"), City: strPtr("Shelbyville")},
+	}
+	assert.Equal(t, expected, pet)
+
+	// The paths of the operations must be those of a pet
+	pet = newPet()
+	err = PetJSONPatch{{Op: "add", Path: "/owner/phone", Value: json.RawMessage(`"555"`)}}.Apply(&pet)
+	assert.Error(t, err)
+	assert.Equal(t, newPet(), pet)
+}
+
+type server struct {
+	pet Pet
+}
+
+func (s *server) PatchPet(ctx echo.Context, id int64) error {
+	var err error
+	switch ctx.Request().Header.Get(echo.HeaderContentType) {
+	case "application/merge-patch+json":
+		var patch PetPatch
+		if err = ctx.Bind(&patch); err == nil {
+			err = patch.Apply(&s.pet)
+		}
+	case "application/json-patch+json":
+		var patch PetJSONPatch
+		if err = ctx.Bind(&patch); err == nil {
+			err = patch.Apply(&s.pet)
+		}
+	default:
+		return echo.ErrUnsupportedMediaType
+	}
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnprocessableEntity, err.Error())
+	}
+	return ctx.JSON(http.StatusOK, s.pet)
+}
+
+func TestPatchRoundTrip(t *testing.T) {
+	s := server{pet: newPet()}
+	e := echo.New()
+	// Patches are JSON, whatever their media type
+	e.Binder = jsonBinder{}
+	RegisterHandlers(e, &s)
+	ts := httptest.NewServer(e)
+	defer ts.Close()
+
+	client, err := NewClientWithResponses(ts.URL)
+	require.NoError(t, err)
+
+	rsp, err := client.PatchPetWithMergePatchBodyWithResponse(context.Background(), 1,
+		PatchPetMergePatchRequestBody{Name: NewNullableString("Max")})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rsp.StatusCode(), string(rsp.Body))
+	assert.Equal(t, "Max", rsp.JSON200.Name)
+
+	rsp, err = client.PatchPetWithJSONPatchBodyWithResponse(context.Background(), 1,
+		PatchPetJSONPatchRequestBody{{Op: "replace", Path: "/name", Value: json.RawMessage(`"Rex"`)}})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rsp.StatusCode(), string(rsp.Body))
+	assert.Equal(t, "Rex", rsp.JSON200.Name)
+
+	rsp, err = client.PatchPetWithJSONPatchBodyWithResponse(context.Background(), 1,
+		PatchPetJSONPatchRequestBody{{Op: "remove", Path: "/color"}})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusUnprocessableEntity, rsp.StatusCode())
+}
+
+// jsonBinder binds request bodies as JSON.
+type jsonBinder struct{}
+
+func (jsonBinder) Bind(i interface{}, ctx echo.Context) error {
+	return json.NewDecoder(ctx.Request().Body).Decode(i)
+}
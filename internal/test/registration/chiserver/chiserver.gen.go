@@ -0,0 +1,314 @@
+// Package chiserver provides primitives to interact the openapi HTTP API.
+//
+// Code generated by github.com/indigonote/oapi-codegen DO NOT EDIT.
+package chiserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/go-chi/chi"
+	"github.com/indigonote/oapi-codegen/pkg/runtime"
+	"net/http"
+	"net/url"
+)
+
+// Pet defines model for Pet.
+type Pet struct {
+	Name *string `json:"name,omitempty"`
+}
+
+// GetHealthURL returns the URL of GetHealth on server, with its parameters
+// serialized as the client serializes them.
+func GetHealthURL(server string) (*url.URL, error) {
+	var err error
+
+	queryUrl, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	basePath := fmt.Sprintf("/health")
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
+	}
+
+	queryUrl, err = queryUrl.Parse(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return queryUrl, nil
+}
+
+// ListPetsURL returns the URL of ListPets on server, with its parameters
+// serialized as the client serializes them.
+func ListPetsURL(server string) (*url.URL, error) {
+	var err error
+
+	queryUrl, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	basePath := fmt.Sprintf("/pets")
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
+	}
+
+	queryUrl, err = queryUrl.Parse(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return queryUrl, nil
+}
+
+// DeletePetURL returns the URL of DeletePet on server, with its parameters
+// serialized as the client serializes them.
+func DeletePetURL(server string, id int) (*url.URL, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParam("simple", false, "id", id)
+	if err != nil {
+		return nil, err
+	}
+
+	queryUrl, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	basePath := fmt.Sprintf("/pets/%s", pathParam0)
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
+	}
+
+	queryUrl, err = queryUrl.Parse(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return queryUrl, nil
+}
+
+// OperationRoute describes an operation of the API, whatever the router serving
+// it.
+type OperationRoute struct {
+	Method      string // The HTTP method, eg. GET
+	Path        string // The path template of the spec, eg. /pets/{id}
+	OperationId string // The operation ID, as the names of the generated code use it
+}
+
+// OperationRoutes lists the operations of the API, in the order of their paths.
+var OperationRoutes = []OperationRoute{
+	{Method: "GET", Path: "/health", OperationId: "GetHealth"},
+	{Method: "GET", Path: "/pets", OperationId: "ListPets"},
+	{Method: "DELETE", Path: "/pets/{id}", OperationId: "DeletePet"},
+}
+
+type ServerInterface interface {
+	//  (GET /health)
+	GetHealth(w http.ResponseWriter, r *http.Request)
+	//  (GET /pets)
+	ListPets(w http.ResponseWriter, r *http.Request)
+	//  (DELETE /pets/{id})
+	DeletePet(w http.ResponseWriter, r *http.Request, id int)
+}
+
+// contextKey is the type of the keys of the values put into request contexts,
+// so that they can't collide with those of other packages.
+type contextKey string
+
+// ScopesFromContext returns the scopes of the security provider named
+// providerName which the operation of the request requires.
+func ScopesFromContext(ctx context.Context, providerName string) ([]string, bool) {
+	scopes, ok := ctx.Value(contextKey(providerName + ".Scopes")).([]string)
+	return scopes, ok
+}
+
+// ServerInterfaceWrapper converts requests to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+
+	// ErrorHandlerFunc answers requests whose parameters can't be bound, with
+	// their InvalidParamFormatError, RequiredParamError, UnmarshalingParamError
+	// or TooManyValuesForParamError, of the runtime package. They are answered
+	// with a 400 plain text error if it is nil.
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// handleError answers a request whose parameters can't be bound.
+func (siw *ServerInterfaceWrapper) handleError(w http.ResponseWriter, r *http.Request, err error) {
+	if siw.ErrorHandlerFunc != nil {
+		siw.ErrorHandlerFunc(w, r, err)
+		return
+	}
+	http.Error(w, err.Error(), http.StatusBadRequest)
+}
+
+// GetHealth converts the request to params.
+func (siw *ServerInterfaceWrapper) GetHealth(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, contextKey("api_key.Scopes"), []string{""})
+
+	siw.Handler.GetHealth(w, r.WithContext(ctx))
+}
+
+// ListPets converts the request to params.
+func (siw *ServerInterfaceWrapper) ListPets(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	siw.Handler.ListPets(w, r.WithContext(ctx))
+}
+
+// IdParamForDeletePet returns the path parameter "id" of DeletePet from
+// the context of its request.
+func IdParamForDeletePet(ctx context.Context) (int, bool) {
+	value, ok := ctx.Value(contextKey("DeletePet.id")).(int)
+	return value, ok
+}
+
+// DeletePet converts the request to params.
+func (siw *ServerInterfaceWrapper) DeletePet(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.handleError(w, r, runtime.ParamError(runtime.ParamLocationPath, "id", err))
+		return
+	}
+
+	ctx = context.WithValue(ctx, contextKey("DeletePet.id"), id)
+
+	ctx = context.WithValue(ctx, contextKey("oauth.Scopes"), []string{"pets:write"})
+
+	siw.Handler.DeletePet(w, r.WithContext(ctx), id)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerFromMux(si, chi.NewRouter())
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	RegisterHandlersWithOptions(r, si, RegisterOptions{})
+	return r
+}
+
+// RegisterOptions configures the middlewares and the error handling of the
+// routes added by RegisterHandlersWithOptions.
+type RegisterOptions struct {
+	// The middlewares of operations, by operation ID.
+	OperationMiddlewares map[string][]func(http.Handler) http.Handler
+
+	// The middlewares of the operations with a tag, by tag.
+	TagMiddlewares map[string][]func(http.Handler) http.Handler
+
+	// The ErrorHandlerFunc of the ServerInterfaceWrapper.
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// RegisterHandlersWithOptions adds each server route to the router, with the
+// middlewares of the tags of its operation, in the order of the tags, and then
+// those of the operation. The OperationInfo of the operation is put into the
+// context of the request before any of them runs.
+func RegisterHandlersWithOptions(r chi.Router, si ServerInterface, opts RegisterOptions) {
+	wrapper := ServerInterfaceWrapper{
+		Handler:          si,
+		ErrorHandlerFunc: opts.ErrorHandlerFunc,
+	}
+	r.With(opts.middlewares("GetHealth")...).Get("/health", wrapper.GetHealth)
+	r.With(opts.middlewares("ListPets")...).Get("/pets", wrapper.ListPets)
+	r.With(opts.middlewares("DeletePet")...).Delete("/pets/{id}", wrapper.DeletePet)
+
+}
+
+// middlewares returns the middlewares of the route of an operation.
+func (opts RegisterOptions) middlewares(operationId string) []func(http.Handler) http.Handler {
+	info := Operations[operationId]
+	middlewares := []func(http.Handler) http.Handler{
+		func(next http.Handler) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				next.ServeHTTP(w, r.WithContext(ContextWithOperationInfo(r.Context(), info)))
+			})
+		},
+	}
+	for _, tag := range info.Tags {
+		middlewares = append(middlewares, opts.TagMiddlewares[tag]...)
+	}
+	return append(middlewares, opts.OperationMiddlewares[operationId]...)
+}
+
+// OperationInfo describes an operation of the API, for the middlewares serving
+// it, which find it in the context of its requests.
+type OperationInfo struct {
+	OperationId string                     // The operation ID, as the names of the generated code use it
+	Method      string                     // The HTTP method, eg. GET
+	Path        string                     // The path template of the spec, eg. /pets/{id}
+	Tags        []string                   // The tags of the operation
+	Security    []OperationSecurity        // The security providers of the operation
+	Extensions  map[string]json.RawMessage // The x- extensions of the operation, by name
+}
+
+// OperationSecurity is a security provider of an operation, and the scopes it
+// requires.
+type OperationSecurity struct {
+	ProviderName string
+	Scopes       []string
+}
+
+// Operations holds the OperationInfo of every operation, by operation ID.
+var Operations = map[string]OperationInfo{
+	"GetHealth": {
+		OperationId: "GetHealth",
+		Method:      "GET",
+		Path:        "/health",
+		Security: []OperationSecurity{
+			{ProviderName: "api_key"},
+		},
+	},
+	"ListPets": {
+		OperationId: "ListPets",
+		Method:      "GET",
+		Path:        "/pets",
+		Tags:        []string{"pets", "public"},
+		Extensions: map[string]json.RawMessage{
+			"x-rate-limit": json.RawMessage("{\"requests\":10}"),
+		},
+	},
+	"DeletePet": {
+		OperationId: "DeletePet",
+		Method:      "DELETE",
+		Path:        "/pets/{id}",
+		Tags:        []string{"pets"},
+		Security: []OperationSecurity{
+			{ProviderName: "oauth", Scopes: []string{"pets:write"}},
+		},
+	},
+}
+
+// operationInfoKey is the context key of the OperationInfo of a request.
+type operationInfoKey struct{}
+
+// ContextWithOperationInfo returns a copy of ctx holding info, which
+// OperationInfoFromContext returns.
+func ContextWithOperationInfo(ctx context.Context, info OperationInfo) context.Context {
+	return context.WithValue(ctx, operationInfoKey{}, info)
+}
+
+// OperationInfoFromContext returns the OperationInfo of the operation serving
+// a request, from its context, and whether there's one.
+func OperationInfoFromContext(ctx context.Context) (OperationInfo, bool) {
+	info, ok := ctx.Value(operationInfoKey{}).(OperationInfo)
+	return info, ok
+}
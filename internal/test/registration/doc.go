@@ -0,0 +1,6 @@
+// Package registration checks the middlewares which the servers register for
+// the tags and operations of the spec.
+package registration
+
+//go:generate go run github.com/indigonote/oapi-codegen/cmd/oapi-codegen --package=echoserver --generate=types,server -o echoserver/echoserver.gen.go registration.yaml
+//go:generate go run github.com/indigonote/oapi-codegen/cmd/oapi-codegen --package=chiserver --generate=types,chi-server -o chiserver/chiserver.gen.go registration.yaml
@@ -0,0 +1,276 @@
+package registration
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/indigonote/oapi-codegen/internal/test/registration/chiserver"
+	"github.com/indigonote/oapi-codegen/internal/test/registration/echoserver"
+	"github.com/indigonote/oapi-codegen/pkg/runtime"
+)
+
+// calls records the middlewares and handlers which run for a request.
+type calls []string
+
+func (c *calls) add(call string) {
+	*c = append(*c, call)
+}
+
+type echoServer struct {
+	calls *calls
+}
+
+func (s echoServer) handle(ctx echo.Context, name string) error {
+	info, ok := echoserver.OperationInfoFromContext(ctx.Request().Context())
+	if !ok {
+		return echo.ErrInternalServerError
+	}
+	s.calls.add(name + ":" + info.OperationId)
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (s echoServer) GetHealth(ctx echo.Context) error {
+	return s.handle(ctx, "handler")
+}
+
+func (s echoServer) ListPets(ctx echo.Context) error {
+	return s.handle(ctx, "handler")
+}
+
+func (s echoServer) DeletePet(ctx echo.Context, id int) error {
+	return s.handle(ctx, "handler")
+}
+
+func echoMiddleware(c *calls, name string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			info, _ := echoserver.OperationInfoFromContext(ctx.Request().Context())
+			c.add(name + ":" + info.OperationId)
+			return next(ctx)
+		}
+	}
+}
+
+func TestEchoRegistration(t *testing.T) {
+	var c calls
+	e := echo.New()
+	echoserver.RegisterHandlersWithOptions(e, echoServer{calls: &c}, echoserver.RegisterOptions{
+		OperationMiddlewares: map[string][]echo.MiddlewareFunc{
+			"DeletePet": {echoMiddleware(&c, "audit")},
+		},
+		TagMiddlewares: map[string][]echo.MiddlewareFunc{
+			"pets":   {echoMiddleware(&c, "pets1"), echoMiddleware(&c, "pets2")},
+			"public": {echoMiddleware(&c, "public")},
+		},
+	})
+
+	for path, expected := range map[string]calls{
+		"GET /pets":      {"pets1:ListPets", "pets2:ListPets", "public:ListPets", "handler:ListPets"},
+		"DELETE /pets/3": {"pets1:DeletePet", "pets2:DeletePet", "audit:DeletePet", "handler:DeletePet"},
+		"GET /health":    {"handler:GetHealth"},
+	} {
+		c = nil
+		parts := strings.Split(path, " ")
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(parts[0], parts[1], nil))
+		assert.Equal(t, http.StatusNoContent, rec.Code, path)
+		assert.Equal(t, expected, c, path)
+	}
+}
+
+type chiServer struct {
+	calls *calls
+}
+
+func (s chiServer) handle(w http.ResponseWriter, r *http.Request) {
+	info, ok := chiserver.OperationInfoFromContext(r.Context())
+	if !ok {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	s.calls.add("handler:" + info.OperationId)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s chiServer) GetHealth(w http.ResponseWriter, r *http.Request) {
+	s.handle(w, r)
+}
+
+func (s chiServer) ListPets(w http.ResponseWriter, r *http.Request) {
+	s.handle(w, r)
+}
+
+func (s chiServer) DeletePet(w http.ResponseWriter, r *http.Request, id int) {
+	s.handle(w, r)
+}
+
+func chiMiddleware(c *calls, name string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			info, _ := chiserver.OperationInfoFromContext(r.Context())
+			c.add(name + ":" + info.OperationId)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func TestChiRegistration(t *testing.T) {
+	var c calls
+	r := chi.NewRouter()
+	chiserver.RegisterHandlersWithOptions(r, chiServer{calls: &c}, chiserver.RegisterOptions{
+		OperationMiddlewares: map[string][]func(http.Handler) http.Handler{
+			"DeletePet": {chiMiddleware(&c, "audit")},
+		},
+		TagMiddlewares: map[string][]func(http.Handler) http.Handler{
+			"pets":   {chiMiddleware(&c, "pets1"), chiMiddleware(&c, "pets2")},
+			"public": {chiMiddleware(&c, "public")},
+		},
+	})
+
+	for path, expected := range map[string]calls{
+		"GET /pets":      {"pets1:ListPets", "pets2:ListPets", "public:ListPets", "handler:ListPets"},
+		"DELETE /pets/3": {"pets1:DeletePet", "pets2:DeletePet", "audit:DeletePet", "handler:DeletePet"},
+		"GET /health":    {"handler:GetHealth"},
+	} {
+		c = nil
+		parts := strings.Split(path, " ")
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, httptest.NewRequest(parts[0], parts[1], nil))
+		assert.Equal(t, http.StatusNoContent, rec.Code, path)
+		assert.Equal(t, expected, c, path)
+	}
+
+	// Plain handlers put the OperationInfo into the context as well
+	c = nil
+	rec := httptest.NewRecorder()
+	chiserver.Handler(chiServer{calls: &c}).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/health", nil))
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Equal(t, calls{"handler:GetHealth"}, c)
+}
+
+func TestChiContextValues(t *testing.T) {
+	var id int
+	var idOK bool
+	var scopes []string
+	var scopesOK bool
+	wrapper := chiserver.ServerInterfaceWrapper{Handler: chiDeleteServer(func(w http.ResponseWriter, r *http.Request) {
+		id, idOK = chiserver.IdParamForDeletePet(r.Context())
+		scopes, scopesOK = chiserver.ScopesFromContext(r.Context(), "oauth")
+	})}
+
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("id", "3")
+	req := httptest.NewRequest(http.MethodDelete, "/pets/3", nil)
+	req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+	wrapper.DeletePet(httptest.NewRecorder(), req)
+	assert.True(t, idOK)
+	assert.Equal(t, 3, id)
+	assert.True(t, scopesOK)
+	assert.Equal(t, []string{"pets:write"}, scopes)
+
+	// Nothing is found in the contexts of other requests
+	_, idOK = chiserver.IdParamForDeletePet(context.Background())
+	assert.False(t, idOK)
+	_, scopesOK = chiserver.ScopesFromContext(req.Context(), "oauth")
+	assert.False(t, scopesOK)
+	// Nor under keys of the same name from other packages
+	ctx := context.WithValue(context.Background(), "oauth.Scopes", []string{"pets:write"})
+	_, scopesOK = chiserver.ScopesFromContext(ctx, "oauth")
+	assert.False(t, scopesOK)
+}
+
+// chiDeleteServer serves DeletePet with a function.
+type chiDeleteServer func(w http.ResponseWriter, r *http.Request)
+
+func (s chiDeleteServer) GetHealth(w http.ResponseWriter, r *http.Request) {}
+
+func (s chiDeleteServer) ListPets(w http.ResponseWriter, r *http.Request) {}
+
+func (s chiDeleteServer) DeletePet(w http.ResponseWriter, r *http.Request, id int) {
+	s(w, r)
+}
+
+func TestOperationInfo(t *testing.T) {
+	info := echoserver.Operations["ListPets"]
+	assert.Equal(t, "GET", info.Method)
+	assert.Equal(t, "/pets", info.Path)
+	assert.Equal(t, []string{"pets", "public"}, info.Tags)
+	assert.Empty(t, info.Security)
+	var rateLimit struct {
+		Requests int `json:"requests"`
+	}
+	require.NoError(t, json.Unmarshal(info.Extensions["x-rate-limit"], &rateLimit))
+	assert.Equal(t, 10, rateLimit.Requests)
+
+	// Operations get the global security unless they override it
+	assert.Equal(t, []echoserver.OperationSecurity{{ProviderName: "api_key"}},
+		echoserver.Operations["GetHealth"].Security)
+	assert.Equal(t, []chiserver.OperationSecurity{{ProviderName: "oauth", Scopes: []string{"pets:write"}}},
+		chiserver.Operations["DeletePet"].Security)
+}
+
+// errorEnvelope is the body of the answers of the error handlers.
+type errorEnvelope struct {
+	Param    string `json:"param"`
+	Location string `json:"location"`
+	Message  string `json:"message"`
+}
+
+func newErrorEnvelope(err error) errorEnvelope {
+	var formatErr *runtime.InvalidParamFormatError
+	if errors.As(err, &formatErr) {
+		return errorEnvelope{
+			Param:    formatErr.ParamName,
+			Location: string(formatErr.ParamLocation),
+			Message:  err.Error(),
+		}
+	}
+	return errorEnvelope{Message: err.Error()}
+}
+
+func TestErrorHandler(t *testing.T) {
+	var c calls
+	e := echo.New()
+	echoserver.RegisterHandlersWithOptions(e, echoServer{calls: &c}, echoserver.RegisterOptions{
+		ErrorHandlerFunc: func(ctx echo.Context, err error) error {
+			return ctx.JSON(http.StatusUnprocessableEntity, newErrorEnvelope(err))
+		},
+	})
+	r := chi.NewRouter()
+	chiserver.RegisterHandlersWithOptions(r, chiServer{calls: &c}, chiserver.RegisterOptions{
+		ErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			_ = json.NewEncoder(w).Encode(newErrorEnvelope(err))
+		},
+	})
+
+	for name, handler := range map[string]http.Handler{"echo": e, "chi": r} {
+		c = nil
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodDelete, "/pets/three", nil))
+		assert.Equal(t, http.StatusUnprocessableEntity, rec.Code, name)
+		var envelope errorEnvelope
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &envelope), name)
+		assert.Equal(t, "id", envelope.Param, name)
+		assert.Equal(t, "path", envelope.Location, name)
+		assert.Contains(t, envelope.Message, "Invalid format for parameter id", name)
+		assert.Empty(t, c, name)
+	}
+
+	// Without a handler, the errors are answered as before
+	rec := httptest.NewRecorder()
+	chiserver.Handler(chiServer{calls: &c}).ServeHTTP(rec, httptest.NewRequest(http.MethodDelete, "/pets/three", nil))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "Invalid format for parameter id")
+}
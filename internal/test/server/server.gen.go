@@ -5,11 +5,13 @@ package server
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
-	"github.com/deepmap/oapi-codegen/pkg/runtime"
-	openapi_types "github.com/deepmap/oapi-codegen/pkg/types"
 	"github.com/go-chi/chi"
+	"github.com/indigonote/oapi-codegen/pkg/runtime"
+	openapi_types "github.com/indigonote/oapi-codegen/pkg/types"
 	"net/http"
+	"net/url"
 	"time"
 )
 
@@ -125,271 +127,663 @@ type CreateResource2JSONRequestBody CreateResource2JSONBody
 // UpdateResource3RequestBody defines body for UpdateResource3 for application/json ContentType.
 type UpdateResource3JSONRequestBody UpdateResource3JSONBody
 
+// GetEveryTypeOptionalURL returns the URL of GetEveryTypeOptional on server, with its parameters
+// serialized as the client serializes them.
+func GetEveryTypeOptionalURL(server string) (*url.URL, error) {
+	var err error
+
+	queryUrl, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	basePath := fmt.Sprintf("/every-type-optional")
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
+	}
+
+	queryUrl, err = queryUrl.Parse(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return queryUrl, nil
+}
+
+// GetSimpleURL returns the URL of GetSimple on server, with its parameters
+// serialized as the client serializes them.
+func GetSimpleURL(server string) (*url.URL, error) {
+	var err error
+
+	queryUrl, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	basePath := fmt.Sprintf("/get-simple")
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
+	}
+
+	queryUrl, err = queryUrl.Parse(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return queryUrl, nil
+}
+
+// GetWithArgsURL returns the URL of GetWithArgs on server, with its parameters
+// serialized as the client serializes them.
+func GetWithArgsURL(server string, params *GetWithArgsParams) (*url.URL, error) {
+	var err error
+
+	queryUrl, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	basePath := fmt.Sprintf("/get-with-args")
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
+	}
+
+	queryUrl, err = queryUrl.Parse(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryUrl.Query()
+
+	if params.OptionalArgument != nil {
+
+		if queryFrag, err := runtime.StyleParam("form", true, "optional_argument", *params.OptionalArgument); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if queryFrag, err := runtime.StyleParam("form", true, "required_argument", params.RequiredArgument); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	queryUrl.RawQuery = queryValues.Encode()
+
+	return queryUrl, nil
+}
+
+// GetWithReferencesURL returns the URL of GetWithReferences on server, with its parameters
+// serialized as the client serializes them.
+func GetWithReferencesURL(server string, globalArgument int64, argument Argument) (*url.URL, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParam("simple", false, "global_argument", globalArgument)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParam("simple", false, "argument", argument)
+	if err != nil {
+		return nil, err
+	}
+
+	queryUrl, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	basePath := fmt.Sprintf("/get-with-references/%s/%s", pathParam0, pathParam1)
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
+	}
+
+	queryUrl, err = queryUrl.Parse(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return queryUrl, nil
+}
+
+// GetWithContentTypeURL returns the URL of GetWithContentType on server, with its parameters
+// serialized as the client serializes them.
+func GetWithContentTypeURL(server string, contentType string) (*url.URL, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParam("simple", false, "content_type", contentType)
+	if err != nil {
+		return nil, err
+	}
+
+	queryUrl, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	basePath := fmt.Sprintf("/get-with-type/%s", pathParam0)
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
+	}
+
+	queryUrl, err = queryUrl.Parse(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return queryUrl, nil
+}
+
+// GetReservedKeywordURL returns the URL of GetReservedKeyword on server, with its parameters
+// serialized as the client serializes them.
+func GetReservedKeywordURL(server string) (*url.URL, error) {
+	var err error
+
+	queryUrl, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	basePath := fmt.Sprintf("/reserved-keyword")
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
+	}
+
+	queryUrl, err = queryUrl.Parse(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return queryUrl, nil
+}
+
+// CreateResourceURL returns the URL of CreateResource on server, with its parameters
+// serialized as the client serializes them.
+func CreateResourceURL(server string, argument Argument) (*url.URL, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParam("simple", false, "argument", argument)
+	if err != nil {
+		return nil, err
+	}
+
+	queryUrl, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	basePath := fmt.Sprintf("/resource/%s", pathParam0)
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
+	}
+
+	queryUrl, err = queryUrl.Parse(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return queryUrl, nil
+}
+
+// CreateResource2URL returns the URL of CreateResource2 on server, with its parameters
+// serialized as the client serializes them.
+func CreateResource2URL(server string, inlineArgument int, params *CreateResource2Params) (*url.URL, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParam("simple", false, "inline_argument", inlineArgument)
+	if err != nil {
+		return nil, err
+	}
+
+	queryUrl, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	basePath := fmt.Sprintf("/resource2/%s", pathParam0)
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
+	}
+
+	queryUrl, err = queryUrl.Parse(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryUrl.Query()
+
+	if params.InlineQueryArgument != nil {
+
+		if queryFrag, err := runtime.StyleParam("form", true, "inline_query_argument", *params.InlineQueryArgument); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryUrl.RawQuery = queryValues.Encode()
+
+	return queryUrl, nil
+}
+
+// UpdateResource3URL returns the URL of UpdateResource3 on server, with its parameters
+// serialized as the client serializes them.
+func UpdateResource3URL(server string, pFallthrough int) (*url.URL, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParam("simple", false, "fallthrough", pFallthrough)
+	if err != nil {
+		return nil, err
+	}
+
+	queryUrl, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	basePath := fmt.Sprintf("/resource3/%s", pathParam0)
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
+	}
+
+	queryUrl, err = queryUrl.Parse(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return queryUrl, nil
+}
+
+// GetResponseWithReferenceURL returns the URL of GetResponseWithReference on server, with its parameters
+// serialized as the client serializes them.
+func GetResponseWithReferenceURL(server string) (*url.URL, error) {
+	var err error
+
+	queryUrl, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	basePath := fmt.Sprintf("/response-with-reference")
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
+	}
+
+	queryUrl, err = queryUrl.Parse(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return queryUrl, nil
+}
+
+// OperationRoute describes an operation of the API, whatever the router serving
+// it.
+type OperationRoute struct {
+	Method      string // The HTTP method, eg. GET
+	Path        string // The path template of the spec, eg. /pets/{id}
+	OperationId string // The operation ID, as the names of the generated code use it
+}
+
+// OperationRoutes lists the operations of the API, in the order of their paths.
+var OperationRoutes = []OperationRoute{
+	{Method: "GET", Path: "/every-type-optional", OperationId: "GetEveryTypeOptional"},
+	{Method: "GET", Path: "/get-simple", OperationId: "GetSimple"},
+	{Method: "GET", Path: "/get-with-args", OperationId: "GetWithArgs"},
+	{Method: "GET", Path: "/get-with-references/{global_argument}/{argument}", OperationId: "GetWithReferences"},
+	{Method: "GET", Path: "/get-with-type/{content_type}", OperationId: "GetWithContentType"},
+	{Method: "GET", Path: "/reserved-keyword", OperationId: "GetReservedKeyword"},
+	{Method: "POST", Path: "/resource/{argument}", OperationId: "CreateResource"},
+	{Method: "POST", Path: "/resource2/{inline_argument}", OperationId: "CreateResource2"},
+	{Method: "PUT", Path: "/resource3/{fallthrough}", OperationId: "UpdateResource3"},
+	{Method: "GET", Path: "/response-with-reference", OperationId: "GetResponseWithReference"},
+}
+
 type ServerInterface interface {
 	// get every type optional (GET /every-type-optional)
 	GetEveryTypeOptional(w http.ResponseWriter, r *http.Request)
 	// Get resource via simple path (GET /get-simple)
 	GetSimple(w http.ResponseWriter, r *http.Request)
 	// Getter with referenced parameter and referenced response (GET /get-with-args)
-	GetWithArgs(w http.ResponseWriter, r *http.Request)
+	GetWithArgs(w http.ResponseWriter, r *http.Request, params GetWithArgsParams)
 	// Getter with referenced parameter and referenced response (GET /get-with-references/{global_argument}/{argument})
-	GetWithReferences(w http.ResponseWriter, r *http.Request)
+	GetWithReferences(w http.ResponseWriter, r *http.Request, globalArgument int64, argument Argument)
 	// Get an object by ID (GET /get-with-type/{content_type})
-	GetWithContentType(w http.ResponseWriter, r *http.Request)
+	GetWithContentType(w http.ResponseWriter, r *http.Request, contentType string)
 	// get with reserved keyword (GET /reserved-keyword)
 	GetReservedKeyword(w http.ResponseWriter, r *http.Request)
 	// Create a resource (POST /resource/{argument})
-	CreateResource(w http.ResponseWriter, r *http.Request)
+	CreateResource(w http.ResponseWriter, r *http.Request, argument Argument)
 	// Create a resource with inline parameter (POST /resource2/{inline_argument})
-	CreateResource2(w http.ResponseWriter, r *http.Request)
+	CreateResource2(w http.ResponseWriter, r *http.Request, inlineArgument int, params CreateResource2Params)
 	// Update a resource with inline body. The parameter name is a reservedkeyword, so make sure that gets prefixed to avoid syntax errors (PUT /resource3/{fallthrough})
-	UpdateResource3(w http.ResponseWriter, r *http.Request)
+	UpdateResource3(w http.ResponseWriter, r *http.Request, pFallthrough int)
 	// get response with reference (GET /response-with-reference)
 	GetResponseWithReference(w http.ResponseWriter, r *http.Request)
 }
 
-// GetEveryTypeOptional operation middleware
-func GetEveryTypeOptionalCtx(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		ctx := r.Context()
+// contextKey is the type of the keys of the values put into request contexts,
+// so that they can't collide with those of other packages.
+type contextKey string
 
-		next.ServeHTTP(w, r.WithContext(ctx))
-	})
+// ScopesFromContext returns the scopes of the security provider named
+// providerName which the operation of the request requires.
+func ScopesFromContext(ctx context.Context, providerName string) ([]string, bool) {
+	scopes, ok := ctx.Value(contextKey(providerName + ".Scopes")).([]string)
+	return scopes, ok
 }
 
-// GetSimple operation middleware
-func GetSimpleCtx(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		ctx := r.Context()
+// ServerInterfaceWrapper converts requests to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
 
-		next.ServeHTTP(w, r.WithContext(ctx))
-	})
+	// ErrorHandlerFunc answers requests whose parameters can't be bound, with
+	// their InvalidParamFormatError, RequiredParamError, UnmarshalingParamError
+	// or TooManyValuesForParamError, of the runtime package. They are answered
+	// with a 400 plain text error if it is nil.
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
 }
 
-// ParamsForGetWithArgs operation parameters from context
-func ParamsForGetWithArgs(ctx context.Context) *GetWithArgsParams {
-	return ctx.Value("GetWithArgsParams").(*GetWithArgsParams)
+// handleError answers a request whose parameters can't be bound.
+func (siw *ServerInterfaceWrapper) handleError(w http.ResponseWriter, r *http.Request, err error) {
+	if siw.ErrorHandlerFunc != nil {
+		siw.ErrorHandlerFunc(w, r, err)
+		return
+	}
+	http.Error(w, err.Error(), http.StatusBadRequest)
 }
 
-// GetWithArgs operation middleware
-func GetWithArgsCtx(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		ctx := r.Context()
+// GetEveryTypeOptional converts the request to params.
+func (siw *ServerInterfaceWrapper) GetEveryTypeOptional(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-		var err error
+	siw.Handler.GetEveryTypeOptional(w, r.WithContext(ctx))
+}
 
-		// Parameter object where we will unmarshal all parameters from the context
-		var params GetWithArgsParams
+// GetSimple converts the request to params.
+func (siw *ServerInterfaceWrapper) GetSimple(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-		// ------------- Optional query parameter "optional_argument" -------------
-		if paramValue := r.URL.Query().Get("optional_argument"); paramValue != "" {
+	siw.Handler.GetSimple(w, r.WithContext(ctx))
+}
 
-		}
+// ParamsForGetWithArgs returns the parameters of GetWithArgs from the context of
+// its request.
+func ParamsForGetWithArgs(ctx context.Context) (GetWithArgsParams, bool) {
+	params, ok := ctx.Value(contextKey("GetWithArgsParams")).(GetWithArgsParams)
+	return params, ok
+}
 
-		err = runtime.BindQueryParameter("form", true, false, "optional_argument", r.URL.Query(), &params.OptionalArgument)
-		if err != nil {
-			http.Error(w, fmt.Sprintf("Invalid format for parameter optional_argument: %s", err), http.StatusBadRequest)
-			return
-		}
+// GetWithArgs converts the request to params.
+func (siw *ServerInterfaceWrapper) GetWithArgs(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-		// ------------- Required query parameter "required_argument" -------------
-		if paramValue := r.URL.Query().Get("required_argument"); paramValue != "" {
+	var err error
 
-		} else {
-			http.Error(w, "Query argument required_argument is required, but not found", http.StatusBadRequest)
-			return
-		}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetWithArgsParams
 
-		err = runtime.BindQueryParameter("form", true, true, "required_argument", r.URL.Query(), &params.RequiredArgument)
-		if err != nil {
-			http.Error(w, fmt.Sprintf("Invalid format for parameter required_argument: %s", err), http.StatusBadRequest)
-			return
-		}
+	// ------------- Optional query parameter "optional_argument" -------------
+	if paramValue := r.URL.Query().Get("optional_argument"); paramValue != "" {
 
-		headers := r.Header
+	}
 
-		// ------------- Optional header parameter "header_argument" -------------
-		if valueList, found := headers[http.CanonicalHeaderKey("header_argument")]; found {
-			var HeaderArgument int32
-			n := len(valueList)
-			if n != 1 {
-				http.Error(w, fmt.Sprintf("Expected one value for header_argument, got %d", n), http.StatusBadRequest)
-				return
-			}
+	err = runtime.BindQueryParameter("form", true, false, "optional_argument", r.URL.Query(), &params.OptionalArgument)
+	if err != nil {
+		siw.handleError(w, r, runtime.ParamError(runtime.ParamLocationQuery, "optional_argument", err))
+		return
+	}
 
-			err = runtime.BindStyledParameter("simple", false, "header_argument", valueList[0], &HeaderArgument)
-			if err != nil {
-				http.Error(w, fmt.Sprintf("Invalid format for parameter header_argument: %s", err), http.StatusBadRequest)
-				return
-			}
+	// ------------- Required query parameter "required_argument" -------------
+	if paramValue := r.URL.Query().Get("required_argument"); paramValue != "" {
 
-			params.HeaderArgument = &HeaderArgument
+	} else {
+		siw.handleError(w, r, &runtime.RequiredParamError{ParamName: "required_argument", ParamLocation: runtime.ParamLocationQuery})
+		return
+	}
 
-		}
+	err = runtime.BindQueryParameter("form", true, true, "required_argument", r.URL.Query(), &params.RequiredArgument)
+	if err != nil {
+		siw.handleError(w, r, runtime.ParamError(runtime.ParamLocationQuery, "required_argument", err))
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "header_argument" -------------
+
+	err = runtime.BindHeaderParameter("simple", false, false, "header_argument", headers, &params.HeaderArgument)
+	if err != nil {
+		siw.handleError(w, r, runtime.ParamError(runtime.ParamLocationHeader, "header_argument", err))
+		return
+	}
 
-		ctx = context.WithValue(ctx, "GetWithArgsParams", &params)
+	ctx = context.WithValue(ctx, contextKey("GetWithArgsParams"), params)
 
-		next.ServeHTTP(w, r.WithContext(ctx))
-	})
+	siw.Handler.GetWithArgs(w, r.WithContext(ctx), params)
 }
 
-// GetWithReferences operation middleware
-func GetWithReferencesCtx(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		ctx := r.Context()
+// GlobalArgumentParamForGetWithReferences returns the path parameter "global_argument" of GetWithReferences from
+// the context of its request.
+func GlobalArgumentParamForGetWithReferences(ctx context.Context) (int64, bool) {
+	value, ok := ctx.Value(contextKey("GetWithReferences.global_argument")).(int64)
+	return value, ok
+}
 
-		var err error
+// ArgumentParamForGetWithReferences returns the path parameter "argument" of GetWithReferences from
+// the context of its request.
+func ArgumentParamForGetWithReferences(ctx context.Context) (Argument, bool) {
+	value, ok := ctx.Value(contextKey("GetWithReferences.argument")).(Argument)
+	return value, ok
+}
 
-		// ------------- Path parameter "global_argument" -------------
-		var globalArgument int64
+// GetWithReferences converts the request to params.
+func (siw *ServerInterfaceWrapper) GetWithReferences(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-		err = runtime.BindStyledParameter("simple", false, "global_argument", chi.URLParam(r, "global_argument"), &globalArgument)
-		if err != nil {
-			http.Error(w, fmt.Sprintf("Invalid format for parameter global_argument: %s", err), http.StatusBadRequest)
-			return
-		}
+	var err error
 
-		ctx = context.WithValue(ctx, "globalArgument", globalArgument)
-		// ------------- Path parameter "argument" -------------
-		var argument Argument
+	// ------------- Path parameter "global_argument" -------------
+	var globalArgument int64
 
-		err = runtime.BindStyledParameter("simple", false, "argument", chi.URLParam(r, "argument"), &argument)
-		if err != nil {
-			http.Error(w, fmt.Sprintf("Invalid format for parameter argument: %s", err), http.StatusBadRequest)
-			return
-		}
+	err = runtime.BindStyledParameter("simple", false, "global_argument", chi.URLParam(r, "global_argument"), &globalArgument)
+	if err != nil {
+		siw.handleError(w, r, runtime.ParamError(runtime.ParamLocationPath, "global_argument", err))
+		return
+	}
 
-		ctx = context.WithValue(ctx, "argument", argument)
+	ctx = context.WithValue(ctx, contextKey("GetWithReferences.global_argument"), globalArgument)
+	// ------------- Path parameter "argument" -------------
+	var argument Argument
 
-		next.ServeHTTP(w, r.WithContext(ctx))
-	})
+	err = runtime.BindStyledParameter("simple", false, "argument", chi.URLParam(r, "argument"), &argument)
+	if err != nil {
+		siw.handleError(w, r, runtime.ParamError(runtime.ParamLocationPath, "argument", err))
+		return
+	}
+
+	ctx = context.WithValue(ctx, contextKey("GetWithReferences.argument"), argument)
+
+	siw.Handler.GetWithReferences(w, r.WithContext(ctx), globalArgument, argument)
 }
 
-// GetWithContentType operation middleware
-func GetWithContentTypeCtx(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		ctx := r.Context()
+// ContentTypeParamForGetWithContentType returns the path parameter "content_type" of GetWithContentType from
+// the context of its request.
+func ContentTypeParamForGetWithContentType(ctx context.Context) (string, bool) {
+	value, ok := ctx.Value(contextKey("GetWithContentType.content_type")).(string)
+	return value, ok
+}
 
-		var err error
+// GetWithContentType converts the request to params.
+func (siw *ServerInterfaceWrapper) GetWithContentType(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-		// ------------- Path parameter "content_type" -------------
-		var contentType string
+	var err error
 
-		err = runtime.BindStyledParameter("simple", false, "content_type", chi.URLParam(r, "content_type"), &contentType)
-		if err != nil {
-			http.Error(w, fmt.Sprintf("Invalid format for parameter content_type: %s", err), http.StatusBadRequest)
-			return
-		}
+	// ------------- Path parameter "content_type" -------------
+	var contentType string
+
+	err = runtime.BindStyledParameter("simple", false, "content_type", chi.URLParam(r, "content_type"), &contentType)
+	if err != nil {
+		siw.handleError(w, r, runtime.ParamError(runtime.ParamLocationPath, "content_type", err))
+		return
+	}
 
-		ctx = context.WithValue(ctx, "contentType", contentType)
+	ctx = context.WithValue(ctx, contextKey("GetWithContentType.content_type"), contentType)
 
-		next.ServeHTTP(w, r.WithContext(ctx))
-	})
+	siw.Handler.GetWithContentType(w, r.WithContext(ctx), contentType)
 }
 
-// GetReservedKeyword operation middleware
-func GetReservedKeywordCtx(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		ctx := r.Context()
+// GetReservedKeyword converts the request to params.
+func (siw *ServerInterfaceWrapper) GetReservedKeyword(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-		next.ServeHTTP(w, r.WithContext(ctx))
-	})
+	siw.Handler.GetReservedKeyword(w, r.WithContext(ctx))
 }
 
-// CreateResource operation middleware
-func CreateResourceCtx(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		ctx := r.Context()
+// ArgumentParamForCreateResource returns the path parameter "argument" of CreateResource from
+// the context of its request.
+func ArgumentParamForCreateResource(ctx context.Context) (Argument, bool) {
+	value, ok := ctx.Value(contextKey("CreateResource.argument")).(Argument)
+	return value, ok
+}
 
-		var err error
+// CreateResource converts the request to params.
+func (siw *ServerInterfaceWrapper) CreateResource(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-		// ------------- Path parameter "argument" -------------
-		var argument Argument
+	var err error
 
-		err = runtime.BindStyledParameter("simple", false, "argument", chi.URLParam(r, "argument"), &argument)
-		if err != nil {
-			http.Error(w, fmt.Sprintf("Invalid format for parameter argument: %s", err), http.StatusBadRequest)
-			return
-		}
+	// ------------- Path parameter "argument" -------------
+	var argument Argument
+
+	err = runtime.BindStyledParameter("simple", false, "argument", chi.URLParam(r, "argument"), &argument)
+	if err != nil {
+		siw.handleError(w, r, runtime.ParamError(runtime.ParamLocationPath, "argument", err))
+		return
+	}
 
-		ctx = context.WithValue(ctx, "argument", argument)
+	ctx = context.WithValue(ctx, contextKey("CreateResource.argument"), argument)
 
-		next.ServeHTTP(w, r.WithContext(ctx))
-	})
+	siw.Handler.CreateResource(w, r.WithContext(ctx), argument)
 }
 
-// ParamsForCreateResource2 operation parameters from context
-func ParamsForCreateResource2(ctx context.Context) *CreateResource2Params {
-	return ctx.Value("CreateResource2Params").(*CreateResource2Params)
+// InlineArgumentParamForCreateResource2 returns the path parameter "inline_argument" of CreateResource2 from
+// the context of its request.
+func InlineArgumentParamForCreateResource2(ctx context.Context) (int, bool) {
+	value, ok := ctx.Value(contextKey("CreateResource2.inline_argument")).(int)
+	return value, ok
 }
 
-// CreateResource2 operation middleware
-func CreateResource2Ctx(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		ctx := r.Context()
+// ParamsForCreateResource2 returns the parameters of CreateResource2 from the context of
+// its request.
+func ParamsForCreateResource2(ctx context.Context) (CreateResource2Params, bool) {
+	params, ok := ctx.Value(contextKey("CreateResource2Params")).(CreateResource2Params)
+	return params, ok
+}
 
-		var err error
+// CreateResource2 converts the request to params.
+func (siw *ServerInterfaceWrapper) CreateResource2(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-		// ------------- Path parameter "inline_argument" -------------
-		var inlineArgument int
+	var err error
 
-		err = runtime.BindStyledParameter("simple", false, "inline_argument", chi.URLParam(r, "inline_argument"), &inlineArgument)
-		if err != nil {
-			http.Error(w, fmt.Sprintf("Invalid format for parameter inline_argument: %s", err), http.StatusBadRequest)
-			return
-		}
+	// ------------- Path parameter "inline_argument" -------------
+	var inlineArgument int
 
-		ctx = context.WithValue(ctx, "inlineArgument", inlineArgument)
+	err = runtime.BindStyledParameter("simple", false, "inline_argument", chi.URLParam(r, "inline_argument"), &inlineArgument)
+	if err != nil {
+		siw.handleError(w, r, runtime.ParamError(runtime.ParamLocationPath, "inline_argument", err))
+		return
+	}
 
-		// Parameter object where we will unmarshal all parameters from the context
-		var params CreateResource2Params
+	ctx = context.WithValue(ctx, contextKey("CreateResource2.inline_argument"), inlineArgument)
 
-		// ------------- Optional query parameter "inline_query_argument" -------------
-		if paramValue := r.URL.Query().Get("inline_query_argument"); paramValue != "" {
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateResource2Params
 
-		}
+	// ------------- Optional query parameter "inline_query_argument" -------------
+	if paramValue := r.URL.Query().Get("inline_query_argument"); paramValue != "" {
 
-		err = runtime.BindQueryParameter("form", true, false, "inline_query_argument", r.URL.Query(), &params.InlineQueryArgument)
-		if err != nil {
-			http.Error(w, fmt.Sprintf("Invalid format for parameter inline_query_argument: %s", err), http.StatusBadRequest)
-			return
-		}
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "inline_query_argument", r.URL.Query(), &params.InlineQueryArgument)
+	if err != nil {
+		siw.handleError(w, r, runtime.ParamError(runtime.ParamLocationQuery, "inline_query_argument", err))
+		return
+	}
 
-		ctx = context.WithValue(ctx, "CreateResource2Params", &params)
+	ctx = context.WithValue(ctx, contextKey("CreateResource2Params"), params)
 
-		next.ServeHTTP(w, r.WithContext(ctx))
-	})
+	siw.Handler.CreateResource2(w, r.WithContext(ctx), inlineArgument, params)
 }
 
-// UpdateResource3 operation middleware
-func UpdateResource3Ctx(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		ctx := r.Context()
+// FallthroughParamForUpdateResource3 returns the path parameter "fallthrough" of UpdateResource3 from
+// the context of its request.
+func FallthroughParamForUpdateResource3(ctx context.Context) (int, bool) {
+	value, ok := ctx.Value(contextKey("UpdateResource3.fallthrough")).(int)
+	return value, ok
+}
 
-		var err error
+// UpdateResource3 converts the request to params.
+func (siw *ServerInterfaceWrapper) UpdateResource3(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-		// ------------- Path parameter "fallthrough" -------------
-		var pFallthrough int
+	var err error
 
-		err = runtime.BindStyledParameter("simple", false, "fallthrough", chi.URLParam(r, "fallthrough"), &pFallthrough)
-		if err != nil {
-			http.Error(w, fmt.Sprintf("Invalid format for parameter fallthrough: %s", err), http.StatusBadRequest)
-			return
-		}
+	// ------------- Path parameter "fallthrough" -------------
+	var pFallthrough int
 
-		ctx = context.WithValue(ctx, "pFallthrough", pFallthrough)
+	err = runtime.BindStyledParameter("simple", false, "fallthrough", chi.URLParam(r, "fallthrough"), &pFallthrough)
+	if err != nil {
+		siw.handleError(w, r, runtime.ParamError(runtime.ParamLocationPath, "fallthrough", err))
+		return
+	}
 
-		next.ServeHTTP(w, r.WithContext(ctx))
-	})
+	ctx = context.WithValue(ctx, contextKey("UpdateResource3.fallthrough"), pFallthrough)
+
+	siw.Handler.UpdateResource3(w, r.WithContext(ctx), pFallthrough)
 }
 
-// GetResponseWithReference operation middleware
-func GetResponseWithReferenceCtx(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		ctx := r.Context()
+// GetResponseWithReference converts the request to params.
+func (siw *ServerInterfaceWrapper) GetResponseWithReference(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-		next.ServeHTTP(w, r.WithContext(ctx))
-	})
+	siw.Handler.GetResponseWithReference(w, r.WithContext(ctx))
 }
 
 // Handler creates http.Handler with routing matching OpenAPI spec.
@@ -399,46 +793,145 @@ func Handler(si ServerInterface) http.Handler {
 
 // HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
 func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	r.Group(func(r chi.Router) {
-		r.Use(GetEveryTypeOptionalCtx)
-		r.Get("/every-type-optional", si.GetEveryTypeOptional)
-	})
-	r.Group(func(r chi.Router) {
-		r.Use(GetSimpleCtx)
-		r.Get("/get-simple", si.GetSimple)
-	})
-	r.Group(func(r chi.Router) {
-		r.Use(GetWithArgsCtx)
-		r.Get("/get-with-args", si.GetWithArgs)
-	})
-	r.Group(func(r chi.Router) {
-		r.Use(GetWithReferencesCtx)
-		r.Get("/get-with-references/{global_argument}/{argument}", si.GetWithReferences)
-	})
-	r.Group(func(r chi.Router) {
-		r.Use(GetWithContentTypeCtx)
-		r.Get("/get-with-type/{content_type}", si.GetWithContentType)
-	})
-	r.Group(func(r chi.Router) {
-		r.Use(GetReservedKeywordCtx)
-		r.Get("/reserved-keyword", si.GetReservedKeyword)
-	})
-	r.Group(func(r chi.Router) {
-		r.Use(CreateResourceCtx)
-		r.Post("/resource/{argument}", si.CreateResource)
-	})
-	r.Group(func(r chi.Router) {
-		r.Use(CreateResource2Ctx)
-		r.Post("/resource2/{inline_argument}", si.CreateResource2)
-	})
-	r.Group(func(r chi.Router) {
-		r.Use(UpdateResource3Ctx)
-		r.Put("/resource3/{fallthrough}", si.UpdateResource3)
-	})
-	r.Group(func(r chi.Router) {
-		r.Use(GetResponseWithReferenceCtx)
-		r.Get("/response-with-reference", si.GetResponseWithReference)
-	})
-
+	RegisterHandlersWithOptions(r, si, RegisterOptions{})
 	return r
 }
+
+// RegisterOptions configures the middlewares and the error handling of the
+// routes added by RegisterHandlersWithOptions.
+type RegisterOptions struct {
+	// The middlewares of operations, by operation ID.
+	OperationMiddlewares map[string][]func(http.Handler) http.Handler
+
+	// The middlewares of the operations with a tag, by tag.
+	TagMiddlewares map[string][]func(http.Handler) http.Handler
+
+	// The ErrorHandlerFunc of the ServerInterfaceWrapper.
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// RegisterHandlersWithOptions adds each server route to the router, with the
+// middlewares of the tags of its operation, in the order of the tags, and then
+// those of the operation. The OperationInfo of the operation is put into the
+// context of the request before any of them runs.
+func RegisterHandlersWithOptions(r chi.Router, si ServerInterface, opts RegisterOptions) {
+	wrapper := ServerInterfaceWrapper{
+		Handler:          si,
+		ErrorHandlerFunc: opts.ErrorHandlerFunc,
+	}
+	r.With(opts.middlewares("GetEveryTypeOptional")...).Get("/every-type-optional", wrapper.GetEveryTypeOptional)
+	r.With(opts.middlewares("GetSimple")...).Get("/get-simple", wrapper.GetSimple)
+	r.With(opts.middlewares("GetWithArgs")...).Get("/get-with-args", wrapper.GetWithArgs)
+	r.With(opts.middlewares("GetWithReferences")...).Get("/get-with-references/{global_argument}/{argument}", wrapper.GetWithReferences)
+	r.With(opts.middlewares("GetWithContentType")...).Get("/get-with-type/{content_type}", wrapper.GetWithContentType)
+	r.With(opts.middlewares("GetReservedKeyword")...).Get("/reserved-keyword", wrapper.GetReservedKeyword)
+	r.With(opts.middlewares("CreateResource")...).Post("/resource/{argument}", wrapper.CreateResource)
+	r.With(opts.middlewares("CreateResource2")...).Post("/resource2/{inline_argument}", wrapper.CreateResource2)
+	r.With(opts.middlewares("UpdateResource3")...).Put("/resource3/{fallthrough}", wrapper.UpdateResource3)
+	r.With(opts.middlewares("GetResponseWithReference")...).Get("/response-with-reference", wrapper.GetResponseWithReference)
+
+}
+
+// middlewares returns the middlewares of the route of an operation.
+func (opts RegisterOptions) middlewares(operationId string) []func(http.Handler) http.Handler {
+	info := Operations[operationId]
+	middlewares := []func(http.Handler) http.Handler{
+		func(next http.Handler) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				next.ServeHTTP(w, r.WithContext(ContextWithOperationInfo(r.Context(), info)))
+			})
+		},
+	}
+	for _, tag := range info.Tags {
+		middlewares = append(middlewares, opts.TagMiddlewares[tag]...)
+	}
+	return append(middlewares, opts.OperationMiddlewares[operationId]...)
+}
+
+// OperationInfo describes an operation of the API, for the middlewares serving
+// it, which find it in the context of its requests.
+type OperationInfo struct {
+	OperationId string                     // The operation ID, as the names of the generated code use it
+	Method      string                     // The HTTP method, eg. GET
+	Path        string                     // The path template of the spec, eg. /pets/{id}
+	Tags        []string                   // The tags of the operation
+	Security    []OperationSecurity        // The security providers of the operation
+	Extensions  map[string]json.RawMessage // The x- extensions of the operation, by name
+}
+
+// OperationSecurity is a security provider of an operation, and the scopes it
+// requires.
+type OperationSecurity struct {
+	ProviderName string
+	Scopes       []string
+}
+
+// Operations holds the OperationInfo of every operation, by operation ID.
+var Operations = map[string]OperationInfo{
+	"GetEveryTypeOptional": {
+		OperationId: "GetEveryTypeOptional",
+		Method:      "GET",
+		Path:        "/every-type-optional",
+	},
+	"GetSimple": {
+		OperationId: "GetSimple",
+		Method:      "GET",
+		Path:        "/get-simple",
+	},
+	"GetWithArgs": {
+		OperationId: "GetWithArgs",
+		Method:      "GET",
+		Path:        "/get-with-args",
+	},
+	"GetWithReferences": {
+		OperationId: "GetWithReferences",
+		Method:      "GET",
+		Path:        "/get-with-references/{global_argument}/{argument}",
+	},
+	"GetWithContentType": {
+		OperationId: "GetWithContentType",
+		Method:      "GET",
+		Path:        "/get-with-type/{content_type}",
+	},
+	"GetReservedKeyword": {
+		OperationId: "GetReservedKeyword",
+		Method:      "GET",
+		Path:        "/reserved-keyword",
+	},
+	"CreateResource": {
+		OperationId: "CreateResource",
+		Method:      "POST",
+		Path:        "/resource/{argument}",
+	},
+	"CreateResource2": {
+		OperationId: "CreateResource2",
+		Method:      "POST",
+		Path:        "/resource2/{inline_argument}",
+	},
+	"UpdateResource3": {
+		OperationId: "UpdateResource3",
+		Method:      "PUT",
+		Path:        "/resource3/{fallthrough}",
+	},
+	"GetResponseWithReference": {
+		OperationId: "GetResponseWithReference",
+		Method:      "GET",
+		Path:        "/response-with-reference",
+	},
+}
+
+// operationInfoKey is the context key of the OperationInfo of a request.
+type operationInfoKey struct{}
+
+// ContextWithOperationInfo returns a copy of ctx holding info, which
+// OperationInfoFromContext returns.
+func ContextWithOperationInfo(ctx context.Context, info OperationInfo) context.Context {
+	return context.WithValue(ctx, operationInfoKey{}, info)
+}
+
+// OperationInfoFromContext returns the OperationInfo of the operation serving
+// a request, from its context, and whether there's one.
+func OperationInfoFromContext(ctx context.Context) (OperationInfo, bool) {
+	info, ok := ctx.Value(operationInfoKey{}).(OperationInfo)
+	return info, ok
+}
@@ -11,11 +11,16 @@ import (
 func TestParameters(t *testing.T) {
 	m := ServerInterfaceMock{}
 
-	m.CreateResource2Func = func(w http.ResponseWriter, r *http.Request) {
-		params := ParamsForCreateResource2(r.Context())
-		arg := r.Context().Value("inlineArgument").(int)
-
+	m.CreateResource2Func = func(w http.ResponseWriter, r *http.Request, inlineArgument int, params CreateResource2Params) {
 		assert.Equal(t, 99, *params.InlineQueryArgument)
+		assert.Equal(t, 1, inlineArgument)
+
+		// They are in the context of the request as well
+		ctxParams, ok := ParamsForCreateResource2(r.Context())
+		assert.True(t, ok)
+		assert.Equal(t, params, ctxParams)
+		arg, ok := InlineArgumentParamForCreateResource2(r.Context())
+		assert.True(t, ok)
 		assert.Equal(t, 1, arg)
 	}
 
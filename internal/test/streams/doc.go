@@ -0,0 +1,5 @@
+// Package streams checks the writers generated for the streamed responses of
+// servers.
+package streams
+
+//go:generate go run github.com/indigonote/oapi-codegen/cmd/oapi-codegen --package=streams --generate=types,chi-server -o streams.gen.go streams.yaml
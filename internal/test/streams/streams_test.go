@@ -0,0 +1,103 @@
+package streams
+
+import (
+	"bufio"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// streams serves the streams of the spec with the generated writers.
+type streams struct {
+	t            *testing.T
+	disconnected chan error
+}
+
+func (s *streams) GetEvents(w http.ResponseWriter, r *http.Request, params GetEventsParams) {
+	events := NewGetEventsWriter(w, r)
+	defer events.Close()
+	if params.Forever != nil && *params.Forever {
+		// Send until the client goes away
+		for {
+			if err := events.Send(GetEventsEvent{}); err != nil {
+				<-events.Done()
+				s.disconnected <- err
+				return
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}
+	events.Heartbeat(time.Millisecond)
+
+	assert.NoError(s.t, events.Send(GetEventsEvent{ID: "1", Data: Pet{Name: "Alex"}}))
+	time.Sleep(5 * time.Millisecond)
+	assert.NoError(s.t, events.Send(GetEventsEvent{Event: "update", Retry: time.Second, Data: Pet{Name: "Sam"}}))
+	assert.Error(s.t, events.Send(GetEventsEvent{ID: "2\n3"}))
+}
+
+func (s *streams) GetExport(w http.ResponseWriter, r *http.Request) {
+	lines := NewGetExportWriter(w, r)
+	defer lines.Close()
+	lines.Heartbeat(time.Millisecond)
+	assert.NoError(s.t, lines.Send(Pet{Name: "Alex"}))
+	time.Sleep(5 * time.Millisecond)
+	assert.NoError(s.t, lines.Send(Pet{Name: "Sam"}))
+}
+
+func TestStreamWriters(t *testing.T) {
+	s := &streams{t: t, disconnected: make(chan error, 1)}
+	ts := httptest.NewServer(Handler(s))
+	defer ts.Close()
+
+	rsp, err := http.Get(ts.URL + "/events")
+	require.NoError(t, err)
+	body, err := ioutil.ReadAll(rsp.Body)
+	rsp.Body.Close()
+	require.NoError(t, err)
+	assert.Equal(t, "text/event-stream", rsp.Header.Get("Content-Type"))
+	assert.Equal(t, "no-cache", rsp.Header.Get("Cache-Control"))
+	// The heartbeats are comments between the events
+	assert.Contains(t, string(body), ":\n\n")
+	assert.Equal(t, "id: 1\ndata: {\"name\":\"Alex\"}\n\n"+
+		"event: update\nretry: 1000\ndata: {\"name\":\"Sam\"}\n\n",
+		strings.Replace(string(body), ":\n\n", "", -1))
+
+	rsp, err = http.Get(ts.URL + "/export")
+	require.NoError(t, err)
+	body, err = ioutil.ReadAll(rsp.Body)
+	rsp.Body.Close()
+	require.NoError(t, err)
+	assert.Equal(t, "application/x-ndjson", rsp.Header.Get("Content-Type"))
+	// The heartbeats are empty lines between the items
+	var lines []string
+	for _, line := range strings.Split(string(body), "\n") {
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	assert.Equal(t, []string{`{"name":"Alex"}`, `{"name":"Sam"}`}, lines)
+
+	// The writers fail once the client went away
+	ctx, cancel := context.WithCancel(context.Background())
+	req, err := http.NewRequest(http.MethodGet, ts.URL+"/events?forever=true", nil)
+	require.NoError(t, err)
+	rsp, err = http.DefaultClient.Do(req.WithContext(ctx))
+	require.NoError(t, err)
+	_, err = bufio.NewReader(rsp.Body).ReadString('\n')
+	require.NoError(t, err)
+	cancel()
+	rsp.Body.Close()
+	select {
+	case err := <-s.disconnected:
+		assert.Error(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the handler didn't notice the client went away")
+	}
+}
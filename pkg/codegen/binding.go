@@ -0,0 +1,125 @@
+package codegen
+
+import (
+	"fmt"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// ParameterBinding describes the function generated to bind a styled
+// parameter without reflection, for parameters of primitive types and arrays
+// of them.
+type ParameterBinding struct {
+	ParameterDefinition
+	FuncName string // The name of the binding function, eg. bindListPetsQueryLimit
+	IsArray  bool   // Whether the parameter is an array of values
+	ElemType string // The Go type of the value, or of the elements of an array
+	Parse    string // The runtime function parsing a value, empty for strings
+	Convert  bool   // Whether parsed values need converting to ElemType
+}
+
+// primitiveParsers maps the Go types of primitive schemas to the runtime
+// functions parsing them.
+var primitiveParsers = map[string]string{
+	"string":             "",
+	"int":                "ParseInt",
+	"int32":              "ParseInt32",
+	"int64":              "ParseInt64",
+	"float32":            "ParseFloat32",
+	"float64":            "ParseFloat64",
+	"bool":               "ParseBool",
+	"time.Time":          "ParseTime",
+	"openapi_types.Date": "ParseDate",
+}
+
+// DescribeParameterBindings sets the bindings of the parameters of the
+// operations which can be bound without reflection, and returns them all.
+func DescribeParameterBindings(ops []OperationDefinition) ([]ParameterBinding, error) {
+	var bindings []ParameterBinding
+	for i := range ops {
+		op := &ops[i]
+		for _, params := range [][]ParameterDefinition{op.PathParams, op.QueryParams, op.HeaderParams, op.CookieParams} {
+			for j := range params {
+				pb, err := describeParameterBinding(op.OperationId, params[j], op.nullables)
+				if err != nil {
+					return nil, fmt.Errorf("error describing the binding of parameter '%s' of %s: %s",
+						params[j].ParamName, op.OperationId, err)
+				}
+				if pb == nil {
+					continue
+				}
+				params[j].Binding = pb
+				bindings = append(bindings, *pb)
+			}
+		}
+	}
+	return bindings, nil
+}
+
+// describeParameterBinding returns the binding of a parameter, or nil when it
+// is bound by reflection, as objects and parameters of other styles are.
+func describeParameterBinding(operationID string, pd ParameterDefinition, nullables *nullableTypes) (*ParameterBinding, error) {
+	if !pd.IsStyled() || pd.Spec.Schema.Value == nil {
+		return nil, nil
+	}
+	switch pd.In {
+	case "query", "cookie":
+		if pd.Style() != "form" {
+			return nil, nil
+		}
+	case "path":
+		if pd.Style() != "simple" && pd.Style() != "label" && pd.Style() != "matrix" {
+			return nil, nil
+		}
+	case "header":
+		if pd.Style() != "simple" {
+			return nil, nil
+		}
+	}
+
+	pb := &ParameterBinding{
+		ParameterDefinition: pd,
+		FuncName:            "bind" + operationID + ToCamelCase(pd.In) + pd.GoName(),
+	}
+	elem := pd.Spec.Schema
+	if elem.Value.Type == "array" {
+		pb.IsArray = true
+		elem = elem.Value.Items
+		if elem == nil || elem.Value == nil {
+			return nil, nil
+		}
+	}
+	if !isPrimitiveSchema(elem.Value) {
+		return nil, nil
+	}
+
+	elemType, err := generateGoSchema(elem, nil, nullables)
+	if err != nil {
+		return nil, err
+	}
+	underlying, err := generateGoSchema(&openapi3.SchemaRef{Value: elem.Value}, nil, nullables)
+	if err != nil {
+		return nil, err
+	}
+	parse, found := primitiveParsers[underlying.GoType]
+	if !found {
+		return nil, nil
+	}
+	pb.ElemType = elemType.TypeDecl()
+	pb.Parse = parse
+	pb.Convert = pb.ElemType != underlying.GoType
+	return pb, nil
+}
+
+// isPrimitiveSchema tells whether a schema is a single value, rather than an
+// object, an array or a combination of schemas.
+func isPrimitiveSchema(schema *openapi3.Schema) bool {
+	if schema.AllOf != nil || schema.AnyOf != nil || schema.OneOf != nil {
+		return false
+	}
+	switch schema.Type {
+	case "string", "integer", "number", "boolean":
+		return true
+	}
+	return false
+}
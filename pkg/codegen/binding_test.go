@@ -0,0 +1,67 @@
+package codegen
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDescribeParameterBindings(t *testing.T) {
+	color := openapi3.NewSchemaRef("#/components/schemas/Color", openapi3.NewStringSchema().WithEnum("red", "blue"))
+	params := openapi3.Parameters{
+		{Value: openapi3.NewPathParameter("id").WithSchema(openapi3.NewInt64Schema())},
+		{Value: openapi3.NewQueryParameter("colors").WithSchema(openapi3.NewArraySchema().WithItems(color.Value))},
+		{Value: &openapi3.Parameter{Name: "color", In: "query", Schema: color}},
+		{Value: openapi3.NewQueryParameter("filter").WithSchema(openapi3.NewObjectSchema().WithProperty("name", openapi3.NewStringSchema()))},
+		{Value: &openapi3.Parameter{Name: "deep", In: "query", Style: "deepObject", Schema: openapi3.NewStringSchema().NewRef()}},
+		{Value: openapi3.NewHeaderParameter("X-Since").WithSchema(openapi3.NewDateTimeSchema())},
+		{Value: openapi3.NewCookieParameter("raw").WithSchema(openapi3.NewBytesSchema())},
+	}
+	params[1].Value.Schema.Value.Items = color
+
+	pathParams, err := DescribeParameters(params[:1], nil)
+	require.NoError(t, err)
+	queryParams, err := DescribeParameters(params[1:5], nil)
+	require.NoError(t, err)
+	headerParams, err := DescribeParameters(params[5:6], nil)
+	require.NoError(t, err)
+	cookieParams, err := DescribeParameters(params[6:], nil)
+	require.NoError(t, err)
+	ops := []OperationDefinition{{
+		OperationId:  "ListThings",
+		PathParams:   pathParams,
+		QueryParams:  queryParams,
+		HeaderParams: headerParams,
+		CookieParams: cookieParams,
+	}}
+
+	bindings, err := DescribeParameterBindings(ops)
+	require.NoError(t, err)
+	require.Len(t, bindings, 4)
+
+	assert.Equal(t, "bindListThingsPathId", bindings[0].FuncName)
+	assert.Equal(t, "int64", bindings[0].ElemType)
+	assert.Equal(t, "ParseInt64", bindings[0].Parse)
+	assert.False(t, bindings[0].Convert)
+
+	// Referenced types are converted from the values
+	assert.Equal(t, "bindListThingsQueryColors", bindings[1].FuncName)
+	assert.True(t, bindings[1].IsArray)
+	assert.Equal(t, "Color", bindings[1].ElemType)
+	assert.Equal(t, "", bindings[1].Parse)
+	assert.True(t, bindings[1].Convert)
+
+	assert.Equal(t, "Color", bindings[2].ElemType)
+	assert.False(t, bindings[2].IsArray)
+
+	assert.Equal(t, "ParseTime", bindings[3].Parse)
+	assert.Equal(t, "simple", bindings[3].Style())
+
+	// Objects, deep objects and bytes are still bound by reflection
+	assert.NotNil(t, ops[0].QueryParams[0].Binding)
+	assert.Nil(t, ops[0].QueryParams[2].Binding)
+	assert.Nil(t, ops[0].QueryParams[3].Binding)
+	assert.Nil(t, ops[0].CookieParams[0].Binding)
+}
@@ -19,7 +19,10 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"go/ast"
 	"go/format"
+	"go/parser"
+	"go/token"
 	"regexp"
 	"sort"
 	"strings"
@@ -33,17 +36,23 @@ import (
 
 // Options defines the optional code to generate.
 type Options struct {
-	GenerateChiServer  bool              // GenerateChiServer specifies whether to generate chi server boilerplate
-	GenerateEchoServer bool              // GenerateEchoServer specifies whether to generate echo server boilerplate
-	GenerateClient     bool              // GenerateClient specifies whether to generate client boilerplate
-	GenerateTypes      bool              // GenerateTypes specifies whether to generate type definitions
-	GenerateEsTemplate bool              // GenerateEsTemplate specifies whether to generate elastic search index template
-	EmbedSpec          bool              // Whether to embed the swagger spec in the generated code
-	SkipFmt            bool              // Whether to skip go fmt on the generated code
-	SkipPrune          bool              // Whether to skip pruning unused components on the generated code
-	IncludeTags        []string          // Only include operations that have one of these tags. Ignored when empty.
-	ExcludeTags        []string          // Exclude operations that have one of these tags. Ignored when empty.
-	UserTemplates      map[string]string // Override built-in templates from user-provided files
+	GenerateChiServer   bool              // GenerateChiServer specifies whether to generate chi server boilerplate
+	GenerateEchoServer  bool              // GenerateEchoServer specifies whether to generate echo server boilerplate
+	GenerateClient      bool              // GenerateClient specifies whether to generate client boilerplate
+	GenerateClientFake  bool              // GenerateClientFake specifies whether to generate an in-memory fake of the client with responses
+	GenerateMockServer  bool              // GenerateMockServer specifies whether to generate a mock server answering with the examples of the spec
+	GenerateTypes       bool              // GenerateTypes specifies whether to generate type definitions
+	GenerateEsTemplate  bool              // GenerateEsTemplate specifies whether to generate elastic search index template
+	EsMaxRecursionDepth int               // How often a recursive schema is expanded within itself in elastic search mappings
+	EsDialect           string            // The elastic search flavour of the index templates, one of the EsDialect constants, es7 by default
+	FastParamBinding    bool              // Whether servers bind parameters of primitive types with generated code rather than by reflection
+	NullableTypes       bool              // Whether nullable properties are of generated types telling null from unspecified, rather than pointers
+	EmbedSpec           bool              // Whether to embed the swagger spec in the generated code
+	SkipFmt             bool              // Whether to skip go fmt on the generated code
+	SkipPrune           bool              // Whether to skip pruning unused components on the generated code
+	IncludeTags         []string          // Only include operations that have one of these tags. Ignored when empty.
+	ExcludeTags         []string          // Exclude operations that have one of these tags. Ignored when empty.
+	UserTemplates       map[string]string // Override built-in templates from user-provided files
 }
 
 type goImport struct {
@@ -64,22 +73,30 @@ type goImports []goImport
 var (
 	allGoImports = goImports{
 		{lookFor: "base64\\.", packageName: "encoding/base64"},
+		{lookFor: "bufio\\.", packageName: "bufio"},
 		{lookFor: "bytes\\.", packageName: "bytes"},
 		{lookFor: "chi\\.", packageName: "github.com/go-chi/chi"},
 		{lookFor: "context\\.", packageName: "context"},
 		{lookFor: "echo\\.", packageName: "github.com/labstack/echo/v4"},
 		{lookFor: "errors\\.", packageName: "github.com/pkg/errors"},
+		{lookFor: "esquery\\.", packageName: "github.com/indigonote/oapi-codegen/pkg/esquery"},
 		{lookFor: "fmt\\.", packageName: "fmt"},
 		{lookFor: "gzip\\.", packageName: "compress/gzip"},
 		{lookFor: "http\\.", packageName: "net/http"},
 		{lookFor: "io\\.", packageName: "io"},
 		{lookFor: "ioutil\\.", packageName: "io/ioutil"},
 		{lookFor: "json\\.", packageName: "encoding/json"},
+		{lookFor: "middleware\\.", packageName: "github.com/indigonote/oapi-codegen/pkg/middleware"},
 		{lookFor: "openapi3\\.", packageName: "github.com/getkin/kin-openapi/openapi3"},
-		{lookFor: "openapi_types\\.", alias: "openapi_types", packageName: "github.com/deepmap/oapi-codegen/pkg/types"},
+		{lookFor: "openapi3filter\\.", packageName: "github.com/getkin/kin-openapi/openapi3filter"},
+		{lookFor: "openapi_types\\.", alias: "openapi_types", packageName: "github.com/indigonote/oapi-codegen/pkg/types"},
 		{lookFor: "path\\.", packageName: "path"},
-		{lookFor: "runtime\\.", packageName: "github.com/deepmap/oapi-codegen/pkg/runtime"},
+		{lookFor: "rand\\.", packageName: "math/rand"},
+		{lookFor: "reflect\\.", packageName: "reflect"},
+		{lookFor: "runtime\\.", packageName: "github.com/indigonote/oapi-codegen/pkg/runtime"},
+		{lookFor: "strconv\\.", packageName: "strconv"},
 		{lookFor: "strings\\.", packageName: "strings"},
+		{lookFor: "sync\\.", packageName: "sync"},
 		{lookFor: "time\\.Duration", packageName: "time"},
 		{lookFor: "time\\.Time", packageName: "time"},
 		{lookFor: "url\\.", packageName: "net/url"},
@@ -92,6 +109,8 @@ var (
 // the descriptions we've built up above from the schema objects.
 // opts defines
 func Generate(swagger *openapi3.Swagger, packageName string, opts Options) (string, string, error) {
+	nullables := newNullableTypes(opts.NullableTypes)
+
 	filterOperationsByTag(swagger, opts)
 	if !opts.SkipPrune {
 		pruneUnusedComponents(swagger)
@@ -116,25 +135,37 @@ func Generate(swagger *openapi3.Swagger, packageName string, opts Options) (stri
 		}
 	}
 
-	ops, err := OperationDefinitions(swagger)
+	ops, err := operationDefinitions(swagger, nullables)
 	if err != nil {
 		return "", "", errors.Wrap(err, "error creating operation definitions")
 	}
 
 	var typeDefinitions string
 	if opts.GenerateTypes {
-		typeDefinitions, err = GenerateTypeDefinitions(t, swagger, ops)
+		typeDefinitions, err = generateTypeDefinitions(t, swagger, ops, nullables)
 		if err != nil {
 			return "", "", errors.Wrap(err, "error generating type definitions")
 		}
 	}
 
-	var indicesDefitions string
+	var indicesDefitions, esFieldDefinitions string
 	if opts.GenerateEsTemplate {
-		indicesDefitions, err = GenerateEsTemplateDefinitions(t, swagger, ops)
+		indicesDefitions, err = GenerateEsTemplateDefinitions(t, swagger, ops, opts)
 		if err != nil {
 			return "", "", errors.Wrap(err, "error generating elastic search index template definitions")
 		}
+		esFieldDefinitions, err = GenerateEsFieldDefinitions(t, swagger, opts)
+		if err != nil {
+			return "", "", errors.Wrap(err, "error generating elastic search field definitions")
+		}
+	}
+
+	var paramBindersOut string
+	if opts.FastParamBinding && (opts.GenerateEchoServer || opts.GenerateChiServer) {
+		paramBindersOut, err = GenerateParamBinders(t, ops)
+		if err != nil {
+			return "", "", errors.Wrap(err, "error generating parameter binders")
+		}
 	}
 
 	var echoServerOut string
@@ -153,12 +184,55 @@ func Generate(swagger *openapi3.Swagger, packageName string, opts Options) (stri
 		}
 	}
 
+	var operationInfoOut string
+	if opts.GenerateEchoServer || opts.GenerateChiServer {
+		operationInfoOut, err = GenerateOperationInfo(t, ops)
+		if err != nil {
+			return "", "", errors.Wrap(err, "error generating operation info")
+		}
+	}
+
+	var serverStreamsOut string
+	if opts.GenerateEchoServer || opts.GenerateChiServer {
+		serverStreamsOut, err = GenerateServerStreams(t, ops)
+		if err != nil {
+			return "", "", errors.Wrap(err, "error generating server streams")
+		}
+	}
+
+	var mockServerOut string
+	if opts.GenerateMockServer {
+		mockServerOut, err = GenerateMockServer(t, ops)
+		if err != nil {
+			return "", "", errors.Wrap(err, "error generating mock server")
+		}
+	}
+
 	var clientOut string
 	if opts.GenerateClient {
 		clientOut, err = GenerateClient(t, ops)
 		if err != nil {
 			return "", "", errors.Wrap(err, "error generating client")
 		}
+
+		servers, err := DescribeServers(swagger.Servers)
+		if err != nil {
+			return "", "", errors.Wrap(err, "error describing servers")
+		}
+		serversOut, err := GenerateClientServers(t, servers, ops)
+		if err != nil {
+			return "", "", errors.Wrap(err, "error generating client servers")
+		}
+		clientOut += serversOut
+
+		// Requests can only be validated against the spec when it is embedded.
+		if opts.EmbedSpec {
+			validationOut, err := GenerateClientValidation(t)
+			if err != nil {
+				return "", "", errors.Wrap(err, "error generating client validation")
+			}
+			clientOut += validationOut
+		}
 	}
 
 	var clientWithResponsesOut string
@@ -169,6 +243,14 @@ func Generate(swagger *openapi3.Swagger, packageName string, opts Options) (stri
 		}
 	}
 
+	var clientFakeOut string
+	if opts.GenerateClientFake {
+		clientFakeOut, err = GenerateClientFake(t, ops)
+		if err != nil {
+			return "", "", errors.Wrap(err, "error generating client fake")
+		}
+	}
+
 	var inlinedSpec string
 	if opts.EmbedSpec {
 		inlinedSpec, err = GenerateInlinedSpec(t, swagger)
@@ -185,7 +267,7 @@ func Generate(swagger *openapi3.Swagger, packageName string, opts Options) (stri
 	i := bufio.NewWriter(&es)
 
 	// Based on module prefixes, figure out which optional imports are required.
-	for _, str := range []string{typeDefinitions, chiServerOut, echoServerOut, clientOut, clientWithResponsesOut, inlinedSpec} {
+	for _, str := range []string{typeDefinitions, esFieldDefinitions, chiServerOut, echoServerOut, paramBindersOut, operationInfoOut, serverStreamsOut, mockServerOut, clientOut, clientWithResponsesOut, clientFakeOut, inlinedSpec} {
 		for _, goImport := range allGoImports {
 			match, err := regexp.MatchString(fmt.Sprintf("[^a-zA-Z0-9_]%s", goImport.lookFor), str)
 			if err != nil {
@@ -213,6 +295,13 @@ func Generate(swagger *openapi3.Swagger, packageName string, opts Options) (stri
 
 	}
 
+	if opts.GenerateEsTemplate {
+		_, err = w.WriteString(esFieldDefinitions)
+		if err != nil {
+			return "", "", errors.Wrap(err, "error writing elastic search field definitions")
+		}
+	}
+
 	if opts.GenerateClient {
 		_, err = w.WriteString(clientOut)
 		if err != nil {
@@ -224,6 +313,11 @@ func Generate(swagger *openapi3.Swagger, packageName string, opts Options) (stri
 		}
 	}
 
+	_, err = w.WriteString(clientFakeOut)
+	if err != nil {
+		return "", "", errors.Wrap(err, "error writing client fake")
+	}
+
 	if opts.GenerateEchoServer {
 		_, err = w.WriteString(echoServerOut)
 		if err != nil {
@@ -238,6 +332,26 @@ func Generate(swagger *openapi3.Swagger, packageName string, opts Options) (stri
 		}
 	}
 
+	_, err = w.WriteString(operationInfoOut)
+	if err != nil {
+		return "", "", errors.Wrap(err, "error writing operation info")
+	}
+
+	_, err = w.WriteString(paramBindersOut)
+	if err != nil {
+		return "", "", errors.Wrap(err, "error writing parameter binders")
+	}
+
+	_, err = w.WriteString(serverStreamsOut)
+	if err != nil {
+		return "", "", errors.Wrap(err, "error writing server streams")
+	}
+
+	_, err = w.WriteString(mockServerOut)
+	if err != nil {
+		return "", "", errors.Wrap(err, "error writing mock server")
+	}
+
 	if opts.EmbedSpec {
 		_, err = w.WriteString(inlinedSpec)
 		if err != nil {
@@ -264,6 +378,9 @@ func Generate(swagger *openapi3.Swagger, packageName string, opts Options) (stri
 	// remove any byte-order-marks which break Go-Code
 	goCode := SanitizeCode(buf.String())
 	esCode := SanitizeCode(es.String())
+	if err := checkDeclarations(goCode); err != nil {
+		return "", "", err
+	}
 	// The generation code produces unindented horrors. Use the Go formatter
 	// to make it all pretty.
 	if opts.SkipFmt {
@@ -279,11 +396,14 @@ func Generate(swagger *openapi3.Swagger, packageName string, opts Options) (stri
 	// if we have data like "a": {"type": "nested", "type": "text"}
 	// this is incorrect format data. json.Unmarshal will do parse data to "a": {"type": "text"}
 	if esCode != "" {
-		var temp interface{}
+		var temp map[string]interface{}
 		if err = json.Unmarshal([]byte(esCode), &temp); err != nil {
 			fmt.Println(esCode)
 			return "", "", errors.Wrap(err, "error encoding Es template")
 		}
+		if err = ApplyEsDialect(opts.EsDialect, temp); err != nil {
+			return "", "", errors.Wrap(err, "error adapting Es template")
+		}
 		outEs, err := json.MarshalIndent(temp, "", "    ")
 		if err != nil {
 			fmt.Println(temp)
@@ -295,25 +415,29 @@ func Generate(swagger *openapi3.Swagger, packageName string, opts Options) (stri
 }
 
 func GenerateTypeDefinitions(t *template.Template, swagger *openapi3.Swagger, ops []OperationDefinition) (string, error) {
+	return generateTypeDefinitions(t, swagger, ops, newNullableTypes(false))
+}
+
+func generateTypeDefinitions(t *template.Template, swagger *openapi3.Swagger, ops []OperationDefinition, nullables *nullableTypes) (string, error) {
 
-	schemaTypes, err := GenerateTypesForSchemas(t, swagger.Components.Schemas)
+	schemaTypes, err := generateTypesForSchemas(t, swagger.Components.Schemas, nullables)
 	if err != nil {
 		return "", errors.Wrap(err, "error generating Go types for component schemas")
 	}
 
-	paramTypes, err := GenerateTypesForParameters(t, swagger.Components.Parameters)
+	paramTypes, err := generateTypesForParameters(t, swagger.Components.Parameters, nullables)
 	if err != nil {
 		return "", errors.Wrap(err, "error generating Go types for component parameters")
 	}
 	allTypes := append(schemaTypes, paramTypes...)
 
-	responseTypes, err := GenerateTypesForResponses(t, swagger.Components.Responses)
+	responseTypes, err := generateTypesForResponses(t, swagger.Components.Responses, nullables)
 	if err != nil {
 		return "", errors.Wrap(err, "error generating Go types for component responses")
 	}
 	allTypes = append(allTypes, responseTypes...)
 
-	bodyTypes, err := GenerateTypesForRequestBodies(t, swagger.Components.RequestBodies)
+	bodyTypes, err := generateTypesForRequestBodies(t, swagger.Components.RequestBodies, nullables)
 	if err != nil {
 		return "", errors.Wrap(err, "error generating Go types for component request bodies")
 	}
@@ -329,7 +453,7 @@ func GenerateTypeDefinitions(t *template.Template, swagger *openapi3.Swagger, op
 		}
 		schemaRef := openapi3.NewSchemaRef("", schema)
 		schemaName := schema.Title
-		goSchema, err := GenerateGoSchema(schemaRef, []string{schemaName})
+		goSchema, err := generateGoSchema(schemaRef, []string{schemaName}, nullables)
 		if err != nil {
 			return "", errors.Wrap(err, fmt.Sprintf("error converting Schema %s to Go type", schemaName))
 		}
@@ -358,14 +482,28 @@ func GenerateTypeDefinitions(t *template.Template, swagger *openapi3.Swagger, op
 		return "", errors.Wrap(err, "error generating allOf boilerplate")
 	}
 
-	typeDefinitions := strings.Join([]string{typesOut, paramTypesOut, allOfBoilerplate}, "")
+	patchTypesOut, err := GeneratePatchTypes(t, ops)
+	if err != nil {
+		return "", errors.Wrap(err, "error generating patch types")
+	}
+	if err := recordPatchNullableTypes(nullables, ops); err != nil {
+		return "", err
+	}
+
+	// The nullable types of all the schemas are known once they're generated.
+	nullableTypesOut, err := GenerateNullableTypes(t, nullables.sorted())
+	if err != nil {
+		return "", errors.Wrap(err, "error generating nullable types")
+	}
+
+	typeDefinitions := strings.Join([]string{typesOut, paramTypesOut, allOfBoilerplate, patchTypesOut, nullableTypesOut}, "")
 	return typeDefinitions, nil
 }
 
 // GenerateEsTemplateDefinitions do generate definition for es template
-func GenerateEsTemplateDefinitions(t *template.Template, swagger *openapi3.Swagger, ops []OperationDefinition) (string, error) {
+func GenerateEsTemplateDefinitions(t *template.Template, swagger *openapi3.Swagger, ops []OperationDefinition, opts Options) (string, error) {
 	// get all esType of component which has name start with `fhir-`
-	esTemplate, err := GenerateEsTemplateForSchemas(t, swagger.Components.Schemas)
+	esTemplate, err := GenerateEsTemplateForSchemas(t, swagger.Components.Schemas, opts)
 	if err != nil {
 		return "", errors.Wrap(err, "error generating ES index template for component schemas")
 	}
@@ -379,12 +517,16 @@ func GenerateEsTemplateDefinitions(t *template.Template, swagger *openapi3.Swagg
 // Generates type definitions for any custom types defined in the
 // components/schemas section of the Swagger spec.
 func GenerateTypesForSchemas(t *template.Template, schemas map[string]*openapi3.SchemaRef) ([]TypeDefinition, error) {
+	return generateTypesForSchemas(t, schemas, nil)
+}
+
+func generateTypesForSchemas(t *template.Template, schemas map[string]*openapi3.SchemaRef, nullables *nullableTypes) ([]TypeDefinition, error) {
 	types := make([]TypeDefinition, 0)
 	// We're going to define Go types for every object under components/schemas
 	for _, schemaName := range SortedSchemaKeys(schemas) {
 		schemaRef := schemas[schemaName]
 
-		goSchema, err := GenerateGoSchema(schemaRef, []string{schemaName})
+		goSchema, err := generateGoSchema(schemaRef, []string{schemaName}, nullables)
 		if err != nil {
 			return nil, errors.Wrap(err, fmt.Sprintf("error converting Schema %s to Go type", schemaName))
 		}
@@ -402,7 +544,7 @@ func GenerateTypesForSchemas(t *template.Template, schemas map[string]*openapi3.
 
 // Generates type definitions for any custom types defined in the
 // components/schemas section of the Swagger spec.
-func GenerateEsTemplateForSchemas(t *template.Template, schemas map[string]*openapi3.SchemaRef) ([]TypeDefinition, error) {
+func GenerateEsTemplateForSchemas(t *template.Template, schemas map[string]*openapi3.SchemaRef, opts Options) ([]TypeDefinition, error) {
 	types := make([]TypeDefinition, 0)
 	// We're going to define Go types for every object under components/schemas
 	for _, schemaName := range SortedSchemaKeys(schemas) {
@@ -410,10 +552,17 @@ func GenerateEsTemplateForSchemas(t *template.Template, schemas map[string]*open
 		if !isExistEsTag(schemaRef) {
 			continue
 		}
-		goSchema, err := GenerateEsSchema(schemaRef, []string{schemaName})
+		state := EsState{
+			MaxDepth:         opts.EsMaxRecursionDepth,
+			DynamicTemplates: &[]string{},
+			Processors:       &[]EsProcessor{},
+		}
+		goSchema, err := GenerateEsSchema(schemaRef, []string{schemaName}, state.Push(schemaRef.Value))
 		if err != nil {
 			return nil, errors.Wrap(err, fmt.Sprintf("error converting Schema %s to Go type", schemaName))
 		}
+		goSchema.EsDynamicTemplates = *state.DynamicTemplates
+		goSchema.EsProcessors = *state.Processors
 
 		types = append(types, TypeDefinition{
 			JsonName: schemaName,
@@ -466,11 +615,15 @@ func getXTags(schema *openapi3.Schema) []string {
 // Generates type definitions for any custom types defined in the
 // components/parameters section of the Swagger spec.
 func GenerateTypesForParameters(t *template.Template, params map[string]*openapi3.ParameterRef) ([]TypeDefinition, error) {
+	return generateTypesForParameters(t, params, nil)
+}
+
+func generateTypesForParameters(t *template.Template, params map[string]*openapi3.ParameterRef, nullables *nullableTypes) ([]TypeDefinition, error) {
 	var types []TypeDefinition
 	for _, paramName := range SortedParameterKeys(params) {
 		paramOrRef := params[paramName]
 
-		goType, err := paramToGoType(paramOrRef.Value, nil)
+		goType, err := paramToGoType(paramOrRef.Value, nil, nullables)
 		if err != nil {
 			return nil, errors.Wrap(err, fmt.Sprintf("error generating Go type for schema in parameter %s", paramName))
 		}
@@ -498,6 +651,10 @@ func GenerateTypesForParameters(t *template.Template, params map[string]*openapi
 // Generates type definitions for any custom types defined in the
 // components/responses section of the Swagger spec.
 func GenerateTypesForResponses(t *template.Template, responses openapi3.Responses) ([]TypeDefinition, error) {
+	return generateTypesForResponses(t, responses, nil)
+}
+
+func generateTypesForResponses(t *template.Template, responses openapi3.Responses, nullables *nullableTypes) ([]TypeDefinition, error) {
 	var types []TypeDefinition
 
 	for _, responseName := range SortedResponsesKeys(responses) {
@@ -509,7 +666,7 @@ func GenerateTypesForResponses(t *template.Template, responses openapi3.Response
 		response := responseOrRef.Value
 		jsonResponse, found := response.Content["application/json"]
 		if found {
-			goType, err := GenerateGoSchema(jsonResponse.Schema, []string{responseName})
+			goType, err := generateGoSchema(jsonResponse.Schema, []string{responseName}, nullables)
 			if err != nil {
 				return nil, errors.Wrap(err, fmt.Sprintf("error generating Go type for schema in response %s", responseName))
 			}
@@ -537,6 +694,10 @@ func GenerateTypesForResponses(t *template.Template, responses openapi3.Response
 // Generates type definitions for any custom types defined in the
 // components/requestBodies section of the Swagger spec.
 func GenerateTypesForRequestBodies(t *template.Template, bodies map[string]*openapi3.RequestBodyRef) ([]TypeDefinition, error) {
+	return generateTypesForRequestBodies(t, bodies, nil)
+}
+
+func generateTypesForRequestBodies(t *template.Template, bodies map[string]*openapi3.RequestBodyRef, nullables *nullableTypes) ([]TypeDefinition, error) {
 	var types []TypeDefinition
 
 	for _, bodyName := range SortedRequestBodyKeys(bodies) {
@@ -547,7 +708,7 @@ func GenerateTypesForRequestBodies(t *template.Template, bodies map[string]*open
 		response := bodyOrRef.Value
 		jsonBody, found := response.Content["application/json"]
 		if found {
-			goType, err := GenerateGoSchema(jsonBody.Schema, []string{bodyName})
+			goType, err := generateGoSchema(jsonBody.Schema, []string{bodyName}, nullables)
 			if err != nil {
 				return nil, errors.Wrap(err, fmt.Sprintf("error generating Go type for schema in body %s", bodyName))
 			}
@@ -609,8 +770,15 @@ func GenerateEsTemplate(t *template.Template, types []TypeDefinition) (string, e
 		Types []TypeDefinition
 	}
 	for _, v := range context.Types {
+		var mappings []string
+		if len(v.Schema.EsDynamicTemplates) != 0 {
+			mappings = append(mappings, fmt.Sprintf(`"dynamic_templates": [%s]`, strings.Join(v.Schema.EsDynamicTemplates, ",")))
+		}
 		if v.Schema.EsTemplateDecl() != "" {
-			v.Schema.EsTemplate = fmt.Sprintf(`{ "mappings": {%s}}`, v.Schema.EsTemplate)
+			mappings = append(mappings, v.Schema.EsTemplateDecl())
+		}
+		if len(mappings) != 0 {
+			v.Schema.EsTemplate = fmt.Sprintf(`{ "mappings": {%s}}`, strings.Join(mappings, ","))
 			newContext.Types = append(newContext.Types, v)
 		}
 	}
@@ -686,3 +854,52 @@ func SanitizeCode(goCode string) string {
 	// See: https://groups.google.com/forum/#!topic/golang-nuts/OToNIPdfkks
 	return strings.Replace(goCode, "\uFEFF", "", -1)
 }
+
+// checkDeclarations returns an error naming the first identifier which the
+// generated code declares twice at package level, such as a component of the
+// spec named as a type of the generated client, eg. APIError. Code which doesn't
+// parse is left to the formatter to report.
+func checkDeclarations(goCode string) error {
+	file, err := parser.ParseFile(token.NewFileSet(), "", goCode, 0)
+	if err != nil {
+		return nil
+	}
+	declared := make(map[string]bool)
+	declare := func(name string) error {
+		if name == "_" || name == "init" {
+			return nil
+		}
+		if declared[name] {
+			return fmt.Errorf("%s is declared more than once in the generated code, rename the component of the spec which takes the name of generated code", name)
+		}
+		declared[name] = true
+		return nil
+	}
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			if decl.Recv != nil {
+				continue
+			}
+			if err := declare(decl.Name.Name); err != nil {
+				return err
+			}
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				switch spec := spec.(type) {
+				case *ast.TypeSpec:
+					if err := declare(spec.Name.Name); err != nil {
+						return err
+					}
+				case *ast.ValueSpec:
+					for _, name := range spec.Names {
+						if err := declare(name.Name); err != nil {
+							return err
+						}
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
@@ -5,11 +5,13 @@ import (
 	"go/format"
 	"io/ioutil"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/golangci/lint-1"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	examplePetstoreClient "github.com/indigonote/oapi-codegen/examples/petstore-expanded"
 	examplePetstore "github.com/indigonote/oapi-codegen/examples/petstore-expanded/echo/api"
 )
@@ -44,7 +46,7 @@ func TestExamplePetStoreCodeGeneration(t *testing.T) {
 	assert.Contains(t, code, "package api")
 
 	// Check that the client method signatures return response structs:
-	assert.Contains(t, code, "func (c *Client) FindPetById(ctx context.Context, id int64) (*http.Response, error) {")
+	assert.Contains(t, code, "func (c *Client) FindPetById(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {")
 
 	// Check that the property comments were generated
 	assert.Contains(t, code, "// Unique id of the pet")
@@ -163,8 +165,8 @@ type GetTestByNameResponse struct {
 	// Check the client method signatures:
 	assert.Contains(t, code, "type GetTestByNameParams struct {")
 	assert.Contains(t, code, "Top *int `json:\"$top,omitempty\"`")
-	assert.Contains(t, code, "func (c *Client) GetTestByName(ctx context.Context, name string, params *GetTestByNameParams) (*http.Response, error) {")
-	assert.Contains(t, code, "func (c *ClientWithResponses) GetTestByNameWithResponse(ctx context.Context, name string, params *GetTestByNameParams) (*GetTestByNameResponse, error) {")
+	assert.Contains(t, code, "func (c *Client) GetTestByName(ctx context.Context, name string, params *GetTestByNameParams, reqEditors ...RequestEditorFn) (*http.Response, error) {")
+	assert.Contains(t, code, "func (c *ClientWithResponses) GetTestByNameWithResponse(ctx context.Context, name string, params *GetTestByNameParams, reqEditors ...RequestEditorFn) (*GetTestByNameResponse, error) {")
 
 	// Make sure the generated code is valid:
 	linter := new(lint.Linter)
@@ -173,6 +175,94 @@ type GetTestByNameResponse struct {
 	assert.Len(t, problems, 0)
 }
 
+func TestGeneratedNamesLeaveSchemaNamesFree(t *testing.T) {
+	swagger, err := openapi3.NewSwaggerLoader().LoadSwaggerFromData([]byte(`
+openapi: 3.0.1
+info:
+  title: Names
+  version: 1.0.0
+paths:
+  /routes:
+    get:
+      operationId: listRoutes
+      responses:
+        '200':
+          description: routes
+          content:
+            application/json:
+              schema:
+                $ref: "#/components/schemas/Routes"
+components:
+  schemas:
+    Route:
+      type: object
+      properties:
+        path:
+          type: string
+    Routes:
+      type: array
+      items:
+        $ref: "#/components/schemas/Route"
+`))
+	require.NoError(t, err)
+
+	code, _, err := Generate(swagger, "names", Options{
+		GenerateClient: true,
+		GenerateTypes:  true,
+	})
+	require.NoError(t, err)
+
+	// The generated helpers must not declare the names of the schemas again
+	assert.Equal(t, 1, strings.Count(code, "type Route struct"))
+	assert.Equal(t, 1, strings.Count(code, "type Routes []Route"))
+	assert.Contains(t, code, "var OperationRoutes = []OperationRoute{")
+	_, err = format.Source([]byte(code))
+	assert.NoError(t, err)
+}
+
+func TestGenerateDeclaredTwice(t *testing.T) {
+	swagger, err := openapi3.NewSwaggerLoader().LoadSwaggerFromData([]byte(`
+openapi: 3.0.1
+info:
+  title: Names
+  version: 1.0.0
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      responses:
+        '200':
+          description: pets
+        default:
+          description: error
+          content:
+            application/json:
+              schema:
+                $ref: "#/components/schemas/APIError"
+components:
+  schemas:
+    APIError:
+      type: object
+      properties:
+        message:
+          type: string
+`))
+	require.NoError(t, err)
+
+	// The types alone leave the name to the schema
+	_, _, err = Generate(swagger, "names", Options{GenerateTypes: true})
+	require.NoError(t, err)
+
+	// The client declares it again, which fails clearly rather than with
+	// code which doesn't compile
+	_, _, err = Generate(swagger, "names", Options{
+		GenerateClient: true,
+		GenerateTypes:  true,
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "APIError is declared more than once")
+}
+
 const testOpenAPIDefinition = `
 openapi: 3.0.1
 
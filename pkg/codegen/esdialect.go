@@ -0,0 +1,162 @@
+package codegen
+
+import (
+	"fmt"
+)
+
+// The elastic search flavours which we can generate index templates for,
+// see Options.EsDialect.
+const (
+	EsDialect6           = "es6"
+	EsDialect7           = "es7"
+	EsDialect8           = "es8"
+	EsDialectOpenSearch2 = "opensearch2"
+)
+
+// esRemovedParams lists the mapping parameters which a dialect no longer
+// accepts, so that we drop them rather than have the template rejected.
+var esRemovedParams = map[string][]string{
+	EsDialect6:           nil,
+	EsDialect7:           {"_all", "include_in_all"},
+	EsDialect8:           {"_all", "include_in_all", "boost"},
+	EsDialectOpenSearch2: {"_all", "include_in_all", "boost"},
+}
+
+// ApplyEsDialect adapts the index templates generated by
+// GenerateEsTemplateDefinitions, keyed by type name, to the given dialect. An
+// empty dialect is the same as EsDialect7, which is what we generate to begin
+// with.
+func ApplyEsDialect(dialect string, templates map[string]interface{}) error {
+	if dialect == "" {
+		dialect = EsDialect7
+	}
+	if _, found := esRemovedParams[dialect]; !found {
+		return fmt.Errorf("unknown elastic search dialect: %s", dialect)
+	}
+
+	for _, typeName := range SortedInterfaceKeys(templates) {
+		template, ok := templates[typeName].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		mappings, ok := template["mappings"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if err := applyEsDialectToMappings(dialect, mappings); err != nil {
+			return fmt.Errorf("error applying dialect %s to %s: %s", dialect, typeName, err)
+		}
+
+		switch dialect {
+		case EsDialect6:
+			// ES 6 indices still have a single mapping type, which is
+			// named after the type of the documents.
+			templates[typeName] = map[string]interface{}{
+				"mappings": map[string]interface{}{"_doc": mappings},
+			}
+		case EsDialect8, EsDialectOpenSearch2:
+			// Composable index templates, the legacy ones are deprecated.
+			templates[typeName] = map[string]interface{}{
+				"template": map[string]interface{}{"mappings": mappings},
+			}
+		}
+	}
+	return nil
+}
+
+func applyEsDialectToMappings(dialect string, mappings map[string]interface{}) error {
+	for _, p := range esRemovedParams[dialect] {
+		delete(mappings, p)
+	}
+	applyEsDialectToDynamic(dialect, mappings)
+	if err := applyEsDialectToProperties(dialect, mappings); err != nil {
+		return err
+	}
+	dynamicTemplates, _ := mappings["dynamic_templates"].([]interface{})
+	for _, t := range dynamicTemplates {
+		named, _ := t.(map[string]interface{})
+		for _, name := range SortedInterfaceKeys(named) {
+			dynamicTemplate, _ := named[name].(map[string]interface{})
+			mapping, ok := dynamicTemplate["mapping"].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if err := applyEsDialectToField(dialect, mapping); err != nil {
+				return fmt.Errorf("dynamic template %s: %s", name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// applyEsDialectToProperties adapts the fields and multi-fields of a mapping.
+func applyEsDialectToProperties(dialect string, mapping map[string]interface{}) error {
+	for _, key := range []string{"properties", "fields"} {
+		fields, _ := mapping[key].(map[string]interface{})
+		for _, name := range SortedInterfaceKeys(fields) {
+			field, ok := fields[name].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if err := applyEsDialectToField(dialect, field); err != nil {
+				return fmt.Errorf("%s: %s", name, err)
+			}
+		}
+	}
+	return nil
+}
+
+func applyEsDialectToField(dialect string, field map[string]interface{}) error {
+	for _, p := range esRemovedParams[dialect] {
+		delete(field, p)
+	}
+	applyEsDialectToDynamic(dialect, field)
+
+	switch field["type"] {
+	case "flattened", "flat_object":
+		switch dialect {
+		case EsDialect6:
+			// There is no flattened type yet, the best we can do is to keep
+			// the object in the source without indexing it.
+			field["type"] = "object"
+			field["enabled"] = false
+		case EsDialectOpenSearch2:
+			field["type"] = "flat_object"
+		default:
+			field["type"] = "flattened"
+		}
+	case "dense_vector", "knn_vector":
+		switch dialect {
+		case EsDialect6:
+			return fmt.Errorf("vector fields aren't supported by %s", dialect)
+		case EsDialectOpenSearch2:
+			field["type"] = "knn_vector"
+			renameEsParam(field, "dims", "dimension")
+		default:
+			field["type"] = "dense_vector"
+			renameEsParam(field, "dimension", "dims")
+		}
+	}
+	return applyEsDialectToProperties(dialect, field)
+}
+
+// applyEsDialectToDynamic adapts the dynamic parameter of a mapping or an
+// object field. Runtime fields came with ES 7.11, so where there are none,
+// unmapped fields are kept in the source without being indexed, as runtime
+// fields would have been.
+func applyEsDialectToDynamic(dialect string, mapping map[string]interface{}) {
+	if mapping["dynamic"] != "runtime" {
+		return
+	}
+	switch dialect {
+	case EsDialect6, EsDialectOpenSearch2:
+		mapping["dynamic"] = false
+	}
+}
+
+func renameEsParam(field map[string]interface{}, from, to string) {
+	if v, found := field[from]; found {
+		delete(field, from)
+		field[to] = v
+	}
+}
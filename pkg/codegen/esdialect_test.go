@@ -0,0 +1,202 @@
+package codegen
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const esDialectTestFixture = `{
+	"FhirPatient": {
+		"mappings": {
+			"_all": {"enabled": false},
+			"dynamic_templates": [
+				{"labels.*": {"path_match": "labels.*", "mapping": {"type": "flattened"}}}
+			],
+			"properties": {
+				"name": {
+					"type": "text",
+					"boost": 2,
+					"include_in_all": false,
+					"fields": {"keyword": {"type": "keyword", "ignore_above": 256}}
+				},
+				"extension": {
+					"type": "nested",
+					"properties": {"value": {"type": "flattened"}}
+				},
+				"embedding": {"type": "dense_vector", "dims": 3}
+			}
+		}
+	}
+}`
+
+func TestApplyEsDialect(t *testing.T) {
+	tests := []struct {
+		dialect  string
+		expected string
+	}{
+		{"", `{
+			"FhirPatient": {
+				"mappings": {
+					"dynamic_templates": [
+						{"labels.*": {"path_match": "labels.*", "mapping": {"type": "flattened"}}}
+					],
+					"properties": {
+						"name": {
+							"type": "text",
+							"boost": 2,
+							"fields": {"keyword": {"type": "keyword", "ignore_above": 256}}
+						},
+						"extension": {
+							"type": "nested",
+							"properties": {"value": {"type": "flattened"}}
+						},
+						"embedding": {"type": "dense_vector", "dims": 3}
+					}
+				}
+			}
+		}`},
+		{EsDialect8, `{
+			"FhirPatient": {
+				"template": {
+					"mappings": {
+						"dynamic_templates": [
+							{"labels.*": {"path_match": "labels.*", "mapping": {"type": "flattened"}}}
+						],
+						"properties": {
+							"name": {
+								"type": "text",
+								"fields": {"keyword": {"type": "keyword", "ignore_above": 256}}
+							},
+							"extension": {
+								"type": "nested",
+								"properties": {"value": {"type": "flattened"}}
+							},
+							"embedding": {"type": "dense_vector", "dims": 3}
+						}
+					}
+				}
+			}
+		}`},
+		{EsDialectOpenSearch2, `{
+			"FhirPatient": {
+				"template": {
+					"mappings": {
+						"dynamic_templates": [
+							{"labels.*": {"path_match": "labels.*", "mapping": {"type": "flat_object"}}}
+						],
+						"properties": {
+							"name": {
+								"type": "text",
+								"fields": {"keyword": {"type": "keyword", "ignore_above": 256}}
+							},
+							"extension": {
+								"type": "nested",
+								"properties": {"value": {"type": "flat_object"}}
+							},
+							"embedding": {"type": "knn_vector", "dimension": 3}
+						}
+					}
+				}
+			}
+		}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.dialect, func(t *testing.T) {
+			var templates map[string]interface{}
+			require.NoError(t, json.Unmarshal([]byte(esDialectTestFixture), &templates))
+
+			err := ApplyEsDialect(tt.dialect, templates)
+			require.NoError(t, err)
+
+			out, err := json.Marshal(templates)
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.expected, string(out))
+		})
+	}
+}
+
+func TestApplyEsDialect6(t *testing.T) {
+	var templates map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(esDialectTestFixture), &templates))
+
+	// ES 6 has no vector fields at all
+	err := ApplyEsDialect(EsDialect6, templates)
+	assert.Error(t, err)
+
+	templates = map[string]interface{}{}
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"FhirPatient": {
+			"mappings": {
+				"_all": {"enabled": false},
+				"properties": {
+					"name": {"type": "text", "include_in_all": false},
+					"labels": {"type": "flattened"}
+				}
+			}
+		}
+	}`), &templates))
+	err = ApplyEsDialect(EsDialect6, templates)
+	require.NoError(t, err)
+
+	out, err := json.Marshal(templates)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"FhirPatient": {
+			"mappings": {
+				"_doc": {
+					"_all": {"enabled": false},
+					"properties": {
+						"name": {"type": "text", "include_in_all": false},
+						"labels": {"type": "object", "enabled": false}
+					}
+				}
+			}
+		}
+	}`, string(out))
+}
+
+func TestApplyEsDialectDynamic(t *testing.T) {
+	for dialect, expected := range map[string]interface{}{
+		EsDialect6:           false,
+		EsDialect7:           "runtime",
+		EsDialect8:           "runtime",
+		EsDialectOpenSearch2: false,
+	} {
+		var templates map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(`{
+			"FhirPatient": {
+				"mappings": {
+					"dynamic": "runtime",
+					"properties": {
+						"meta": {"type": "object", "dynamic": "runtime"},
+						"contact": {"type": "object", "dynamic": "strict"}
+					}
+				}
+			}
+		}`), &templates))
+		require.NoError(t, ApplyEsDialect(dialect, templates), dialect)
+
+		var mappings map[string]interface{}
+		switch dialect {
+		case EsDialect6:
+			mappings = templates["FhirPatient"].(map[string]interface{})["mappings"].(map[string]interface{})["_doc"].(map[string]interface{})
+		case EsDialect7:
+			mappings = templates["FhirPatient"].(map[string]interface{})["mappings"].(map[string]interface{})
+		default:
+			mappings = templates["FhirPatient"].(map[string]interface{})["template"].(map[string]interface{})["mappings"].(map[string]interface{})
+		}
+		properties := mappings["properties"].(map[string]interface{})
+		assert.Equal(t, expected, mappings["dynamic"], dialect)
+		assert.Equal(t, expected, properties["meta"].(map[string]interface{})["dynamic"], dialect)
+		assert.Equal(t, "strict", properties["contact"].(map[string]interface{})["dynamic"], dialect)
+	}
+}
+
+func TestApplyEsDialectUnknown(t *testing.T) {
+	err := ApplyEsDialect("solr", map[string]interface{}{})
+	assert.Error(t, err)
+}
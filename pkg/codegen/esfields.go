@@ -0,0 +1,149 @@
+package codegen
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/pkg/errors"
+)
+
+// EsField describes one indexed field path of an elastic search mapping.
+type EsField struct {
+	Path   string // The dotted field path, eg. name.family
+	GoName string // The Go name of the path, eg. NameFamily
+	GoType string // The esquery type for the field, eg. TextField
+}
+
+// EsFieldsDefinition holds all indexed field paths of one elastic search
+// mapping.
+type EsFieldsDefinition struct {
+	TypeName string
+	Fields   []EsField
+}
+
+// esFieldTypes maps the elastic search field types onto the typed fields of
+// the esquery package. Anything which isn't listed here becomes an untyped
+// esquery.Field.
+var esFieldTypes = map[string]string{
+	"keyword":          "KeywordField",
+	"constant_keyword": "KeywordField",
+	"wildcard":         "KeywordField",
+	"text":             "TextField",
+	"match_only_text":  "TextField",
+	"long":             "LongField",
+	"integer":          "LongField",
+	"short":            "LongField",
+	"byte":             "LongField",
+	"double":           "DoubleField",
+	"float":            "DoubleField",
+	"half_float":       "DoubleField",
+	"scaled_float":     "DoubleField",
+	"date":             "DateField",
+	"date_nanos":       "DateField",
+	"boolean":          "BooleanField",
+	"nested":           "NestedPath",
+}
+
+// GenerateEsFieldDefinitions generates the field path constants for every
+// schema tagged `elastic`, so that Go code can refer to the indexed fields of
+// a mapping without spelling out their paths.
+func GenerateEsFieldDefinitions(t *template.Template, swagger *openapi3.Swagger, opts Options) (string, error) {
+	esTypes, err := GenerateEsTemplateForSchemas(t, swagger.Components.Schemas, opts)
+	if err != nil {
+		return "", errors.Wrap(err, "error generating ES index template for component schemas")
+	}
+
+	var defs []EsFieldsDefinition
+	for _, td := range esTypes {
+		if td.Schema.EsTemplateDecl() == "" {
+			continue
+		}
+		fields, err := EsFieldsFromMapping(td.Schema.EsTemplateDecl())
+		if err != nil {
+			return "", errors.Wrap(err, fmt.Sprintf("error generating ES field paths for %s", td.TypeName))
+		}
+		defs = append(defs, EsFieldsDefinition{
+			TypeName: td.TypeName,
+			Fields:   fields,
+		})
+	}
+
+	var buf bytes.Buffer
+	w := bufio.NewWriter(&buf)
+	err = t.ExecuteTemplate(w, "esfields.tmpl", defs)
+	if err != nil {
+		return "", errors.Wrap(err, "error generating ES field paths")
+	}
+	err = w.Flush()
+	if err != nil {
+		return "", errors.Wrap(err, "error flushing output buffer for ES field paths")
+	}
+	return buf.String(), nil
+}
+
+// EsFieldsFromMapping lists the indexed field paths of a mapping, in the
+// `"properties": {...}` form produced by GenEsTemplateFromSchema. Fields which
+// are mapped as plain objects aren't queryable by themselves, so only their
+// children are listed, while nested fields are listed as a NestedPath, as
+// queries on their children must be wrapped into a nested query.
+func EsFieldsFromMapping(mapping string) ([]EsField, error) {
+	var m map[string]interface{}
+	if err := json.Unmarshal([]byte("{"+mapping+"}"), &m); err != nil {
+		return nil, errors.Wrap(err, "error decoding ES mapping")
+	}
+
+	var fields []EsField
+	collectEsFields(m, nil, &fields)
+
+	// Different paths may end up with the same Go name, eg. name.family and
+	// nameFamily, which we can't tell apart in the generated code.
+	seen := map[string]string{}
+	for _, f := range fields {
+		if other, found := seen[f.GoName]; found {
+			return nil, fmt.Errorf("ES field paths '%s' and '%s' both map to %s", other, f.Path, f.GoName)
+		}
+		seen[f.GoName] = f.Path
+	}
+	return fields, nil
+}
+
+func collectEsFields(mapping map[string]interface{}, path []string, fields *[]EsField) {
+	properties, _ := mapping["properties"].(map[string]interface{})
+	for _, name := range SortedInterfaceKeys(properties) {
+		field, ok := properties[name].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		fieldPath := append(append([]string{}, path...), name)
+		esType, _ := field["type"].(string)
+		if esType != "" && esType != "object" {
+			*fields = append(*fields, newEsField(fieldPath, esType))
+		}
+		// Multi-fields, such as the keyword we add to every text field.
+		subFields, _ := field["fields"].(map[string]interface{})
+		for _, subName := range SortedInterfaceKeys(subFields) {
+			subField, _ := subFields[subName].(map[string]interface{})
+			if subType, _ := subField["type"].(string); subType != "" {
+				*fields = append(*fields, newEsField(append(fieldPath, subName), subType))
+			}
+		}
+		collectEsFields(field, fieldPath, fields)
+	}
+}
+
+func newEsField(path []string, esType string) EsField {
+	goType, found := esFieldTypes[esType]
+	if !found {
+		goType = "Field"
+	}
+	return EsField{
+		Path:   strings.Join(path, "."),
+		GoName: SchemaNameToTypeName(strings.Join(path, ".")),
+		GoType: goType,
+	}
+}
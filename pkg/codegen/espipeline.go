@@ -0,0 +1,170 @@
+package codegen
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/pkg/errors"
+)
+
+// EsProcessor is an ingest processor for one property of an elastic search
+// mapping, as declared by its `x-es-pipeline` extension.
+type EsProcessor struct {
+	Path      string                 // The dotted field path of the property, eg. identifier.value
+	Processor map[string]interface{} // The processor, with its field filled in
+}
+
+// GenerateEsPipelines generates an ingest pipeline for every schema tagged
+// `elastic` which has properties with an `x-es-pipeline` extension. The
+// pipelines are returned as a JSON object keyed by the type name, like the
+// index templates, with the processors ordered by their property path. It
+// expects the swagger spec as left by Generate, so that both see the same
+// schemas.
+func GenerateEsPipelines(swagger *openapi3.Swagger, opts Options) (string, error) {
+	esTypes, err := GenerateEsTemplateForSchemas(nil, swagger.Components.Schemas, opts)
+	if err != nil {
+		return "", errors.Wrap(err, "error generating ES index template for component schemas")
+	}
+
+	pipelines := map[string]interface{}{}
+	for _, td := range esTypes {
+		if len(td.Schema.EsProcessors) == 0 {
+			continue
+		}
+		esProcessors := append([]EsProcessor{}, td.Schema.EsProcessors...)
+		sort.SliceStable(esProcessors, func(i, j int) bool {
+			return esProcessors[i].Path < esProcessors[j].Path
+		})
+		processors := make([]interface{}, len(esProcessors))
+		for i, p := range esProcessors {
+			processors[i] = p.Processor
+		}
+		pipelines[td.TypeName] = map[string]interface{}{
+			"description": fmt.Sprintf("Ingest pipeline for %s", td.JsonName),
+			"processors":  processors,
+		}
+	}
+	if len(pipelines) == 0 {
+		return "", nil
+	}
+
+	out, err := json.MarshalIndent(pipelines, "", "    ")
+	if err != nil {
+		return "", errors.Wrap(err, "error formatting ES ingest pipelines")
+	}
+	return string(out), nil
+}
+
+// addEsProcessors adds the processors of the `x-es-pipeline` extension of the
+// property at path. Processors are written in the ingest pipeline syntax,
+// without their field, either as the name of the processor or as an object
+// holding its options:
+//
+//	x-es-pipeline:
+//	  - lowercase
+//	  - set:
+//	      value: active
+//	      override: false
+func addEsProcessors(schema *openapi3.Schema, path []string, state EsState) error {
+	if state.Processors == nil || schema == nil {
+		return nil
+	}
+	processors, err := parseEsPipeline(schema)
+	if err != nil {
+		return err
+	}
+	if len(processors) == 0 {
+		return nil
+	}
+
+	// The first element of the path is the name of the mapping itself.
+	fieldPath := append([]string{}, path[1:]...)
+	for _, p := range fieldPath {
+		if p == "*" {
+			return errors.New("x-es-pipeline isn't supported below additionalProperties")
+		}
+	}
+	var lists []int
+	for _, l := range state.Lists {
+		lists = append(lists, l-1)
+	}
+
+	for _, p := range processors {
+		*state.Processors = append(*state.Processors, EsProcessor{
+			Path:      strings.Join(fieldPath, "."),
+			Processor: esProcessorForPath(fieldPath, lists, "", p),
+		})
+	}
+	return nil
+}
+
+// esProcessorForPath sets the field of processor to path. Processors can't
+// refer to the fields of objects within an array, so when the path goes
+// through one of the arrays in lists, we run the processor on each of its
+// elements with a foreach processor.
+func esProcessorForPath(path []string, lists []int, prefix string, processor map[string]interface{}) map[string]interface{} {
+	for i, l := range lists {
+		if l <= 0 || l >= len(path) {
+			continue
+		}
+		var inner []int
+		for _, other := range lists[i+1:] {
+			inner = append(inner, other-l)
+		}
+		return map[string]interface{}{
+			"foreach": map[string]interface{}{
+				"field":     prefix + strings.Join(path[:l], "."),
+				"processor": esProcessorForPath(path[l:], inner, "_ingest._value.", processor),
+			},
+		}
+	}
+
+	out := map[string]interface{}{}
+	for name, options := range processor {
+		o := map[string]interface{}{}
+		for k, v := range options.(map[string]interface{}) {
+			o[k] = v
+		}
+		o["field"] = prefix + strings.Join(path, ".")
+		out[name] = o
+	}
+	return out
+}
+
+func parseEsPipeline(schema *openapi3.Schema) ([]map[string]interface{}, error) {
+	ext, found := schema.Extensions["x-es-pipeline"]
+	if !found {
+		return nil, nil
+	}
+	var v []interface{}
+	if err := json.Unmarshal(ext.(json.RawMessage), &v); err != nil {
+		return nil, errors.Wrap(err, "error decoding x-es-pipeline")
+	}
+
+	var processors []map[string]interface{}
+	for _, p := range v {
+		switch p := p.(type) {
+		case string:
+			processors = append(processors, map[string]interface{}{p: map[string]interface{}{}})
+		case map[string]interface{}:
+			if len(p) != 1 {
+				return nil, fmt.Errorf("x-es-pipeline processors must have exactly one name, got %d", len(p))
+			}
+			for name, options := range p {
+				if options == nil {
+					options = map[string]interface{}{}
+				}
+				if _, ok := options.(map[string]interface{}); !ok {
+					return nil, fmt.Errorf("invalid options for x-es-pipeline processor %s", name)
+				}
+				processors = append(processors, map[string]interface{}{name: options})
+			}
+		default:
+			return nil, fmt.Errorf("invalid x-es-pipeline processor: %v", p)
+		}
+	}
+	return processors, nil
+}
@@ -0,0 +1,62 @@
+package codegen
+
+import (
+	"strings"
+)
+
+// FakeOperationDefinition is an operation of the fake client, along with the
+// responses which its stubs can return.
+type FakeOperationDefinition struct {
+	OperationDefinition
+	Responses []FakeResponseDefinition
+}
+
+// FakeResponseDefinition describes a typed response which a stub of the fake
+// client can return.
+type FakeResponseDefinition struct {
+	Method      string // The name of the stub method returning it, eg. Return200
+	Field       string // The field of the response holding the payload, eg. JSON200
+	Type        string // The Go type of the payload
+	StatusCode  int    // The status code of the response, 0 for the default response
+	ContentType string // The media type of the response
+	Marshal     string // The function encoding the payload, eg. json.Marshal
+}
+
+// DescribeFakeResponses returns the typed responses of an operation, which
+// are those of the ClientWithResponses.
+func DescribeFakeResponses(op *OperationDefinition) ([]FakeResponseDefinition, error) {
+	tds, err := op.GetResponseTypeDefinitions()
+	if err != nil {
+		return nil, err
+	}
+
+	var responses []FakeResponseDefinition
+	seen := map[string]bool{}
+	for _, td := range tds {
+		if seen[td.TypeName] {
+			continue
+		}
+		seen[td.TypeName] = true
+
+		statusCode, err := responseStatusCode(td.ResponseName)
+		if err != nil {
+			return nil, err
+		}
+		response := FakeResponseDefinition{
+			Method:     "Return" + strings.TrimPrefix(td.TypeName, "JSON"),
+			Field:      td.TypeName,
+			Type:       td.Schema.TypeDecl(),
+			StatusCode: statusCode,
+		}
+		switch {
+		case strings.HasPrefix(td.TypeName, "YAML"):
+			response.ContentType, response.Marshal = contentTypesYAML[0], "yaml.Marshal"
+		case strings.HasPrefix(td.TypeName, "XML"):
+			response.ContentType, response.Marshal = contentTypesXML[0], "xml.Marshal"
+		default:
+			response.ContentType, response.Marshal = contentTypesJSON[0], "json.Marshal"
+		}
+		responses = append(responses, response)
+	}
+	return responses, nil
+}
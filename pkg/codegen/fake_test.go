@@ -0,0 +1,31 @@
+package codegen
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDescribeFakeResponses(t *testing.T) {
+	description := "a response"
+	op := &OperationDefinition{OperationId: "GetPet", Spec: &openapi3.Operation{Responses: openapi3.Responses{
+		"200": {Value: &openapi3.Response{Description: &description, Content: openapi3.Content{
+			"application/json": openapi3.NewMediaType().WithSchema(openapi3.NewStringSchema()),
+			"application/xml":  openapi3.NewMediaType().WithSchema(openapi3.NewStringSchema()),
+		}}},
+		"204": {Value: &openapi3.Response{Description: &description}},
+		"default": {Value: &openapi3.Response{Description: &description, Content: openapi3.Content{
+			"application/json": openapi3.NewMediaType().WithSchema(openapi3.NewIntegerSchema()),
+		}}},
+	}}}
+	responses, err := DescribeFakeResponses(op)
+	require.NoError(t, err)
+
+	assert.Equal(t, []FakeResponseDefinition{
+		{Method: "Return200", Field: "JSON200", Type: "string", StatusCode: 200, ContentType: "application/json", Marshal: "json.Marshal"},
+		{Method: "ReturnXML200", Field: "XML200", Type: "string", StatusCode: 200, ContentType: "application/xml", Marshal: "xml.Marshal"},
+		{Method: "ReturnDefault", Field: "JSONDefault", Type: "int", StatusCode: 0, ContentType: "application/json", Marshal: "json.Marshal"},
+	}, responses)
+}
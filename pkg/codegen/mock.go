@@ -0,0 +1,282 @@
+package codegen
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/pkg/errors"
+)
+
+// MockOperationDefinition is an operation answered by the mock server, along
+// with the responses it answers with.
+type MockOperationDefinition struct {
+	OperationDefinition
+	Responses []MockResponseDefinition
+}
+
+// MockResponseDefinition describes a response of an operation, for one of its
+// media types.
+type MockResponseDefinition struct {
+	StatusCode  int    // The status code of the response, 0 for the default response
+	ContentType string // The media type of the response, empty when it has no body
+	Examples    []MockExampleDefinition
+}
+
+// MockExampleDefinition is a body which the mock server can answer with.
+type MockExampleDefinition struct {
+	Name string // The name of the example, empty for the example of the media type, or a value made up from the schema
+	Body string
+}
+
+// DescribeMockResponses returns the responses of an operation, successes
+// first, and the default response last. Each response has the examples given
+// in the spec, or else one made up from its schema.
+func DescribeMockResponses(op *OperationDefinition) ([]MockResponseDefinition, error) {
+	keys := SortedResponsesKeys(op.Spec.Responses)
+	sort.SliceStable(keys, func(i, j int) bool {
+		return mockResponseRank(keys[i]) < mockResponseRank(keys[j])
+	})
+
+	var responses []MockResponseDefinition
+	for _, key := range keys {
+		responseRef := op.Spec.Responses[key]
+		if responseRef.Value == nil {
+			continue
+		}
+		statusCode, err := responseStatusCode(key)
+		if err != nil {
+			return nil, err
+		}
+		if len(responseRef.Value.Content) == 0 {
+			responses = append(responses, MockResponseDefinition{StatusCode: statusCode})
+			continue
+		}
+
+		contentTypes := SortedContentKeys(responseRef.Value.Content)
+		// JSON is preferred when the request doesn't tell
+		sort.SliceStable(contentTypes, func(i, j int) bool {
+			return isJSONMediaType(contentTypes[i]) && !isJSONMediaType(contentTypes[j])
+		})
+		for _, contentType := range contentTypes {
+			examples, err := mockExamples(contentType, responseRef.Value.Content[contentType])
+			if err != nil {
+				return nil, errors.Wrap(err, fmt.Sprintf("error generating examples of the %s response", key))
+			}
+			responses = append(responses, MockResponseDefinition{
+				StatusCode:  statusCode,
+				ContentType: contentType,
+				Examples:    examples,
+			})
+		}
+	}
+	return responses, nil
+}
+
+// mockResponseRank orders successes before the other responses, and the
+// default response last.
+func mockResponseRank(key string) int {
+	switch {
+	case key == "default":
+		return 2
+	case isSuccessStatus(key), strings.EqualFold(key, "2XX"):
+		return 0
+	default:
+		return 1
+	}
+}
+
+// responseStatusCode returns the status code of a response, the first of its
+// range for ranges such as 2XX.
+func responseStatusCode(key string) (int, error) {
+	if key == "default" {
+		return 0, nil
+	}
+	if len(key) == 3 && strings.ToUpper(key[1:]) == "XX" {
+		key = key[:1] + "00"
+	}
+	statusCode, err := strconv.Atoi(key)
+	if err != nil {
+		return 0, fmt.Errorf("invalid response status code %s", key)
+	}
+	return statusCode, nil
+}
+
+func mockExamples(contentType string, mediaType *openapi3.MediaType) ([]MockExampleDefinition, error) {
+	var examples []MockExampleDefinition
+	add := func(name string, value interface{}) error {
+		body, err := mockBody(contentType, value)
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("error encoding example %s", name))
+		}
+		examples = append(examples, MockExampleDefinition{Name: name, Body: body})
+		return nil
+	}
+
+	if mediaType.Example != nil {
+		if err := add("", mediaType.Example); err != nil {
+			return nil, err
+		}
+	}
+	names := make([]string, 0, len(mediaType.Examples))
+	for name := range mediaType.Examples {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		example := mediaType.Examples[name]
+		if example == nil || example.Value == nil {
+			continue
+		}
+		if err := add(name, example.Value.Value); err != nil {
+			return nil, err
+		}
+	}
+	if len(examples) > 0 || mediaType.Schema == nil {
+		return examples, nil
+	}
+
+	if err := add("", MockValue(mediaType.Schema)); err != nil {
+		return nil, err
+	}
+	return examples, nil
+}
+
+// mockBody encodes the value of an example. Strings are sent as is, unless the
+// media type is JSON.
+func mockBody(contentType string, value interface{}) (string, error) {
+	if s, ok := value.(string); ok && !isJSONMediaType(contentType) {
+		return s, nil
+	}
+	buf, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(buf), nil
+}
+
+func isJSONMediaType(contentType string) bool {
+	return contentType == "application/json" || strings.HasSuffix(contentType, "+json")
+}
+
+// MockValue makes up a value conforming to a schema, preferring the examples,
+// defaults and enums which it gives.
+func MockValue(sref *openapi3.SchemaRef) interface{} {
+	return mockValue(sref, map[string]bool{})
+}
+
+// mockValue makes up a value for a schema, seen holds the references which
+// are being expanded, so that recursive schemas end.
+func mockValue(sref *openapi3.SchemaRef, seen map[string]bool) interface{} {
+	if sref == nil || sref.Value == nil {
+		return nil
+	}
+	if sref.Ref != "" {
+		if seen[sref.Ref] {
+			return nil
+		}
+		seen[sref.Ref] = true
+		defer delete(seen, sref.Ref)
+	}
+
+	schema := sref.Value
+	switch {
+	case schema.Example != nil:
+		return schema.Example
+	case schema.Default != nil:
+		return schema.Default
+	case len(schema.Enum) > 0:
+		return schema.Enum[0]
+	case len(schema.AllOf) > 0:
+		merged := map[string]interface{}{}
+		for _, s := range schema.AllOf {
+			if object, ok := mockValue(s, seen).(map[string]interface{}); ok {
+				for name, value := range object {
+					merged[name] = value
+				}
+			}
+		}
+		for name, value := range mockProperties(schema, seen) {
+			merged[name] = value
+		}
+		return merged
+	case len(schema.OneOf) > 0:
+		return mockValue(schema.OneOf[0], seen)
+	case len(schema.AnyOf) > 0:
+		return mockValue(schema.AnyOf[0], seen)
+	}
+
+	switch schema.Type {
+	case "array":
+		item := mockValue(schema.Items, seen)
+		if item == nil {
+			return []interface{}{}
+		}
+		return []interface{}{item}
+	case "string":
+		return mockString(schema)
+	case "integer":
+		if schema.Min != nil {
+			return int64(*schema.Min)
+		}
+		return 0
+	case "number":
+		if schema.Min != nil {
+			return *schema.Min
+		}
+		return 0.0
+	case "boolean":
+		return true
+	case "object", "":
+		if schema.Type == "" && len(schema.Properties) == 0 {
+			return nil
+		}
+		return mockProperties(schema, seen)
+	}
+	return nil
+}
+
+// mockProperties makes up the properties of an object, leaving out those
+// which can't appear in responses.
+func mockProperties(schema *openapi3.Schema, seen map[string]bool) map[string]interface{} {
+	object := map[string]interface{}{}
+	for name, property := range schema.Properties {
+		if property.Value != nil && property.Value.WriteOnly {
+			continue
+		}
+		if value := mockValue(property, seen); value != nil {
+			object[name] = value
+		}
+	}
+	return object
+}
+
+func mockString(schema *openapi3.Schema) string {
+	var s string
+	switch schema.Format {
+	case "date":
+		s = "2020-01-01"
+	case "date-time":
+		s = "2020-01-01T00:00:00Z"
+	case "email":
+		s = "user@example.com"
+	case "uuid":
+		s = "00000000-0000-0000-0000-000000000000"
+	case "uri", "url":
+		s = "https://example.com"
+	case "byte", "binary":
+		s = ""
+	default:
+		s = "string"
+	}
+	for uint64(len(s)) < schema.MinLength {
+		s += "s"
+	}
+	if schema.MaxLength != nil && uint64(len(s)) > *schema.MaxLength {
+		s = s[:*schema.MaxLength]
+	}
+	return s
+}
@@ -0,0 +1,56 @@
+package codegen
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMockValue(t *testing.T) {
+	maxLength := uint64(3)
+	node := openapi3.NewObjectSchema().
+		WithProperty("kind", openapi3.NewStringSchema().WithEnum("leaf", "branch")).
+		WithProperty("code", &openapi3.Schema{Type: "string", MaxLength: &maxLength}).
+		WithProperty("padded", openapi3.NewStringSchema().WithMinLength(8)).
+		WithProperty("size", openapi3.NewIntegerSchema().WithMin(5)).
+		WithProperty("secret", &openapi3.Schema{Type: "string", WriteOnly: true})
+	nodeRef := openapi3.NewSchemaRef("#/components/schemas/Node", node)
+	node.WithPropertyRef("parent", nodeRef)
+	node.WithProperty("children", &openapi3.Schema{Type: "array", Items: nodeRef})
+
+	assert.Equal(t, map[string]interface{}{
+		"kind":     "leaf",
+		"code":     "str",
+		"padded":   "stringss",
+		"size":     int64(5),
+		"children": []interface{}{},
+	}, MockValue(nodeRef))
+
+	// Examples are preferred
+	assert.Equal(t, "Tom", MockValue(openapi3.NewSchemaRef("", &openapi3.Schema{Type: "string", Example: "Tom"})))
+}
+
+func TestDescribeMockResponses(t *testing.T) {
+	description := "a response"
+	op := &OperationDefinition{Spec: &openapi3.Operation{Responses: openapi3.Responses{
+		"default": {Value: &openapi3.Response{Description: &description}},
+		"404":     {Value: &openapi3.Response{Description: &description}},
+		"2XX": {Value: &openapi3.Response{Description: &description, Content: openapi3.Content{
+			"text/plain":       openapi3.NewMediaType().WithSchema(openapi3.NewStringSchema()),
+			"application/json": openapi3.NewMediaType().WithExample("b", 2).WithExample("a", 1),
+		}}},
+	}}}
+	responses, err := DescribeMockResponses(op)
+	require.NoError(t, err)
+
+	// Successes come first, JSON first among them, and the default response
+	// last
+	assert.Equal(t, []MockResponseDefinition{
+		{StatusCode: 200, ContentType: "application/json", Examples: []MockExampleDefinition{{Name: "a", Body: "1"}, {Name: "b", Body: "2"}}},
+		{StatusCode: 200, ContentType: "text/plain", Examples: []MockExampleDefinition{{Body: "string"}}},
+		{StatusCode: 404},
+		{StatusCode: 0},
+	}, responses)
+}
@@ -0,0 +1,134 @@
+package codegen
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/pkg/errors"
+)
+
+// NullableType describes a type generated for the nullable properties of a Go
+// type, which tells an unspecified property from a null one.
+type NullableType struct {
+	TypeName string // The name of the nullable type, eg. NullableString
+	GoType   string // The Go type of its value, eg. string
+}
+
+// nullableTypes holds the nullable types needed by the schemas generated by a
+// call to Generate, by name, and whether its nullable properties are of them.
+// Schemas are generated from many places, which are all handed the one of
+// their call. A nil one records nothing, and leaves nullable properties
+// pointers.
+type nullableTypes struct {
+	properties bool
+	types      map[string]NullableType
+}
+
+func newNullableTypes(properties bool) *nullableTypes {
+	return &nullableTypes{
+		properties: properties,
+		types:      make(map[string]NullableType),
+	}
+}
+
+// nullableBaseNames maps the Go types of primitive schemas to the base of the
+// names of their nullable types.
+var nullableBaseNames = map[string]string{
+	"[]byte":             "Bytes",
+	"openapi_types.Date": "Date",
+	"time.Time":          "Time",
+}
+
+var goIdentifierRE = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// nullableBaseName returns the base of the name of the nullable type of a Go
+// type, or "" when it has no name to derive it from, such as an inline struct.
+func nullableBaseName(goType string) string {
+	if name, found := nullableBaseNames[goType]; found {
+		return name
+	}
+	if strings.HasPrefix(goType, "[]") {
+		elem := nullableBaseName(strings.TrimPrefix(goType, "[]"))
+		if elem == "" {
+			return ""
+		}
+		return elem + "Array"
+	}
+	if !goIdentifierRE.MatchString(goType) {
+		return ""
+	}
+	return UppercaseFirstCharacter(goType)
+}
+
+// describe returns the name of the nullable type of a nullable
+// property of the given schema, and records it to be generated. It returns ""
+// when nullable types aren't generated, or the schema has none, in which case
+// the property is a pointer as usual.
+func (n *nullableTypes) describe(schema Schema) (string, error) {
+	if n == nil || !n.properties || schema.SkipOptionalPointer {
+		return "", nil
+	}
+	return n.record(schema.TypeDecl())
+}
+
+// record returns the name of the nullable type of a Go type, and
+// records it to be generated. It returns "" when the type has none.
+func (n *nullableTypes) record(goType string) (string, error) {
+	base := nullableBaseName(goType)
+	if base == "" {
+		return "", nil
+	}
+
+	nt := NullableType{
+		TypeName: "Nullable" + base,
+		GoType:   goType,
+	}
+	if n == nil {
+		return nt.TypeName, nil
+	}
+	if existing, found := n.types[nt.TypeName]; found && existing.GoType != goType {
+		return "", fmt.Errorf("nullable type %s is needed for both %s and %s", nt.TypeName, existing.GoType, goType)
+	}
+	n.types[nt.TypeName] = nt
+	return nt.TypeName, nil
+}
+
+// sorted returns the nullable types recorded, sorted by name.
+func (n *nullableTypes) sorted() []NullableType {
+	if n == nil {
+		return nil
+	}
+	var types []NullableType
+	for _, nt := range n.types {
+		types = append(types, nt)
+	}
+	sort.Slice(types, func(i, j int) bool {
+		return types[i].TypeName < types[j].TypeName
+	})
+	return types
+}
+
+// GenerateNullableTypes generates the given nullable types.
+func GenerateNullableTypes(t *template.Template, types []NullableType) (string, error) {
+	if len(types) == 0 {
+		return "", nil
+	}
+
+	var buf bytes.Buffer
+	w := bufio.NewWriter(&buf)
+
+	err := t.ExecuteTemplate(w, "nullable.tmpl", types)
+	if err != nil {
+		return "", errors.Wrap(err, "error generating nullable types")
+	}
+	err = w.Flush()
+	if err != nil {
+		return "", errors.Wrap(err, "error flushing output buffer for nullable types")
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,112 @@
+package codegen
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNullableBaseName(t *testing.T) {
+	for goType, expected := range map[string]string{
+		"string":                  "String",
+		"int32":                   "Int32",
+		"openapi_types.Date":      "Date",
+		"time.Time":               "Time",
+		"[]byte":                  "Bytes",
+		"Pet":                     "Pet",
+		"[]Pet":                   "PetArray",
+		"[][]string":              "StringArrayArray",
+		"interface{}":             "",
+		"map[string]interface{}":  "",
+		"struct {\n Name string}": "",
+	} {
+		assert.Equal(t, expected, nullableBaseName(goType), goType)
+	}
+}
+
+func TestGenerateNullableProperties(t *testing.T) {
+	schema := openapi3.NewObjectSchema().
+		WithProperty("name", openapi3.NewStringSchema().WithNullable()).
+		WithProperty("age", openapi3.NewInt32Schema()).
+		WithProperty("raw", &openapi3.Schema{Type: "string", Format: "json", Nullable: true}).
+		WithProperty("inline", openapi3.NewObjectSchema().WithNullable().WithProperty("a", openapi3.NewStringSchema()))
+	schema.Properties["pet"] = &openapi3.SchemaRef{Ref: "#/components/schemas/Pet", Value: openapi3.NewObjectSchema().WithNullable()}
+	schema.Required = []string{"name"}
+	ref := openapi3.NewSchemaRef("", schema)
+
+	// Without the option, nullable properties are pointers
+	goSchema, err := GenerateGoSchema(ref, []string{"Thing"})
+	require.NoError(t, err)
+	assert.Contains(t, goSchema.GoType, "Name *string `json:\"name\"`")
+
+	nullables := newNullableTypes(true)
+	goSchema, err = generateGoSchema(ref, []string{"Thing"}, nullables)
+	require.NoError(t, err)
+	assert.Contains(t, goSchema.GoType, "Name NullableString `json:\"name\"`")
+	assert.Contains(t, goSchema.GoType, "Pet NullablePet `json:\"pet,omitempty\"`")
+	assert.Contains(t, goSchema.GoType, "Age *int32 `json:\"age,omitempty\"`")
+	// Types without a name, or which tell null apart already, are as usual
+	assert.Contains(t, goSchema.GoType, "Raw json.RawMessage")
+	assert.Contains(t, goSchema.GoType, "Inline *struct")
+	assert.Equal(t, []NullableType{
+		{TypeName: "NullablePet", GoType: "Pet"},
+		{TypeName: "NullableString", GoType: "string"},
+	}, nullables.sorted())
+
+	// Types with the same name can't share a nullable type
+	clash := openapi3.NewObjectSchema()
+	clash.Properties = map[string]*openapi3.SchemaRef{
+		"pet": {Ref: "#/components/schemas/String", Value: openapi3.NewObjectSchema().WithNullable()},
+	}
+	_, err = generateGoSchema(openapi3.NewSchemaRef("", clash), []string{"Clash"}, nullables)
+	assert.Error(t, err)
+}
+
+func TestGenerateNullableTypesConcurrently(t *testing.T) {
+	spec := `
+openapi: 3.0.1
+info:
+  title: Nullable
+  version: 1.0.0
+paths: {}
+components:
+  schemas:
+    Thing:
+      type: object
+      properties:
+        name:
+          type: string
+          nullable: true
+`
+	generate := func(nullableTypes bool) string {
+		swagger, err := openapi3.NewSwaggerLoader().LoadSwaggerFromData([]byte(spec))
+		require.NoError(t, err)
+		code, _, err := Generate(swagger, "nullable", Options{GenerateTypes: true, NullableTypes: nullableTypes, SkipPrune: true})
+		require.NoError(t, err)
+		return code
+	}
+
+	// Calls with and without nullable types don't see each other's
+	var wg sync.WaitGroup
+	codes := make([]string, 8)
+	for i := range codes {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			codes[i] = generate(i%2 == 0)
+		}(i)
+	}
+	wg.Wait()
+	for i, code := range codes {
+		if i%2 == 0 {
+			assert.Contains(t, code, "type NullableString map[bool]string")
+			assert.Contains(t, code, "Name NullableString")
+		} else {
+			assert.NotContains(t, code, "NullableString")
+			assert.Contains(t, code, "Name *string")
+		}
+	}
+}
@@ -16,7 +16,9 @@ package codegen
 import (
 	"bufio"
 	"bytes"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"strings"
 	"text/template"
 	"unicode"
@@ -31,6 +33,7 @@ type ParameterDefinition struct {
 	Required  bool   // Is this a required parameter?
 	Spec      *openapi3.Parameter
 	Schema    Schema
+	Binding   *ParameterBinding // How servers bind it without reflection, if they do
 }
 
 // This function is here as an adapter after a large refactoring so that I don't
@@ -142,11 +145,15 @@ func (p ParameterDefinitions) FindByName(name string) *ParameterDefinition {
 // descriptors into a flat list. This makes it a lot easier to traverse the
 // data in the template engine.
 func DescribeParameters(params openapi3.Parameters, path []string) ([]ParameterDefinition, error) {
+	return describeParameters(params, path, nil)
+}
+
+func describeParameters(params openapi3.Parameters, path []string, nullables *nullableTypes) ([]ParameterDefinition, error) {
 	outParams := make([]ParameterDefinition, 0)
 	for _, paramOrRef := range params {
 		param := paramOrRef.Value
 
-		goType, err := paramToGoType(param, append(path, param.Name))
+		goType, err := paramToGoType(param, append(path, param.Name), nullables)
 		if err != nil {
 			return nil, fmt.Errorf("error generating type for param (%s): %s",
 				param.Name, err)
@@ -208,7 +215,12 @@ type OperationDefinition struct {
 	Summary             string                  // Summary string from Swagger, used to generate a comment
 	Method              string                  // GET, POST, DELETE, etc.
 	Path                string                  // The Swagger path for the operation, like /resource/{id}
+	Pagination          *PaginationDefinition   // How to page through the results, from x-pagination
+	Servers             []ServerDefinition      // The servers overriding those of the spec for this operation
+	Stream              *StreamDefinition       // The success response which the client reads as a stream, if any
 	Spec                *openapi3.Operation
+
+	nullables *nullableTypes // Where the schemas of the operation record their nullable types
 }
 
 // Returns the list of all parameters except Path parameters. Path parameters
@@ -241,6 +253,45 @@ func (o *OperationDefinition) HasBody() bool {
 	return o.Spec.RequestBody != nil
 }
 
+// This is called by the template engine to determine whether the client may
+// retry the operation. Operations are idempotent when their method is, unless
+// they override it with the `x-idempotent` extension, eg. for a POST which
+// carries an idempotency key.
+func (o *OperationDefinition) IsIdempotent() (bool, error) {
+	if ext, found := o.Spec.Extensions["x-idempotent"]; found {
+		var idempotent bool
+		if err := json.Unmarshal(ext.(json.RawMessage), &idempotent); err != nil {
+			return false, errors.Wrap(err, fmt.Sprintf("error decoding x-idempotent of %s", o.OperationId))
+		}
+		return idempotent, nil
+	}
+	switch o.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace, http.MethodPut, http.MethodDelete:
+		return true, nil
+	}
+	return false, nil
+}
+
+// Extensions returns the x- extensions of the operation, as JSON by name.
+func (o *OperationDefinition) Extensions() (map[string]string, error) {
+	if len(o.Spec.Extensions) == 0 {
+		return nil, nil
+	}
+	extensions := make(map[string]string)
+	for name, ext := range o.Spec.Extensions {
+		if raw, ok := ext.(json.RawMessage); ok {
+			extensions[name] = string(raw)
+			continue
+		}
+		buf, err := json.Marshal(ext)
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("error encoding %s of %s", name, o.OperationId))
+		}
+		extensions[name] = string(buf)
+	}
+	return extensions, nil
+}
+
 // This returns the Operations summary as a multi line comment
 func (o *OperationDefinition) SummaryAsComment() string {
 	if o.Summary == "" {
@@ -273,7 +324,7 @@ func (o *OperationDefinition) GetResponseTypeDefinitions() ([]TypeDefinition, er
 				contentType := responseRef.Value.Content[contentTypeName]
 				// We can only generate a type if we have a schema:
 				if contentType.Schema != nil {
-					responseSchema, err := GenerateGoSchema(contentType.Schema, []string{responseName})
+					responseSchema, err := generateGoSchema(contentType.Schema, []string{responseName}, o.nullables)
 					if err != nil {
 						return nil, errors.Wrap(err, fmt.Sprintf("Unable to determine Go type for %s.%s", o.OperationId, contentTypeName))
 					}
@@ -330,6 +381,10 @@ type RequestBodyDefinition struct {
 	// Whether this is the default body type. For an operation named OpFoo, we
 	// will not add suffixes like OpFooJSONBody for this one.
 	Default bool
+
+	// For patches, the types generated for them, such as PetPatch for the
+	// merge patches of a Pet.
+	PatchTypes []PatchType
 }
 
 // Returns the Go type definition for a request body
@@ -369,13 +424,17 @@ func FilterParameterDefinitionByType(params []ParameterDefinition, in string) []
 
 // OperationDefinitions returns all operations for a swagger definition.
 func OperationDefinitions(swagger *openapi3.Swagger) ([]OperationDefinition, error) {
+	return operationDefinitions(swagger, nil)
+}
+
+func operationDefinitions(swagger *openapi3.Swagger, nullables *nullableTypes) ([]OperationDefinition, error) {
 	var operations []OperationDefinition
 
 	for _, requestPath := range SortedPathsKeys(swagger.Paths) {
 		pathItem := swagger.Paths[requestPath]
 		// These are parameters defined for all methods on a given path. They
 		// are shared by all methods.
-		globalParams, err := DescribeParameters(pathItem.Parameters, nil)
+		globalParams, err := describeParameters(pathItem.Parameters, nil, nullables)
 		if err != nil {
 			return nil, fmt.Errorf("error describing global parameters for %s: %s",
 				requestPath, err)
@@ -399,7 +458,7 @@ func OperationDefinitions(swagger *openapi3.Swagger) ([]OperationDefinition, err
 
 			// These are parameters defined for the specific path method that
 			// we're iterating over.
-			localParams, err := DescribeParameters(op.Parameters, []string{op.OperationID + "Params"})
+			localParams, err := describeParameters(op.Parameters, []string{op.OperationID + "Params"}, nullables)
 			if err != nil {
 				return nil, fmt.Errorf("error describing global parameters for %s/%s: %s",
 					opName, requestPath, err)
@@ -417,7 +476,7 @@ func OperationDefinitions(swagger *openapi3.Swagger) ([]OperationDefinition, err
 				return nil, err
 			}
 
-			bodyDefinitions, typeDefinitions, err := GenerateBodyDefinitions(op.OperationID, op.RequestBody)
+			bodyDefinitions, typeDefinitions, err := generateBodyDefinitions(op.OperationID, op.RequestBody, nullables)
 			if err != nil {
 				return nil, errors.Wrap(err, "error generating body definitions")
 			}
@@ -435,6 +494,7 @@ func OperationDefinitions(swagger *openapi3.Swagger) ([]OperationDefinition, err
 				Spec:            op,
 				Bodies:          bodyDefinitions,
 				TypeDefinitions: typeDefinitions,
+				nullables:       nullables,
 			}
 
 			// check for overrides of SecurityDefinitions.
@@ -455,6 +515,32 @@ func OperationDefinitions(swagger *openapi3.Swagger) ([]OperationDefinition, err
 				opDef.BodyRequired = op.RequestBody.Value.Required
 			}
 
+			// Servers of the operation replace those of its path, which
+			// replace those of the spec.
+			servers := pathItem.Servers
+			if op.Servers != nil {
+				servers = *op.Servers
+			}
+			opDef.Servers, err = DescribeServers(servers)
+			if err != nil {
+				return nil, errors.Wrap(err, fmt.Sprintf("error describing servers of %s", op.OperationID))
+			}
+			for i := range opDef.Servers {
+				if opDef.Servers[i].VariablesType != "" {
+					opDef.Servers[i].VariablesType = opDef.OperationId + opDef.Servers[i].VariablesType
+				}
+			}
+
+			opDef.Stream, err = DescribeStream(&opDef)
+			if err != nil {
+				return nil, errors.Wrap(err, fmt.Sprintf("error describing stream of %s", op.OperationID))
+			}
+
+			opDef.Pagination, err = DescribePagination(&opDef)
+			if err != nil {
+				return nil, errors.Wrap(err, fmt.Sprintf("error describing pagination of %s", op.OperationID))
+			}
+
 			// Generate all the type definitions needed for this operation
 			opDef.TypeDefinitions = append(opDef.TypeDefinitions, GenerateTypeDefsForOperation(opDef)...)
 
@@ -487,6 +573,10 @@ func generateDefaultOperationID(opName string, requestPath string) (string, erro
 // This function turns the Swagger body definitions into a list of our body
 // definitions which will be used for code generation.
 func GenerateBodyDefinitions(operationID string, bodyOrRef *openapi3.RequestBodyRef) ([]RequestBodyDefinition, []TypeDefinition, error) {
+	return generateBodyDefinitions(operationID, bodyOrRef, nil)
+}
+
+func generateBodyDefinitions(operationID string, bodyOrRef *openapi3.RequestBodyRef, nullables *nullableTypes) ([]RequestBodyDefinition, []TypeDefinition, error) {
 	if bodyOrRef == nil {
 		return nil, nil, nil
 	}
@@ -495,7 +585,8 @@ func GenerateBodyDefinitions(operationID string, bodyOrRef *openapi3.RequestBody
 	var bodyDefinitions []RequestBodyDefinition
 	var typeDefinitions []TypeDefinition
 
-	for contentType, content := range body.Content {
+	for _, contentType := range SortedContentKeys(body.Content) {
+		content := body.Content[contentType]
 		var tag string
 		var defaultBody bool
 
@@ -503,18 +594,34 @@ func GenerateBodyDefinitions(operationID string, bodyOrRef *openapi3.RequestBody
 		case "application/json":
 			tag = "JSON"
 			defaultBody = true
+		case "application/merge-patch+json":
+			tag = "MergePatch"
+		case "application/json-patch+json":
+			tag = "JSONPatch"
 		default:
 			continue
 		}
 
 		bodyTypeName := operationID + tag + "Body"
-		bodySchema, err := GenerateGoSchema(content.Schema, []string{bodyTypeName})
+		bodySchema, err := generateGoSchema(content.Schema, []string{bodyTypeName}, nullables)
 		if err != nil {
 			return nil, nil, errors.Wrap(err, "error generating request body definition")
 		}
 
+		// Patches are of types generated for them, from the schemas of the
+		// types they patch, which their bodies refer to.
+		patchTypes, patchType, err := describePatchTypes(contentType, content.Schema, nullables)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "error generating patch types of request body")
+		}
+		if patchType != "" {
+			bodySchema = Schema{GoType: patchType, RefType: patchType}
+		} else if tag != "JSON" {
+			continue
+		}
+
 		// If the body is a pre-defined type
-		if bodyOrRef.Ref != "" {
+		if bodyOrRef.Ref != "" && patchType == "" {
 			// Convert the reference path to Go type
 			refType, err := RefPathToGoType(bodyOrRef.Ref)
 			if err != nil {
@@ -542,6 +649,7 @@ func GenerateBodyDefinitions(operationID string, bodyOrRef *openapi3.RequestBody
 			NameTag:     tag,
 			ContentType: contentType,
 			Default:     defaultBody,
+			PatchTypes:  patchTypes,
 		}
 		bodyDefinitions = append(bodyDefinitions, bd)
 	}
@@ -621,6 +729,16 @@ func GenerateTypesForOperations(t *template.Template, ops []OperationDefinition)
 		return "", errors.Wrap(err, "error generating request bodies for operations")
 	}
 
+	err = t.ExecuteTemplate(w, "stream-types.tmpl", ops)
+	if err != nil {
+		return "", errors.Wrap(err, "error generating stream types for operations")
+	}
+
+	err = t.ExecuteTemplate(w, "urls.tmpl", ops)
+	if err != nil {
+		return "", errors.Wrap(err, "error generating URL builders for operations")
+	}
+
 	// Generate boiler plate for all additional types.
 	var td []TypeDefinition
 	for _, op := range ops {
@@ -699,6 +817,99 @@ func GenerateEchoServer(t *template.Template, operations []OperationDefinition)
 	return strings.Join([]string{si, wrappers, register}, "\n"), nil
 }
 
+// GenerateOperationInfo generates the OperationInfo of the operations, which
+// either server puts into the context of their requests.
+func GenerateOperationInfo(t *template.Template, ops []OperationDefinition) (string, error) {
+	var buf bytes.Buffer
+	w := bufio.NewWriter(&buf)
+
+	err := t.ExecuteTemplate(w, "operation-info.tmpl", ops)
+	if err != nil {
+		return "", errors.Wrap(err, "error generating operation info")
+	}
+	err = w.Flush()
+	if err != nil {
+		return "", errors.Wrap(err, "error flushing output buffer for operation info")
+	}
+	return buf.String(), nil
+}
+
+// GenerateServerStreams generates the writers of the streamed responses of
+// the operations, for either server.
+func GenerateServerStreams(t *template.Template, ops []OperationDefinition) (string, error) {
+	var streamed []OperationDefinition
+	for _, op := range OperationsWithStreams(ops) {
+		if op.Stream.IsEventStream() || op.Stream.IsNDJSON() {
+			streamed = append(streamed, op)
+		}
+	}
+	if len(streamed) == 0 {
+		return "", nil
+	}
+
+	var buf bytes.Buffer
+	w := bufio.NewWriter(&buf)
+
+	err := t.ExecuteTemplate(w, "server-streams.tmpl", streamed)
+	if err != nil {
+		return "", errors.Wrap(err, "error generating server streams")
+	}
+	err = w.Flush()
+	if err != nil {
+		return "", errors.Wrap(err, "error flushing output buffer for server streams")
+	}
+	return buf.String(), nil
+}
+
+// GenerateParamBinders describes the bindings of the parameters which can be
+// bound without reflection, which the server templates then use, and
+// generates their functions.
+func GenerateParamBinders(t *template.Template, ops []OperationDefinition) (string, error) {
+	bindings, err := DescribeParameterBindings(ops)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	w := bufio.NewWriter(&buf)
+
+	err = t.ExecuteTemplate(w, "param-binders.tmpl", bindings)
+	if err != nil {
+		return "", errors.Wrap(err, "error generating parameter binders")
+	}
+	err = w.Flush()
+	if err != nil {
+		return "", errors.Wrap(err, "error flushing output buffer for parameter binders")
+	}
+	return buf.String(), nil
+}
+
+// GenerateMockServer generates a server answering the operations with the
+// examples of their responses.
+func GenerateMockServer(t *template.Template, ops []OperationDefinition) (string, error) {
+	var mocks []MockOperationDefinition
+	for i := range ops {
+		responses, err := DescribeMockResponses(&ops[i])
+		if err != nil {
+			return "", errors.Wrap(err, fmt.Sprintf("error describing the responses of %s", ops[i].OperationId))
+		}
+		mocks = append(mocks, MockOperationDefinition{OperationDefinition: ops[i], Responses: responses})
+	}
+
+	var buf bytes.Buffer
+	w := bufio.NewWriter(&buf)
+
+	err := t.ExecuteTemplate(w, "mock-server.tmpl", mocks)
+	if err != nil {
+		return "", errors.Wrap(err, "error generating mock server")
+	}
+	err = w.Flush()
+	if err != nil {
+		return "", errors.Wrap(err, "error flushing output buffer for mock server")
+	}
+	return buf.String(), nil
+}
+
 // Uses the template engine to generate the server interface
 func GenerateServerInterface(t *template.Template, ops []OperationDefinition) (string, error) {
 	var buf bytes.Buffer
@@ -771,6 +982,74 @@ func GenerateClient(t *template.Template, ops []OperationDefinition) (string, er
 	return buf.String(), nil
 }
 
+// GenerateClientServers generates the constructors of the URLs of the
+// servers of the spec, and the options selecting the servers of the client
+// and of its operations.
+func GenerateClientServers(t *template.Template, servers []ServerDefinition, ops []OperationDefinition) (string, error) {
+	var buf bytes.Buffer
+	w := bufio.NewWriter(&buf)
+
+	context := struct {
+		Servers    []ServerDefinition
+		Operations []OperationDefinition
+	}{
+		Servers:    servers,
+		Operations: ops,
+	}
+	err := t.ExecuteTemplate(w, "client-servers.tmpl", context)
+	if err != nil {
+		return "", fmt.Errorf("error generating client servers: %s", err)
+	}
+	err = w.Flush()
+	if err != nil {
+		return "", fmt.Errorf("error flushing output buffer for client servers: %s", err)
+	}
+	return buf.String(), nil
+}
+
+// GenerateClientValidation generates the option validating the requests of
+// the client against the embedded spec.
+func GenerateClientValidation(t *template.Template) (string, error) {
+	var buf bytes.Buffer
+	w := bufio.NewWriter(&buf)
+
+	err := t.ExecuteTemplate(w, "client-validation.tmpl", nil)
+	if err != nil {
+		return "", fmt.Errorf("error generating client validation: %s", err)
+	}
+	err = w.Flush()
+	if err != nil {
+		return "", fmt.Errorf("error flushing output buffer for client validation: %s", err)
+	}
+	return buf.String(), nil
+}
+
+// GenerateClientFake generates an in-memory fake of the client with
+// responses, for tests.
+func GenerateClientFake(t *template.Template, ops []OperationDefinition) (string, error) {
+	var fakes []FakeOperationDefinition
+	for i := range ops {
+		responses, err := DescribeFakeResponses(&ops[i])
+		if err != nil {
+			return "", errors.Wrap(err, fmt.Sprintf("error describing the responses of %s", ops[i].OperationId))
+		}
+		fakes = append(fakes, FakeOperationDefinition{OperationDefinition: ops[i], Responses: responses})
+	}
+
+	var buf bytes.Buffer
+	w := bufio.NewWriter(&buf)
+
+	err := t.ExecuteTemplate(w, "client-fake.tmpl", fakes)
+	if err != nil {
+		return "", errors.Wrap(err, "error generating client fake")
+	}
+	err = w.Flush()
+	if err != nil {
+		return "", errors.Wrap(err, "error flushing output buffer for client fake")
+	}
+	return buf.String(), nil
+}
+
 // This generates a client which extends the basic client which does response
 // unmarshaling.
 func GenerateClientWithResponses(t *template.Template, ops []OperationDefinition) (string, error) {
@@ -782,6 +1061,20 @@ func GenerateClientWithResponses(t *template.Template, ops []OperationDefinition
 	if err != nil {
 		return "", fmt.Errorf("error generating client bindings: %s", err)
 	}
+
+	if streamed := OperationsWithStreams(ops); len(streamed) > 0 {
+		err = t.ExecuteTemplate(w, "client-streams.tmpl", streamed)
+		if err != nil {
+			return "", fmt.Errorf("error generating client streams: %s", err)
+		}
+	}
+
+	if paginated := OperationsWithPagination(ops); len(paginated) > 0 {
+		err = t.ExecuteTemplate(w, "client-pagination.tmpl", paginated)
+		if err != nil {
+			return "", fmt.Errorf("error generating client pagination: %s", err)
+		}
+	}
 	err = w.Flush()
 	if err != nil {
 		return "", fmt.Errorf("error flushing output buffer for client: %s", err)
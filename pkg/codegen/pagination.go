@@ -0,0 +1,157 @@
+package codegen
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/pkg/errors"
+)
+
+// The pagination strategies supported by the `x-pagination` extension.
+const (
+	// The response holds the cursor of the next page, which is passed back
+	// through a query parameter.
+	PaginationCursor = "cursor"
+	// Pages are requested by their offset, through a query parameter, until
+	// an empty page is returned.
+	PaginationOffset = "offset"
+	// The response links to the next page through a Link header, as in
+	// RFC 8288.
+	PaginationLink = "link"
+)
+
+// PaginationDefinition describes how to page through the results of a list
+// operation, as declared by its `x-pagination` extension, eg:
+//
+//	x-pagination:
+//	  strategy: cursor
+//	  cursorParam: cursor
+//	  nextCursor: /meta/next
+//	  items: /data
+type PaginationDefinition struct {
+	Strategy   string               // One of the pagination strategies above
+	Param      *ParameterDefinition // The query parameter holding the cursor or the offset
+	NextCursor string               // JSON pointer to the next cursor in the response body
+	Items      string               // JSON pointer to the items in the response body
+	ItemType   string               // The Go type of the items
+}
+
+type paginationExtension struct {
+	Strategy    string `json:"strategy"`
+	CursorParam string `json:"cursorParam"`
+	NextCursor  string `json:"nextCursor"`
+	Items       string `json:"items"`
+}
+
+// DescribePagination returns the pagination of an operation, or nil when it
+// has no `x-pagination` extension.
+func DescribePagination(op *OperationDefinition) (*PaginationDefinition, error) {
+	ext, found := op.Spec.Extensions["x-pagination"]
+	if !found {
+		return nil, nil
+	}
+	var e paginationExtension
+	if err := json.Unmarshal(ext.(json.RawMessage), &e); err != nil {
+		return nil, errors.Wrap(err, "error decoding x-pagination")
+	}
+	if op.HasBody() {
+		return nil, errors.New("x-pagination isn't supported for operations with a request body")
+	}
+
+	pagination := PaginationDefinition{
+		Strategy:   e.Strategy,
+		NextCursor: e.NextCursor,
+		Items:      e.Items,
+	}
+	switch e.Strategy {
+	case PaginationCursor, PaginationOffset:
+		pagination.Param = ParameterDefinitions(op.QueryParams).FindByName(e.CursorParam)
+		if pagination.Param == nil {
+			return nil, fmt.Errorf("x-pagination cursorParam '%s' isn't a query parameter", e.CursorParam)
+		}
+		if pagination.Param.IsJson() || pagination.Param.IsPassThrough() {
+			return nil, fmt.Errorf("x-pagination cursorParam '%s' must be defined by a schema", e.CursorParam)
+		}
+		paramType := pagination.Param.Spec.Schema.Value.Type
+		if e.Strategy == PaginationCursor {
+			if e.NextCursor == "" {
+				return nil, errors.New("x-pagination nextCursor is required by the cursor strategy")
+			}
+			// The iteration stops on a zero cursor, so it must be comparable
+			if paramType != "string" && paramType != "integer" && paramType != "number" {
+				return nil, fmt.Errorf("x-pagination cursorParam '%s' must be a string or a number", e.CursorParam)
+			}
+		}
+		if e.Strategy == PaginationOffset && paramType != "integer" {
+			return nil, fmt.Errorf("x-pagination cursorParam '%s' must be an integer for the offset strategy", e.CursorParam)
+		}
+	case PaginationLink:
+	default:
+		return nil, fmt.Errorf("unknown x-pagination strategy: '%s'", e.Strategy)
+	}
+
+	itemType, err := paginationItemType(op.Spec, e.Items, op.nullables)
+	if err != nil {
+		return nil, err
+	}
+	pagination.ItemType = itemType
+	return &pagination, nil
+}
+
+// paginationItemType returns the Go type of the items of the JSON 200
+// response found at the pointer.
+func paginationItemType(op *openapi3.Operation, pointer string, nullables *nullableTypes) (string, error) {
+	response := op.Responses.Get(200)
+	if response == nil || response.Value == nil {
+		return "", errors.New("x-pagination requires a 200 response")
+	}
+	content := response.Value.Content.Get("application/json")
+	if content == nil || content.Schema == nil {
+		return "", errors.New("x-pagination requires a JSON 200 response")
+	}
+
+	sref := content.Schema
+	for _, token := range jsonPointerTokens(pointer) {
+		property, found := sref.Value.Properties[token]
+		if !found {
+			return "", fmt.Errorf("x-pagination items '%s' isn't in the response schema", pointer)
+		}
+		sref = property
+	}
+	if sref.Value.Type != "array" || sref.Value.Items == nil {
+		return "", fmt.Errorf("x-pagination items '%s' isn't an array", pointer)
+	}
+
+	itemSchema, err := generateGoSchema(sref.Value.Items, nil, nullables)
+	if err != nil {
+		return "", errors.Wrap(err, "error generating type for x-pagination items")
+	}
+	return itemSchema.TypeDecl(), nil
+}
+
+// jsonPointerTokens splits a JSON pointer, as in RFC 6901, into its unescaped
+// tokens.
+func jsonPointerTokens(pointer string) []string {
+	if pointer == "" || pointer == "/" {
+		return nil
+	}
+	tokens := strings.Split(strings.TrimPrefix(pointer, "/"), "/")
+	for i, t := range tokens {
+		tokens[i] = strings.Replace(strings.Replace(t, "~1", "/", -1), "~0", "~", -1)
+	}
+	return tokens
+}
+
+// OperationsWithPagination returns the operations which have an
+// `x-pagination` extension.
+func OperationsWithPagination(ops []OperationDefinition) []OperationDefinition {
+	var out []OperationDefinition
+	for _, op := range ops {
+		if op.Pagination != nil {
+			out = append(out, op)
+		}
+	}
+	return out
+}
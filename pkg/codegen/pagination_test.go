@@ -0,0 +1,85 @@
+package codegen
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const paginationSpec = `
+openapi: "3.0.1"
+info:
+  version: 1.0.0
+  title: Pagination
+paths:
+  /things:
+    get:
+      operationId: ListThings
+      x-pagination: %s
+      parameters:
+        - name: cursor
+          in: query
+          schema:
+            type: string
+        - name: filter
+          in: query
+          schema:
+            type: array
+            items:
+              type: string
+      responses:
+        200:
+          description: a page of things
+          content:
+            application/json:
+              schema:
+                type: object
+                properties:
+                  next:
+                    type: string
+                  things:
+                    type: array
+                    items:
+                      type: object
+                      properties:
+                        name:
+                          type: string
+`
+
+func TestDescribePagination(t *testing.T) {
+	tests := []struct {
+		extension string
+		err       string
+	}{
+		{`{strategy: cursor, cursorParam: cursor, nextCursor: /next, items: /things}`, ""},
+		{`{strategy: link, items: /things}`, ""},
+		{`{strategy: pages, items: /things}`, "unknown x-pagination strategy: 'pages'"},
+		{`{strategy: cursor, cursorParam: page, nextCursor: /next, items: /things}`, "x-pagination cursorParam 'page' isn't a query parameter"},
+		{`{strategy: cursor, cursorParam: cursor, items: /things}`, "x-pagination nextCursor is required by the cursor strategy"},
+		{`{strategy: cursor, cursorParam: filter, nextCursor: /next, items: /things}`, "x-pagination cursorParam 'filter' must be a string or a number"},
+		{`{strategy: offset, cursorParam: cursor, items: /things}`, "x-pagination cursorParam 'cursor' must be an integer for the offset strategy"},
+		{`{strategy: link, items: /items}`, "x-pagination items '/items' isn't in the response schema"},
+		{`{strategy: link, items: /next}`, "x-pagination items '/next' isn't an array"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.extension, func(t *testing.T) {
+			swagger, err := openapi3.NewSwaggerLoader().LoadSwaggerFromData([]byte(fmt.Sprintf(paginationSpec, tt.extension)))
+			require.NoError(t, err)
+
+			ops, err := OperationDefinitions(swagger)
+			if tt.err != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, ops, 1)
+			require.NotNil(t, ops[0].Pagination)
+			assert.Contains(t, ops[0].Pagination.ItemType, "Name *string")
+		})
+	}
+}
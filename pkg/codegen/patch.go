@@ -0,0 +1,206 @@
+package codegen
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/pkg/errors"
+)
+
+// PatchType describes a type generated for the patches of a Go type, which
+// applies them to a value of that type.
+type PatchType struct {
+	TypeName  string            // The name of the patch type, eg. PetPatch
+	Target    string            // The type it patches, eg. Pet
+	JSONPatch bool              // Whether it's a JSON patch, rather than a JSON merge patch
+	Fields    []MergePatchField // The fields of a merge patch
+}
+
+// MergePatchField describes a field of a merge patch, which patches a property
+// of its target.
+type MergePatchField struct {
+	Property         // The property of the target
+	PatchType string // The Go type of the field, eg. NullableString
+	Merged    bool   // Whether the field is a merge patch of the property, rather than its new value
+}
+
+// Raw tells whether the field is raw JSON, as the property has no nullable
+// type to tell null from unspecified.
+func (f MergePatchField) Raw() bool {
+	return f.PatchType == "json.RawMessage"
+}
+
+// JsonTag returns the struct tag of the field, which is omitted when it's
+// unspecified.
+func (f MergePatchField) JsonTag() string {
+	return fmt.Sprintf("`json:\"%s,omitempty\"`", f.JsonFieldName)
+}
+
+// TargetPointer tells whether the property of the target is a pointer.
+func (f MergePatchField) TargetPointer() bool {
+	return strings.HasPrefix(f.GoTypeDef(), "*")
+}
+
+// TargetNullable tells whether the property of the target is of a nullable type.
+func (f MergePatchField) TargetNullable() bool {
+	return f.NullableType != ""
+}
+
+// valueType returns the Go type of the values of the field, which it holds in
+// a nullable type when it has one.
+func (f MergePatchField) valueType() string {
+	if f.Merged {
+		return f.Schema.TypeDecl() + "Patch"
+	}
+	return f.Schema.TypeDecl()
+}
+
+// describePatchTypes returns the patch types of a request body of the given
+// content type, whose schema is sref, along with the Go type of the body. It
+// returns no types for bodies which aren't patches, or which don't refer to
+// the schema of the type they patch.
+func describePatchTypes(contentType string, sref *openapi3.SchemaRef, nullables *nullableTypes) ([]PatchType, string, error) {
+	if sref == nil || sref.Ref == "" {
+		return nil, "", nil
+	}
+	target, err := RefPathToGoType(sref.Ref)
+	if err != nil {
+		return nil, "", fmt.Errorf("error turning reference (%s) into a Go type: %s", sref.Ref, err)
+	}
+
+	switch contentType {
+	case "application/merge-patch+json":
+		patchTypes, err := describeMergePatch(target, sref, map[string]bool{}, nullables)
+		if err != nil {
+			return nil, "", err
+		}
+		return patchTypes, target + "Patch", nil
+	case "application/json-patch+json":
+		patchType := PatchType{
+			TypeName:  target + "JSONPatch",
+			Target:    target,
+			JSONPatch: true,
+		}
+		return []PatchType{patchType}, patchType.TypeName, nil
+	}
+	return nil, "", nil
+}
+
+// describeMergePatch returns the merge patch type of the given target type,
+// whose schema is sref, followed by those of the properties it merges.
+// described holds the types described already, which aren't again.
+func describeMergePatch(target string, sref *openapi3.SchemaRef, described map[string]bool, nullables *nullableTypes) ([]PatchType, error) {
+	patchType := PatchType{
+		TypeName: target + "Patch",
+		Target:   target,
+	}
+	if described[patchType.TypeName] {
+		return nil, nil
+	}
+	described[patchType.TypeName] = true
+
+	schema, err := generateGoSchema(openapi3.NewSchemaRef("", sref.Value), []string{target}, nullables)
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("error generating Go schema for %s", target))
+	}
+	if len(schema.Properties) == 0 {
+		return nil, fmt.Errorf("%s has no properties to merge patch", target)
+	}
+
+	var nested []PatchType
+	for _, p := range schema.Properties {
+		field := MergePatchField{Property: p}
+		if pref := sref.Value.Properties[p.JsonFieldName]; mergeable(pref) {
+			// Objects of other types are merged with their own patches.
+			patchTypes, err := describeMergePatch(p.Schema.TypeDecl(), pref, described, nullables)
+			if err != nil {
+				return nil, errors.Wrap(err, fmt.Sprintf("error describing merge patch of property '%s'", p.JsonFieldName))
+			}
+			nested = append(nested, patchTypes...)
+			field.Merged = true
+		}
+		field.PatchType, err = nullables.record(field.valueType())
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("error generating merge patch of property '%s'", p.JsonFieldName))
+		}
+		if field.PatchType == "" {
+			field.PatchType = "json.RawMessage"
+		}
+		patchType.Fields = append(patchType.Fields, field)
+	}
+	return append([]PatchType{patchType}, nested...), nil
+}
+
+// mergeable tells whether a property refers to an object with properties,
+// which is merged, rather than replaced, by a merge patch.
+func mergeable(sref *openapi3.SchemaRef) bool {
+	if sref == nil || sref.Ref == "" || sref.Value == nil {
+		return false
+	}
+	schema := sref.Value
+	return (schema.Type == "" || schema.Type == "object") && len(schema.Properties) != 0 &&
+		schema.AllOf == nil && schema.AnyOf == nil && schema.OneOf == nil &&
+		!SchemaHasAdditionalProperties(schema)
+}
+
+// recordPatchNullableTypes records the nullable types of the fields of the
+// merge patches of the operations to nullables, as the operations may have
+// been described without it.
+func recordPatchNullableTypes(nullables *nullableTypes, ops []OperationDefinition) error {
+	for _, op := range ops {
+		for _, body := range op.Bodies {
+			for _, pt := range body.PatchTypes {
+				for _, field := range pt.Fields {
+					if field.Raw() {
+						continue
+					}
+					if _, err := nullables.record(field.valueType()); err != nil {
+						return errors.Wrap(err, fmt.Sprintf("error generating merge patch %s", pt.TypeName))
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// GeneratePatchTypes generates the patch types of the request bodies of the
+// operations, sorted by name.
+func GeneratePatchTypes(t *template.Template, ops []OperationDefinition) (string, error) {
+	types := make(map[string]PatchType)
+	for _, op := range ops {
+		for _, body := range op.Bodies {
+			for _, pt := range body.PatchTypes {
+				types[pt.TypeName] = pt
+			}
+		}
+	}
+	if len(types) == 0 {
+		return "", nil
+	}
+	var patchTypes []PatchType
+	for _, pt := range types {
+		patchTypes = append(patchTypes, pt)
+	}
+	sort.Slice(patchTypes, func(i, j int) bool {
+		return patchTypes[i].TypeName < patchTypes[j].TypeName
+	})
+
+	var buf bytes.Buffer
+	w := bufio.NewWriter(&buf)
+
+	err := t.ExecuteTemplate(w, "patch.tmpl", patchTypes)
+	if err != nil {
+		return "", errors.Wrap(err, "error generating patch types")
+	}
+	err = w.Flush()
+	if err != nil {
+		return "", errors.Wrap(err, "error flushing output buffer for patch types")
+	}
+	return buf.String(), nil
+}
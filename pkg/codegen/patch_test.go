@@ -0,0 +1,123 @@
+package codegen
+
+import (
+	"go/format"
+	"testing"
+	"text/template"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/indigonote/oapi-codegen/pkg/codegen/templates"
+)
+
+func TestGeneratePatchBodyDefinitions(t *testing.T) {
+	owner := openapi3.NewObjectSchema().WithProperty("name", openapi3.NewStringSchema())
+	pet := openapi3.NewObjectSchema().WithProperty("name", openapi3.NewStringSchema()).
+		WithProperty("extra", openapi3.NewSchema())
+	pet.Properties["owner"] = &openapi3.SchemaRef{Ref: "#/components/schemas/Owner", Value: owner}
+	pet.Required = []string{"owner"}
+	petRef := &openapi3.SchemaRef{Ref: "#/components/schemas/Pet", Value: pet}
+
+	body := &openapi3.RequestBodyRef{Value: openapi3.NewRequestBody().WithContent(openapi3.Content{
+		"application/merge-patch+json": openapi3.NewMediaType().WithSchemaRef(petRef),
+		"application/json-patch+json":  openapi3.NewMediaType().WithSchemaRef(petRef),
+		"application/json":             openapi3.NewMediaType().WithSchemaRef(petRef),
+	})}
+	bodies, _, err := GenerateBodyDefinitions("PatchPet", body)
+	require.NoError(t, err)
+	require.Len(t, bodies, 3)
+
+	assert.Equal(t, "application/json", bodies[0].ContentType)
+	assert.Equal(t, "PatchPetJSONBody", bodies[0].TypeDef())
+	assert.Empty(t, bodies[0].PatchTypes)
+
+	assert.Equal(t, "application/json-patch+json", bodies[1].ContentType)
+	assert.Equal(t, "WithJSONPatchBody", bodies[1].Suffix())
+	assert.Equal(t, "PetJSONPatch", bodies[1].TypeDef())
+	assert.Equal(t, []PatchType{{TypeName: "PetJSONPatch", Target: "Pet", JSONPatch: true}}, bodies[1].PatchTypes)
+
+	assert.Equal(t, "application/merge-patch+json", bodies[2].ContentType)
+	assert.Equal(t, "WithMergePatchBody", bodies[2].Suffix())
+	assert.Equal(t, "PetPatch", bodies[2].TypeDef())
+	patchTypes := bodies[2].PatchTypes
+	require.Len(t, patchTypes, 2)
+	assert.Equal(t, "PetPatch", patchTypes[0].TypeName)
+	assert.Equal(t, "OwnerPatch", patchTypes[1].TypeName)
+
+	fields := patchTypes[0].Fields
+	require.Len(t, fields, 3)
+	assert.Equal(t, "json.RawMessage", fields[0].PatchType)
+	assert.True(t, fields[0].Raw())
+	assert.Equal(t, "NullableString", fields[1].PatchType)
+	assert.True(t, fields[1].TargetPointer())
+	assert.Equal(t, "NullableOwnerPatch", fields[2].PatchType)
+	assert.True(t, fields[2].Merged)
+	assert.False(t, fields[2].TargetPointer())
+
+	// Patches which don't refer to the type they patch are left out
+	body = &openapi3.RequestBodyRef{Value: openapi3.NewRequestBody().WithContent(openapi3.Content{
+		"application/merge-patch+json": openapi3.NewMediaType().WithSchema(pet),
+	})}
+	bodies, _, err = GenerateBodyDefinitions("PatchPet", body)
+	require.NoError(t, err)
+	assert.Empty(t, bodies)
+}
+
+func TestGeneratePatchTypeDefinitions(t *testing.T) {
+	swagger, err := openapi3.NewSwaggerLoader().LoadSwaggerFromData([]byte(`
+openapi: 3.0.1
+info:
+  title: Patches
+  version: 1.0.0
+paths:
+  /pets/{id}:
+    patch:
+      operationId: patchPet
+      parameters:
+        - name: id
+          in: path
+          required: true
+          schema:
+            type: integer
+      requestBody:
+        content:
+          application/merge-patch+json:
+            schema:
+              $ref: "#/components/schemas/Pet"
+      responses:
+        '204':
+          description: patched
+components:
+  schemas:
+    Owner:
+      type: object
+      properties:
+        name:
+          type: string
+    Pet:
+      type: object
+      properties:
+        name:
+          type: string
+        owner:
+          $ref: "#/components/schemas/Owner"
+`))
+	require.NoError(t, err)
+	tmpl, err := templates.Parse(template.New("oapi-codegen").Funcs(TemplateFunctions))
+	require.NoError(t, err)
+
+	// The exported helpers declare the nullable types of the patches, as
+	// Generate does
+	ops, err := OperationDefinitions(swagger)
+	require.NoError(t, err)
+	code, err := GenerateTypeDefinitions(tmpl, swagger, ops)
+	require.NoError(t, err)
+	assert.Contains(t, code, "Name NullableString")
+	assert.Contains(t, code, "type NullableString map[bool]string")
+	assert.Contains(t, code, "Owner NullableOwnerPatch")
+	assert.Contains(t, code, "type NullableOwnerPatch map[bool]OwnerPatch")
+	_, err = format.Source([]byte(code))
+	assert.NoError(t, err)
+}
@@ -0,0 +1,58 @@
+package codegen
+
+import (
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// ResponsesDefinition sorts the documented responses of an operation into
+// successes and errors, so that the generated client can return the payload
+// of the former and turn the latter into an `error`.
+type ResponsesDefinition struct {
+	Success     []TypeDefinition // The payloads of the 2xx responses
+	SuccessType string           // The Go type of those payloads, empty when they don't have a single one
+	ErrorCodes  []string         // The other documented status codes
+	Errors      []TypeDefinition // The payloads of the responses with those status codes
+	Default     []TypeDefinition // The payloads of the default response
+}
+
+// DescribeResponses sorts the responses of an operation, see
+// ResponsesDefinition.
+func DescribeResponses(op *OperationDefinition) (*ResponsesDefinition, error) {
+	tds, err := op.GetResponseTypeDefinitions()
+	if err != nil {
+		return nil, errors.Wrap(err, "error describing responses")
+	}
+
+	var responses ResponsesDefinition
+	for _, responseName := range SortedResponsesKeys(op.Spec.Responses) {
+		if _, err := strconv.Atoi(responseName); err == nil && !isSuccessStatus(responseName) {
+			responses.ErrorCodes = append(responses.ErrorCodes, responseName)
+		}
+	}
+	for _, td := range tds {
+		switch {
+		case td.ResponseName == "default":
+			responses.Default = append(responses.Default, td)
+		case StringInArray(td.ResponseName, responses.ErrorCodes):
+			responses.Errors = append(responses.Errors, td)
+		case isSuccessStatus(td.ResponseName):
+			responses.Success = append(responses.Success, td)
+		}
+	}
+
+	for i, td := range responses.Success {
+		if i > 0 && td.Schema.TypeDecl() != responses.SuccessType {
+			responses.SuccessType = ""
+			break
+		}
+		responses.SuccessType = td.Schema.TypeDecl()
+	}
+	return &responses, nil
+}
+
+func isSuccessStatus(responseName string) bool {
+	code, err := strconv.Atoi(responseName)
+	return err == nil && code >= 200 && code < 300
+}
@@ -15,6 +15,9 @@ type Schema struct {
 	RefType    string // If the type has a type name, this is set
 	EsTemplate string // This field use for create es index template
 
+	EsDynamicTemplates []string      // Dynamic templates of the es index template, for additional properties
+	EsProcessors       []EsProcessor // Ingest processors of the es index, from x-es-pipeline
+
 	EnumValues []string // Enum values
 
 	Properties               []Property       // For an object, the fields with names
@@ -66,6 +69,7 @@ type Property struct {
 	Schema        Schema
 	Required      bool
 	Nullable      bool
+	NullableType  string // The type of a nullable property telling null from unspecified, if generated
 	Validation    map[string]string
 	EsTag         string
 }
@@ -75,6 +79,9 @@ func (p Property) GoFieldName() string {
 }
 
 func (p Property) GoTypeDef() string {
+	if p.NullableType != "" {
+		return p.NullableType
+	}
 	typeDef := p.Schema.TypeDecl()
 	if !p.Schema.SkipOptionalPointer && (!p.Required || p.Nullable) {
 		typeDef = "*" + typeDef
@@ -93,7 +100,15 @@ func PropertiesEqual(a, b Property) bool {
 	return a.JsonFieldName == b.JsonFieldName && a.Schema.TypeDecl() == b.Schema.TypeDecl() && a.Required == b.Required
 }
 
+// GenerateGoSchema generates the Go schema of sref, with nullable properties
+// as pointers.
 func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
+	return generateGoSchema(sref, path, nil)
+}
+
+// generateGoSchema generates the Go schema of sref, recording the nullable
+// types of its properties to nullables.
+func generateGoSchema(sref *openapi3.SchemaRef, path []string, nullables *nullableTypes) (Schema, error) {
 	// If Ref is set on the SchemaRef, it means that this type is actually a reference to
 	// another type. We're not de-referencing, so simply use the referenced type.
 	var refType string
@@ -134,7 +149,7 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 	// so that in a RESTful paradigm, the Create operation can return
 	// (object, id), so that other operations can refer to (id)
 	if schema.AllOf != nil {
-		mergedSchema, err := MergeSchemas(schema.AllOf, path)
+		mergedSchema, err := mergeSchemas(schema.AllOf, path, nullables)
 		if err != nil {
 			return Schema{}, errors.Wrap(err, "error merging schemas")
 		}
@@ -170,7 +185,7 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 			for _, pName := range SortedSchemaKeys(schema.Properties) {
 				p := schema.Properties[pName]
 				propertyPath := append(path, pName)
-				pSchema, err := GenerateGoSchema(p, propertyPath)
+				pSchema, err := generateGoSchema(p, propertyPath, nullables)
 				if err != nil {
 					return Schema{}, errors.Wrap(err, fmt.Sprintf("error generating Go schema for property '%s'", pName))
 				}
@@ -206,6 +221,12 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 					Nullable:      p.Value.Nullable,
 					Validation:    v,
 				}
+				if prop.Nullable {
+					prop.NullableType, err = nullables.describe(pSchema)
+					if err != nil {
+						return Schema{}, errors.Wrap(err, fmt.Sprintf("error generating nullable type for property '%s'", pName))
+					}
+				}
 				outSchema.Properties = append(outSchema.Properties, prop)
 			}
 
@@ -214,7 +235,7 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 				GoType: "interface{}",
 			}
 			if schema.AdditionalProperties != nil {
-				additionalSchema, err := GenerateGoSchema(schema.AdditionalProperties, path)
+				additionalSchema, err := generateGoSchema(schema.AdditionalProperties, path, nullables)
 				if err != nil {
 					return Schema{}, errors.Wrap(err, "error generating type for additional properties")
 				}
@@ -232,7 +253,7 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 		case "array":
 			// For arrays, we'll get the type of the Items and throw a
 			// [] in front of it.
-			arrayType, err := GenerateGoSchema(schema.Items, path)
+			arrayType, err := generateGoSchema(schema.Items, path, nullables)
 			if err != nil {
 				return Schema{}, errors.Wrap(err, "error generating type for array")
 			}
@@ -290,7 +311,7 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 }
 
 // GenerateEsSchema func do generate EsSchema
-func GenerateEsSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
+func GenerateEsSchema(sref *openapi3.SchemaRef, path []string, state EsState) (Schema, error) {
 	if sref == nil {
 		return Schema{}, nil
 	}
@@ -301,7 +322,7 @@ func GenerateEsSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 		// need generate json template for $ref field
 		// With go struct, we can only add $ref name like FhirPatient or FhirEncounter...
 		// But with es json template, we cannot use this format
-		template, err := GenEsTemplateFromReference(sref, path)
+		template, err := GenEsTemplateFromReference(sref, path, state)
 		if err != nil {
 			return Schema{}, fmt.Errorf("error turning reference (%s) into a ElasticSearch index template: %s",
 				sref.Ref, err)
@@ -326,7 +347,7 @@ func GenerateEsSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 	// (object, id), so that other operations can refer to (id)
 	if schema.AllOf != nil {
 		tag := parseEsType(schema)
-		mergedSchema, err := MergeSchemasForEs(schema.AllOf, path, tag)
+		mergedSchema, err := MergeSchemasForEs(schema.AllOf, path, tag, state)
 		if err != nil {
 			return Schema{}, errors.Wrap(err, "error merging schemas")
 		}
@@ -339,34 +360,23 @@ func GenerateEsSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 	outSchema := Schema{}
 	// Handle objects and empty schemas first as a special case
 	if t == "" || t == "object" {
-		if len(schema.Properties) == 0 && !SchemaHasAdditionalProperties(schema) {
+		// The loader disallows additional properties when they have a schema,
+		// so we check for the schema as well.
+		hasAdditionalProperties := schema.AdditionalProperties != nil || SchemaHasAdditionalProperties(schema)
+		if len(schema.Properties) == 0 && !hasAdditionalProperties {
 			return outSchema, nil
 		}
 		// We've got an object with some properties.
 		for _, pName := range SortedSchemaKeys(schema.Properties) {
 			p := schema.Properties[pName]
 			propertyPath := append(path, pName)
-			pSchema, err := GenerateEsSchema(p, propertyPath)
+			pSchema, err := GenerateEsSchema(p, propertyPath, state)
 			if err != nil {
 				return Schema{}, errors.Wrap(err, fmt.Sprintf("error generating Es schema for property '%s'", pName))
 			}
-			// TODO: implement later
-			// if pSchema.HasAdditionalProperties && pSchema.RefType == "" {
-			// 	// If we have fields present which have additional properties,
-			// 	// but are not a pre-defined type, we need to define a type
-			// 	// for them, which will be based on the field names we followed
-			// 	// to get to the type.
-			// 	typeName := PathToTypeName(propertyPath)
-
-			// 	typeDef := TypeDefinition{
-			// 		TypeName: typeName,
-			// 		JsonName: strings.Join(propertyPath, "."),
-			// 		Schema:   pSchema,
-			// 	}
-			// 	pSchema.AdditionalTypes = append(pSchema.AdditionalTypes, typeDef)
-
-			// 	pSchema.RefType = typeName
-			// }
+			if err := addEsProcessors(p.Value, propertyPath, state); err != nil {
+				return Schema{}, errors.Wrap(err, fmt.Sprintf("error generating ingest processors for property '%s'", pName))
+			}
 			e := parseEsType(p.Value)
 			prop := Property{
 				JsonFieldName: pName,
@@ -375,21 +385,31 @@ func GenerateEsSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 			}
 			outSchema.Properties = append(outSchema.Properties, prop)
 		}
-		outSchema.HasAdditionalProperties = SchemaHasAdditionalProperties(schema)
+		outSchema.HasAdditionalProperties = hasAdditionalProperties
 		outSchema.AdditionalPropertiesType = &Schema{
 			GoType: "interface{}",
 		}
-		// TODO: implement later
-		// if schema.AdditionalProperties != nil {
-		// 	additionalSchema, err := GenerateEsSchema(schema.AdditionalProperties, path)
-		// 	if err != nil {
-		// 		return Schema{}, errors.Wrap(err, "error generating type for additional properties")
-		// 	}
-		//
-		// 	outSchema.AdditionalPropertiesType = &additionalSchema
-		// }
+		// The keys of additional properties aren't known up front, so rather
+		// than mapping them, we add a dynamic template for their path, which
+		// elastic search applies as they show up.
+		if schema.AdditionalProperties != nil && parseEsType(schema) == "" {
+			err := addEsDynamicTemplate(schema.AdditionalProperties, path, state)
+			if err != nil {
+				return Schema{}, errors.Wrap(err, "error generating dynamic template for additional properties")
+			}
+		}
 
+		dynamic, err := parseEsDynamic(schema)
+		if err != nil {
+			return Schema{}, err
+		}
+		if dynamic != "" {
+			outSchema.EsTemplate = fmt.Sprintf(`"dynamic": %s`, dynamic)
+		}
 		if d := GenEsTemplateFromSchema(outSchema); d != "" {
+			if outSchema.EsTemplate != "" {
+				d = outSchema.EsTemplate + ",\n" + d
+			}
 			outSchema.EsTemplate = d
 		}
 		return outSchema, nil
@@ -401,7 +421,10 @@ func GenerateEsSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 			templates := []string{}
 			for _, v := range parts {
 				if v == "fielddata" {
-					templates = append(templates, `"fielddata": "true"`)
+					templates = append(templates, `"fielddata": true`)
+				} else if (v == "dense_vector" || v == "knn_vector") && schema.MaxItems != nil {
+					// vectors have a fixed number of dimensions
+					templates = append(templates, fmt.Sprintf(`"type": "%s","dims": %d`, v, *schema.MaxItems))
 				} else if v == "text" {
 					templates = append(templates, fmt.Sprintf(`"type": "%s","fields": {"keyword": {"type": "keyword", "ignore_above" : 256}}`, v))
 				} else {
@@ -414,7 +437,7 @@ func GenerateEsSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 		case "array":
 			// For arrays, we'll get the type of the Items and throw a
 			// [] in front of it.
-			arrayType, err := GenerateEsSchema(schema.Items, path)
+			arrayType, err := GenerateEsSchema(schema.Items, path, state.List(path))
 			if err != nil {
 				return Schema{}, errors.Wrap(err, "error generating type for array")
 			}
@@ -475,10 +498,13 @@ func GenFieldsFromProperties(props []Property) []string {
 			field += fmt.Sprintf("\n%s\n", StringToGoComment(p.Description))
 		}
 		field += fmt.Sprintf("    %s %s", p.GoFieldName(), p.GoTypeDef())
+		// Nullable types are maps, which are omitted when unspecified, and
+		// which the validation rules of their values don't apply to.
+		nullable := p.Nullable && p.NullableType == ""
 		validator := ""
-		if len(p.Validation) > 0 {
+		if len(p.Validation) > 0 && p.NullableType == "" {
 			s := []string{}
-			if !p.Required || p.Nullable {
+			if !p.Required || nullable {
 				s = append(s, "omitempty")
 			}
 			for _, v := range p.Validation {
@@ -486,7 +512,7 @@ func GenFieldsFromProperties(props []Property) []string {
 			}
 			validator = strings.Join(s, ",")
 		}
-		if p.Required || p.Nullable {
+		if p.Required || nullable {
 			if validator != "" {
 				field += fmt.Sprintf(" `json:\"%s\" validate:\"%s\"`", p.JsonFieldName, validator)
 			} else {
@@ -540,6 +566,11 @@ func GenEsTemplateFromProperties(props []Property) []string {
 			// in case normal object.
 			// append all properties into es index template
 			if p.EsTag == "" {
+				// maps without any known field are still declared, so
+				// that their dynamic templates apply with strict mappings
+				if p.Schema.HasAdditionalProperties {
+					fields = append(fields, fmt.Sprintf(`"%s": {"type": "object"}`, p.JsonFieldName))
+				}
 				continue
 			}
 			field := fmt.Sprintf(`"%s":`, p.JsonFieldName)
@@ -561,7 +592,8 @@ func GenEsTemplateFromSchema(schema Schema) string {
 	if strings.Join(fields, "") == "" {
 		return ""
 	}
-	// TODO: handle for additional properties fields
+	// additional properties are mapped through dynamic templates, see
+	// addEsDynamicTemplate
 	objectParts = append(objectParts, strings.Join(fields, ",\n"))
 	objectParts = append(objectParts, "}")
 	return strings.Join(objectParts, "\n")
@@ -569,6 +601,10 @@ func GenEsTemplateFromSchema(schema Schema) string {
 
 // Merge all the fields in the schemas supplied into one giant schema.
 func MergeSchemas(allOf []*openapi3.SchemaRef, path []string) (Schema, error) {
+	return mergeSchemas(allOf, path, nil)
+}
+
+func mergeSchemas(allOf []*openapi3.SchemaRef, path []string, nullables *nullableTypes) (Schema, error) {
 	var outSchema Schema
 	for _, schemaOrRef := range allOf {
 		ref := schemaOrRef.Ref
@@ -582,7 +618,7 @@ func MergeSchemas(allOf []*openapi3.SchemaRef, path []string) (Schema, error) {
 			}
 		}
 
-		schema, err := GenerateGoSchema(schemaOrRef, path)
+		schema, err := generateGoSchema(schemaOrRef, path, nullables)
 		if err != nil {
 			return Schema{}, errors.Wrap(err, "error generating Go schema in allOf")
 		}
@@ -613,7 +649,7 @@ func MergeSchemas(allOf []*openapi3.SchemaRef, path []string) (Schema, error) {
 
 	// Now, we generate the struct which merges together all the fields.
 	var err error
-	outSchema.GoType, err = GenStructFromAllOf(allOf, path)
+	outSchema.GoType, err = genStructFromAllOf(allOf, path, nullables)
 	if err != nil {
 		return Schema{}, errors.Wrap(err, "unable to generate aggregate type for AllOf")
 	}
@@ -624,6 +660,10 @@ func MergeSchemas(allOf []*openapi3.SchemaRef, path []string) (Schema, error) {
 // input array. In the case of Ref objects, we use an embedded struct, otherwise,
 // we inline the fields.
 func GenStructFromAllOf(allOf []*openapi3.SchemaRef, path []string) (string, error) {
+	return genStructFromAllOf(allOf, path, nil)
+}
+
+func genStructFromAllOf(allOf []*openapi3.SchemaRef, path []string, nullables *nullableTypes) (string, error) {
 	// Start out with struct {
 	objectParts := []string{"struct {"}
 	for _, schemaOrRef := range allOf {
@@ -646,7 +686,7 @@ func GenStructFromAllOf(allOf []*openapi3.SchemaRef, path []string) (string, err
 		} else {
 			// Inline all the fields from the schema into the output struct,
 			// just like in the simple case of generating an object.
-			goSchema, err := GenerateGoSchema(schemaOrRef, path)
+			goSchema, err := generateGoSchema(schemaOrRef, path, nullables)
 			if err != nil {
 				return "", err
 			}
@@ -660,10 +700,10 @@ func GenStructFromAllOf(allOf []*openapi3.SchemaRef, path []string) (string, err
 }
 
 // MergeSchemasForEs do merge all the fields in the schemas supplied into one giant schema.
-func MergeSchemasForEs(allOf []*openapi3.SchemaRef, path []string, tag string) (Schema, error) {
+func MergeSchemasForEs(allOf []*openapi3.SchemaRef, path []string, tag string, state EsState) (Schema, error) {
 	var outSchema Schema
 	// Now, we generate the struct which merges together all the fields.
-	template, err := GenEsTemplateFromAllOf(allOf, path, tag)
+	template, err := GenEsTemplateFromAllOf(allOf, path, tag, state)
 	if err != nil {
 		return Schema{}, errors.Wrap(err, "unable to generate aggregate indices for AllOf")
 	}
@@ -676,7 +716,7 @@ func MergeSchemasForEs(allOf []*openapi3.SchemaRef, path []string, tag string) (
 }
 
 // GenEsTemplateFromAllOf do create es template to for `allOf` field
-func GenEsTemplateFromAllOf(allOf []*openapi3.SchemaRef, path []string, tag string) (string, error) {
+func GenEsTemplateFromAllOf(allOf []*openapi3.SchemaRef, path []string, tag string, state EsState) (string, error) {
 	// Start out with "properties": {
 	if tag != "" {
 		return fmt.Sprintf(`"type": "%s"`, "nested"), nil
@@ -685,7 +725,7 @@ func GenEsTemplateFromAllOf(allOf []*openapi3.SchemaRef, path []string, tag stri
 	for _, schemaOrRef := range allOf {
 		// Inline all the fields from the schema into the output struct,
 		// just like in the simple case of generating an object.
-		esSchema, err := GenerateEsSchema(schemaOrRef, path)
+		esSchema, err := GenerateEsSchema(schemaOrRef, path, state)
 		if err != nil {
 			return "", err
 		}
@@ -700,20 +740,18 @@ func GenEsTemplateFromAllOf(allOf []*openapi3.SchemaRef, path []string, tag stri
 
 // GenEsTemplateFromReference do create es template from $ref field
 // format will like `"properties": { "field1": { "type": "text" }, "field2": { "type": "text" } }`
-func GenEsTemplateFromReference(reference *openapi3.SchemaRef, path []string) (string, error) {
-	// fmt.Println(path, reference.Ref)
-	s, err := isDeepMapObject(reference)
-	if err != nil {
-		return "", err
-	}
-	if s != "" {
-		return s, nil
+// A reference to a schema which is already being expanded is recursive, once
+// it has been expanded state.MaxDepth times within itself, we stop there and
+// map it according to its `x-es-recursion` extension.
+func GenEsTemplateFromReference(reference *openapi3.SchemaRef, path []string, state EsState) (string, error) {
+	if state.Depth(reference.Value) > state.MaxDepth {
+		return esRecursionTemplate(reference.Value)
 	}
 	newRef := *reference
 	newRef.Ref = ""
 	// Inline all the fields from the schema into the output struct,
 	// just like in the simple case of generating an object.
-	esSchema, err := GenerateEsSchema(&newRef, path)
+	esSchema, err := GenerateEsSchema(&newRef, path, state.Push(reference.Value))
 	if err != nil {
 		return "", err
 	}
@@ -726,14 +764,14 @@ func GenEsTemplateFromReference(reference *openapi3.SchemaRef, path []string) (s
 
 // This constructs a Go type for a parameter, looking at either the schema or
 // the content, whichever is available
-func paramToGoType(param *openapi3.Parameter, path []string) (Schema, error) {
+func paramToGoType(param *openapi3.Parameter, path []string, nullables *nullableTypes) (Schema, error) {
 	if param.Content == nil && param.Schema == nil {
 		return Schema{}, fmt.Errorf("parameter '%s' has no schema or content", param.Name)
 	}
 
 	// We can process the schema through the generic schema processor
 	if param.Schema != nil {
-		return GenerateGoSchema(param.Schema, path)
+		return generateGoSchema(param.Schema, path, nullables)
 	}
 
 	// At this point, we have a content type. We know how to deal with
@@ -755,7 +793,7 @@ func paramToGoType(param *openapi3.Parameter, path []string) (Schema, error) {
 	}
 
 	// For json, we go through the standard schema mechanism
-	return GenerateGoSchema(mt.Schema, path)
+	return generateGoSchema(mt.Schema, path, nullables)
 }
 
 func parseValidateRule(schema *openapi3.Schema, required bool) map[string]string {
@@ -861,61 +899,156 @@ func parseEsType(schema *openapi3.Schema) string {
 	return v
 }
 
-func isDeepMapObject(reference *openapi3.SchemaRef) (string, error) {
-	fields := []string{}
-	isCall := false
-	isInfinityObject("", reference, &fields, &isCall)
-	if isCall {
-		field := fmt.Sprintf(`"type": "%s"`, "nested")
-		return field, nil
-	}
-	return "", nil
+// EsState is threaded through the elastic search mapping generation, to
+// detect recursive schemas.
+type EsState struct {
+	// MaxDepth is how often a recursive schema is expanded within itself
+	// before we stop mapping its properties.
+	MaxDepth int
+	// Schemas holds the schemas currently being expanded, outermost first.
+	Schemas []*openapi3.Schema
+	// DynamicTemplates collects the dynamic templates of the whole mapping,
+	// which elastic search only accepts at its root.
+	DynamicTemplates *[]string
+	// Lists holds the lengths of the paths of the arrays being expanded.
+	Lists []int
+	// Processors collects the ingest processors of the whole mapping.
+	Processors *[]EsProcessor
+}
+
+// Push returns a copy of the state, with schema being expanded.
+func (s EsState) Push(schema *openapi3.Schema) EsState {
+	schemas := make([]*openapi3.Schema, len(s.Schemas), len(s.Schemas)+1)
+	copy(schemas, s.Schemas)
+	s.Schemas = append(schemas, schema)
+	return s
 }
 
-func isInfinityObject(parentTitle string, ref *openapi3.SchemaRef, refs *[]string, isRecall *bool) {
-	parentT := parentTitle
-	schema := ref.Value
-	if ref.Ref != "" && parentT != "" && strings.Contains(ref.Ref, parentT) {
-		*isRecall = true
+// List returns a copy of the state, with the array at path being expanded.
+func (s EsState) List(path []string) EsState {
+	lists := make([]int, len(s.Lists), len(s.Lists)+1)
+	copy(lists, s.Lists)
+	s.Lists = append(lists, len(path))
+	return s
+}
+
+// Depth returns how often schema is already being expanded. References are
+// resolved to the same schema object by the loader, so we compare those
+// rather than reference paths.
+func (s EsState) Depth(schema *openapi3.Schema) int {
+	depth := 0
+	for _, e := range s.Schemas {
+		if e == schema {
+			depth++
+		}
 	}
+	return depth
+}
 
-	if isRecall != nil && *isRecall {
-		return
+// esRecursionTemplate returns the mapping for a recursive schema which we
+// don't expand any further, as chosen by its `x-es-recursion` extension:
+// - nested, the default, maps it as a nested field without properties
+// - object maps it as an object, leaving its properties to dynamic mapping
+// - disabled keeps it in the source without indexing it
+// - flattened indexes the whole object as a single flattened field
+func esRecursionTemplate(schema *openapi3.Schema) (string, error) {
+	recursion, err := parseEsRecursion(schema)
+	if err != nil {
+		return "", err
 	}
-	// only run one time at initial
-	if parentT == "" {
-		parentT = schema.Title
+	switch recursion {
+	case "", "nested":
+		return `"type": "nested"`, nil
+	case "object":
+		return `"type": "object"`, nil
+	case "disabled":
+		return `"type": "object", "enabled": false`, nil
+	case "flattened":
+		return `"type": "flattened"`, nil
+	default:
+		return "", fmt.Errorf("invalid x-es-recursion value: %s", recursion)
 	}
-	switch schema.Type {
-	case "", "object":
-		if len(schema.Properties) == 0 {
-			return
-		}
-		// We've got an object with some properties.
-		for _, pName := range SortedSchemaKeys(schema.Properties) {
-			p := schema.Properties[pName]
-			isInfinityObject(parentT, p, refs, isRecall)
-		}
-		if schema.AdditionalProperties != nil {
-			isInfinityObject(parentT, schema.AdditionalProperties, refs, isRecall)
-		}
-	case "array":
-		isInfinityObject(parentT, schema.Items, refs, isRecall)
+}
+
+func parseEsRecursion(schema *openapi3.Schema) (string, error) {
+	ext, found := schema.Extensions["x-es-recursion"]
+	if !found {
+		return "", nil
 	}
-	return
+	var v string
+	if err := json.Unmarshal(ext.(json.RawMessage), &v); err != nil {
+		return "", errors.Wrap(err, "error decoding x-es-recursion")
+	}
+	return v, nil
 }
 
-func isDuplicate(refs []string) bool {
-	// Use map to record duplicates as we find them.
-	keys := map[string]struct{}{}
+// esDynamicTypes are the mappings for additional properties of a plain type,
+// without an `x-es-tag`.
+var esDynamicTypes = map[string]string{
+	"string":  `"type": "keyword"`,
+	"integer": `"type": "long"`,
+	"number":  `"type": "double"`,
+	"boolean": `"type": "boolean"`,
+}
 
-	for _, entry := range refs {
-		_, ok := keys[entry]
-		if !ok {
-			keys[entry] = struct{}{}
-		} else {
-			return true
+// addEsDynamicTemplate adds a dynamic template mapping every additional
+// property of the object at path according to the additionalProperties
+// schema. The template is named after the path it matches, eg. labels.*
+func addEsDynamicTemplate(sref *openapi3.SchemaRef, path []string, state EsState) error {
+	if state.DynamicTemplates == nil {
+		return nil
+	}
+	valuePath := append(append([]string{}, path...), "*")
+	esSchema, err := GenerateEsSchema(sref, valuePath, state)
+	if err != nil {
+		return err
+	}
+	mapping := esSchema.EsTemplateDecl()
+	if mapping == "" && sref.Value != nil {
+		mapping = esDynamicTypes[sref.Value.Type]
+	}
+	if mapping == "" {
+		return nil
+	}
+
+	// The first element of the path is the name of the mapping itself.
+	fieldPath := valuePath[1:]
+	pathMatch := strings.Join(fieldPath, ".")
+	for _, t := range *state.DynamicTemplates {
+		if strings.HasPrefix(t, fmt.Sprintf(`{"%s":`, pathMatch)) {
+			return nil
 		}
 	}
-	return false
+	// Additional properties of the mapping itself only match its top level
+	// fields.
+	unmatch := ""
+	if len(fieldPath) == 1 {
+		unmatch = `, "path_unmatch": "*.*"`
+	}
+	*state.DynamicTemplates = append(*state.DynamicTemplates,
+		fmt.Sprintf(`{"%s": {"path_match": "%s"%s, "mapping": {%s}}}`, pathMatch, pathMatch, unmatch, mapping))
+	return nil
+}
+
+// parseEsDynamic returns the value of the `dynamic` mapping parameter set by
+// the `x-es-dynamic` extension of an object schema, encoded as JSON.
+func parseEsDynamic(schema *openapi3.Schema) (string, error) {
+	ext, found := schema.Extensions["x-es-dynamic"]
+	if !found {
+		return "", nil
+	}
+	var v interface{}
+	if err := json.Unmarshal(ext.(json.RawMessage), &v); err != nil {
+		return "", errors.Wrap(err, "error decoding x-es-dynamic")
+	}
+	switch v {
+	case true, "true":
+		return "true", nil
+	case false, "false":
+		return "false", nil
+	case "strict", "runtime":
+		return fmt.Sprintf(`"%s"`, v), nil
+	default:
+		return "", fmt.Errorf("invalid x-es-dynamic value: %v", v)
+	}
 }
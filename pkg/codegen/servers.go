@@ -0,0 +1,98 @@
+package codegen
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"unicode"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// ServerDefinition describes one of the `servers` of the spec, or of an
+// operation. Their URLs may hold variables, eg:
+//
+//	servers:
+//	  - url: https://{region}.api.example.com/{basePath}
+//	    description: Production
+//	    variables:
+//	      region:
+//	        enum: [eu, us]
+//	        default: eu
+//	      basePath:
+//	        default: v1
+type ServerDefinition struct {
+	Name          string // Name of the server in generated code, from its description or its index
+	URL           string // The URL template, eg. https://{region}.api.example.com
+	Description   string
+	Variables     []ServerVariableDefinition // The variables of the URL, sorted by name
+	VariablesType string                     // The Go type holding its variables, if it has any
+}
+
+// ServerVariableDefinition describes a variable of a server URL.
+type ServerVariableDefinition struct {
+	Name        string   // The name of the variable, as in the URL
+	Default     string   // Its default value, which may be empty when it has none
+	Enum        []string // The values it may take, any when empty
+	Description string
+}
+
+// Label returns how generated comments refer to the server.
+func (s ServerDefinition) Label() string {
+	if _, err := strconv.Atoi(s.Name); err == nil {
+		return "server " + s.Name
+	}
+	return "the " + s.Name + " server"
+}
+
+// GoName returns the name of the field holding the variable.
+func (v ServerVariableDefinition) GoName() string {
+	return SchemaNameToTypeName(v.Name)
+}
+
+// DescribeServers describes the given servers, naming them after their
+// description, or after their position in the list when that doesn't make
+// a distinct Go name.
+func DescribeServers(servers openapi3.Servers) ([]ServerDefinition, error) {
+	var out []ServerDefinition
+	names := map[string]bool{}
+	for i, server := range servers {
+		def := ServerDefinition{
+			Name:        ToCamelCase(server.Description),
+			URL:         server.URL,
+			Description: server.Description,
+		}
+		if def.Name == "" || unicode.IsDigit([]rune(def.Name)[0]) || names[def.Name] {
+			def.Name = strconv.Itoa(i)
+		}
+		names[def.Name] = true
+
+		variableNames := make([]string, 0, len(server.Variables))
+		for name := range server.Variables {
+			variableNames = append(variableNames, name)
+		}
+		sort.Strings(variableNames)
+		for _, name := range variableNames {
+			variable := server.Variables[name]
+			v := ServerVariableDefinition{
+				Name:        name,
+				Description: variable.Description,
+			}
+			if variable.Default != nil {
+				v.Default = fmt.Sprint(variable.Default)
+			}
+			for _, e := range variable.Enum {
+				v.Enum = append(v.Enum, fmt.Sprint(e))
+			}
+			if v.Default != "" && len(v.Enum) != 0 && !StringInArray(v.Default, v.Enum) {
+				return nil, fmt.Errorf("default of variable %s of server %s isn't one of its enum", name, server.URL)
+			}
+			def.Variables = append(def.Variables, v)
+		}
+		if len(def.Variables) != 0 {
+			def.VariablesType = "Server" + def.Name + "Variables"
+		}
+		out = append(out, def)
+	}
+	return out, nil
+}
@@ -0,0 +1,44 @@
+package codegen
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDescribeServers(t *testing.T) {
+	servers, err := DescribeServers(openapi3.Servers{
+		{URL: "https://{region}.example.com", Description: "production", Variables: map[string]*openapi3.ServerVariable{
+			"region": {Enum: []interface{}{"eu", "us"}, Default: "eu"},
+			"port":   {Default: float64(443)},
+		}},
+		{URL: "https://staging.example.com", Description: "Production"},
+		{URL: "http://localhost", Description: "2nd"},
+		{URL: "http://localhost:8080"},
+	})
+	require.NoError(t, err)
+	require.Len(t, servers, 4)
+
+	// Servers are named after their description, unless it isn't a distinct
+	// Go name
+	assert.Equal(t, "Production", servers[0].Name)
+	assert.Equal(t, "1", servers[1].Name)
+	assert.Equal(t, "2", servers[2].Name)
+	assert.Equal(t, "3", servers[3].Name)
+
+	assert.Equal(t, "ServerProductionVariables", servers[0].VariablesType)
+	assert.Empty(t, servers[1].VariablesType)
+	assert.Equal(t, []ServerVariableDefinition{
+		{Name: "port", Default: "443"},
+		{Name: "region", Default: "eu", Enum: []string{"eu", "us"}},
+	}, servers[0].Variables)
+
+	_, err = DescribeServers(openapi3.Servers{
+		{URL: "https://{region}.example.com", Variables: map[string]*openapi3.ServerVariable{
+			"region": {Enum: []interface{}{"eu", "us"}, Default: "ap"},
+		}},
+	})
+	assert.EqualError(t, err, "default of variable region of server https://{region}.example.com isn't one of its enum")
+}
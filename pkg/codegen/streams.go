@@ -0,0 +1,85 @@
+package codegen
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// The media types of the responses which the client can stream, in order of
+// preference.
+var contentTypesStream = []string{
+	"text/event-stream",
+	"application/x-ndjson",
+	"application/octet-stream",
+}
+
+// StreamDefinition describes a success response of an operation which the
+// generated client reads as a stream, rather than all at once.
+type StreamDefinition struct {
+	ContentType string // The media type of the response
+	ItemType    string // The Go type of the lines of NDJSON, or of the data of events
+	JSONData    bool   // Whether the data of events is JSON, rather than a string
+}
+
+// IsEventStream tells whether the stream is made of Server-Sent Events.
+func (s StreamDefinition) IsEventStream() bool {
+	return s.ContentType == "text/event-stream"
+}
+
+// IsNDJSON tells whether the stream is newline delimited JSON.
+func (s StreamDefinition) IsNDJSON() bool {
+	return s.ContentType == "application/x-ndjson"
+}
+
+// DescribeStream returns the stream of the first success response of an
+// operation with a streamed media type, or nil when there is none.
+func DescribeStream(op *OperationDefinition) (*StreamDefinition, error) {
+	for _, responseName := range SortedResponsesKeys(op.Spec.Responses) {
+		responseRef := op.Spec.Responses[responseName]
+		if !isSuccessStatus(responseName) || responseRef.Value == nil {
+			continue
+		}
+		for _, contentType := range contentTypesStream {
+			mediaType, found := responseRef.Value.Content[contentType]
+			if !found {
+				continue
+			}
+
+			stream := StreamDefinition{
+				ContentType: contentType,
+				ItemType:    "string",
+			}
+			if contentType == "application/octet-stream" || mediaType.Schema == nil {
+				if stream.IsNDJSON() {
+					stream.ItemType = "interface{}"
+				}
+				return &stream, nil
+			}
+			if stream.IsEventStream() && mediaType.Schema.Value.Type == "string" {
+				return &stream, nil
+			}
+
+			itemSchema, err := generateGoSchema(mediaType.Schema, nil, op.nullables)
+			if err != nil {
+				return nil, errors.Wrap(err, fmt.Sprintf("error generating type of the %s stream", contentType))
+			}
+			stream.ItemType = itemSchema.TypeDecl()
+			stream.JSONData = true
+			return &stream, nil
+		}
+	}
+	return nil, nil
+}
+
+// OperationsWithStreams returns the operations which have a streamed
+// response.
+func OperationsWithStreams(ops []OperationDefinition) []OperationDefinition {
+	var out []OperationDefinition
+	for _, op := range ops {
+		if op.Stream != nil {
+			out = append(out, op)
+		}
+	}
+	return out
+}
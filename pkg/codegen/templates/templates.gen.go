@@ -80,33 +80,109 @@ func Handler(si ServerInterface) http.Handler {
 
 // HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
 func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-{{range .}}r.Group(func(r chi.Router) {
-  r.Use({{.OperationId}}Ctx)
-  r.{{.Method | lower | title }}("{{.Path | swaggerUriToChiUri}}", si.{{.OperationId}})
-})
-{{end}}
+  RegisterHandlersWithOptions(r, si, RegisterOptions{})
   return r
 }
+
+// RegisterOptions configures the middlewares and the error handling of the
+// routes added by RegisterHandlersWithOptions.
+type RegisterOptions struct {
+  // The middlewares of operations, by operation ID.
+  OperationMiddlewares map[string][]func(http.Handler) http.Handler
+
+  // The middlewares of the operations with a tag, by tag.
+  TagMiddlewares map[string][]func(http.Handler) http.Handler
+
+  // The ErrorHandlerFunc of the ServerInterfaceWrapper.
+  ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// RegisterHandlersWithOptions adds each server route to the router, with the
+// middlewares of the tags of its operation, in the order of the tags, and then
+// those of the operation. The OperationInfo of the operation is put into the
+// context of the request before any of them runs.
+func RegisterHandlersWithOptions(r chi.Router, si ServerInterface, opts RegisterOptions) {
+  wrapper := ServerInterfaceWrapper{
+    Handler:          si,
+    ErrorHandlerFunc: opts.ErrorHandlerFunc,
+  }
+{{range .}}  r.With(opts.middlewares("{{.OperationId}}")...).{{.Method | lower | title }}("{{.Path | swaggerUriToChiUri}}", wrapper.{{.OperationId}})
+{{end}}
+}
+
+// middlewares returns the middlewares of the route of an operation.
+func (opts RegisterOptions) middlewares(operationId string) []func(http.Handler) http.Handler {
+  info := Operations[operationId]
+  middlewares := []func(http.Handler) http.Handler{
+    func(next http.Handler) http.Handler {
+      return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        next.ServeHTTP(w, r.WithContext(ContextWithOperationInfo(r.Context(), info)))
+      })
+    },
+  }
+  for _, tag := range info.Tags {
+    middlewares = append(middlewares, opts.TagMiddlewares[tag]...)
+  }
+  return append(middlewares, opts.OperationMiddlewares[operationId]...)
+}
 `,
 	"chi-interface.tmpl": `type ServerInterface interface {
 {{range .}}// {{.Summary | stripNewLines }} ({{.Method}} {{.Path}})
-{{.OperationId}}(w http.ResponseWriter, r *http.Request)
+{{.OperationId}}(w http.ResponseWriter, r *http.Request{{genParamArgs .PathParams}}{{if .RequiresParamObject}}, params {{.OperationId}}Params{{end}})
 {{end}}
 }
 `,
-	"chi-middleware.tmpl": `
-{{range .}}{{$opid := .OperationId}}
+	"chi-middleware.tmpl": `// contextKey is the type of the keys of the values put into request contexts,
+// so that they can't collide with those of other packages.
+type contextKey string
+
+// ScopesFromContext returns the scopes of the security provider named
+// providerName which the operation of the request requires.
+func ScopesFromContext(ctx context.Context, providerName string) ([]string, bool) {
+  scopes, ok := ctx.Value(contextKey(providerName + ".Scopes")).([]string)
+  return scopes, ok
+}
+
+// ServerInterfaceWrapper converts requests to parameters.
+type ServerInterfaceWrapper struct {
+  Handler ServerInterface
+
+  // ErrorHandlerFunc answers requests whose parameters can't be bound, with
+  // their InvalidParamFormatError, RequiredParamError, UnmarshalingParamError
+  // or TooManyValuesForParamError, of the runtime package. They are answered
+  // with a 400 plain text error if it is nil.
+  ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
 
+// handleError answers a request whose parameters can't be bound.
+func (siw *ServerInterfaceWrapper) handleError(w http.ResponseWriter, r *http.Request, err error) {
+  if siw.ErrorHandlerFunc != nil {
+    siw.ErrorHandlerFunc(w, r, err)
+    return
+  }
+  http.Error(w, err.Error(), http.StatusBadRequest)
+}
+
+{{range .}}{{$opid := .OperationId}}
+{{range .PathParams}}
+// {{.GoName}}ParamFor{{$opid}} returns the path parameter "{{.ParamName}}" of {{$opid}} from
+// the context of its request.
+func {{.GoName}}ParamFor{{$opid}}(ctx context.Context) ({{.TypeDef}}, bool) {
+  value, ok := ctx.Value(contextKey("{{$opid}}.{{.ParamName}}")).({{.TypeDef}})
+  return value, ok
+}
+{{end}}
 {{if .RequiresParamObject}}
-// ParamsFor{{.OperationId}} operation parameters from context
-func ParamsFor{{.OperationId}}(ctx context.Context) *{{.OperationId}}Params {
-  return ctx.Value("{{.OperationId}}Params").(*{{.OperationId}}Params)
+// ParamsFor{{$opid}} returns the parameters of {{$opid}} from the context of
+// its request.
+func ParamsFor{{$opid}}(ctx context.Context) ({{$opid}}Params, bool) {
+  params, ok := ctx.Value(contextKey("{{$opid}}Params")).({{$opid}}Params)
+  return params, ok
 }
 {{end}}
 
-// {{$opid}} operation middleware
-func {{$opid}}Ctx(next http.Handler) http.Handler {
-  return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+// {{$opid}} converts the request to params.
+func (siw *ServerInterfaceWrapper) {{$opid}}(w http.ResponseWriter, r *http.Request) {
     ctx := r.Context()
     {{if or .RequiresParamObject (gt (len .PathParams) 0) }}
     var err error
@@ -121,23 +197,23 @@ func {{$opid}}Ctx(next http.Handler) http.Handler {
     {{if .IsJson}}
     err = json.Unmarshal([]byte(chi.URLParam(r, "{{.ParamName}}")), &{{$varName}})
     if err != nil {
-      http.Error(w, "Error unmarshaling parameter '{{.ParamName}}' as JSON", http.StatusBadRequest)
+      siw.handleError(w, r, &runtime.UnmarshalingParamError{ParamName: "{{.ParamName}}", ParamLocation: runtime.ParamLocationPath, Err: err})
       return
     }
     {{end}}
     {{if .IsStyled}}
-    err = runtime.BindStyledParameter("{{.Style}}",{{.Explode}}, "{{.ParamName}}", chi.URLParam(r, "{{.ParamName}}"), &{{$varName}})
+    {{if .Binding}}err = {{.Binding.FuncName}}(chi.URLParam(r, "{{.ParamName}}"), &{{$varName}}){{else}}err = runtime.BindStyledParameter("{{.Style}}",{{.Explode}}, "{{.ParamName}}", chi.URLParam(r, "{{.ParamName}}"), &{{$varName}}){{end}}
     if err != nil {
-      http.Error(w, fmt.Sprintf("Invalid format for parameter {{.ParamName}}: %s", err), http.StatusBadRequest)
+      siw.handleError(w, r, runtime.ParamError(runtime.ParamLocationPath, "{{.ParamName}}", err))
       return
     }
     {{end}}
 
-    ctx = context.WithValue(ctx, "{{$varName}}", {{$varName}})
+    ctx = context.WithValue(ctx, contextKey("{{$opid}}.{{.ParamName}}"), {{$varName}})
     {{end}}
 
 {{range .SecurityDefinitions}}
-    ctx = context.WithValue(ctx, "{{.ProviderName}}.Scopes", {{toStringArray .Scopes}})
+    ctx = context.WithValue(ctx, contextKey("{{.ProviderName}}.Scopes"), {{toStringArray .Scopes}})
 {{end}}
 
     {{if .RequiresParamObject}}
@@ -155,20 +231,20 @@ func {{$opid}}Ctx(next http.Handler) http.Handler {
           var value {{.TypeDef}}
           err = json.Unmarshal([]byte(paramValue), &value)
           if err != nil {
-            http.Error(w, "Error unmarshaling parameter '{{.ParamName}}' as JSON", http.StatusBadRequest)
+            siw.handleError(w, r, &runtime.UnmarshalingParamError{ParamName: "{{.ParamName}}", ParamLocation: runtime.ParamLocationQuery, Err: err})
             return
           }
 
           params.{{.GoName}} = {{if not .Required}}&{{end}}value
         {{end}}
         }{{if .Required}} else {
-            http.Error(w, "Query argument {{.ParamName}} is required, but not found", http.StatusBadRequest)
+            siw.handleError(w, r, &runtime.RequiredParamError{ParamName: "{{.ParamName}}", ParamLocation: runtime.ParamLocationQuery})
             return
         }{{end}}
         {{if .IsStyled}}
-        err = runtime.BindQueryParameter("{{.Style}}", {{.Explode}}, {{.Required}}, "{{.ParamName}}", r.URL.Query(), &params.{{.GoName}})
+        {{if .Binding}}err = {{.Binding.FuncName}}(r.URL.Query(), &params.{{.GoName}}){{else}}err = runtime.BindQueryParameter("{{.Style}}", {{.Explode}}, {{.Required}}, "{{.ParamName}}", r.URL.Query(), &params.{{.GoName}}){{end}}
         if err != nil {
-          http.Error(w, fmt.Sprintf("Invalid format for parameter {{.ParamName}}: %s", err), http.StatusBadRequest)
+          siw.handleError(w, r, runtime.ParamError(runtime.ParamLocationQuery, "{{.ParamName}}", err))
           return
         }
         {{end}}
@@ -178,11 +254,18 @@ func {{$opid}}Ctx(next http.Handler) http.Handler {
         headers := r.Header
 
         {{range .HeaderParams}}// ------------- {{if .Required}}Required{{else}}Optional{{end}} header parameter "{{.ParamName}}" -------------
+        {{if .IsStyled}}
+          {{if .Binding}}err = {{.Binding.FuncName}}(headers, &params.{{.GoName}}){{else}}err = runtime.BindHeaderParameter("{{.Style}}", {{.Explode}}, {{.Required}}, "{{.ParamName}}", headers, &params.{{.GoName}}){{end}}
+          if err != nil {
+            siw.handleError(w, r, runtime.ParamError(runtime.ParamLocationHeader, "{{.ParamName}}", err))
+            return
+          }
+        {{else}}
           if valueList, found := headers[http.CanonicalHeaderKey("{{.ParamName}}")]; found {
             var {{.GoName}} {{.TypeDef}}
             n := len(valueList)
             if n != 1 {
-              http.Error(w, fmt.Sprintf("Expected one value for {{.ParamName}}, got %d", n), http.StatusBadRequest)
+              siw.handleError(w, r, &runtime.TooManyValuesForParamError{ParamName: "{{.ParamName}}", ParamLocation: runtime.ParamLocationHeader, Count: n})
               return
             }
 
@@ -193,15 +276,7 @@ func {{$opid}}Ctx(next http.Handler) http.Handler {
           {{if .IsJson}}
             err = json.Unmarshal([]byte(valueList[0]), &{{.GoName}})
             if err != nil {
-              http.Error(w, "Error unmarshaling parameter '{{.ParamName}}' as JSON", http.StatusBadRequest)
-              return
-            }
-          {{end}}
-
-          {{if .IsStyled}}
-            err = runtime.BindStyledParameter("{{.Style}}",{{.Explode}}, "{{.ParamName}}", valueList[0], &{{.GoName}})
-            if err != nil {
-              http.Error(w, fmt.Sprintf("Invalid format for parameter {{.ParamName}}: %s", err), http.StatusBadRequest)
+              siw.handleError(w, r, &runtime.UnmarshalingParamError{ParamName: "{{.ParamName}}", ParamLocation: runtime.ParamLocationHeader, Err: err})
               return
             }
           {{end}}
@@ -209,14 +284,22 @@ func {{$opid}}Ctx(next http.Handler) http.Handler {
             params.{{.GoName}} = {{if not .Required}}&{{end}}{{.GoName}}
 
           } {{if .Required}}else {
-              http.Error(w, fmt.Sprintf("Header parameter {{.ParamName}} is required, but not found: %s", err), http.StatusBadRequest)
+              siw.handleError(w, r, &runtime.RequiredParamError{ParamName: "{{.ParamName}}", ParamLocation: runtime.ParamLocationHeader})
               return
           }{{end}}
+        {{end}}
 
         {{end}}
       {{end}}
 
       {{range .CookieParams}}
+        {{- if .IsStyled}}
+          {{if .Binding}}err = {{.Binding.FuncName}}(r.Cookies(), &params.{{.GoName}}){{else}}err = runtime.BindCookieParameter("{{.Style}}", {{.Explode}}, {{.Required}}, "{{.ParamName}}", r.Cookies(), &params.{{.GoName}}){{end}}
+          if err != nil {
+            siw.handleError(w, r, runtime.ParamError(runtime.ParamLocationCookie, "{{.ParamName}}", err))
+            return
+          }
+        {{else}}
         if cookie, err := r.Cookie("{{.ParamName}}"); err == nil {
 
         {{- if .IsPassThrough}}
@@ -228,376 +311,1649 @@ func {{$opid}}Ctx(next http.Handler) http.Handler {
           var decoded string
           decoded, err := url.QueryUnescape(cookie.Value)
           if err != nil {
-            http.Error(w, "Error unescaping cookie parameter '{{.ParamName}}'", http.StatusBadRequest)
+            siw.handleError(w, r, &runtime.InvalidParamFormatError{ParamName: "{{.ParamName}}", ParamLocation: runtime.ParamLocationCookie, Err: err})
             return
           }
 
           err = json.Unmarshal([]byte(decoded), &value)
           if err != nil {
-            http.Error(w, "Error unmarshaling parameter '{{.ParamName}}' as JSON", http.StatusBadRequest)
+            siw.handleError(w, r, &runtime.UnmarshalingParamError{ParamName: "{{.ParamName}}", ParamLocation: runtime.ParamLocationCookie, Err: err})
             return
           }
 
           params.{{.GoName}} = {{if not .Required}}&{{end}}value
         {{end}}
 
-        {{- if .IsStyled}}
-          var value {{.TypeDef}}
-          err = runtime.BindStyledParameter("simple",{{.Explode}}, "{{.ParamName}}", cookie.Value, &value)
-          if err != nil {
-            http.Error(w, "Invalid format for parameter {{.ParamName}}: %s", http.StatusBadRequest)
-            return
-          }
-          params.{{.GoName}} = {{if not .Required}}&{{end}}value
-        {{end}}
-
         }
 
         {{- if .Required}} else {
-          http.Error(w, "Query argument {{.ParamName}} is required, but not found", http.StatusBadRequest)
+          siw.handleError(w, r, &runtime.RequiredParamError{ParamName: "{{.ParamName}}", ParamLocation: runtime.ParamLocationCookie})
           return
         }
         {{- end}}
+        {{end}}
       {{end}}
 
-      ctx = context.WithValue(ctx, "{{.OperationId}}Params", &params)
+      ctx = context.WithValue(ctx, contextKey("{{$opid}}Params"), params)
     {{end}}
-    next.ServeHTTP(w, r.WithContext(ctx))
-  })
+    siw.Handler.{{$opid}}(w, r.WithContext(ctx){{genParamNames .PathParams}}{{if .RequiresParamObject}}, params{{end}})
 }
 {{end}}
+`,
+	"client-fake.tmpl": `// FakeTestingT is the part of testing.TB which the fake client reports failed
+// assertions to.
+type FakeTestingT interface {
+    Helper()
+    Errorf(format string, args ...interface{})
+}
 
+// FakeCall is a call made to the fake client.
+type FakeCall struct {
+    Operation   string        // The operation ID
+    PathParams  []interface{} // The path parameters, in order
+    Params      interface{}   // The parameters object, when the operation has one
+    ContentType string        // The media type of the body, when there is one
+    Body        interface{}   // The body, as a []byte when given as an io.Reader
+}
 
+func (c FakeCall) String() string {
+    args := make([]string, 0, len(c.PathParams)+1)
+    for _, p := range c.PathParams {
+        args = append(args, fmt.Sprintf("%v", p))
+    }
+    if params := reflect.ValueOf(c.Params); params.Kind() == reflect.Ptr && !params.IsNil() {
+        args = append(args, fmt.Sprintf("%+v", params.Elem().Interface()))
+    }
+    return fmt.Sprintf("%s(%s)", c.Operation, strings.Join(args, ", "))
+}
 
-`,
-	"client-with-responses.tmpl": `// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-    ClientInterface
+// UnexpectedCallError is returned by the fake client for the calls which no
+// stub answers.
+type UnexpectedCallError struct {
+    Call FakeCall
 }
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-    client, err := NewClient(server, opts...)
-    if err != nil {
-        return nil, err
-    }
-    return &ClientWithResponses{client}, nil
+func (e *UnexpectedCallError) Error() string {
+    return fmt.Sprintf("unexpected call to %s", e.Call)
 }
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
-	}
+// FakeClientWithResponses is an in-memory ClientWithResponsesInterface for
+// tests. Calls are answered by the latest stub matching them, see the On
+// methods, and fail with an *UnexpectedCallError when there is none. Stubs are
+// meant to be set up before the calls, which may be concurrent.
+type FakeClientWithResponses struct {
+    mu    sync.Mutex
+    stubs []*fakeStub
+    calls []FakeCall
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-{{range . -}}
-{{$hasParams := .RequiresParamObject -}}
-{{$pathParams := .PathParams -}}
-{{$opid := .OperationId -}}
-    // {{$opid}} request {{if .HasBody}} with any body{{end}}
-    {{$opid}}{{if .HasBody}}WithBody{{end}}WithResponse(ctx context.Context{{genParamArgs .PathParams}}{{if .RequiresParamObject}}, params *{{$opid}}Params{{end}}{{if .HasBody}}, contentType string, body io.Reader{{end}}) (*{{genResponseTypeName $opid}}, error)
-{{range .Bodies}}
-    {{$opid}}{{.Suffix}}WithResponse(ctx context.Context{{genParamArgs $pathParams}}{{if $hasParams}}, params *{{$opid}}Params{{end}}, body {{$opid}}{{.NameTag}}RequestBody) (*{{genResponseTypeName $opid}}, error)
-{{end}}{{/* range .Bodies */}}
-{{end}}{{/* range . $opid := .OperationId */}}
+var _ ClientWithResponsesInterface = (*FakeClientWithResponses)(nil)
+
+// NewFakeClientWithResponses returns a fake client without any stub.
+func NewFakeClientWithResponses() *FakeClientWithResponses {
+    return &FakeClientWithResponses{}
 }
 
-{{range .}}{{$opid := .OperationId}}{{$op := .}}
-type {{$opid | ucFirst}}Response struct {
-    Body         []byte
-	HTTPResponse *http.Response
-    {{- range getResponseTypeDefinitions .}}
-    {{.TypeName}} *{{.Schema.TypeDecl}}
-    {{- end}}
+// Calls returns the calls made so far, in order.
+func (f *FakeClientWithResponses) Calls() []FakeCall {
+    f.mu.Lock()
+    defer f.mu.Unlock()
+    return append([]FakeCall(nil), f.calls...)
 }
 
-// Status returns HTTPResponse.Status
-func (r {{$opid | ucFirst}}Response) Status() string {
-    if r.HTTPResponse != nil {
-        return r.HTTPResponse.Status
+// CallsTo returns the calls made so far to the given operation, in order.
+func (f *FakeClientWithResponses) CallsTo(operation string) []FakeCall {
+    var calls []FakeCall
+    for _, call := range f.Calls() {
+        if call.Operation == operation {
+            calls = append(calls, call)
+        }
     }
-    return http.StatusText(0)
+    return calls
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r {{$opid | ucFirst}}Response) StatusCode() int {
-    if r.HTTPResponse != nil {
-        return r.HTTPResponse.StatusCode
+// AssertCalled checks that the given operation was called, with the given path
+// parameters when there are any.
+func (f *FakeClientWithResponses) AssertCalled(t FakeTestingT, operation string, pathParams ...interface{}) bool {
+    t.Helper()
+    calls := f.CallsTo(operation)
+    for _, call := range calls {
+        if len(pathParams) == 0 || reflect.DeepEqual(pathParams, call.PathParams) {
+            return true
+        }
     }
-    return 0
+    if len(calls) == 0 {
+        t.Errorf("%s wasn't called", operation)
+    } else {
+        t.Errorf("%s wasn't called with %v, but: %v", operation, pathParams, calls)
+    }
+    return false
 }
-{{end}}
-
-
-{{range .}}
-{{$opid := .OperationId -}}
-{{/* Generate client methods (with responses)*/}}
 
-// {{$opid}}{{if .HasBody}}WithBody{{end}}WithResponse request{{if .HasBody}} with arbitrary body{{end}} returning *{{$opid}}Response
-func (c *ClientWithResponses) {{$opid}}{{if .HasBody}}WithBody{{end}}WithResponse(ctx context.Context{{genParamArgs .PathParams}}{{if .RequiresParamObject}}, params *{{$opid}}Params{{end}}{{if .HasBody}}, contentType string, body io.Reader{{end}}) (*{{genResponseTypeName $opid}}, error){
-    rsp, err := c.{{$opid}}{{if .HasBody}}WithBody{{end}}(ctx{{genParamNames .PathParams}}{{if .RequiresParamObject}}, params{{end}}{{if .HasBody}}, contentType, body{{end}})
-    if err != nil {
-        return nil, err
+// AssertNotCalled checks that the given operation wasn't called.
+func (f *FakeClientWithResponses) AssertNotCalled(t FakeTestingT, operation string) bool {
+    t.Helper()
+    if calls := f.CallsTo(operation); len(calls) > 0 {
+        t.Errorf("%s was called: %v", operation, calls)
+        return false
     }
-    return Parse{{genResponseTypeName $opid | ucFirst}}(rsp)
+    return true
 }
 
-{{$hasParams := .RequiresParamObject -}}
-{{$pathParams := .PathParams -}}
-{{$bodyRequired := .BodyRequired -}}
-{{range .Bodies}}
-func (c *ClientWithResponses) {{$opid}}{{.Suffix}}WithResponse(ctx context.Context{{genParamArgs $pathParams}}{{if $hasParams}}, params *{{$opid}}Params{{end}}, body {{$opid}}{{.NameTag}}RequestBody) (*{{genResponseTypeName $opid}}, error) {
-    rsp, err := c.{{$opid}}{{.Suffix}}(ctx{{genParamNames $pathParams}}{{if $hasParams}}, params{{end}}, body)
-    if err != nil {
-        return nil, err
+// AssertExpectations checks that every stub answered a call, as many times as
+// it was given with Times.
+func (f *FakeClientWithResponses) AssertExpectations(t FakeTestingT) bool {
+    t.Helper()
+    f.mu.Lock()
+    defer f.mu.Unlock()
+    ok := true
+    for _, s := range f.stubs {
+        switch {
+        case s.times > 0 && s.calls != s.times:
+            t.Errorf("%s was called %d times, instead of %d", s.call, s.calls, s.times)
+            ok = false
+        case s.calls == 0:
+            t.Errorf("%s wasn't called", s.call)
+            ok = false
+        }
     }
-    return Parse{{genResponseTypeName $opid | ucFirst}}(rsp)
+    return ok
 }
-{{end}}
 
-{{end}}{{/* operations */}}
-
-{{/* Generate parse functions for responses*/}}
-{{range .}}{{$opid := .OperationId}}
+// stub adds a stub answering the calls matching the given one.
+func (f *FakeClientWithResponses) stub(call FakeCall) *fakeStub {
+    f.mu.Lock()
+    defer f.mu.Unlock()
+    s := &fakeStub{call: call}
+    f.stubs = append(f.stubs, s)
+    return s
+}
 
-// Parse{{genResponseTypeName $opid | ucFirst}} parses an HTTP response from a {{$opid}}WithResponse call
-func Parse{{genResponseTypeName $opid | ucFirst}}(rsp *http.Response) (*{{genResponseTypeName $opid}}, error) {
-    bodyBytes, err := ioutil.ReadAll(rsp.Body)
-    defer rsp.Body.Close()
-    if err != nil {
-        return nil, err
+// do records a call, and returns the answer of the latest stub matching it.
+func (f *FakeClientWithResponses) do(call FakeCall) (interface{}, error) {
+    f.mu.Lock()
+    defer f.mu.Unlock()
+    f.calls = append(f.calls, call)
+    for i := len(f.stubs) - 1; i >= 0; i-- {
+        s := f.stubs[i]
+        if !s.matches(call) {
+            continue
+        }
+        s.calls++
+        if s.err != nil {
+            return nil, s.err
+        }
+        if s.response == nil {
+            return nil, fmt.Errorf("the stub of %s returns nothing", s.call)
+        }
+        return s.response, nil
     }
-
-    response := {{genResponsePayload $opid}}
-
-    {{genResponseUnmarshal .}}
-
-    return response, nil
+    return nil, &UnexpectedCallError{Call: call}
 }
-{{end}}{{/* range . $opid := .OperationId */}}
-
-`,
-	"client.tmpl": `// RequestEditorFn  is the function signature for the RequestEditor callback function
-type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
-// Doer performs HTTP requests.
-//
-// The standard http.Client implements this interface.
-type HttpRequestDoer interface {
-	Do(req *http.Request) (*http.Response, error)
+// fakeStub answers the calls matching its call, which matches any parameters
+// or body unless given.
+type fakeStub struct {
+    call      FakeCall
+    hasParams bool
+    hasBody   bool
+    response  interface{}
+    err       error
+    times     int // How many calls the stub answers, any number when 0
+    calls     int
 }
 
-// Client which conforms to the OpenAPI3 specification for this service.
-type Client struct {
-	// The endpoint of the server conforming to this interface, with scheme,
-	// https://api.deepmap.com for example.
-	Server string
-
-	// Doer for performing requests, typically a *http.Client with any
-	// customized settings, such as certificate chains.
-	Client HttpRequestDoer
-
-	// A callback for modifying requests which are generated before sending over
-	// the network.
-	RequestEditor RequestEditorFn
+func (s *fakeStub) matches(call FakeCall) bool {
+    switch {
+    case s.call.Operation != call.Operation || !reflect.DeepEqual(s.call.PathParams, call.PathParams):
+        return false
+    case s.hasParams && !reflect.DeepEqual(s.call.Params, call.Params):
+        return false
+    case s.hasBody && (s.call.ContentType != call.ContentType || !reflect.DeepEqual(s.call.Body, call.Body)):
+        return false
+    }
+    return s.times == 0 || s.calls < s.times
 }
 
-// ClientOption allows setting custom parameters during construction
-type ClientOption func(*Client) error
-
-// Creates a new Client, with reasonable defaults
-func NewClient(server string, opts ...ClientOption) (*Client, error) {
-    // create a client with sane default values
-    client := Client{
-        Server: server,
-    }
-    // mutate client and add all optional params
-    for _, o := range opts {
-        if err := o(&client); err != nil {
-            return nil, err
-        }
+// returnPayload answers with the given status code, and the encoded payload.
+func (s *fakeStub) returnPayload(statusCode int, contentType string, payload interface{}, marshal func(interface{}) ([]byte, error), response func(body []byte, rsp *http.Response) interface{}) {
+    body, err := marshal(payload)
+    if err != nil {
+        s.err = err
+        return
     }
-    // ensure the server URL always has a trailing slash
-    if !strings.HasSuffix(client.Server, "/") {
-        client.Server += "/"
+    s.response = response(body, fakeHTTPResponse(statusCode, contentType, body))
+}
+
+func fakeHTTPResponse(statusCode int, contentType string, body []byte) *http.Response {
+    rsp := &http.Response{
+        Status:     fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode)),
+        StatusCode: statusCode,
+        Header:     http.Header{},
+        Body:       ioutil.NopCloser(bytes.NewReader(body)),
     }
-    // create httpClient, if not already present
-    if client.Client == nil {
-        client.Client = http.DefaultClient
+    if contentType != "" {
+        rsp.Header.Set("Content-Type", contentType)
     }
-    return &client, nil
+    return rsp
 }
 
-// WithHTTPClient allows overriding the default Doer, which is
-// automatically created using http.Client. This is useful for tests.
-func WithHTTPClient(doer HttpRequestDoer) ClientOption {
-	return func(c *Client) error {
-		c.Client = doer
-		return nil
-	}
+{{range .}}{{$opid := .OperationId}}{{$hasParams := .RequiresParamObject}}{{$pathParams := .PathParams}}
+{{- $pathArgs := ""}}{{range $i, $p := .PathParams}}{{if $i}}{{$pathArgs = printf "%s, " $pathArgs}}{{end}}{{$pathArgs = printf "%s%s %s" $pathArgs $p.GoVariableName $p.TypeDef}}{{end}}
+{{- $pathValues := ""}}{{range $i, $p := .PathParams}}{{if $i}}{{$pathValues = printf "%s, " $pathValues}}{{end}}{{$pathValues = printf "%s%s" $pathValues $p.GoVariableName}}{{end}}
+// {{$opid}}Stub answers the calls to {{$opid}}, see On{{$opid}}.
+type {{$opid}}Stub struct {
+    stub *fakeStub
 }
 
-// WithRequestEditorFn allows setting up a callback function, which will be
-// called right before sending the request. This can be used to mutate the request.
-func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
-	return func(c *Client) error {
-		c.RequestEditor = fn
-		return nil
-	}
+// On{{$opid}} stubs the calls to {{$opid}}{{if .PathParams}} with the given path parameters{{end}}.
+func (f *FakeClientWithResponses) On{{$opid}}({{$pathArgs}}) *{{$opid}}Stub {
+    return &{{$opid}}Stub{f.stub(FakeCall{Operation: "{{$opid}}", PathParams: []interface{}{ {{- $pathValues -}} }})}
 }
-
-// The interface specification for the client above.
-type ClientInterface interface {
-{{range . -}}
-{{$hasParams := .RequiresParamObject -}}
-{{$pathParams := .PathParams -}}
-{{$opid := .OperationId -}}
-    // {{$opid}} request {{if .HasBody}} with any body{{end}}
-    {{$opid}}{{if .HasBody}}WithBody{{end}}(ctx context.Context{{genParamArgs $pathParams}}{{if $hasParams}}, params *{{$opid}}Params{{end}}{{if .HasBody}}, contentType string, body io.Reader{{end}}) (*http.Response, error)
-{{range .Bodies}}
-    {{$opid}}{{.Suffix}}(ctx context.Context{{genParamArgs $pathParams}}{{if $hasParams}}, params *{{$opid}}Params{{end}}, body {{$opid}}{{.NameTag}}RequestBody) (*http.Response, error)
-{{end}}{{/* range .Bodies */}}
-{{end}}{{/* range . $opid := .OperationId */}}
+{{if $hasParams}}
+// WithParams only answers the calls with the given parameters.
+func (s *{{$opid}}Stub) WithParams(params {{$opid}}Params) *{{$opid}}Stub {
+    s.stub.call.Params = &params
+    s.stub.hasParams = true
+    return s
+}
+{{end}}
+{{- if .HasBody}}
+// WithBody only answers the calls with the given body.
+func (s *{{$opid}}Stub) WithBody(contentType string, body []byte) *{{$opid}}Stub {
+    s.stub.call.ContentType, s.stub.call.Body = contentType, body
+    s.stub.hasBody = true
+    return s
+}
+{{end}}
+{{- range .Bodies}}
+// With{{.NameTag}}Body only answers the calls with the given {{.ContentType}} body.
+func (s *{{$opid}}Stub) With{{.NameTag}}Body(body {{$opid}}{{.NameTag}}RequestBody) *{{$opid}}Stub {
+    s.stub.call.ContentType, s.stub.call.Body = "{{.ContentType}}", body
+    s.stub.hasBody = true
+    return s
+}
+{{end}}
+// Times only answers the given number of calls, which AssertExpectations
+// checks.
+func (s *{{$opid}}Stub) Times(n int) *{{$opid}}Stub {
+    s.stub.times = n
+    return s
 }
 
+// Return answers with the given response.
+func (s *{{$opid}}Stub) Return(rsp *{{genResponseTypeName $opid}}) *{{$opid}}Stub {
+    s.stub.response = rsp
+    return s
+}
 
-{{/* Generate client methods */}}
-{{range . -}}
-{{$hasParams := .RequiresParamObject -}}
-{{$pathParams := .PathParams -}}
-{{$opid := .OperationId -}}
+// ReturnError fails with the given error.
+func (s *{{$opid}}Stub) ReturnError(err error) *{{$opid}}Stub {
+    s.stub.err = err
+    return s
+}
 
-func (c *Client) {{$opid}}{{if .HasBody}}WithBody{{end}}(ctx context.Context{{genParamArgs $pathParams}}{{if $hasParams}}, params *{{$opid}}Params{{end}}{{if .HasBody}}, contentType string, body io.Reader{{end}}) (*http.Response, error) {
-    req, err := New{{$opid}}Request{{if .HasBody}}WithBody{{end}}(c.Server{{genParamNames .PathParams}}{{if $hasParams}}, params{{end}}{{if .HasBody}}, contentType, body{{end}})
-    if err != nil {
-        return nil, err
-    }
-    req = req.WithContext(ctx)
-    if c.RequestEditor != nil {
-        err = c.RequestEditor(ctx, req)
-        if err != nil {
+// ReturnStatus answers with the given status code, and no body.
+func (s *{{$opid}}Stub) ReturnStatus(statusCode int) *{{$opid}}Stub {
+    s.stub.response = &{{genResponseTypeName $opid}}{HTTPResponse: fakeHTTPResponse(statusCode, "", nil)}
+    return s
+}
+{{range .Responses}}
+// {{.Method}} answers with {{if .StatusCode}}a {{.StatusCode}}{{else}}the given status code{{end}}, and the given payload.
+func (s *{{$opid}}Stub) {{.Method}}({{if not .StatusCode}}statusCode int, {{end}}payload {{.Type}}) *{{$opid}}Stub {
+    s.stub.returnPayload({{if .StatusCode}}{{.StatusCode}}{{else}}statusCode{{end}}, "{{.ContentType}}", payload, {{.Marshal}}, func(body []byte, rsp *http.Response) interface{} {
+        return &{{genResponseTypeName $opid}}{Body: body, HTTPResponse: rsp, {{.Field}}: &payload}
+    })
+    return s
+}
+{{end}}
+{{- if .HasBody}}
+// {{$opid}}WithBodyWithResponse records the call, and answers it with the latest stub matching it.
+func (f *FakeClientWithResponses) {{$opid}}WithBodyWithResponse(ctx context.Context{{genParamArgs .PathParams}}{{if $hasParams}}, params *{{$opid}}Params{{end}}, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*{{genResponseTypeName $opid}}, error) {
+    var buf []byte
+    if body != nil {
+        var err error
+        if buf, err = ioutil.ReadAll(body); err != nil {
             return nil, err
         }
     }
-    return c.Client.Do(req)
+    return f.do{{$opid}}(FakeCall{Operation: "{{$opid}}", PathParams: []interface{}{ {{- $pathValues -}} }{{if $hasParams}}, Params: params{{end}}, ContentType: contentType, Body: buf})
 }
-
-{{range .Bodies}}
-func (c *Client) {{$opid}}{{.Suffix}}(ctx context.Context{{genParamArgs $pathParams}}{{if $hasParams}}, params *{{$opid}}Params{{end}}, body {{$opid}}{{.NameTag}}RequestBody) (*http.Response, error) {
-    req, err := New{{$opid}}{{.Suffix}}Request(c.Server{{genParamNames $pathParams}}{{if $hasParams}}, params{{end}}, body)
+{{else}}
+// {{$opid}}WithResponse records the call, and answers it with the latest stub matching it.
+func (f *FakeClientWithResponses) {{$opid}}WithResponse(ctx context.Context{{genParamArgs .PathParams}}{{if $hasParams}}, params *{{$opid}}Params{{end}}, reqEditors ...RequestEditorFn) (*{{genResponseTypeName $opid}}, error) {
+    return f.do{{$opid}}(FakeCall{Operation: "{{$opid}}", PathParams: []interface{}{ {{- $pathValues -}} }{{if $hasParams}}, Params: params{{end}}})
+}
+{{end}}
+{{- range .Bodies}}
+// {{$opid}}{{.Suffix}}WithResponse records the call, and answers it with the latest stub matching it.
+func (f *FakeClientWithResponses) {{$opid}}{{.Suffix}}WithResponse(ctx context.Context{{genParamArgs $pathParams}}{{if $hasParams}}, params *{{$opid}}Params{{end}}, body {{$opid}}{{.NameTag}}RequestBody, reqEditors ...RequestEditorFn) (*{{genResponseTypeName $opid}}, error) {
+    return f.do{{$opid}}(FakeCall{Operation: "{{$opid}}", PathParams: []interface{}{ {{- $pathValues -}} }{{if $hasParams}}, Params: params{{end}}, ContentType: "{{.ContentType}}", Body: body})
+}
+{{end}}
+func (f *FakeClientWithResponses) do{{$opid}}(call FakeCall) (*{{genResponseTypeName $opid}}, error) {
+    rsp, err := f.do(call)
     if err != nil {
         return nil, err
     }
-    req = req.WithContext(ctx)
-    if c.RequestEditor != nil {
-        err = c.RequestEditor(ctx, req)
-        if err != nil {
-            return nil, err
+    return rsp.(*{{genResponseTypeName $opid}}), nil
+}
+{{end}}{{/* range . */}}
+`,
+	"client-pagination.tmpl": `{{range .}}{{$opid := .OperationId}}{{$hasParams := .RequiresParamObject}}{{$pagination := .Pagination}}{{$item := $pagination.ItemType}}
+// {{$opid}}Iterator pages through the results of {{$opid}}, see {{$opid}}Iter.
+type {{$opid}}Iterator struct {
+    fetch func() ([]{{$item}}, bool, error)
+    page  []{{$item}}
+    item  {{$item}}
+    more  bool
+    err   error
+}
+
+// Next advances the iterator to the next item, fetching the next page when
+// the current one is exhausted. It returns false once there are no more items,
+// or when a page couldn't be fetched, see Err.
+func (it *{{$opid}}Iterator) Next() bool {
+    for len(it.page) == 0 {
+        if !it.more || it.err != nil {
+            return false
         }
+        it.page, it.more, it.err = it.fetch()
     }
-    return c.Client.Do(req)
+    it.item, it.page = it.page[0], it.page[1:]
+    return true
 }
-{{end}}{{/* range .Bodies */}}
-{{end}}
 
-{{/* Generate request builders */}}
-{{range .}}
-{{$hasParams := .RequiresParamObject -}}
-{{$pathParams := .PathParams -}}
-{{$bodyRequired := .BodyRequired -}}
+// Item returns the current item.
+func (it *{{$opid}}Iterator) Item() {{$item}} {
+    return it.item
+}
+
+// Err returns the error which stopped the iteration, if any.
+func (it *{{$opid}}Iterator) Err() error {
+    return it.err
+}
+
+// {{$opid}}Iter returns an iterator over the results of {{$opid}}, requesting
+// the pages as they are needed.
+func (c *ClientWithResponses) {{$opid}}Iter(ctx context.Context{{genParamArgs .PathParams}}{{if $hasParams}}, params *{{$opid}}Params{{end}}, reqEditors ...RequestEditorFn) *{{$opid}}Iterator {
+{{- if $hasParams}}
+    var p {{$opid}}Params
+    if params != nil {
+        p = *params
+    }
+{{- end}}
+    editors := reqEditors
+{{- if eq $pagination.Strategy "cursor"}}
+    // The cursors of the pages already requested, a server handing one out
+    // again would be paged through forever.
+    seen := make(map[{{$pagination.Param.TypeDef}}]bool)
+{{- else if ne $pagination.Strategy "offset"}}
+    // The pages already requested, a server linking to one again would be
+    // paged through forever.
+    seen := make(map[string]bool)
+{{- end}}
+    it := &{{$opid}}Iterator{more: true}
+    it.fetch = func() ([]{{$item}}, bool, error) {
+        rsp, err := c.{{$opid}}WithResponse(ctx{{genParamNames .PathParams}}{{if $hasParams}}, &p{{end}}, editors...)
+        if err != nil {
+            return nil, false, err
+        }
+        if rsp.StatusCode() != http.StatusOK {
+            return nil, false, fmt.Errorf("unexpected status paging through {{$opid}}: %s", rsp.Status())
+        }
+
+        var items []{{$item}}
+        if _, err := paginationValue(rsp.Body, "{{$pagination.Items}}", &items); err != nil {
+            return nil, false, err
+        }
+{{- if eq $pagination.Strategy "cursor"}}
+{{- $param := $pagination.Param}}
+
+        var next, zero {{$param.TypeDef}}
+        found, err := paginationValue(rsp.Body, "{{$pagination.NextCursor}}", &next)
+        if err != nil {
+            return nil, false, err
+        }
+        if !found || next == zero {
+            return items, false, nil
+        }
+        current := {{if $param.IndirectOptional}}zero
+        if p.{{$param.GoName}} != nil {
+            current = *p.{{$param.GoName}}
+        }{{else}}p.{{$param.GoName}}{{end}}
+        seen[current] = true
+        if seen[next] {
+            return items, false, fmt.Errorf("{{$opid}} returned the cursor %v of a page already requested as the next one", next)
+        }
+        p.{{$param.GoName}} = {{if $param.IndirectOptional}}&{{end}}next
+        return items, true, nil
+{{- else if eq $pagination.Strategy "offset"}}
+{{- $param := $pagination.Param}}
+
+        if len(items) == 0 {
+            return nil, false, nil
+        }
+        offset := {{if $param.IndirectOptional}}{{$param.TypeDef}}(0)
+        if p.{{$param.GoName}} != nil {
+            offset = *p.{{$param.GoName}}
+        }{{else}}p.{{$param.GoName}}{{end}}
+        offset += {{$param.TypeDef}}(len(items))
+        p.{{$param.GoName}} = {{if $param.IndirectOptional}}&{{end}}offset
+        return items, true, nil
+{{- else}}
+
+        next, err := paginationNextLink(rsp.HTTPResponse)
+        if err != nil {
+            return nil, false, err
+        }
+        if next == nil {
+            return items, false, nil
+        }
+        if req := rsp.HTTPResponse.Request; req != nil && req.URL != nil {
+            seen[req.URL.String()] = true
+        }
+        if seen[next.String()] {
+            return items, false, fmt.Errorf("{{$opid}} returned the page %s already requested as the next one", next)
+        }
+        editors = append(append([]RequestEditorFn{}, reqEditors...), func(ctx context.Context, req *http.Request) error {
+            req.URL = next
+            req.Host = next.Host
+            return nil
+        })
+        return items, true, nil
+{{- end}}
+    }
+    return it
+}
+
+// {{$opid}}All returns all the results of {{$opid}}, going through every
+// page.
+func (c *ClientWithResponses) {{$opid}}All(ctx context.Context{{genParamArgs .PathParams}}{{if $hasParams}}, params *{{$opid}}Params{{end}}, reqEditors ...RequestEditorFn) ([]{{$item}}, error) {
+    var items []{{$item}}
+    it := c.{{$opid}}Iter(ctx{{genParamNames .PathParams}}{{if $hasParams}}, params{{end}}, reqEditors...)
+    for it.Next() {
+        items = append(items, it.Item())
+    }
+    return items, it.Err()
+}
+{{end}}{{/* range . */}}
+
+// paginationValue decodes the value found at a JSON pointer of body into
+// dest. It returns false, leaving dest alone, when there is no such value or
+// when it is null.
+func paginationValue(body []byte, pointer string, dest interface{}) (bool, error) {
+    value := json.RawMessage(body)
+    if pointer != "" && pointer != "/" {
+        unescape := strings.NewReplacer("~1", "/", "~0", "~")
+        for _, token := range strings.Split(strings.TrimPrefix(pointer, "/"), "/") {
+            var object map[string]json.RawMessage
+            if err := json.Unmarshal(value, &object); err != nil {
+                return false, fmt.Errorf("error decoding %s: %s", pointer, err)
+            }
+            var found bool
+            if value, found = object[unescape.Replace(token)]; !found {
+                return false, nil
+            }
+        }
+    }
+    if bytes.Equal(bytes.TrimSpace(value), []byte("null")) {
+        return false, nil
+    }
+    if err := json.Unmarshal(value, dest); err != nil {
+        return false, fmt.Errorf("error decoding %s: %s", pointer, err)
+    }
+    return true, nil
+}
+
+// paginationNextLink returns the target of the rel="next" Link header of rsp,
+// resolved against the URL of its request, or nil when there is none.
+func paginationNextLink(rsp *http.Response) (*url.URL, error) {
+    for _, header := range rsp.Header["Link"] {
+        rest := header
+        for {
+            start := strings.Index(rest, "<")
+            end := strings.Index(rest, ">")
+            if start < 0 || end < start {
+                break
+            }
+            target := rest[start+1 : end]
+            rest = rest[end+1:]
+            params := rest
+            if i := strings.Index(rest, "<"); i >= 0 {
+                params = rest[:i]
+            }
+
+            for _, param := range strings.Split(params, ";") {
+                kv := strings.SplitN(strings.Trim(param, " ,"), "=", 2)
+                if len(kv) != 2 || !strings.EqualFold(strings.TrimSpace(kv[0]), "rel") {
+                    continue
+                }
+                for _, rel := range strings.Fields(strings.Trim(kv[1], "\"")) {
+                    if !strings.EqualFold(rel, "next") {
+                        continue
+                    }
+                    next, err := url.Parse(target)
+                    if err != nil {
+                        return nil, fmt.Errorf("invalid Link header: %s", err)
+                    }
+                    if rsp.Request != nil && rsp.Request.URL != nil {
+                        next = rsp.Request.URL.ResolveReference(next)
+                    }
+                    return next, nil
+                }
+            }
+        }
+    }
+    return nil, nil
+}
+`,
+	"client-servers.tmpl": `// serverDefinition is a server of the API, whose URL may hold variables.
+type serverDefinition struct {
+    url       string
+    variables []serverVariable
+}
+
+// serverVariable is a variable of the URL of a server.
+type serverVariable struct {
+    name         string
+    defaultValue string
+    enum         []string
+}
+
+// ServerVariables holds the values of the variables of the URL of a server. It
+// is one of the types generated for the servers with variables, such as those
+// given to WithServer.
+type ServerVariables interface {
+    // serverURL returns the URL of the server whose variables these are.
+    serverURL() string
+    // values returns the values of the variables, by name.
+    values() map[string]string
+}
+
+// resolve replaces the variables in the URL of the server with their values,
+// or their defaults, checking them against their enum. vars must be those of
+// the server, or nil.
+func (s serverDefinition) resolve(vars ServerVariables) (string, error) {
+    var values map[string]string
+    if vars != nil {
+        if vars.serverURL() != s.url {
+            return "", fmt.Errorf("variables of server %s given for server %s", vars.serverURL(), s.url)
+        }
+        values = vars.values()
+    }
+
+    serverURL := s.url
+    for _, v := range s.variables {
+        value := values[v.name]
+        if value == "" {
+            value = v.defaultValue
+        }
+        if value == "" {
+            return "", fmt.Errorf("missing value for variable %s of server %s", v.name, s.url)
+        }
+        valid := len(v.enum) == 0
+        for _, e := range v.enum {
+            valid = valid || value == e
+        }
+        if !valid {
+            return "", fmt.Errorf("invalid value %q for variable %s of server %s, must be one of: %s", value, v.name, s.url, strings.Join(v.enum, ", "))
+        }
+        serverURL = strings.Replace(serverURL, "{"+v.name+"}", value, -1)
+    }
+    return serverURL, nil
+}
+
+// servers lists the servers of the API, in the order of the spec.
+var servers = []serverDefinition{
+{{- range .Servers}}
+    {{template "server-definition" .}},
+{{- end}}
+}
+
+// operationServers lists the servers of the operations which override those
+// of the API.
+var operationServers = map[string][]serverDefinition{
+{{- range .Operations}}{{if .Servers}}
+    "{{.OperationId}}": {
+    {{- range .Servers}}
+        {{template "server-definition" .}},
+    {{- end}}
+    },
+{{- end}}{{end}}
+}
+
+{{range $i, $server := .Servers}}
+{{- if .Variables}}
+// {{.VariablesType}} holds the variables of the URL of {{.Label}},
+// {{.URL}}
+{{template "server-variables" .}}
+
+// ServerURL{{.Name}} returns the URL of {{.Label}}, after checking
+// the variables against their enum.
+func ServerURL{{.Name}}(vars {{.VariablesType}}) (string, error) {
+    return servers[{{$i}}].resolve(vars)
+}
+{{else}}
+// ServerURL{{.Name}} is the URL of {{.Label}}.
+const ServerURL{{.Name}} = {{printf "%q" .URL}}
+{{end}}
+{{- end}}
+{{range .Operations}}{{$opid := .OperationId}}{{range .Servers}}{{if .Variables}}
+// {{.VariablesType}} holds the variables of the URL of {{.Label}} of
+// {{$opid}}, {{.URL}}
+{{template "server-variables" .}}
+{{end}}{{end}}{{end}}
+// WithServer sets the server of the client to one of the servers of the API,
+// by its index in the spec. Its variables take the given values, which must
+// be the variables of that server, such as a ServerProductionVariables for a
+// production server. They are checked against their enum, and take their
+// default when empty, or when vars is nil.
+func WithServer(index int, vars ServerVariables) ClientOption {
+    return func(c *Client) error {
+        if index < 0 || index >= len(servers) {
+            return fmt.Errorf("no server at index %d", index)
+        }
+        server, err := servers[index].resolve(vars)
+        if err != nil {
+            return err
+        }
+        c.Server = server
+        return nil
+    }
+}
+
+// WithOperationServer sets the server of an operation which overrides the
+// servers of the API to another of its servers, by its index in the spec. Its
+// variables are handled as by WithServer, and are of the types named after the
+// operation, such as GetPetServer0Variables.
+func WithOperationServer(operationID string, index int, vars ServerVariables) ClientOption {
+    return func(c *Client) error {
+        defs := operationServers[operationID]
+        if index < 0 || index >= len(defs) {
+            return fmt.Errorf("operation %s has no server at index %d", operationID, index)
+        }
+        server, err := defs[index].resolve(vars)
+        if err != nil {
+            return err
+        }
+        if c.OperationServers == nil {
+            c.OperationServers = map[string]string{}
+        }
+        c.OperationServers[operationID] = server
+        return nil
+    }
+}
+
+// operationServer returns the server of an operation which overrides the
+// servers of the API, which is its first one unless set otherwise.
+func (c *Client) operationServer(operationID string) (string, error) {
+    server, found := c.OperationServers[operationID]
+    if !found {
+        var err error
+        server, err = operationServers[operationID][0].resolve(nil)
+        if err != nil {
+            return "", err
+        }
+    }
+    if !strings.HasSuffix(server, "/") {
+        server += "/"
+    }
+    return server, nil
+}
+
+{{define "server-definition"}}{url: {{printf "%q" .URL}}{{if .Variables}}, variables: []serverVariable{
+    {{- range .Variables}}
+        {name: "{{.Name}}"{{if .Default}}, defaultValue: {{printf "%q" .Default}}{{end}}{{if .Enum}}, enum: []string{ {{- range $j, $e := .Enum}}{{if $j}}, {{end}}{{printf "%q" $e}}{{end}}}{{end}}},
+    {{- end}}
+    }{{end}}}{{end}}
+
+{{define "server-variables"}}type {{.VariablesType}} struct {
+{{- range .Variables}}
+    {{if .Description}}// {{.Description | stripNewLines}}
+    {{end}}{{if .Enum}}// One of: {{range $j, $e := .Enum}}{{if $j}}, {{end}}{{$e}}{{end}}
+    {{end}}{{if .Default}}// Defaults to {{.Default}} when empty.
+    {{end}}{{.GoName}} string
+{{- end}}
+}
+
+func (vars {{.VariablesType}}) serverURL() string {
+    return {{printf "%q" .URL}}
+}
+
+func (vars {{.VariablesType}}) values() map[string]string {
+    return map[string]string{
+    {{- range .Variables}}
+        "{{.Name}}": vars.{{.GoName}},
+    {{- end}}
+    }
+}{{end}}
+`,
+	"client-streams.tmpl": `{{range .}}{{$opid := .OperationId}}{{$stream := .Stream}}{{$hasParams := .RequiresParamObject}}{{$pathParams := .PathParams}}
+{{- $streamType := "io.ReadCloser"}}
+{{- if $stream.IsEventStream}}{{$streamType = printf "*%sEventStream" $opid}}{{end}}
+{{- if $stream.IsNDJSON}}{{$streamType = printf "*%sDecoder" $opid}}{{end}}
+{{- if $stream.IsEventStream}}
+// {{$opid}}EventStream reads the events sent by {{$opid}} as they arrive,
+// it must be closed once done with.
+type {{$opid}}EventStream struct {
+    ctx    context.Context
+    rsp    *http.Response
+    events *sseReader
+    event  {{$opid}}Event
+    err    error
+}
+
+// Next waits for the next event. It returns false once the stream is over,
+// or when it failed, see Err.
+func (s *{{$opid}}EventStream) Next() bool {
+    if s.err != nil {
+        return false
+    }
+    ev, err := s.events.next()
+    if err != nil {
+        s.err = streamError(s.ctx, err)
+        return false
+    }
+    s.event = {{$opid}}Event{ID: ev.id, Event: ev.event, Retry: ev.retry}
+    {{- if $stream.JSONData}}
+    if err := json.Unmarshal([]byte(ev.data), &s.event.Data); err != nil {
+        s.err = fmt.Errorf("error decoding event of {{$opid}}: %s", err)
+        return false
+    }
+    {{- else}}
+    s.event.Data = ev.data
+    {{- end}}
+    return true
+}
+
+// Event returns the current event.
+func (s *{{$opid}}EventStream) Event() {{$opid}}Event {
+    return s.event
+}
+
+// Err returns the error which ended the stream, if any.
+func (s *{{$opid}}EventStream) Err() error {
+    return s.err
+}
+
+// Close ends the stream.
+func (s *{{$opid}}EventStream) Close() error {
+    return s.rsp.Body.Close()
+}
+{{- end}}
+{{- if $stream.IsNDJSON}}
+// {{$opid}}Decoder decodes the lines sent by {{$opid}} as they arrive, it
+// must be closed once done with.
+type {{$opid}}Decoder struct {
+    ctx  context.Context
+    rsp  *http.Response
+    dec  *json.Decoder
+    item {{$stream.ItemType}}
+    err  error
+}
+
+// Next waits for the next line. It returns false once the stream is over,
+// or when it failed, see Err.
+func (d *{{$opid}}Decoder) Next() bool {
+    if d.err != nil {
+        return false
+    }
+    var item {{$stream.ItemType}}
+    if err := d.dec.Decode(&item); err != nil {
+        d.err = streamError(d.ctx, err)
+        return false
+    }
+    d.item = item
+    return true
+}
+
+// Item returns the current line.
+func (d *{{$opid}}Decoder) Item() {{$stream.ItemType}} {
+    return d.item
+}
+
+// Err returns the error which ended the stream, if any.
+func (d *{{$opid}}Decoder) Err() error {
+    return d.err
+}
+
+// Close ends the stream.
+func (d *{{$opid}}Decoder) Close() error {
+    return d.rsp.Body.Close()
+}
+{{- end}}
+
+// {{$opid}}{{if .HasBody}}WithBody{{end}}Stream calls {{$opid}}{{if .HasBody}}WithBody{{end}}, returning its {{$stream.ContentType}} response as it
+// arrives, or an error for any response other than a success.
+func (c *ClientWithResponses) {{$opid}}{{if .HasBody}}WithBody{{end}}Stream(ctx context.Context{{genParamArgs .PathParams}}{{if $hasParams}}, params *{{$opid}}Params{{end}}{{if .HasBody}}, contentType string, body io.Reader{{end}}, reqEditors ...RequestEditorFn) ({{$streamType}}, error) {
+    rsp, err := c.{{$opid}}{{if .HasBody}}WithBody{{end}}(ctx{{genParamNames .PathParams}}{{if $hasParams}}, params{{end}}{{if .HasBody}}, contentType, body{{end}}, streamEditors("{{$stream.ContentType}}", reqEditors)...)
+    if err != nil {
+        return nil, err
+    }
+    return new{{$opid}}Stream(ctx, rsp)
+}
+{{range .Bodies}}
+// {{$opid}}{{.Suffix}}Stream calls {{$opid}}{{.Suffix}}, returning its {{$stream.ContentType}} response as it
+// arrives, or an error for any response other than a success.
+func (c *ClientWithResponses) {{$opid}}{{.Suffix}}Stream(ctx context.Context{{genParamArgs $pathParams}}{{if $hasParams}}, params *{{$opid}}Params{{end}}, body {{$opid}}{{.NameTag}}RequestBody, reqEditors ...RequestEditorFn) ({{$streamType}}, error) {
+    rsp, err := c.{{$opid}}{{.Suffix}}(ctx{{genParamNames $pathParams}}{{if $hasParams}}, params{{end}}, body, streamEditors("{{$stream.ContentType}}", reqEditors)...)
+    if err != nil {
+        return nil, err
+    }
+    return new{{$opid}}Stream(ctx, rsp)
+}
+{{end}}{{/* range .Bodies */}}
+func new{{$opid}}Stream(ctx context.Context, rsp *http.Response) ({{$streamType}}, error) {
+    if rsp.StatusCode < 200 || rsp.StatusCode >= 300 {
+        return nil, streamResponseError("{{$opid}}", rsp)
+    }
+    {{- if $stream.IsEventStream}}
+    return &{{$opid}}EventStream{ctx: ctx, rsp: rsp, events: newSSEReader(rsp.Body)}, nil
+    {{- else if $stream.IsNDJSON}}
+    return &{{$opid}}Decoder{ctx: ctx, rsp: rsp, dec: json.NewDecoder(rsp.Body)}, nil
+    {{- else}}
+    return rsp.Body, nil
+    {{- end}}
+}
+{{end}}{{/* range . */}}
+
+// streamEditors asks for the streamed media type, before running the given
+// request editors.
+func streamEditors(contentType string, reqEditors []RequestEditorFn) []RequestEditorFn {
+    accept := func(ctx context.Context, req *http.Request) error {
+        req.Header.Set("Accept", contentType)
+        return nil
+    }
+    return append([]RequestEditorFn{accept}, reqEditors...)
+}
+
+// streamResponseError reads an error response to a streamed operation.
+func streamResponseError(operation string, rsp *http.Response) error {
+    defer rsp.Body.Close()
+    body, err := ioutil.ReadAll(rsp.Body)
+    if err != nil {
+        return err
+    }
+    return &APIError{
+        Operation:    operation,
+        StatusCode:   rsp.StatusCode,
+        Body:         body,
+        HTTPResponse: rsp,
+    }
+}
+
+// streamError returns why a stream ended, nil when it was complete.
+func streamError(ctx context.Context, err error) error {
+    if err == io.EOF {
+        return nil
+    }
+    if ctx.Err() != nil {
+        return ctx.Err()
+    }
+    return err
+}
+
+// sseEvent is a Server-Sent Event.
+type sseEvent struct {
+    id    string
+    event string
+    data  string
+    retry time.Duration
+}
+
+// sseReader parses a stream of Server-Sent Events, as described in
+// https://html.spec.whatwg.org/multipage/server-sent-events.html
+type sseReader struct {
+    r      *bufio.Reader
+    lastID string
+    retry  time.Duration
+}
+
+func newSSEReader(r io.Reader) *sseReader {
+    return &sseReader{r: bufio.NewReader(r)}
+}
+
+// next returns the next event, or io.EOF at the end of the stream.
+func (r *sseReader) next() (sseEvent, error) {
+    var ev sseEvent
+    var data strings.Builder
+    hasData := false
+    for {
+        line, err := r.r.ReadString('\n')
+        if err != nil {
+            // An incomplete event at the end of the stream is dropped.
+            return sseEvent{}, err
+        }
+        line = strings.TrimSuffix(strings.TrimSuffix(line, "\n"), "\r")
+
+        if line == "" {
+            if !hasData {
+                ev.event = ""
+                continue
+            }
+            ev.id = r.lastID
+            ev.retry = r.retry
+            if ev.event == "" {
+                ev.event = "message"
+            }
+            ev.data = strings.TrimSuffix(data.String(), "\n")
+            return ev, nil
+        }
+        if line[0] == ':' {
+            // A comment, eg. to keep the connection alive
+            continue
+        }
+
+        field, value := line, ""
+        if i := strings.IndexByte(line, ':'); i >= 0 {
+            field, value = line[:i], strings.TrimPrefix(line[i+1:], " ")
+        }
+        switch field {
+        case "event":
+            ev.event = value
+        case "data":
+            data.WriteString(value)
+            data.WriteByte('\n')
+            hasData = true
+        case "id":
+            if !strings.ContainsRune(value, 0) {
+                r.lastID = value
+            }
+        case "retry":
+            if ms, err := strconv.Atoi(value); err == nil && ms >= 0 {
+                r.retry = time.Duration(ms) * time.Millisecond
+            }
+        }
+    }
+}
+`,
+	"client-validation.tmpl": `// RequestValidationError is returned by the client for a request which
+// doesn't conform to the spec, it isn't sent.
+type RequestValidationError struct {
+    Method string
+    URL    string
+    // Why the request is invalid, usually an *openapi3filter.RequestError,
+    // or an *openapi3filter.RouteError when it matches no operation.
+    Err error
+}
+
+func (e *RequestValidationError) Error() string {
+    return fmt.Sprintf("invalid request %s %s: %s", e.Method, e.URL, e.Err)
+}
+
+// Cause returns why the request is invalid.
+func (e *RequestValidationError) Cause() error {
+    return e.Err
+}
+
+// Unwrap returns why the request is invalid.
+func (e *RequestValidationError) Unwrap() error {
+    return e.Err
+}
+
+// WithRequestValidation validates the parameters and bodies of requests
+// against the given spec, usually the one returned by GetSwagger, before they
+// are sent. Requests are validated once the request editors ran, so security
+// requirements are assumed to be met.
+func WithRequestValidation(swagger *openapi3.Swagger) ClientOption {
+    return func(c *Client) error {
+        // Requests are matched against their path relative to the server of
+        // the client, which may be none of the servers of the spec.
+        spec := *swagger
+        spec.Servers = nil
+        router := openapi3filter.NewRouter()
+        if err := router.AddSwagger(&spec); err != nil {
+            return fmt.Errorf("error loading spec for request validation: %s", err)
+        }
+        c.RequestValidator = func(ctx context.Context, server string, req *http.Request) error {
+            return validateRequest(ctx, router, server, req)
+        }
+        return nil
+    }
+}
+
+// validateRequest validates a request built against the given server.
+func validateRequest(ctx context.Context, router *openapi3filter.Router, server string, req *http.Request) error {
+    serverURL, err := url.Parse(server)
+    if err != nil {
+        return err
+    }
+    invalid := func(err error) error {
+        return &RequestValidationError{Method: req.Method, URL: req.URL.String(), Err: err}
+    }
+
+    path := strings.TrimPrefix(req.URL.Path, strings.TrimSuffix(serverURL.Path, "/"))
+    route, pathParams, err := router.FindRoute(req.Method, &url.URL{Path: path})
+    if err != nil {
+        return invalid(err)
+    }
+    input := &openapi3filter.RequestValidationInput{
+        Request:    req,
+        PathParams: pathParams,
+        Route:      route,
+        Options: &openapi3filter.Options{
+            AuthenticationFunc: func(context.Context, *openapi3filter.AuthenticationInput) error {
+                return nil
+            },
+        },
+    }
+    if err := openapi3filter.ValidateRequest(ctx, input); err != nil {
+        return invalid(err)
+    }
+    return nil
+}
+`,
+	"client-with-responses.tmpl": `// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+    ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+    client, err := NewClient(server, opts...)
+    if err != nil {
+        return nil, err
+    }
+    return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+{{range . -}}
+{{$hasParams := .RequiresParamObject -}}
+{{$pathParams := .PathParams -}}
+{{$opid := .OperationId -}}
+    // {{$opid}} request {{if .HasBody}} with any body{{end}}
+    {{$opid}}{{if .HasBody}}WithBody{{end}}WithResponse(ctx context.Context{{genParamArgs .PathParams}}{{if .RequiresParamObject}}, params *{{$opid}}Params{{end}}{{if .HasBody}}, contentType string, body io.Reader{{end}}, reqEditors ...RequestEditorFn) (*{{genResponseTypeName $opid}}, error)
+{{range .Bodies}}
+    {{$opid}}{{.Suffix}}WithResponse(ctx context.Context{{genParamArgs $pathParams}}{{if $hasParams}}, params *{{$opid}}Params{{end}}, body {{$opid}}{{.NameTag}}RequestBody, reqEditors ...RequestEditorFn) (*{{genResponseTypeName $opid}}, error)
+{{end}}{{/* range .Bodies */}}
+{{end}}{{/* range . $opid := .OperationId */}}
+}
+
+{{range .}}{{$opid := .OperationId}}{{$op := .}}
+type {{$opid | ucFirst}}Response struct {
+    Body         []byte
+	HTTPResponse *http.Response
+    {{- range getResponseTypeDefinitions .}}
+    {{.TypeName}} *{{.Schema.TypeDecl}}
+    {{- end}}
+}
+
+// Status returns HTTPResponse.Status
+func (r {{$opid | ucFirst}}Response) Status() string {
+    if r.HTTPResponse != nil {
+        return r.HTTPResponse.Status
+    }
+    return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r {{$opid | ucFirst}}Response) StatusCode() int {
+    if r.HTTPResponse != nil {
+        return r.HTTPResponse.StatusCode
+    }
+    return 0
+}
+{{end}}
+
+
+{{range .}}
 {{$opid := .OperationId -}}
+{{/* Generate client methods (with responses)*/}}
+
+// {{$opid}}{{if .HasBody}}WithBody{{end}}WithResponse request{{if .HasBody}} with arbitrary body{{end}} returning *{{$opid}}Response
+func (c *ClientWithResponses) {{$opid}}{{if .HasBody}}WithBody{{end}}WithResponse(ctx context.Context{{genParamArgs .PathParams}}{{if .RequiresParamObject}}, params *{{$opid}}Params{{end}}{{if .HasBody}}, contentType string, body io.Reader{{end}}, reqEditors ...RequestEditorFn) (*{{genResponseTypeName $opid}}, error){
+    rsp, err := c.{{$opid}}{{if .HasBody}}WithBody{{end}}(ctx{{genParamNames .PathParams}}{{if .RequiresParamObject}}, params{{end}}{{if .HasBody}}, contentType, body{{end}}, reqEditors...)
+    if err != nil {
+        return nil, err
+    }
+    return Parse{{genResponseTypeName $opid | ucFirst}}(rsp)
+}
+
+{{$hasParams := .RequiresParamObject -}}
+{{$pathParams := .PathParams -}}
+{{$bodyRequired := .BodyRequired -}}
+{{range .Bodies}}
+func (c *ClientWithResponses) {{$opid}}{{.Suffix}}WithResponse(ctx context.Context{{genParamArgs $pathParams}}{{if $hasParams}}, params *{{$opid}}Params{{end}}, body {{$opid}}{{.NameTag}}RequestBody, reqEditors ...RequestEditorFn) (*{{genResponseTypeName $opid}}, error) {
+    rsp, err := c.{{$opid}}{{.Suffix}}(ctx{{genParamNames $pathParams}}{{if $hasParams}}, params{{end}}, body, reqEditors...)
+    if err != nil {
+        return nil, err
+    }
+    return Parse{{genResponseTypeName $opid | ucFirst}}(rsp)
+}
+{{end}}
+
+{{end}}{{/* operations */}}
+
+{{/* Generate parse functions for responses*/}}
+{{range .}}{{$opid := .OperationId}}
+
+// Parse{{genResponseTypeName $opid | ucFirst}} parses an HTTP response from a {{$opid}}WithResponse call
+func Parse{{genResponseTypeName $opid | ucFirst}}(rsp *http.Response) (*{{genResponseTypeName $opid}}, error) {
+    bodyBytes, err := ioutil.ReadAll(rsp.Body)
+    defer rsp.Body.Close()
+    if err != nil {
+        return nil, err
+    }
+
+    response := {{genResponsePayload $opid}}
+
+    {{genResponseUnmarshal .}}
+
+    return response, nil
+}
+{{end}}{{/* range . $opid := .OperationId */}}
+
+
+// APIError is an error response from the server, with the payload of the
+// default response of the operation when the API documents one.
+type APIError struct {
+    Operation    string
+    StatusCode   int
+    Body         []byte
+    HTTPResponse *http.Response
+    // The decoded default response, eg. *Error, or nil
+    Model interface{}
+}
+
+// Error describes the response.
+func (e *APIError) Error() string {
+    return fmt.Sprintf("%s returned %d %s", e.Operation, e.StatusCode, http.StatusText(e.StatusCode))
+}
+
+{{range .}}{{$opid := .OperationId}}{{$responses := getResponses .}}
+{{- if $responses.ErrorCodes}}
+// {{$opid}}Error is returned by the OrError methods of {{$opid}} for the documented error
+// responses of {{$opid}}, with their decoded payload.
+type {{$opid}}Error struct {
+    APIError
+    {{- range $responses.Errors}}
+    {{.TypeName}} *{{.Schema.TypeDecl}}
+    {{- end}}
+}
+{{end}}
+// {{$opid}}{{if .HasBody}}WithBody{{end}}OrError calls {{$opid}}{{if .HasBody}}WithBody{{end}}WithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) {{$opid}}{{if .HasBody}}WithBody{{end}}OrError(ctx context.Context{{genParamArgs .PathParams}}{{if .RequiresParamObject}}, params *{{$opid}}Params{{end}}{{if .HasBody}}, contentType string, body io.Reader{{end}}, reqEditors ...RequestEditorFn) ({{if $responses.SuccessType}}*{{$responses.SuccessType}}{{else}}*{{genResponseTypeName $opid}}{{end}}, error) {
+    rsp, err := c.{{$opid}}{{if .HasBody}}WithBody{{end}}WithResponse(ctx{{genParamNames .PathParams}}{{if .RequiresParamObject}}, params{{end}}{{if .HasBody}}, contentType, body{{end}}, reqEditors...)
+    if err != nil {
+        return nil, err
+    }
+    return {{$opid | lcFirst}}OrError(rsp)
+}
+{{$hasParams := .RequiresParamObject -}}
+{{$pathParams := .PathParams -}}
+{{range .Bodies}}
+// {{$opid}}{{.Suffix}}OrError calls {{$opid}}{{.Suffix}}WithResponse, returning the payload of
+// a success response, or an error for any other response.
+func (c *ClientWithResponses) {{$opid}}{{.Suffix}}OrError(ctx context.Context{{genParamArgs $pathParams}}{{if $hasParams}}, params *{{$opid}}Params{{end}}, body {{$opid}}{{.NameTag}}RequestBody, reqEditors ...RequestEditorFn) ({{if $responses.SuccessType}}*{{$responses.SuccessType}}{{else}}*{{genResponseTypeName $opid}}{{end}}, error) {
+    rsp, err := c.{{$opid}}{{.Suffix}}WithResponse(ctx{{genParamNames $pathParams}}{{if $hasParams}}, params{{end}}, body, reqEditors...)
+    if err != nil {
+        return nil, err
+    }
+    return {{$opid | lcFirst}}OrError(rsp)
+}
+{{end}}{{/* range .Bodies */}}
+// {{$opid | lcFirst}}OrError returns the payload of a success response to
+// {{$opid}}, or an error for any other response.
+func {{$opid | lcFirst}}OrError(rsp *{{genResponseTypeName $opid}}) ({{if $responses.SuccessType}}*{{$responses.SuccessType}}{{else}}*{{genResponseTypeName $opid}}{{end}}, error) {
+    {{- if $responses.SuccessType}}
+    {{- range $responses.Success}}
+    if rsp.{{.TypeName}} != nil {
+        return rsp.{{.TypeName}}, nil
+    }
+    {{- end}}
+    if rsp.StatusCode() >= 200 && rsp.StatusCode() < 300 {
+        return nil, nil
+    }
+    {{- else}}
+    if rsp.StatusCode() >= 200 && rsp.StatusCode() < 300 {
+        return rsp, nil
+    }
+    {{- end}}
+
+    apiErr := APIError{
+        Operation:    "{{$opid}}",
+        StatusCode:   rsp.StatusCode(),
+        Body:         rsp.Body,
+        HTTPResponse: rsp.HTTPResponse,
+    }
+    {{- if $responses.ErrorCodes}}
+    switch rsp.StatusCode() {
+    case {{range $i, $code := $responses.ErrorCodes}}{{if $i}}, {{end}}{{$code}}{{end}}:
+        return nil, &{{$opid}}Error{
+            APIError: apiErr,
+            {{- range $responses.Errors}}
+            {{.TypeName}}: rsp.{{.TypeName}},
+            {{- end}}
+        }
+    }
+    {{- end}}
+    {{- range $responses.Default}}
+    if rsp.{{.TypeName}} != nil {
+        apiErr.Model = rsp.{{.TypeName}}
+    }
+    {{- end}}
+    return nil, &apiErr
+}
+{{end}}{{/* range . $opid := .OperationId */}}
+`,
+	"client.tmpl": `// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// ResponseEditorFn is the function signature for the ResponseEditor callback
+// function, which may inspect or replace the response before it is parsed
+type ResponseEditorFn func(ctx context.Context, rsp *http.Response) error
+
+// RequestValidatorFn is the function signature for the callback validating
+// requests before they are sent, given the server they were built against
+type RequestValidatorFn func(ctx context.Context, server string, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before
+	// sending over the network, in the order they are run.
+	RequestEditors []RequestEditorFn
+
+	// A callback for modifying requests which are generated before sending over
+	// the network, which runs before RequestEditors.
+	//
+	// Deprecated: use RequestEditors, or WithRequestEditorFn.
+	RequestEditor RequestEditorFn
+
+	// A list of callbacks for inspecting responses before they are returned,
+	// in the order they are run.
+	ResponseEditors []ResponseEditorFn
+
+	// A callback validating requests once the request editors ran, requests
+	// failing it aren't sent. See WithRequestValidation.
+	RequestValidator RequestValidatorFn
+
+	// How failed requests are retried, they aren't when nil.
+	RetryPolicy *RetryPolicy
+
+	// The servers of the operations which override the servers of the API,
+	// keyed by operation ID, when they aren't the first of their servers. See
+	// WithOperationServer.
+	OperationServers map[string]string
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+    // create a client with sane default values
+    client := Client{
+        Server: server,
+    }
+    // mutate client and add all optional params
+    for _, o := range opts {
+        if err := o(&client); err != nil {
+            return nil, err
+        }
+    }
+    // ensure the server URL always has a trailing slash
+    if !strings.HasSuffix(client.Server, "/") {
+        client.Server += "/"
+    }
+    // create httpClient, if not already present
+    if client.Client == nil {
+        client.Client = http.DefaultClient
+    }
+    return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+// It may be given more than once, the callbacks are called in the same order.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// WithResponseEditorFn allows setting up a callback function, which will be
+// called with every response, before it is returned or parsed. It may be
+// given more than once, the callbacks are called in the same order.
+func WithResponseEditorFn(fn ResponseEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.ResponseEditors = append(c.ResponseEditors, fn)
+		return nil
+	}
+}
+
+// applyEditors runs the request editors of the client, followed by those
+// given for this call only.
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	if c.RequestEditor != nil {
+		if err := c.RequestEditor(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RetryPolicy describes how the client retries requests which failed with a
+// network error, a 5xx or a 429 response.
+type RetryPolicy struct {
+	// The number of retries after the first attempt.
+	MaxRetries int
+
+	// The backoff before the first retry, it doubles for each of the
+	// following ones, with some jitter so that clients don't retry in lockstep.
+	MinBackoff time.Duration
+
+	// The upper bound of any backoff, including those asked for by the server
+	// through a Retry-After header.
+	MaxBackoff time.Duration
+
+	// Whether to retry operations which aren't idempotent. Operations are
+	// idempotent when their method is, or when marked with x-idempotent.
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryPolicy returns a policy retrying idempotent operations three
+// times, backing off from 100ms up to 10s.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries: 3,
+		MinBackoff: 100 * time.Millisecond,
+		MaxBackoff: 10 * time.Second,
+	}
+}
+
+// WithRetryPolicy allows retrying failed requests. Request bodies are buffered
+// so that they can be sent again.
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(c *Client) error {
+		c.RetryPolicy = &policy
+		return nil
+	}
+}
+
+// retryJitter randomizes backoffs, it isn't safe for concurrent use.
+var (
+	retryJitter   = rand.New(rand.NewSource(time.Now().UnixNano()))
+	retryJitterMu sync.Mutex
+)
+
+// do sends the request, and runs the response editors of the client on its
+// response.
+func (c *Client) do(req *http.Request, idempotent bool) (*http.Response, error) {
+	rsp, err := c.send(req, idempotent)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range c.ResponseEditors {
+		if err := r(req.Context(), rsp); err != nil {
+			rsp.Body.Close()
+			return nil, err
+		}
+	}
+	return rsp, nil
+}
+
+// send sends the request, retrying it according to the retry policy of the
+// client.
+func (c *Client) send(req *http.Request, idempotent bool) (*http.Response, error) {
+	policy := c.RetryPolicy
+	if policy == nil || policy.MaxRetries <= 0 || !(idempotent || policy.RetryNonIdempotent) {
+		return c.Client.Do(req)
+	}
+
+	// The body is consumed by each attempt, so we need a fresh one for every
+	// retry.
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		buf, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.GetBody = func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(bytes.NewReader(buf)), nil
+		}
+		req.Body, _ = req.GetBody()
+	}
+
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+		rsp, err := c.Client.Do(req)
+		if attempt >= policy.MaxRetries || !shouldRetry(req, rsp, err) {
+			return rsp, err
+		}
+
+		backoff := policy.backoff(attempt, rsp)
+		if rsp != nil {
+			// Drain the body, so that the connection can be reused.
+			io.Copy(ioutil.Discard, rsp.Body)
+			rsp.Body.Close()
+		}
+		timer := time.NewTimer(backoff)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func shouldRetry(req *http.Request, rsp *http.Response, err error) bool {
+	if err != nil {
+		// Nothing to retry once the caller gave up.
+		return req.Context().Err() == nil
+	}
+	return rsp.StatusCode == http.StatusTooManyRequests || rsp.StatusCode >= 500
+}
+
+// backoff returns how long to wait before the given retry, preferring the
+// delay asked for by the Retry-After header of the response.
+func (p *RetryPolicy) backoff(attempt int, rsp *http.Response) time.Duration {
+	if rsp != nil {
+		if after, ok := retryAfter(rsp.Header.Get("Retry-After")); ok {
+			if p.MaxBackoff > 0 && after > p.MaxBackoff {
+				return p.MaxBackoff
+			}
+			return after
+		}
+	}
+
+	backoff := p.MinBackoff << uint(attempt)
+	if backoff <= 0 || (p.MaxBackoff > 0 && backoff > p.MaxBackoff) {
+		backoff = p.MaxBackoff
+	}
+	if backoff <= 0 {
+		return 0
+	}
+	// Wait somewhere between half and all of the backoff.
+	retryJitterMu.Lock()
+	jitter := time.Duration(retryJitter.Int63n(int64(backoff)/2 + 1))
+	retryJitterMu.Unlock()
+	return backoff/2 + jitter
+}
+
+// retryAfter parses a Retry-After header, which either holds a number of
+// seconds or a date.
+func retryAfter(header string) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(header); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(header); err == nil {
+		after := time.Until(date)
+		if after < 0 {
+			after = 0
+		}
+		return after, true
+	}
+	return 0, false
+}
 
+// The interface specification for the client above.
+type ClientInterface interface {
+{{range . -}}
+{{$hasParams := .RequiresParamObject -}}
+{{$pathParams := .PathParams -}}
+{{$opid := .OperationId -}}
+    // {{$opid}} request {{if .HasBody}} with any body{{end}}
+    {{$opid}}{{if .HasBody}}WithBody{{end}}(ctx context.Context{{genParamArgs $pathParams}}{{if $hasParams}}, params *{{$opid}}Params{{end}}{{if .HasBody}}, contentType string, body io.Reader{{end}}, reqEditors ...RequestEditorFn) (*http.Response, error)
 {{range .Bodies}}
-// New{{$opid}}Request{{.Suffix}} calls the generic {{$opid}} builder with {{.ContentType}} body
-func New{{$opid}}Request{{.Suffix}}(server string{{genParamArgs $pathParams}}{{if $hasParams}}, params *{{$opid}}Params{{end}}, body {{$opid}}{{.NameTag}}RequestBody) (*http.Request, error) {
-    var bodyReader io.Reader
-    buf, err := json.Marshal(body)
-    if err != nil {
-        return nil, err
-    }
-    bodyReader = bytes.NewReader(buf)
-    return New{{$opid}}RequestWithBody(server{{genParamNames $pathParams}}{{if $hasParams}}, params{{end}}, "{{.ContentType}}", bodyReader)
+    {{$opid}}{{.Suffix}}(ctx context.Context{{genParamArgs $pathParams}}{{if $hasParams}}, params *{{$opid}}Params{{end}}, body {{$opid}}{{.NameTag}}RequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+{{end}}{{/* range .Bodies */}}
+{{end}}{{/* range . $opid := .OperationId */}}
 }
-{{end}}
 
-// New{{$opid}}Request{{if .HasBody}}WithBody{{end}} generates requests for {{$opid}}{{if .HasBody}} with any type of body{{end}}
-func New{{$opid}}Request{{if .HasBody}}WithBody{{end}}(server string{{genParamArgs $pathParams}}{{if $hasParams}}, params *{{$opid}}Params{{end}}{{if .HasBody}}, contentType string, body io.Reader{{end}}) (*http.Request, error) {
-    var err error
-{{range $paramIdx, $param := .PathParams}}
-    var pathParam{{$paramIdx}} string
-    {{if .IsPassThrough}}
-    pathParam{{$paramIdx}} = {{.ParamName}}
-    {{end}}
-    {{if .IsJson}}
-    var pathParamBuf{{$paramIdx}} []byte
-    pathParamBuf{{$paramIdx}}, err = json.Marshal({{.ParamName}})
+
+{{/* Generate client methods */}}
+{{range . -}}
+{{$hasParams := .RequiresParamObject -}}
+{{$pathParams := .PathParams -}}
+{{$opid := .OperationId -}}
+{{$idempotent := .IsIdempotent -}}
+{{$servers := .Servers -}}
+
+func (c *Client) {{$opid}}{{if .HasBody}}WithBody{{end}}(ctx context.Context{{genParamArgs $pathParams}}{{if $hasParams}}, params *{{$opid}}Params{{end}}{{if .HasBody}}, contentType string, body io.Reader{{end}}, reqEditors ...RequestEditorFn) (*http.Response, error) {
+{{- if .Servers}}
+    server, err := c.operationServer("{{$opid}}")
     if err != nil {
         return nil, err
     }
-    pathParam{{$paramIdx}} = string(pathParamBuf{{$paramIdx}})
-    {{end}}
-    {{if .IsStyled}}
-    pathParam{{$paramIdx}}, err = runtime.StyleParam("{{.Style}}", {{.Explode}}, "{{.ParamName}}", {{.GoVariableName}})
+{{- else}}
+    server := c.Server
+{{- end}}
+    req, err := New{{$opid}}Request{{if .HasBody}}WithBody{{end}}(server{{genParamNames .PathParams}}{{if $hasParams}}, params{{end}}{{if .HasBody}}, contentType, body{{end}})
     if err != nil {
         return nil, err
     }
-    {{end}}
-{{end}}
-    queryUrl, err := url.Parse(server)
-    if err != nil {
+    req = req.WithContext(ctx)
+    if err := c.applyEditors(ctx, req, reqEditors); err != nil {
         return nil, err
     }
-
-    basePath := fmt.Sprintf("{{genParamFmtString .Path}}"{{range $paramIdx, $param := .PathParams}}, pathParam{{$paramIdx}}{{end}})
-    if basePath[0] == '/' {
-        basePath = basePath[1:]
+    if c.RequestValidator != nil {
+        if err := c.RequestValidator(ctx, server, req); err != nil {
+            return nil, err
+        }
     }
+    return c.do(req, {{$idempotent}})
+}
 
-    queryUrl, err = queryUrl.Parse(basePath)
+{{range .Bodies}}
+func (c *Client) {{$opid}}{{.Suffix}}(ctx context.Context{{genParamArgs $pathParams}}{{if $hasParams}}, params *{{$opid}}Params{{end}}, body {{$opid}}{{.NameTag}}RequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+{{- if $servers}}
+    server, err := c.operationServer("{{$opid}}")
     if err != nil {
         return nil, err
     }
-{{if .QueryParams}}
-    queryValues := queryUrl.Query()
-{{range $paramIdx, $param := .QueryParams}}
-    {{if not .Required}} if params.{{.GoName}} != nil { {{end}}
-    {{if .IsPassThrough}}
-    queryValues.Add("{{.ParamName}}", {{if not .Required}}*{{end}}params.{{.GoName}})
-    {{end}}
-    {{if .IsJson}}
-    if queryParamBuf, err := json.Marshal({{if not .Required}}*{{end}}params.{{.GoName}}); err != nil {
+{{- else}}
+    server := c.Server
+{{- end}}
+    req, err := New{{$opid}}Request{{.Suffix}}(server{{genParamNames $pathParams}}{{if $hasParams}}, params{{end}}, body)
+    if err != nil {
         return nil, err
-    } else {
-        queryValues.Add("{{.ParamName}}", string(queryParamBuf))
     }
+    req = req.WithContext(ctx)
+    if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+        return nil, err
+    }
+    if c.RequestValidator != nil {
+        if err := c.RequestValidator(ctx, server, req); err != nil {
+            return nil, err
+        }
+    }
+    return c.do(req, {{$idempotent}})
+}
+{{end}}{{/* range .Bodies */}}
+{{end}}
 
-    {{end}}
-    {{if .IsStyled}}
-    if queryFrag, err := runtime.StyleParam("{{.Style}}", {{.Explode}}, "{{.ParamName}}", {{if not .Required}}*{{end}}params.{{.GoName}}); err != nil {
+{{/* Generate request builders */}}
+{{range .}}
+{{$hasParams := .RequiresParamObject -}}
+{{$pathParams := .PathParams -}}
+{{$bodyRequired := .BodyRequired -}}
+{{$opid := .OperationId -}}
+
+{{range .Bodies}}
+// New{{$opid}}Request{{.Suffix}} calls the generic {{$opid}} builder with {{.ContentType}} body
+func New{{$opid}}Request{{.Suffix}}(server string{{genParamArgs $pathParams}}{{if $hasParams}}, params *{{$opid}}Params{{end}}, body {{$opid}}{{.NameTag}}RequestBody) (*http.Request, error) {
+    var bodyReader io.Reader
+    buf, err := json.Marshal(body)
+    if err != nil {
         return nil, err
-    } else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-       return nil, err
-    } else {
-       for k, v := range parsed {
-           for _, v2 := range v {
-               queryValues.Add(k, v2)
-           }
-       }
     }
-    {{end}}
-    {{if not .Required}}}{{end}}
+    bodyReader = bytes.NewReader(buf)
+    return New{{$opid}}RequestWithBody(server{{genParamNames $pathParams}}{{if $hasParams}}, params{{end}}, "{{.ContentType}}", bodyReader)
+}
 {{end}}
-    queryUrl.RawQuery = queryValues.Encode()
-{{end}}{{/* if .QueryParams */}}
+
+// New{{$opid}}Request{{if .HasBody}}WithBody{{end}} generates requests for {{$opid}}{{if .HasBody}} with any type of body{{end}}
+func New{{$opid}}Request{{if .HasBody}}WithBody{{end}}(server string{{genParamArgs $pathParams}}{{if $hasParams}}, params *{{$opid}}Params{{end}}{{if .HasBody}}, contentType string, body io.Reader{{end}}) (*http.Request, error) {
+    queryUrl, err := {{$opid}}URL(server{{genParamNames .PathParams}}{{if .QueryParams}}, params{{end}})
+    if err != nil {
+        return nil, err
+    }
+
     req, err := http.NewRequest("{{.Method}}", queryUrl.String(), {{if .HasBody}}body{{else}}nil{{end}})
     if err != nil {
         return nil, err
@@ -627,84 +1983,478 @@ func New{{$opid}}Request{{if .HasBody}}WithBody{{end}}(server string{{genParamAr
     {{if not .Required}}}{{end}}
 {{end}}
 
-{{range $paramIdx, $param := .CookieParams}}
-    {{if not .Required}} if params.{{.GoName}} != nil { {{end}}
-    var cookieParam{{$paramIdx}} string
-    {{if .IsPassThrough}}
-    cookieParam{{$paramIdx}} = {{if not .Required}}*{{end}}params.{{.GoName}}
-    {{end}}
-    {{if .IsJson}}
-    var cookieParamBuf{{$paramIdx}} []byte
-    cookieParamBuf{{$paramIdx}}, err = json.Marshal({{if not .Required}}*{{end}}params.{{.GoName}})
-    if err != nil {
-        return nil, err
+{{range $paramIdx, $param := .CookieParams}}
+    {{if not .Required}} if params.{{.GoName}} != nil { {{end}}
+    {{if .IsStyled}}
+    var cookies{{$paramIdx}} []*http.Cookie
+    cookies{{$paramIdx}}, err = runtime.StyleCookieParam("{{.Style}}", {{.Explode}}, "{{.ParamName}}", {{if not .Required}}*{{end}}params.{{.GoName}})
+    if err != nil {
+        return nil, err
+    }
+    for _, cookie := range cookies{{$paramIdx}} {
+        req.AddCookie(cookie)
+    }
+    {{else}}
+    var cookieParam{{$paramIdx}} string
+    {{if .IsPassThrough}}
+    cookieParam{{$paramIdx}} = {{if not .Required}}*{{end}}params.{{.GoName}}
+    {{end}}
+    {{if .IsJson}}
+    var cookieParamBuf{{$paramIdx}} []byte
+    cookieParamBuf{{$paramIdx}}, err = json.Marshal({{if not .Required}}*{{end}}params.{{.GoName}})
+    if err != nil {
+        return nil, err
+    }
+    cookieParam{{$paramIdx}} = url.QueryEscape(string(cookieParamBuf{{$paramIdx}}))
+    {{end}}
+    cookie{{$paramIdx}} := &http.Cookie{
+        Name:"{{.ParamName}}",
+        Value:cookieParam{{$paramIdx}},
+    }
+    req.AddCookie(cookie{{$paramIdx}})
+    {{end}}
+    {{if not .Required}}}{{end}}
+{{end}}
+    {{if .HasBody}}req.Header.Add("Content-Type", contentType){{end}}
+    return req, nil
+}
+
+{{end}}{{/* Range */}}
+`,
+	"esfields.tmpl": `{{range .}}
+// {{.TypeName}}Fields lists the indexed field paths of the {{.TypeName}} elastic search mapping.
+var {{.TypeName}}Fields = struct {
+{{- range .Fields}}
+    {{.GoName}} esquery.{{.GoType}}
+{{- end}}
+}{
+{{- range .Fields}}
+    {{.GoName}}: "{{.Path}}",
+{{- end}}
+}
+{{end}}
+`,
+	"estemplate.tmpl": `{
+{{ $types := .Types }}
+{{range $index, $element := $types}}
+{{- if $index}},{{end}}"{{.TypeName}}": {{.Schema.EsTemplateDecl}}{{end}}
+}`,
+	"imports.tmpl": `// Package {{.PackageName}} provides primitives to interact the openapi HTTP API.
+//
+// Code generated by github.com/indigonote/oapi-codegen DO NOT EDIT.
+package {{.PackageName}}
+
+{{if .Imports}}
+import (
+{{range .Imports}} {{ . }}
+{{end}})
+{{end}}
+`,
+	"inline.tmpl": `// Base64 encoded, gzipped, json marshaled Swagger object
+var swaggerSpec = []string{
+{{range .}}
+    "{{.}}",{{end}}
+}
+
+// GetSwagger returns the Swagger specification corresponding to the generated code
+// in this file.
+func GetSwagger() (*openapi3.Swagger, error) {
+    zipped, err := base64.StdEncoding.DecodeString(strings.Join(swaggerSpec, ""))
+    if err != nil {
+        return nil, fmt.Errorf("error base64 decoding spec: %s", err)
+    }
+    zr, err := gzip.NewReader(bytes.NewReader(zipped))
+    if err != nil {
+        return nil, fmt.Errorf("error decompressing spec: %s", err)
+    }
+    var buf bytes.Buffer
+    _, err = buf.ReadFrom(zr)
+    if err != nil {
+        return nil, fmt.Errorf("error decompressing spec: %s", err)
+    }
+
+    swagger, err := openapi3.NewSwaggerLoader().LoadSwaggerFromData(buf.Bytes())
+    if err != nil {
+        return nil, fmt.Errorf("error loading Swagger: %s", err)
+    }
+    return swagger, nil
+}
+`,
+	"mock-server.tmpl": `// mockResponse is a response which the mock server answers with, for one of
+// the media types of the response.
+type mockResponse struct {
+    statusCode  int // 0 for the default response
+    contentType string
+    examples    []mockExample
+}
+
+// mockExample is a body of a mock response, unnamed for the example of the
+// media type or one made up from its schema.
+type mockExample struct {
+    name string
+    body string
+}
+
+// mockResponses holds the responses of each operation, in the order they are
+// preferred.
+var mockResponses = map[string][]mockResponse{
+{{- range .}}
+    "{{.OperationId}}": {
+    {{- range .Responses}}
+        {statusCode: {{.StatusCode}}, contentType: {{printf "%q" .ContentType}}, examples: []mockExample{
+        {{- range .Examples}}
+            {name: {{printf "%q" .Name}}, body: {{printf "%q" .Body}}},
+        {{- end}}
+        }},
+    {{- end}}
+    },
+{{- end}}
+}
+
+// NewMockServer returns a server answering every operation of the spec with
+// the examples of its responses, or with bodies made up from their schemas.
+// Requests pick a response with the Prefer header, eg.
+// "Prefer: code=404, example=notFound", they get the first success response
+// otherwise. Requests are validated against the given spec, usually the one
+// returned by GetSwagger, unless it is nil.
+func NewMockServer(swagger *openapi3.Swagger) (http.Handler, error) {
+    e := echo.New()
+    if swagger != nil {
+        // Requests are matched against the paths of the spec, whatever its
+        // servers.
+        spec := *swagger
+        spec.Servers = nil
+        if err := spec.Validate(context.Background()); err != nil {
+            return nil, fmt.Errorf("error validating spec: %s", err)
+        }
+        e.Use(middleware.OapiRequestValidator(&spec))
+    }
+{{range .}}    e.{{.Method}}("{{.Path | swaggerUriToEchoUri}}", mockHandler("{{.OperationId}}"))
+{{end}}
+    return e, nil
+}
+
+// mockHandler answers an operation with the response which the request
+// prefers.
+func mockHandler(operationID string) echo.HandlerFunc {
+    return func(ctx echo.Context) error {
+        req := ctx.Request()
+        code, example := mockPreferences(req.Header.Get("Prefer"))
+        rsp, statusCode, err := selectMockResponse(mockResponses[operationID], code, req.Header.Get("Accept"))
+        if err != nil {
+            return echo.NewHTTPError(http.StatusNotImplemented, fmt.Sprintf("%s %s", operationID, err))
+        }
+        if rsp.contentType == "" {
+            return ctx.NoContent(statusCode)
+        }
+        if len(rsp.examples) == 0 {
+            return ctx.Blob(statusCode, rsp.contentType, nil)
+        }
+        if example == "" {
+            return ctx.Blob(statusCode, rsp.contentType, []byte(rsp.examples[0].body))
+        }
+        var names []string
+        for _, ex := range rsp.examples {
+            if ex.name == example {
+                return ctx.Blob(statusCode, rsp.contentType, []byte(ex.body))
+            }
+            names = append(names, ex.name)
+        }
+        return echo.NewHTTPError(http.StatusNotImplemented, fmt.Sprintf("%s has no example %s for %d, but: %s", operationID, example, statusCode, strings.Join(names, ", ")))
+    }
+}
+
+// mockPreferences parses the code and example preferences of a Prefer
+// header.
+func mockPreferences(header string) (code int, example string) {
+    for _, pref := range strings.Split(header, ",") {
+        parts := strings.SplitN(strings.TrimSpace(pref), "=", 2)
+        if len(parts) != 2 {
+            continue
+        }
+        value := strings.Trim(strings.TrimSpace(parts[1]), "\"")
+        switch strings.TrimSpace(parts[0]) {
+        case "code":
+            code, _ = strconv.Atoi(value)
+        case "example":
+            example = value
+        }
+    }
+    return code, example
+}
+
+// selectMockResponse returns the response with the given status code, the
+// first one when 0, in the accepted media type if there is one, along with the
+// status code to answer with. The default response stands in for the status
+// codes which aren't described, 200 unless asked for another.
+func selectMockResponse(responses []mockResponse, code int, accept string) (mockResponse, int, error) {
+    if len(responses) == 0 {
+        return mockResponse{}, 0, errors.New("has no responses")
+    }
+    statusCode := code
+    if statusCode == 0 {
+        statusCode = responses[0].statusCode
+    }
+
+    var candidates []mockResponse
+    for _, rsp := range responses {
+        if rsp.statusCode == statusCode {
+            candidates = append(candidates, rsp)
+        }
+    }
+    if len(candidates) == 0 {
+        for _, rsp := range responses {
+            if rsp.statusCode == 0 {
+                candidates = append(candidates, rsp)
+            }
+        }
+    }
+    if len(candidates) == 0 {
+        return mockResponse{}, 0, fmt.Errorf("has no response for %d", code)
+    }
+    if statusCode == 0 {
+        statusCode = http.StatusOK
+    }
+
+    for _, accepted := range strings.Split(accept, ",") {
+        accepted = strings.TrimSpace(strings.SplitN(accepted, ";", 2)[0])
+        for _, rsp := range candidates {
+            if accepted == rsp.contentType || accepted == "*/*" ||
+                (strings.HasSuffix(accepted, "/*") && strings.HasPrefix(rsp.contentType, strings.TrimSuffix(accepted, "*"))) {
+                return rsp, statusCode, nil
+            }
+        }
+    }
+    return candidates[0], statusCode, nil
+}
+`,
+	"nullable.tmpl": `{{range .}}
+// {{.TypeName}} is a nullable {{.GoType}}, which is either unspecified, null
+// or set to a value. It is a map, so that encoding/json omits it when it is
+// unspecified, as its zero value is.
+type {{.TypeName}} map[bool]{{.GoType}}
+
+// New{{.TypeName}} returns a {{.TypeName}} set to value.
+func New{{.TypeName}}(value {{.GoType}}) {{.TypeName}} {
+    return {{.TypeName}}{true: value}
+}
+
+// NewNull{{.TypeName}} returns a null {{.TypeName}}.
+func NewNull{{.TypeName}}() {{.TypeName}} {
+    var zero {{.GoType}}
+    return {{.TypeName}}{false: zero}
+}
+
+// Get returns the value of n, and whether it is set to one, rather than null
+// or unspecified.
+func (n {{.TypeName}}) Get() ({{.GoType}}, bool) {
+    value, set := n[true]
+    return value, set
+}
+
+// IsNull tells whether n is explicitly null.
+func (n {{.TypeName}}) IsNull() bool {
+    _, null := n[false]
+    return null
+}
+
+// IsSpecified tells whether n is either null or set to a value.
+func (n {{.TypeName}}) IsSpecified() bool {
+    return len(n) != 0
+}
+
+// Set sets n to value.
+func (n *{{.TypeName}}) Set(value {{.GoType}}) {
+    *n = {{.TypeName}}{true: value}
+}
+
+// SetNull sets n to null.
+func (n *{{.TypeName}}) SetNull() {
+    *n = NewNull{{.TypeName}}()
+}
+
+// SetUnspecified makes n unspecified.
+func (n *{{.TypeName}}) SetUnspecified() {
+    *n = nil
+}
+
+// MarshalJSON marshals the value of n, or null.
+func (n {{.TypeName}}) MarshalJSON() ([]byte, error) {
+    if value, set := n[true]; set {
+        return json.Marshal(value)
     }
-    cookieParam{{$paramIdx}} = url.QueryEscape(string(cookieParamBuf{{$paramIdx}}))
-    {{end}}
-    {{if .IsStyled}}
-    cookieParam{{$paramIdx}}, err = runtime.StyleParam("simple", {{.Explode}}, "{{.ParamName}}", {{if not .Required}}*{{end}}params.{{.GoName}})
-    if err != nil {
-        return nil, err
+    return []byte("null"), nil
+}
+
+// UnmarshalJSON sets n to null, or to the value in data.
+func (n *{{.TypeName}}) UnmarshalJSON(data []byte) error {
+    if string(data) == "null" {
+        n.SetNull()
+        return nil
     }
-    {{end}}
-    cookie{{$paramIdx}} := &http.Cookie{
-        Name:"{{.ParamName}}",
-        Value:cookieParam{{$paramIdx}},
+    var value {{.GoType}}
+    if err := json.Unmarshal(data, &value); err != nil {
+        return err
     }
-    req.AddCookie(cookie{{$paramIdx}})
-    {{if not .Required}}}{{end}}
-{{end}}
-    {{if .HasBody}}req.Header.Add("Content-Type", contentType){{end}}
-    return req, nil
+    n.Set(value)
+    return nil
 }
 
-{{end}}{{/* Range */}}
-`,
-	"estemplate.tmpl": `{
-{{ $types := .Types }}
-{{range $index, $element := $types}}
-{{- if $index}},{{end}}"{{.TypeName}}": {{.Schema.EsTemplateDecl}}{{end}}
-}`,
-	"imports.tmpl": `// Package {{.PackageName}} provides primitives to interact the openapi HTTP API.
-//
-// Code generated by github.com/indigonote/oapi-codegen DO NOT EDIT.
-package {{.PackageName}}
+// Bind sets n to the value of a parameter, as runtime.BindStringToObject binds
+// it, or to null when the value is empty.
+func (n *{{.TypeName}}) Bind(src string) error {
+    if src == "" {
+        n.SetNull()
+        return nil
+    }
+    var value {{.GoType}}
+    if err := runtime.BindStringToObject(src, &value); err != nil {
+        return err
+    }
+    n.Set(value)
+    return nil
+}
 
-{{if .Imports}}
-import (
-{{range .Imports}} {{ . }}
-{{end}})
+// MarshalText styles the value of n as runtime.StyleParam does, for parameters
+// holding it. A null value is empty, which binds back to null.
+func (n {{.TypeName}}) MarshalText() ([]byte, error) {
+    value, set := n[true]
+    if !set {
+        return nil, nil
+    }
+    text, err := runtime.StyleParam("simple", false, "", value)
+    return []byte(text), err
+}
 {{end}}
 `,
-	"inline.tmpl": `// Base64 encoded, gzipped, json marshaled Swagger object
-var swaggerSpec = []string{
-{{range .}}
-    "{{.}}",{{end}}
+	"operation-info.tmpl": `// OperationInfo describes an operation of the API, for the middlewares serving
+// it, which find it in the context of its requests.
+type OperationInfo struct {
+    OperationId string                     // The operation ID, as the names of the generated code use it
+    Method      string                     // The HTTP method, eg. GET
+    Path        string                     // The path template of the spec, eg. /pets/{id}
+    Tags        []string                   // The tags of the operation
+    Security    []OperationSecurity        // The security providers of the operation
+    Extensions  map[string]json.RawMessage // The x- extensions of the operation, by name
 }
 
-// GetSwagger returns the Swagger specification corresponding to the generated code
-// in this file.
-func GetSwagger() (*openapi3.Swagger, error) {
-    zipped, err := base64.StdEncoding.DecodeString(strings.Join(swaggerSpec, ""))
-    if err != nil {
-        return nil, fmt.Errorf("error base64 decoding spec: %s", err)
+// OperationSecurity is a security provider of an operation, and the scopes it
+// requires.
+type OperationSecurity struct {
+    ProviderName string
+    Scopes       []string
+}
+
+// Operations holds the OperationInfo of every operation, by operation ID.
+var Operations = map[string]OperationInfo{
+{{- range .}}
+    "{{.OperationId}}": {
+        OperationId: "{{.OperationId}}",
+        Method:      "{{.Method}}",
+        Path:        "{{.Path}}",
+{{- if .Spec.Tags}}
+        Tags:        {{printf "%#v" .Spec.Tags}},
+{{- end}}
+{{- if .SecurityDefinitions}}
+        Security: []OperationSecurity{
+{{- range .SecurityDefinitions}}
+            {ProviderName: "{{.ProviderName}}"{{if .Scopes}}, Scopes: {{printf "%#v" .Scopes}}{{end}}},
+{{- end}}
+        },
+{{- end}}
+{{- with .Extensions}}
+        Extensions: map[string]json.RawMessage{
+{{- range $name, $value := .}}
+            "{{$name}}": json.RawMessage({{printf "%q" $value}}),
+{{- end}}
+        },
+{{- end}}
+    },
+{{- end}}
+}
+
+// operationInfoKey is the context key of the OperationInfo of a request.
+type operationInfoKey struct{}
+
+// ContextWithOperationInfo returns a copy of ctx holding info, which
+// OperationInfoFromContext returns.
+func ContextWithOperationInfo(ctx context.Context, info OperationInfo) context.Context {
+    return context.WithValue(ctx, operationInfoKey{}, info)
+}
+
+// OperationInfoFromContext returns the OperationInfo of the operation serving
+// a request, from its context, and whether there's one.
+func OperationInfoFromContext(ctx context.Context) (OperationInfo, bool) {
+    info, ok := ctx.Value(operationInfoKey{}).(OperationInfo)
+    return info, ok
+}
+`,
+	"param-binders.tmpl": `{{range .}}
+// {{.FuncName}} binds the {{.In}} parameter "{{.ParamName}}" without
+// reflection.
+{{if eq .In "query" "cookie" -}}
+func {{.FuncName}}({{if eq .In "query"}}queryParams url.Values{{else}}cookies []*http.Cookie{{end}}, dest *{{if not .Required}}*{{end}}{{.TypeDef}}) error {
+    values, found, err := runtime.{{if eq .In "query"}}QueryParameterValues{{else}}CookieParameterValues{{end}}({{.Explode}}, {{.Required}}, {{.IsArray}}, "{{.ParamName}}", {{if eq .In "query"}}queryParams{{else}}cookies{{end}})
+    if !found || err != nil {
+        return err
     }
-    zr, err := gzip.NewReader(bytes.NewReader(zipped))
+{{- if not .IsArray}}
+    value := values[0]
+{{- end}}
+{{- else -}}
+{{if eq .In "header" -}}
+func {{.FuncName}}(headers http.Header, dest *{{if not .Required}}*{{end}}{{.TypeDef}}) error {
+    value, found, err := runtime.HeaderParameterValue({{.Required}}, {{.IsArray}}, "{{.ParamName}}", headers)
+    if !found || err != nil {
+        return err
+    }
+{{- else -}}
+func {{.FuncName}}(value string, dest *{{.TypeDef}}) error {
+{{- end}}
+{{- if .IsArray}}
+    values, err := runtime.SplitStyledParameter("{{.Style}}", {{.Explode}}, "{{.ParamName}}", value)
     if err != nil {
-        return nil, fmt.Errorf("error decompressing spec: %s", err)
+        return err
     }
-    var buf bytes.Buffer
-    _, err = buf.ReadFrom(zr)
+{{- else}}
+    value, err {{if eq .In "header"}}={{else}}:={{end}} runtime.StyledParameterValue("{{.Style}}", {{.Explode}}, "{{.ParamName}}", value)
     if err != nil {
-        return nil, fmt.Errorf("error decompressing spec: %s", err)
+        return err
     }
-
-    swagger, err := openapi3.NewSwaggerLoader().LoadSwaggerFromData(buf.Bytes())
+{{- end}}
+{{- end}}
+{{if .IsArray}}
+    bound := make({{.TypeDef}}, len(values))
+    {{- if .Parse}}
+    for i, value := range values {
+        v, err := runtime.{{.Parse}}(value)
+        if err != nil {
+            return fmt.Errorf("error setting array element: %s", err)
+        }
+        bound[i] = {{if .Convert}}{{.ElemType}}(v){{else}}v{{end}}
+    }
+    {{- else if .Convert}}
+    for i, value := range values {
+        bound[i] = {{.ElemType}}(value)
+    }
+    {{- else}}
+    copy(bound, values)
+    {{- end}}
+{{- else if .Parse}}
+    {{if .Convert}}v{{else}}bound{{end}}, err := runtime.{{.Parse}}(value)
     if err != nil {
-        return nil, fmt.Errorf("error loading Swagger: %s", err)
+        return err
     }
-    return swagger, nil
+    {{- if .Convert}}
+    bound := {{.ElemType}}(v)
+    {{- end}}
+{{- else}}
+    bound := {{if .Convert}}{{.ElemType}}(value){{else}}value{{end}}
+{{- end}}
+    *dest = {{if and (ne .In "path") (not .Required)}}&{{end}}bound
+    return nil
 }
+{{end}}
 `,
 	"param-types.tmpl": `{{range .}}{{$opid := .OperationId}}
 {{range .TypeDefinitions}}
@@ -712,6 +2462,81 @@ func GetSwagger() (*openapi3.Swagger, error) {
 type {{.TypeName}} {{.Schema.TypeDecl}}
 {{end}}
 {{end}}
+`,
+	"patch.tmpl": `{{range .}}{{$target := .Target}}{{if .JSONPatch}}
+// {{.TypeName}} is a JSON patch of a {{.Target}}, as of RFC 6902.
+type {{.TypeName}} []runtime.PatchOperation
+
+// Apply applies the operations of p to target, once their paths are checked
+// against the properties of {{.Target}}. target is left alone if any fails.
+func (p {{.TypeName}}) Apply(target *{{.Target}}) error {
+    return runtime.ApplyJSONPatch(target, p)
+}
+{{else}}
+// {{.TypeName}} is a JSON merge patch of a {{.Target}}, as of RFC 7396. Its
+// unspecified properties are left alone, its null ones are removed, and the
+// others are set, or merged into objects.
+type {{.TypeName}} struct {
+{{- range .Fields}}
+    {{.GoFieldName}} {{.PatchType}} {{.JsonTag}}
+{{- end}}
+}
+
+// Apply applies p to target, which is left alone if it fails.
+func (p {{.TypeName}}) Apply(target *{{.Target}}) error {
+    patched := *target
+{{- range .Fields}}
+{{- if .Raw}}
+    if len(p.{{.GoFieldName}}) != 0 {
+        if string(p.{{.GoFieldName}}) == "null" {
+            {{if .Required}}return errors.New("property '{{.JsonFieldName}}' of {{$target}} is required, and can't be removed"){{else}}patched.{{.GoFieldName}} = nil{{end}}
+        } else {
+            var value {{.GoTypeDef}}
+            if err := json.Unmarshal(p.{{.GoFieldName}}, &value); err != nil {
+                return errors.Wrap(err, "error applying property '{{.JsonFieldName}}'")
+            }
+            patched.{{.GoFieldName}} = value
+        }
+    }
+{{- else}}
+    if p.{{.GoFieldName}}.IsNull() {
+        {{if .Required}}return errors.New("property '{{.JsonFieldName}}' of {{$target}} is required, and can't be removed"){{else}}patched.{{.GoFieldName}} = nil{{end}}
+    } else if value, set := p.{{.GoFieldName}}.Get(); set {
+{{- if .Merged}}
+{{- if .TargetNullable}}
+        merged, _ := patched.{{.GoFieldName}}.Get()
+        if err := value.Apply(&merged); err != nil {
+            return errors.Wrap(err, "error applying property '{{.JsonFieldName}}'")
+        }
+        patched.{{.GoFieldName}}.Set(merged)
+{{- else if .TargetPointer}}
+        var merged {{.Schema.TypeDecl}}
+        if patched.{{.GoFieldName}} != nil {
+            merged = *patched.{{.GoFieldName}}
+        }
+        if err := value.Apply(&merged); err != nil {
+            return errors.Wrap(err, "error applying property '{{.JsonFieldName}}'")
+        }
+        patched.{{.GoFieldName}} = &merged
+{{- else}}
+        if err := value.Apply(&patched.{{.GoFieldName}}); err != nil {
+            return errors.Wrap(err, "error applying property '{{.JsonFieldName}}'")
+        }
+{{- end}}
+{{- else if .TargetNullable}}
+        patched.{{.GoFieldName}}.Set(value)
+{{- else if .TargetPointer}}
+        patched.{{.GoFieldName}} = &value
+{{- else}}
+        patched.{{.GoFieldName}} = value
+{{- end}}
+    }
+{{- end}}
+{{- end}}
+    *target = patched
+    return nil
+}
+{{end}}{{end}}
 `,
 	"register.tmpl": `
 
@@ -730,20 +2555,60 @@ type EchoRouter interface {
 	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
 }
 
+// RegisterOptions configures the middlewares and the error handling of the
+// routes added by RegisterHandlersWithOptions.
+type RegisterOptions struct {
+    // The middlewares of operations, by operation ID.
+    OperationMiddlewares map[string][]echo.MiddlewareFunc
+
+    // The middlewares of the operations with a tag, by tag.
+    TagMiddlewares map[string][]echo.MiddlewareFunc
+
+    // The ErrorHandlerFunc of the ServerInterfaceWrapper.
+    ErrorHandlerFunc func(ctx echo.Context, err error) error
+}
+
 // RegisterHandlers adds each server route to the EchoRouter.
 func RegisterHandlers(router EchoRouter, si ServerInterface) {
+    RegisterHandlersWithOptions(router, si, RegisterOptions{})
+}
+
+// RegisterHandlersWithOptions adds each server route to the EchoRouter, with
+// the middlewares of the tags of its operation, in the order of the tags, and
+// then those of the operation. The OperationInfo of the operation is put into
+// the context of the request before any of them runs.
+func RegisterHandlersWithOptions(router EchoRouter, si ServerInterface, opts RegisterOptions) {
 {{if .}}
     wrapper := ServerInterfaceWrapper{
-        Handler: si,
+        Handler:          si,
+        ErrorHandlerFunc: opts.ErrorHandlerFunc,
     }
 {{end}}
-{{range .}}router.{{.Method}}("{{.Path | swaggerUriToEchoUri}}", wrapper.{{.OperationId}})
+{{range .}}router.{{.Method}}("{{.Path | swaggerUriToEchoUri}}", wrapper.{{.OperationId}}, opts.middlewares("{{.OperationId}}")...)
 {{end}}
 }
+
+// middlewares returns the middlewares of the route of an operation.
+func (opts RegisterOptions) middlewares(operationId string) []echo.MiddlewareFunc {
+    info := Operations[operationId]
+    middlewares := []echo.MiddlewareFunc{
+        func(next echo.HandlerFunc) echo.HandlerFunc {
+            return func(ctx echo.Context) error {
+                r := ctx.Request()
+                ctx.SetRequest(r.WithContext(ContextWithOperationInfo(r.Context(), info)))
+                return next(ctx)
+            }
+        },
+    }
+    for _, tag := range info.Tags {
+        middlewares = append(middlewares, opts.TagMiddlewares[tag]...)
+    }
+    return append(middlewares, opts.OperationMiddlewares[operationId]...)
+}
 `,
 	"request-bodies.tmpl": `{{range .}}{{$opid := .OperationId}}
 {{range .Bodies}}
-// {{$opid}}RequestBody defines body for {{$opid}} for application/json ContentType.
+// {{$opid}}RequestBody defines body for {{$opid}} for {{.ContentType}} ContentType.
 type {{$opid}}{{.NameTag}}RequestBody {{.TypeDef}}
 {{end}}
 {{end}}
@@ -755,6 +2620,173 @@ type ServerInterface interface {
 {{.OperationId}}(ctx echo.Context{{genParamArgs .PathParams}}{{if .RequiresParamObject}}, params {{.OperationId}}Params{{end}}) error
 {{end}}
 }
+`,
+	"server-streams.tmpl": `{{range .}}{{$opid := .OperationId}}{{$stream := .Stream}}
+// {{$opid}}Writer writes the {{$stream.ContentType}} response of {{$opid}}, one
+// {{if $stream.IsEventStream}}event{{else}}line{{end}} at a time. It must be closed before the handler returns.
+type {{$opid}}Writer struct {
+    *streamWriter
+}
+
+// New{{$opid}}Writer starts the {{$stream.ContentType}} response of {{$opid}}.
+// The stream ends when the client goes away, which cancels the context of r.
+func New{{$opid}}Writer(w http.ResponseWriter, r *http.Request) *{{$opid}}Writer {
+    return &{{$opid}}Writer{newStreamWriter(w, r, "{{$stream.ContentType}}")}
+}
+{{if $stream.IsEventStream}}
+// Send writes an event and flushes it to the client. It fails once the
+// client went away.
+func (s *{{$opid}}Writer) Send(ev {{$opid}}Event) error {
+    {{- if $stream.JSONData}}
+    data, err := json.Marshal(ev.Data)
+    if err != nil {
+        return err
+    }
+    buf, err := formatEvent(ev.ID, ev.Event, ev.Retry, string(data))
+    {{- else}}
+    buf, err := formatEvent(ev.ID, ev.Event, ev.Retry, ev.Data)
+    {{- end}}
+    if err != nil {
+        return err
+    }
+    return s.write(buf)
+}
+
+// Heartbeat sends a comment every interval, until the writer is closed, so
+// that idle streams aren't closed by proxies.
+func (s *{{$opid}}Writer) Heartbeat(interval time.Duration) {
+    s.heartbeat(interval, []byte(":\n\n"))
+}
+{{else}}
+// Send writes a line and flushes it to the client. It fails once the client
+// went away.
+func (s *{{$opid}}Writer) Send(item {{$stream.ItemType}}) error {
+    buf, err := json.Marshal(item)
+    if err != nil {
+        return err
+    }
+    return s.write(append(buf, '\n'))
+}
+
+// Heartbeat sends an empty line every interval, until the writer is closed,
+// so that idle streams aren't closed by proxies.
+func (s *{{$opid}}Writer) Heartbeat(interval time.Duration) {
+    s.heartbeat(interval, []byte("\n"))
+}
+{{end}}
+{{end}}{{/* range . */}}
+
+// streamWriter writes a streamed response, flushing what it writes to the
+// client right away.
+type streamWriter struct {
+    ctx    context.Context
+    w      http.ResponseWriter
+    mu     sync.Mutex
+    closed chan struct{}
+}
+
+func newStreamWriter(w http.ResponseWriter, r *http.Request, contentType string) *streamWriter {
+    w.Header().Set("Content-Type", contentType)
+    w.Header().Set("Cache-Control", "no-cache")
+    w.WriteHeader(http.StatusOK)
+    if f, ok := w.(http.Flusher); ok {
+        f.Flush()
+    }
+    return &streamWriter{ctx: r.Context(), w: w, closed: make(chan struct{})}
+}
+
+// Done is closed once the client went away.
+func (s *streamWriter) Done() <-chan struct{} {
+    return s.ctx.Done()
+}
+
+// Close stops the heartbeats, and any further write.
+func (s *streamWriter) Close() error {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    select {
+    case <-s.closed:
+    default:
+        close(s.closed)
+    }
+    return nil
+}
+
+func (s *streamWriter) write(buf []byte) error {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    select {
+    case <-s.closed:
+        return errors.New("write to closed stream")
+    default:
+    }
+    if err := s.ctx.Err(); err != nil {
+        return err
+    }
+    if _, err := s.w.Write(buf); err != nil {
+        return err
+    }
+    if f, ok := s.w.(http.Flusher); ok {
+        f.Flush()
+    }
+    return nil
+}
+
+func (s *streamWriter) heartbeat(interval time.Duration, beat []byte) {
+    go func() {
+        ticker := time.NewTicker(interval)
+        defer ticker.Stop()
+        for {
+            select {
+            case <-s.closed:
+                return
+            case <-s.ctx.Done():
+                return
+            case <-ticker.C:
+                if err := s.write(beat); err != nil {
+                    return
+                }
+            }
+        }
+    }()
+}
+
+// formatEvent formats a Server-Sent Event, as described in
+// https://html.spec.whatwg.org/multipage/server-sent-events.html
+func formatEvent(id, event string, retry time.Duration, data string) ([]byte, error) {
+    if strings.ContainsAny(id, "\r\n\x00") || strings.ContainsAny(event, "\r\n") {
+        return nil, errors.New("event IDs and types can't hold line breaks")
+    }
+    var buf bytes.Buffer
+    if id != "" {
+        fmt.Fprintf(&buf, "id: %s\n", id)
+    }
+    if event != "" {
+        fmt.Fprintf(&buf, "event: %s\n", event)
+    }
+    if retry > 0 {
+        fmt.Fprintf(&buf, "retry: %d\n", retry/time.Millisecond)
+    }
+    data = strings.Replace(strings.Replace(data, "\r\n", "\n", -1), "\r", "\n", -1)
+    for _, line := range strings.Split(data, "\n") {
+        fmt.Fprintf(&buf, "data: %s\n", line)
+    }
+    buf.WriteString("\n")
+    return buf.Bytes(), nil
+}
+`,
+	"stream-types.tmpl": `{{range .}}{{$opid := .OperationId}}{{if .Stream}}{{if .Stream.IsEventStream}}
+// {{$opid}}Event is an event of the text/event-stream response of {{$opid}}.
+type {{$opid}}Event struct {
+    // The ID of the last event which had one
+    ID string
+    // The type of the event, "message" by default
+    Event string
+    // The reconnection time asked for by the server, if any
+    Retry time.Duration
+    Data  {{.Stream.ItemType}}
+}
+{{end}}{{end}}{{end}}
 `,
 	"typedef.tmpl": `{{range .Types}}
 // {{.TypeName}} defines model for {{.JsonName}}.
@@ -769,10 +2801,115 @@ const (
 )
 {{- end }}
 {{end}}
+`,
+	"urls.tmpl": `{{range .}}{{$opid := .OperationId}}
+// {{$opid}}URL returns the URL of {{$opid}} on server, with its parameters
+// serialized as the client serializes them.
+func {{$opid}}URL(server string{{genParamArgs .PathParams}}{{if .QueryParams}}, params *{{$opid}}Params{{end}}) (*url.URL, error) {
+    var err error
+{{range $paramIdx, $param := .PathParams}}
+    var pathParam{{$paramIdx}} string
+    {{if .IsPassThrough}}
+    pathParam{{$paramIdx}} = {{.GoVariableName}}
+    {{end}}
+    {{if .IsJson}}
+    var pathParamBuf{{$paramIdx}} []byte
+    pathParamBuf{{$paramIdx}}, err = json.Marshal({{.GoVariableName}})
+    if err != nil {
+        return nil, err
+    }
+    pathParam{{$paramIdx}} = string(pathParamBuf{{$paramIdx}})
+    {{end}}
+    {{if .IsStyled}}
+    pathParam{{$paramIdx}}, err = runtime.StyleParam("{{.Style}}", {{.Explode}}, "{{.ParamName}}", {{.GoVariableName}})
+    if err != nil {
+        return nil, err
+    }
+    {{end}}
+{{end}}
+    queryUrl, err := url.Parse(server)
+    if err != nil {
+        return nil, err
+    }
+
+    basePath := fmt.Sprintf("{{genParamFmtString .Path}}"{{range $paramIdx, $param := .PathParams}}, pathParam{{$paramIdx}}{{end}})
+    if basePath[0] == '/' {
+        basePath = basePath[1:]
+    }
+
+    queryUrl, err = queryUrl.Parse(basePath)
+    if err != nil {
+        return nil, err
+    }
+{{if .QueryParams}}
+    queryValues := queryUrl.Query()
+{{range $paramIdx, $param := .QueryParams}}
+    {{if not .Required}} if params.{{.GoName}} != nil { {{end}}
+    {{if .IsPassThrough}}
+    queryValues.Add("{{.ParamName}}", {{if not .Required}}*{{end}}params.{{.GoName}})
+    {{end}}
+    {{if .IsJson}}
+    if queryParamBuf, err := json.Marshal({{if not .Required}}*{{end}}params.{{.GoName}}); err != nil {
+        return nil, err
+    } else {
+        queryValues.Add("{{.ParamName}}", string(queryParamBuf))
+    }
+
+    {{end}}
+    {{if .IsStyled}}
+    if queryFrag, err := runtime.StyleParam("{{.Style}}", {{.Explode}}, "{{.ParamName}}", {{if not .Required}}*{{end}}params.{{.GoName}}); err != nil {
+        return nil, err
+    } else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+       return nil, err
+    } else {
+       for k, v := range parsed {
+           for _, v2 := range v {
+               queryValues.Add(k, v2)
+           }
+       }
+    }
+    {{end}}
+    {{if not .Required}}}{{end}}
+{{end}}
+    queryUrl.RawQuery = queryValues.Encode()
+{{end}}{{/* if .QueryParams */}}
+    return queryUrl, nil
+}
+{{end}}{{/* Range */}}
+{{if .}}
+// OperationRoute describes an operation of the API, whatever the router serving
+// it.
+type OperationRoute struct {
+    Method      string // The HTTP method, eg. GET
+    Path        string // The path template of the spec, eg. /pets/{id}
+    OperationId string // The operation ID, as the names of the generated code use it
+}
+
+// OperationRoutes lists the operations of the API, in the order of their paths.
+var OperationRoutes = []OperationRoute{
+{{- range .}}
+    {Method: "{{.Method}}", Path: "{{.Path}}", OperationId: "{{.OperationId}}"},
+{{- end}}
+}
+{{end}}
 `,
 	"wrappers.tmpl": `// ServerInterfaceWrapper converts echo contexts to parameters.
 type ServerInterfaceWrapper struct {
     Handler ServerInterface
+
+    // ErrorHandlerFunc answers requests whose parameters can't be bound, with
+    // their InvalidParamFormatError, RequiredParamError, UnmarshalingParamError
+    // or TooManyValuesForParamError, of the runtime package. They are answered
+    // with a 400 echo.HTTPError if it is nil.
+    ErrorHandlerFunc func(ctx echo.Context, err error) error
+}
+
+// handleError answers a request whose parameters can't be bound.
+func (w *ServerInterfaceWrapper) handleError(ctx echo.Context, err error) error {
+    if w.ErrorHandlerFunc != nil {
+        return w.ErrorHandlerFunc(ctx, err)
+    }
+    return echo.NewHTTPError(http.StatusBadRequest, err.Error())
 }
 
 {{range .}}{{$opid := .OperationId}}// {{$opid}} converts echo context to params.
@@ -786,18 +2923,21 @@ func (w *ServerInterfaceWrapper) {{.OperationId}} (ctx echo.Context) error {
 {{if .IsJson}}
     err = json.Unmarshal([]byte(ctx.Param("{{.ParamName}}")), &{{$varName}})
     if err != nil {
-        return echo.NewHTTPError(http.StatusBadRequest, "Error unmarshaling parameter '{{.ParamName}}' as JSON")
+        return w.handleError(ctx, &runtime.UnmarshalingParamError{ParamName: "{{.ParamName}}", ParamLocation: runtime.ParamLocationPath, Err: err})
     }
 {{end}}
 {{if .IsStyled}}
-    err = runtime.BindStyledParameter("{{.Style}}",{{.Explode}}, "{{.ParamName}}", ctx.Param("{{.ParamName}}"), &{{$varName}})
+    {{if .Binding}}err = {{.Binding.FuncName}}(ctx.Param("{{.ParamName}}"), &{{$varName}}){{else}}err = runtime.BindStyledParameter("{{.Style}}",{{.Explode}}, "{{.ParamName}}", ctx.Param("{{.ParamName}}"), &{{$varName}}){{end}}
     if err != nil {
-        return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter {{.ParamName}}: %s", err))
+        return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationPath, "{{.ParamName}}", err))
     }
 {{end}}
 {{end}}
 
-{{range .SecurityDefinitions}}
+{{if .SecurityDefinitions}}
+    // Deprecated: the scopes are set under string keys only for handlers
+    // which predate OperationInfoFromContext, and its Security.
+{{- end}}{{range .SecurityDefinitions}}
     ctx.Set("{{.ProviderName}}.Scopes", {{toStringArray .Scopes}})
 {{end}}
 
@@ -806,9 +2946,9 @@ func (w *ServerInterfaceWrapper) {{.OperationId}} (ctx echo.Context) error {
     var params {{.OperationId}}Params
 {{range $paramIdx, $param := .QueryParams}}// ------------- {{if .Required}}Required{{else}}Optional{{end}} query parameter "{{.ParamName}}" -------------
     {{if .IsStyled}}
-    err = runtime.BindQueryParameter("{{.Style}}", {{.Explode}}, {{.Required}}, "{{.ParamName}}", ctx.QueryParams(), &params.{{.GoName}})
+    {{if .Binding}}err = {{.Binding.FuncName}}(ctx.QueryParams(), &params.{{.GoName}}){{else}}err = runtime.BindQueryParameter("{{.Style}}", {{.Explode}}, {{.Required}}, "{{.ParamName}}", ctx.QueryParams(), &params.{{.GoName}}){{end}}
     if err != nil {
-        return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter {{.ParamName}}: %s", err))
+        return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationQuery, "{{.ParamName}}", err))
     }
     {{else}}
     if paramValue := ctx.QueryParam("{{.ParamName}}"); paramValue != "" {
@@ -819,12 +2959,12 @@ func (w *ServerInterfaceWrapper) {{.OperationId}} (ctx echo.Context) error {
     var value {{.TypeDef}}
     err = json.Unmarshal([]byte(paramValue), &value)
     if err != nil {
-        return echo.NewHTTPError(http.StatusBadRequest, "Error unmarshaling parameter '{{.ParamName}}' as JSON")
+        return w.handleError(ctx, &runtime.UnmarshalingParamError{ParamName: "{{.ParamName}}", ParamLocation: runtime.ParamLocationQuery, Err: err})
     }
     params.{{.GoName}} = {{if not .Required}}&{{end}}value
     {{end}}
     }{{if .Required}} else {
-        return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Query argument {{.ParamName}} is required, but not found"))
+        return w.handleError(ctx, &runtime.RequiredParamError{ParamName: "{{.ParamName}}", ParamLocation: runtime.ParamLocationQuery})
     }{{end}}
     {{end}}
 {{end}}
@@ -832,11 +2972,17 @@ func (w *ServerInterfaceWrapper) {{.OperationId}} (ctx echo.Context) error {
 {{if .HeaderParams}}
     headers := ctx.Request().Header
 {{range .HeaderParams}}// ------------- {{if .Required}}Required{{else}}Optional{{end}} header parameter "{{.ParamName}}" -------------
+{{if .IsStyled}}
+    {{if .Binding}}err = {{.Binding.FuncName}}(headers, &params.{{.GoName}}){{else}}err = runtime.BindHeaderParameter("{{.Style}}", {{.Explode}}, {{.Required}}, "{{.ParamName}}", headers, &params.{{.GoName}}){{end}}
+    if err != nil {
+        return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationHeader, "{{.ParamName}}", err))
+    }
+{{else}}
     if valueList, found := headers[http.CanonicalHeaderKey("{{.ParamName}}")]; found {
         var {{.GoName}} {{.TypeDef}}
         n := len(valueList)
         if n != 1 {
-            return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for {{.ParamName}}, got %d", n))
+            return w.handleError(ctx, &runtime.TooManyValuesForParamError{ParamName: "{{.ParamName}}", ParamLocation: runtime.ParamLocationHeader, Count: n})
         }
 {{if .IsPassThrough}}
         params.{{.GoName}} = {{if not .Required}}&{{end}}valueList[0]
@@ -844,23 +2990,24 @@ func (w *ServerInterfaceWrapper) {{.OperationId}} (ctx echo.Context) error {
 {{if .IsJson}}
         err = json.Unmarshal([]byte(valueList[0]), &{{.GoName}})
         if err != nil {
-            return echo.NewHTTPError(http.StatusBadRequest, "Error unmarshaling parameter '{{.ParamName}}' as JSON")
-        }
-{{end}}
-{{if .IsStyled}}
-        err = runtime.BindStyledParameter("{{.Style}}",{{.Explode}}, "{{.ParamName}}", valueList[0], &{{.GoName}})
-        if err != nil {
-            return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter {{.ParamName}}: %s", err))
+            return w.handleError(ctx, &runtime.UnmarshalingParamError{ParamName: "{{.ParamName}}", ParamLocation: runtime.ParamLocationHeader, Err: err})
         }
 {{end}}
         params.{{.GoName}} = {{if not .Required}}&{{end}}{{.GoName}}
         } {{if .Required}}else {
-            return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter {{.ParamName}} is required, but not found"))
+            return w.handleError(ctx, &runtime.RequiredParamError{ParamName: "{{.ParamName}}", ParamLocation: runtime.ParamLocationHeader})
         }{{end}}
 {{end}}
 {{end}}
+{{end}}
 
 {{range .CookieParams}}
+{{if .IsStyled}}
+    {{if .Binding}}err = {{.Binding.FuncName}}(ctx.Cookies(), &params.{{.GoName}}){{else}}err = runtime.BindCookieParameter("{{.Style}}", {{.Explode}}, {{.Required}}, "{{.ParamName}}", ctx.Cookies(), &params.{{.GoName}}){{end}}
+    if err != nil {
+        return w.handleError(ctx, runtime.ParamError(runtime.ParamLocationCookie, "{{.ParamName}}", err))
+    }
+{{else}}
     if cookie, err := ctx.Cookie("{{.ParamName}}"); err == nil {
     {{if .IsPassThrough}}
     params.{{.GoName}} = {{if not .Required}}&{{end}}cookie.Value
@@ -870,26 +3017,18 @@ func (w *ServerInterfaceWrapper) {{.OperationId}} (ctx echo.Context) error {
     var decoded string
     decoded, err := url.QueryUnescape(cookie.Value)
     if err != nil {
-        return echo.NewHTTPError(http.StatusBadRequest, "Error unescaping cookie parameter '{{.ParamName}}'")
+        return w.handleError(ctx, &runtime.InvalidParamFormatError{ParamName: "{{.ParamName}}", ParamLocation: runtime.ParamLocationCookie, Err: err})
     }
     err = json.Unmarshal([]byte(decoded), &value)
     if err != nil {
-        return echo.NewHTTPError(http.StatusBadRequest, "Error unmarshaling parameter '{{.ParamName}}' as JSON")
-    }
-    params.{{.GoName}} = {{if not .Required}}&{{end}}value
-    {{end}}
-    {{if .IsStyled}}
-    var value {{.TypeDef}}
-    err = runtime.BindStyledParameter("simple",{{.Explode}}, "{{.ParamName}}", cookie.Value, &value)
-    if err != nil {
-        return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter {{.ParamName}}: %s", err))
+        return w.handleError(ctx, &runtime.UnmarshalingParamError{ParamName: "{{.ParamName}}", ParamLocation: runtime.ParamLocationCookie, Err: err})
     }
     params.{{.GoName}} = {{if not .Required}}&{{end}}value
     {{end}}
     }{{if .Required}} else {
-        return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Query argument {{.ParamName}} is required, but not found"))
+        return w.handleError(ctx, &runtime.RequiredParamError{ParamName: "{{.ParamName}}", ParamLocation: runtime.ParamLocationCookie})
     }{{end}}
-
+{{end}}
 {{end}}{{/* .CookieParams */}}
 
 {{end}}{{/* .RequiresParamObject */}}
@@ -182,6 +182,18 @@ func SortedRequestBodyKeys(dict map[string]*openapi3.RequestBodyRef) []string {
 	return keys
 }
 
+// This returns the keys of a decoded JSON object in sorted order
+func SortedInterfaceKeys(dict map[string]interface{}) []string {
+	keys := make([]string, len(dict))
+	i := 0
+	for key := range dict {
+		keys[i] = key
+		i++
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 // This function checks whether the specified string is present in an array
 // of strings
 func StringInArray(str string, array []string) bool {
@@ -333,14 +345,15 @@ func SchemaNameToTypeName(name string) string {
 // If it's false, no additional properties are allowed. We're going to act a little
 // differently, in that if you want additionalProperties code to be generated,
 // you must specify an additionalProperties type
-// If additionalProperties it true/false, this field will be non-nil.
+// If additionalProperties it true/false, this field will be non-nil. The loader
+// sets it to false for a schema too, so the schema is looked for first.
 func SchemaHasAdditionalProperties(schema *openapi3.Schema) bool {
-	if schema.AdditionalPropertiesAllowed != nil {
-		return *schema.AdditionalPropertiesAllowed
-	}
 	if schema.AdditionalProperties != nil {
 		return true
 	}
+	if schema.AdditionalPropertiesAllowed != nil {
+		return *schema.AdditionalPropertiesAllowed
+	}
 	return false
 }
 
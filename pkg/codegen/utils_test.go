@@ -233,3 +233,23 @@ Line
 	}
 
 }
+
+func TestSchemaHasAdditionalProperties(t *testing.T) {
+	swagger, err := openapi3.NewSwaggerLoader().LoadSwaggerFromData([]byte(`
+openapi: "3.0.1"
+info: {version: 1.0.0, title: AdditionalProperties}
+paths: {}
+components:
+  schemas:
+    Typed: {type: object, additionalProperties: {type: integer}}
+    Any: {type: object, additionalProperties: true}
+    None: {type: object, additionalProperties: false}
+    Unspecified: {type: object}
+`))
+	assert.NoError(t, err)
+	schemas := swagger.Components.Schemas
+	assert.True(t, SchemaHasAdditionalProperties(schemas["Typed"].Value))
+	assert.True(t, SchemaHasAdditionalProperties(schemas["Any"].Value))
+	assert.False(t, SchemaHasAdditionalProperties(schemas["None"].Value))
+	assert.False(t, SchemaHasAdditionalProperties(schemas["Unspecified"].Value))
+}
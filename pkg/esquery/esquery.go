@@ -0,0 +1,419 @@
+// Package esquery contains a small, typed Elasticsearch query builder. The
+// field path constants generated for schemas tagged `elastic` use the field
+// types defined here, so that a query can only be built against a path which
+// exists in the mapping, with a value of the type the mapping expects.
+package esquery
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Query is implemented by every query in this package. Source returns the
+// query in the Elasticsearch query DSL, ready to be marshaled to JSON.
+type Query interface {
+	Source() map[string]interface{}
+}
+
+// Field is an indexed field whose Elasticsearch type has no dedicated Go
+// representation, such as ip or geo_point.
+type Field string
+
+// KeywordField is a field mapped as keyword.
+type KeywordField string
+
+// TextField is a field mapped as full text.
+type TextField string
+
+// LongField is a field mapped as one of the integer types.
+type LongField string
+
+// DoubleField is a field mapped as one of the floating point types.
+type DoubleField string
+
+// DateField is a field mapped as date.
+type DateField string
+
+// BooleanField is a field mapped as boolean.
+type BooleanField string
+
+// NestedPath is the path of a field mapped as nested. Queries on the fields
+// below it must be wrapped into a nested query on this path.
+type NestedPath string
+
+// Term matches documents which contain exactly the given value.
+func (f Field) Term(value interface{}) *TermQuery {
+	return &TermQuery{field: string(f), value: value}
+}
+
+// Exists matches documents which have a value for the field.
+func (f Field) Exists() *ExistsQuery {
+	return &ExistsQuery{field: string(f)}
+}
+
+// Term matches documents which contain exactly the given value.
+func (f KeywordField) Term(value string) *TermQuery {
+	return &TermQuery{field: string(f), value: value}
+}
+
+// Terms matches documents which contain any of the given values.
+func (f KeywordField) Terms(values ...string) *TermsQuery {
+	v := make([]interface{}, len(values))
+	for i, value := range values {
+		v[i] = value
+	}
+	return &TermsQuery{field: string(f), values: v}
+}
+
+// Exists matches documents which have a value for the field.
+func (f KeywordField) Exists() *ExistsQuery {
+	return &ExistsQuery{field: string(f)}
+}
+
+// Match runs a full text query against the field.
+func (f TextField) Match(text string) *MatchQuery {
+	return &MatchQuery{field: string(f), query: text}
+}
+
+// Exists matches documents which have a value for the field.
+func (f TextField) Exists() *ExistsQuery {
+	return &ExistsQuery{field: string(f)}
+}
+
+// Term matches documents which contain exactly the given value.
+func (f LongField) Term(value int64) *TermQuery {
+	return &TermQuery{field: string(f), value: value}
+}
+
+// Terms matches documents which contain any of the given values.
+func (f LongField) Terms(values ...int64) *TermsQuery {
+	v := make([]interface{}, len(values))
+	for i, value := range values {
+		v[i] = value
+	}
+	return &TermsQuery{field: string(f), values: v}
+}
+
+// Range starts a range query on the field.
+func (f LongField) Range() *LongRangeQuery {
+	return &LongRangeQuery{newRangeQuery(string(f))}
+}
+
+// Exists matches documents which have a value for the field.
+func (f LongField) Exists() *ExistsQuery {
+	return &ExistsQuery{field: string(f)}
+}
+
+// Term matches documents which contain exactly the given value.
+func (f DoubleField) Term(value float64) *TermQuery {
+	return &TermQuery{field: string(f), value: value}
+}
+
+// Range starts a range query on the field.
+func (f DoubleField) Range() *DoubleRangeQuery {
+	return &DoubleRangeQuery{newRangeQuery(string(f))}
+}
+
+// Exists matches documents which have a value for the field.
+func (f DoubleField) Exists() *ExistsQuery {
+	return &ExistsQuery{field: string(f)}
+}
+
+// Term matches documents which contain exactly the given value.
+func (f DateField) Term(value time.Time) *TermQuery {
+	return &TermQuery{field: string(f), value: value.Format(time.RFC3339Nano)}
+}
+
+// Range starts a range query on the field.
+func (f DateField) Range() *DateRangeQuery {
+	return &DateRangeQuery{newRangeQuery(string(f))}
+}
+
+// Exists matches documents which have a value for the field.
+func (f DateField) Exists() *ExistsQuery {
+	return &ExistsQuery{field: string(f)}
+}
+
+// Term matches documents which contain exactly the given value.
+func (f BooleanField) Term(value bool) *TermQuery {
+	return &TermQuery{field: string(f), value: value}
+}
+
+// Exists matches documents which have a value for the field.
+func (f BooleanField) Exists() *ExistsQuery {
+	return &ExistsQuery{field: string(f)}
+}
+
+// Query wraps the given query, which must only refer to fields below this
+// path, into a nested query.
+func (p NestedPath) Query(q Query) *NestedQuery {
+	return &NestedQuery{path: string(p), query: q}
+}
+
+// TermQuery is an Elasticsearch term query.
+type TermQuery struct {
+	field string
+	value interface{}
+}
+
+// Source implements Query.
+func (q *TermQuery) Source() map[string]interface{} {
+	return map[string]interface{}{
+		"term": map[string]interface{}{
+			q.field: map[string]interface{}{"value": q.value},
+		},
+	}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (q *TermQuery) MarshalJSON() ([]byte, error) {
+	return json.Marshal(q.Source())
+}
+
+// TermsQuery is an Elasticsearch terms query.
+type TermsQuery struct {
+	field  string
+	values []interface{}
+}
+
+// Source implements Query.
+func (q *TermsQuery) Source() map[string]interface{} {
+	return map[string]interface{}{
+		"terms": map[string]interface{}{q.field: q.values},
+	}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (q *TermsQuery) MarshalJSON() ([]byte, error) {
+	return json.Marshal(q.Source())
+}
+
+// MatchQuery is an Elasticsearch match query.
+type MatchQuery struct {
+	field string
+	query string
+}
+
+// Source implements Query.
+func (q *MatchQuery) Source() map[string]interface{} {
+	return map[string]interface{}{
+		"match": map[string]interface{}{
+			q.field: map[string]interface{}{"query": q.query},
+		},
+	}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (q *MatchQuery) MarshalJSON() ([]byte, error) {
+	return json.Marshal(q.Source())
+}
+
+// ExistsQuery is an Elasticsearch exists query.
+type ExistsQuery struct {
+	field string
+}
+
+// Source implements Query.
+func (q *ExistsQuery) Source() map[string]interface{} {
+	return map[string]interface{}{
+		"exists": map[string]interface{}{"field": q.field},
+	}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (q *ExistsQuery) MarshalJSON() ([]byte, error) {
+	return json.Marshal(q.Source())
+}
+
+// rangeQuery holds the bounds shared by the typed range queries below.
+type rangeQuery struct {
+	field  string
+	bounds map[string]interface{}
+}
+
+func newRangeQuery(field string) rangeQuery {
+	return rangeQuery{field: field, bounds: map[string]interface{}{}}
+}
+
+// Source implements Query.
+func (q rangeQuery) Source() map[string]interface{} {
+	return map[string]interface{}{
+		"range": map[string]interface{}{q.field: q.bounds},
+	}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (q rangeQuery) MarshalJSON() ([]byte, error) {
+	return json.Marshal(q.Source())
+}
+
+// LongRangeQuery is a range query on a LongField.
+type LongRangeQuery struct {
+	rangeQuery
+}
+
+// Gt sets an exclusive lower bound.
+func (q *LongRangeQuery) Gt(v int64) *LongRangeQuery {
+	q.bounds["gt"] = v
+	return q
+}
+
+// Gte sets an inclusive lower bound.
+func (q *LongRangeQuery) Gte(v int64) *LongRangeQuery {
+	q.bounds["gte"] = v
+	return q
+}
+
+// Lt sets an exclusive upper bound.
+func (q *LongRangeQuery) Lt(v int64) *LongRangeQuery {
+	q.bounds["lt"] = v
+	return q
+}
+
+// Lte sets an inclusive upper bound.
+func (q *LongRangeQuery) Lte(v int64) *LongRangeQuery {
+	q.bounds["lte"] = v
+	return q
+}
+
+// DoubleRangeQuery is a range query on a DoubleField.
+type DoubleRangeQuery struct {
+	rangeQuery
+}
+
+// Gt sets an exclusive lower bound.
+func (q *DoubleRangeQuery) Gt(v float64) *DoubleRangeQuery {
+	q.bounds["gt"] = v
+	return q
+}
+
+// Gte sets an inclusive lower bound.
+func (q *DoubleRangeQuery) Gte(v float64) *DoubleRangeQuery {
+	q.bounds["gte"] = v
+	return q
+}
+
+// Lt sets an exclusive upper bound.
+func (q *DoubleRangeQuery) Lt(v float64) *DoubleRangeQuery {
+	q.bounds["lt"] = v
+	return q
+}
+
+// Lte sets an inclusive upper bound.
+func (q *DoubleRangeQuery) Lte(v float64) *DoubleRangeQuery {
+	q.bounds["lte"] = v
+	return q
+}
+
+// DateRangeQuery is a range query on a DateField.
+type DateRangeQuery struct {
+	rangeQuery
+}
+
+// Gt sets an exclusive lower bound.
+func (q *DateRangeQuery) Gt(v time.Time) *DateRangeQuery {
+	q.bounds["gt"] = v.Format(time.RFC3339Nano)
+	return q
+}
+
+// Gte sets an inclusive lower bound.
+func (q *DateRangeQuery) Gte(v time.Time) *DateRangeQuery {
+	q.bounds["gte"] = v.Format(time.RFC3339Nano)
+	return q
+}
+
+// Lt sets an exclusive upper bound.
+func (q *DateRangeQuery) Lt(v time.Time) *DateRangeQuery {
+	q.bounds["lt"] = v.Format(time.RFC3339Nano)
+	return q
+}
+
+// Lte sets an inclusive upper bound.
+func (q *DateRangeQuery) Lte(v time.Time) *DateRangeQuery {
+	q.bounds["lte"] = v.Format(time.RFC3339Nano)
+	return q
+}
+
+// NestedQuery is an Elasticsearch nested query.
+type NestedQuery struct {
+	path  string
+	query Query
+}
+
+// Source implements Query.
+func (q *NestedQuery) Source() map[string]interface{} {
+	return map[string]interface{}{
+		"nested": map[string]interface{}{
+			"path":  q.path,
+			"query": q.query.Source(),
+		},
+	}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (q *NestedQuery) MarshalJSON() ([]byte, error) {
+	return json.Marshal(q.Source())
+}
+
+// BoolQuery combines other queries into an Elasticsearch bool query.
+type BoolQuery struct {
+	must    []Query
+	should  []Query
+	filter  []Query
+	mustNot []Query
+}
+
+// Bool creates an empty bool query.
+func Bool() *BoolQuery {
+	return &BoolQuery{}
+}
+
+// Must adds queries which must match, and contribute to the score.
+func (q *BoolQuery) Must(queries ...Query) *BoolQuery {
+	q.must = append(q.must, queries...)
+	return q
+}
+
+// Should adds queries of which at least one should match.
+func (q *BoolQuery) Should(queries ...Query) *BoolQuery {
+	q.should = append(q.should, queries...)
+	return q
+}
+
+// Filter adds queries which must match, without contributing to the score.
+func (q *BoolQuery) Filter(queries ...Query) *BoolQuery {
+	q.filter = append(q.filter, queries...)
+	return q
+}
+
+// MustNot adds queries which must not match.
+func (q *BoolQuery) MustNot(queries ...Query) *BoolQuery {
+	q.mustNot = append(q.mustNot, queries...)
+	return q
+}
+
+// Source implements Query.
+func (q *BoolQuery) Source() map[string]interface{} {
+	clauses := map[string]interface{}{}
+	for name, queries := range map[string][]Query{
+		"must":     q.must,
+		"should":   q.should,
+		"filter":   q.filter,
+		"must_not": q.mustNot,
+	} {
+		if len(queries) == 0 {
+			continue
+		}
+		sources := make([]interface{}, len(queries))
+		for i, query := range queries {
+			sources[i] = query.Source()
+		}
+		clauses[name] = sources
+	}
+	return map[string]interface{}{"bool": clauses}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (q *BoolQuery) MarshalJSON() ([]byte, error) {
+	return json.Marshal(q.Source())
+}
@@ -0,0 +1,48 @@
+package esquery
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueries(t *testing.T) {
+	d := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		query    Query
+		expected string
+	}{
+		{"keyword term", KeywordField("id").Term("abc"), `{"term":{"id":{"value":"abc"}}}`},
+		{"keyword terms", KeywordField("gender").Terms("male", "other"), `{"terms":{"gender":["male","other"]}}`},
+		{"text match", TextField("name.text").Match("john"), `{"match":{"name.text":{"query":"john"}}}`},
+		{"boolean term", BooleanField("active").Term(true), `{"term":{"active":{"value":true}}}`},
+		{"long range", LongField("size").Range().Gte(1).Lt(10), `{"range":{"size":{"gte":1,"lt":10}}}`},
+		{"double range", DoubleField("score").Range().Gt(0.5), `{"range":{"score":{"gt":0.5}}}`},
+		{"date range", DateField("birthDate").Range().Lte(d), `{"range":{"birthDate":{"lte":"2020-01-02T03:04:05Z"}}}`},
+		{"date term", DateField("birthDate").Term(d), `{"term":{"birthDate":{"value":"2020-01-02T03:04:05Z"}}}`},
+		{"exists", Field("location").Exists(), `{"exists":{"field":"location"}}`},
+		{
+			"nested",
+			NestedPath("name").Query(TextField("name.family").Match("doe")),
+			`{"nested":{"path":"name","query":{"match":{"name.family":{"query":"doe"}}}}}`,
+		},
+		{
+			"bool",
+			Bool().Must(KeywordField("id").Term("abc")).MustNot(BooleanField("active").Term(false)),
+			`{"bool":{"must":[{"term":{"id":{"value":"abc"}}}],"must_not":[{"term":{"active":{"value":false}}}]}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf, err := json.Marshal(tt.query)
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.expected, string(buf))
+		})
+	}
+}
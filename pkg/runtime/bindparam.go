@@ -16,14 +16,13 @@ package runtime
 import (
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"net/url"
 	"reflect"
+	"strconv"
 	"strings"
-	"time"
 
 	"github.com/pkg/errors"
-
-	"github.com/indigonote/oapi-codegen/pkg/types"
 )
 
 // This function binds a parameter as described in the Path Parameters
@@ -36,15 +35,27 @@ func BindStyledParameter(style string, explode bool, paramName string,
 		return fmt.Errorf("parameter '%s' is empty, can't bind its value", paramName)
 	}
 
+	if style == "deepObject" {
+		// The value is a query string of its own, as in the query.
+		if !explode {
+			return errors.New("deepObjects must be exploded")
+		}
+		params, err := url.ParseQuery(value)
+		if err != nil {
+			return fmt.Errorf("error parsing deepObject parameter '%s': %s", paramName, err)
+		}
+		return UnmarshalDeepObject(dest, paramName, params)
+	}
+
 	// Everything comes in by pointer, dereference it
 	v := reflect.Indirect(reflect.ValueOf(dest))
 
 	// This is the basic type of the destination object.
 	t := v.Type()
 
-	if t.Kind() == reflect.Struct {
-		// We've got a destination object, we'll create a JSON representation
-		// of the input value, and let the json library deal with the unmarshaling
+	if t.Kind() == reflect.Struct && !bindsItself(dest) {
+		// We've got a destination object, we split the input value into its
+		// properties and bind them as an exploded form would be.
 		parts, err := splitStyledParameter(style, explode, true, paramName, value)
 		if err != nil {
 			return err
@@ -53,17 +64,21 @@ func BindStyledParameter(style string, explode bool, paramName string,
 		return bindSplitPartsToDestinationStruct(paramName, parts, explode, dest)
 	}
 
-	if t.Kind() == reflect.Slice {
+	if t.Kind() == reflect.Slice && !bindsItself(dest) {
 		// Chop up the parameter into parts based on its style
-		parts, err := splitStyledParameter(style, explode, false, paramName, value)
+		parts, err := SplitStyledParameter(style, explode, paramName, value)
 		if err != nil {
-			return fmt.Errorf("error splitting input '%s' into parts: %s", value, err)
+			return err
 		}
 
 		return bindSplitPartsToDestinationArray(parts, dest)
 	}
 
 	// Try to bind the remaining types as a base type.
+	value, err := StyledParameterValue(style, explode, paramName, value)
+	if err != nil {
+		return err
+	}
 	return BindStringToObject(value, dest)
 }
 
@@ -153,11 +168,51 @@ func splitStyledParameter(style string, explode bool, object bool, paramName str
 			}
 		}
 		return parts, nil
+	case "spaceDelimited", "pipeDelimited":
+		// Exploded, these are the same as form parameters, otherwise the
+		// values follow the parameter name, separated by spaces or pipes:
+		// id=3|4|5, or id=role|admin|firstName|Alex for objects
+		if explode {
+			return splitStyledParameter("form", explode, object, paramName, value)
+		}
+		value = strings.TrimPrefix(value, paramName+"=")
+		return strings.Split(value, delimiters[style]), nil
 	}
 
 	return nil, fmt.Errorf("unhandled parameter style: %s", style)
 }
 
+// delimiters holds the separators of the values of the delimited styles.
+var delimiters = map[string]string{
+	"form":           ",",
+	"spaceDelimited": " ",
+	"pipeDelimited":  "|",
+}
+
+// StyledParameterValue returns the value of a styled primitive parameter,
+// without the prefix of its style, eg. 5 for the label styled .5.
+func StyledParameterValue(style string, explode bool, paramName string, value string) (string, error) {
+	if value == "" {
+		return "", fmt.Errorf("parameter '%s' is empty, can't bind its value", paramName)
+	}
+	switch style {
+	case "label":
+		if value[0] != '.' {
+			return "", fmt.Errorf("invalid format for label parameter '%s', should start with '.'", paramName)
+		}
+		return value[1:], nil
+	case "matrix":
+		prefix := ";" + paramName + "="
+		if !strings.HasPrefix(value, prefix) {
+			return "", fmt.Errorf("expected parameter '%s' to start with %s", paramName, prefix)
+		}
+		return value[len(prefix):], nil
+	case "form", "spaceDelimited", "pipeDelimited":
+		return strings.TrimPrefix(value, paramName+"="), nil
+	}
+	return value, nil
+}
+
 // Given a set of values as a slice, create a slice to hold them all, and
 // assign to each one by one.
 func bindSplitPartsToDestinationArray(parts []string, dest interface{}) error {
@@ -190,39 +245,44 @@ func bindSplitPartsToDestinationArray(parts []string, dest interface{}) error {
 // ["firstName=Alex", "role=admin"], where in the non-exploded case, we would
 // pass "firstName", "Alex", "role", "admin"]
 //
-// We punt the hard work of binding these values to the object to the json
-// library. We'll turn those arrays into JSON strings, and unmarshal
-// into the struct.
+// Each field is bound from its value as a primitive parameter would be. Only
+// the objects which unmarshal themselves from JSON, such as those with
+// additional properties, are given a JSON object of strings instead.
 func bindSplitPartsToDestinationStruct(paramName string, parts []string, explode bool, dest interface{}) error {
-	// We've got a destination object, we'll create a JSON representation
-	// of the input value, and let the json library deal with the unmarshaling
-	var fields []string
+	values := make(url.Values)
+	var keys []string
 	if explode {
-		fields = make([]string, len(parts))
-		for i, property := range parts {
-			propertyParts := strings.Split(property, "=")
+		for _, property := range parts {
+			propertyParts := strings.SplitN(property, "=", 2)
 			if len(propertyParts) != 2 {
 				return fmt.Errorf("parameter '%s' has invalid exploded format", paramName)
 			}
-			fields[i] = "\"" + propertyParts[0] + "\":\"" + propertyParts[1] + "\""
+			keys = append(keys, propertyParts[0])
+			values.Add(propertyParts[0], propertyParts[1])
 		}
 	} else {
 		if len(parts)%2 != 0 {
 			return fmt.Errorf("parameter '%s' has invalid format, property/values need to be pairs", paramName)
 		}
-		fields = make([]string, len(parts)/2)
 		for i := 0; i < len(parts); i += 2 {
-			key := parts[i]
-			value := parts[i+1]
-			fields[i/2] = "\"" + key + "\":\"" + value + "\""
+			keys = append(keys, parts[i])
+			values.Add(parts[i], parts[i+1])
 		}
 	}
-	jsonParam := "{" + strings.Join(fields, ",") + "}"
-	err := json.Unmarshal([]byte(jsonParam), dest)
-	if err != nil {
-		return fmt.Errorf("error binding parameter %s fields: %s", paramName, err)
+
+	if _, ok := dest.(json.Unmarshaler); ok {
+		fields := make([]string, len(keys))
+		for i, key := range keys {
+			fields[i] = strconv.Quote(key) + ":" + strconv.Quote(values.Get(key))
+		}
+		jsonParam := "{" + strings.Join(fields, ",") + "}"
+		err := json.Unmarshal([]byte(jsonParam), dest)
+		if err != nil {
+			return fmt.Errorf("error binding parameter %s fields: %s", paramName, err)
+		}
+		return nil
 	}
-	return nil
+	return bindParamsToExplodedObject(paramName, values, dest)
 }
 
 // This works much like BindStyledParameter, however it takes a query argument
@@ -241,6 +301,97 @@ func bindSplitPartsToDestinationStruct(paramName string, parts []string, explode
 // the Content parameter form.
 func BindQueryParameter(style string, explode bool, required bool, paramName string,
 	queryParams url.Values, dest interface{}) error {
+	return bindFormParameter("query", style, explode, required, paramName, queryParams, dest)
+}
+
+// BindHeaderParameter binds the header parameter paramName to dest, as
+// BindStyledParameter does, looking it up in headers whatever the case of its
+// name. The values of an array or object may be given in several headers, which
+// are joined with commas as RFC 7230 allows. As for query parameters, dest
+// points to the pointer of an optional parameter, which is only set when the
+// header is present.
+func BindHeaderParameter(style string, explode bool, required bool, paramName string,
+	headers http.Header, dest interface{}) error {
+
+	output := optionalOutput(required, dest)
+	list := style != "deepObject" && isList(output)
+	value, found, err := HeaderParameterValue(required, list, paramName, headers)
+	if !found || err != nil {
+		return err
+	}
+	err = BindStyledParameter(style, explode, paramName, value, output)
+	if err != nil {
+		return err
+	}
+	setOptional(required, dest, output)
+	return nil
+}
+
+// BindCookieParameter binds the cookie parameter paramName to dest. The
+// cookies of a request make up a form of their own: a form styled parameter is
+// in the cookie named after it, or in a cookie per value for exploded arrays,
+// and in a cookie per property for exploded objects, so it is bound as
+// BindQueryParameter binds the query. The value of a parameter of another style
+// is in the cookie named after it, and is bound as BindStyledParameter does.
+func BindCookieParameter(style string, explode bool, required bool, paramName string,
+	cookies []*http.Cookie, dest interface{}) error {
+
+	switch style {
+	case "form", "spaceDelimited", "pipeDelimited":
+		return bindFormParameter("cookie", style, explode, required, paramName, cookieValues(cookies), dest)
+	}
+
+	values := cookieValues(cookies)[paramName]
+	if len(values) == 0 {
+		if required {
+			return &RequiredParamError{ParamName: paramName, ParamLocation: ParamLocationCookie}
+		}
+		return nil
+	}
+	if len(values) != 1 {
+		return &TooManyValuesForParamError{ParamName: paramName, ParamLocation: ParamLocationCookie, Count: len(values)}
+	}
+	output := optionalOutput(required, dest)
+	err := BindStyledParameter(style, explode, paramName, values[0], output)
+	if err != nil {
+		return err
+	}
+	setOptional(required, dest, output)
+	return nil
+}
+
+// optionalOutput returns the value to bind a parameter to: dest itself for a
+// required parameter, or a new value for the pointer dest points to.
+func optionalOutput(required bool, dest interface{}) interface{} {
+	if required {
+		return dest
+	}
+	t := reflect.Indirect(reflect.ValueOf(dest)).Type()
+	return reflect.New(t.Elem()).Interface()
+}
+
+// setOptional points the pointer dest points to at the bound output of an
+// optional parameter.
+func setOptional(required bool, dest interface{}, output interface{}) {
+	if !required {
+		reflect.Indirect(reflect.ValueOf(dest)).Set(reflect.ValueOf(output))
+	}
+}
+
+// isList tells whether dest is an array or an object, which may be given in
+// several values, rather than a single value.
+func isList(dest interface{}) bool {
+	if bindsItself(dest) {
+		return false
+	}
+	k := reflect.Indirect(reflect.ValueOf(dest)).Kind()
+	return k == reflect.Slice || k == reflect.Struct
+}
+
+// bindFormParameter binds a form styled parameter from the values of the form
+// it is in, the query or the cookies.
+func bindFormParameter(in string, style string, explode bool, required bool, paramName string,
+	queryParams url.Values, dest interface{}) error {
 
 	// dv = destination value.
 	dv := reflect.Indirect(reflect.ValueOf(dest))
@@ -282,9 +433,15 @@ func BindQueryParameter(style string, explode bool, required bool, paramName str
 	// This is the basic type of the destination object.
 	t := v.Type()
 	k := t.Kind()
+	if bindsItself(output) {
+		// Types which bind themselves are single values, whatever their kind.
+		k = reflect.Invalid
+	}
 
 	switch style {
-	case "form":
+	case "form", "spaceDelimited", "pipeDelimited":
+		// The delimited styles only differ from the form style in the
+		// separator of the values of unexploded arrays and objects.
 		var parts []string
 		if explode {
 			// ok, the explode case in query arguments is very, very annoying,
@@ -300,7 +457,7 @@ func BindQueryParameter(style string, explode bool, required bool, paramName str
 				// http library.
 				if !found {
 					if required {
-						return fmt.Errorf("query parameter '%s' is required", paramName)
+						return &RequiredParamError{ParamName: paramName, ParamLocation: ParamLocation(in)}
 					} else {
 						return nil
 					}
@@ -317,13 +474,13 @@ func BindQueryParameter(style string, explode bool, required bool, paramName str
 				// unmarshal.
 				if len(values) == 0 {
 					if required {
-						return fmt.Errorf("query parameter '%s' is required", paramName)
+						return &RequiredParamError{ParamName: paramName, ParamLocation: ParamLocation(in)}
 					} else {
 						return nil
 					}
 				}
 				if len(values) != 1 {
-					return fmt.Errorf("multiple values for single value parameter '%s'", paramName)
+					return &TooManyValuesForParamError{ParamName: paramName, ParamLocation: ParamLocation(in), Count: len(values)}
 				}
 				err = BindStringToObject(values[0], output)
 			}
@@ -340,15 +497,20 @@ func BindQueryParameter(style string, explode bool, required bool, paramName str
 			values, found := queryParams[paramName]
 			if !found {
 				if required {
-					return fmt.Errorf("query parameter '%s' is required", paramName)
+					return &RequiredParamError{ParamName: paramName, ParamLocation: ParamLocation(in)}
 				} else {
 					return nil
 				}
 			}
 			if len(values) != 1 {
-				return fmt.Errorf("parameter '%s' is not exploded, but is specified multiple times", paramName)
+				return &TooManyValuesForParamError{ParamName: paramName, ParamLocation: ParamLocation(in), Count: len(values)}
+			}
+			if style == "form" || k == reflect.Slice || k == reflect.Struct {
+				parts = strings.Split(values[0], delimiters[style])
+			} else {
+				// Primitives aren't delimited, whatever their style.
+				parts = values
 			}
-			parts = strings.Split(values[0], ",")
 		}
 		var err error
 		switch k {
@@ -359,13 +521,13 @@ func BindQueryParameter(style string, explode bool, required bool, paramName str
 		default:
 			if len(parts) == 0 {
 				if required {
-					return fmt.Errorf("query parameter '%s' is required", paramName)
+					return &RequiredParamError{ParamName: paramName, ParamLocation: ParamLocation(in)}
 				} else {
 					return nil
 				}
 			}
 			if len(parts) != 1 {
-				return fmt.Errorf("multiple values for single value parameter '%s'", paramName)
+				return &TooManyValuesForParamError{ParamName: paramName, ParamLocation: ParamLocation(in), Count: len(parts)}
 			}
 			err = BindStringToObject(parts[0], output)
 		}
@@ -380,9 +542,13 @@ func BindQueryParameter(style string, explode bool, required bool, paramName str
 		if !explode {
 			return errors.New("deepObjects must be exploded")
 		}
+		if !hasDeepObject(paramName, queryParams) {
+			if required {
+				return &RequiredParamError{ParamName: paramName, ParamLocation: ParamLocation(in)}
+			}
+			return nil
+		}
 		return UnmarshalDeepObject(dest, paramName, queryParams)
-	case "spaceDelimited", "pipeDelimited":
-		return fmt.Errorf("query arguments of style '%s' aren't yet supported", style)
 	default:
 		return fmt.Errorf("style '%s' on parameter '%s' is invalid", style, paramName)
 
@@ -396,12 +562,8 @@ func BindQueryParameter(style string, explode bool, required bool, paramName str
 // set its value.
 func bindParamsToExplodedObject(paramName string, values url.Values, dest interface{}) error {
 	// special handling for custom types
-	switch dest.(type) {
-	case *types.Date:
+	if bindsItself(dest) {
 		return BindStringToObject(values.Get(paramName), dest)
-	case *time.Time:
-		return BindStringToObject(values.Get(paramName), dest)
-
 	}
 
 	v := reflect.Indirect(reflect.ValueOf(dest))
@@ -14,11 +14,13 @@
 package runtime
 
 import (
+	"net/http"
 	"net/url"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/indigonote/oapi-codegen/pkg/types"
 )
 
@@ -270,4 +272,112 @@ func TestBindQueryParameter(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, expected, birthday)
 	})
+
+	t.Run("binders", func(t *testing.T) {
+		queryParams := url.Values{
+			"id":    {"abc"},
+			"color": {"blue"},
+		}
+		var id upperID
+		assert.NoError(t, BindQueryParameter("form", true, true, "id", queryParams, &id))
+		assert.Equal(t, "ABC", id.value)
+
+		var optional *upperID
+		assert.NoError(t, BindQueryParameter("form", false, false, "id", queryParams, &optional))
+		assert.Equal(t, &upperID{value: "ABC"}, optional)
+
+		var c *color
+		assert.NoError(t, BindQueryParameter("form", true, false, "color", queryParams, &c))
+		require.NotNil(t, c)
+		assert.Equal(t, color("blue"), *c)
+	})
+}
+
+func TestBindStyledParameterBinders(t *testing.T) {
+	var id upperID
+	assert.NoError(t, BindStyledParameter("simple", false, "id", "abc", &id))
+	assert.Equal(t, "ABC", id.value)
+
+	var ids []upperID
+	assert.NoError(t, BindStyledParameter("simple", false, "ids", "abc,def", &ids))
+	assert.Equal(t, []upperID{{value: "ABC"}, {value: "DEF"}}, ids)
+}
+
+func TestBindHeaderParameter(t *testing.T) {
+	headers := http.Header{
+		"X-Sizes":  {"1, 2", "3"},
+		"x-flags":  {"a,b"},
+		"X-Limit":  {"5"},
+		"X-Limits": {"5", "6"},
+	}
+
+	// Lists may be given in several headers
+	var sizes []int
+	assert.NoError(t, BindHeaderParameter("simple", false, true, "x-sizes", headers, &sizes))
+	assert.Equal(t, []int{1, 2, 3}, sizes)
+
+	// Headers which aren't canonical are found whatever their case
+	var flags *[]string
+	assert.NoError(t, BindHeaderParameter("simple", false, false, "X-Flags", headers, &flags))
+	assert.Equal(t, &[]string{"a", "b"}, flags)
+
+	var limit *int32
+	assert.NoError(t, BindHeaderParameter("simple", false, false, "X-Limit", headers, &limit))
+	require.NotNil(t, limit)
+	assert.Equal(t, int32(5), *limit)
+
+	var missing *int32
+	assert.NoError(t, BindHeaderParameter("simple", false, false, "X-Missing", headers, &missing))
+	assert.Nil(t, missing)
+
+	var required int32
+	err := BindHeaderParameter("simple", false, true, "X-Missing", headers, &required)
+	assert.EqualError(t, err, "header parameter 'X-Missing' is required")
+	err = BindHeaderParameter("simple", false, true, "X-Limits", headers, &required)
+	assert.EqualError(t, err, "multiple values for single value parameter 'X-Limits'")
+}
+
+func TestBindCookieParameter(t *testing.T) {
+	type object struct {
+		Role      string `json:"role"`
+		FirstName string `json:"firstName"`
+	}
+
+	cookies, err := StyleCookieParam("form", true, "ids", []int{3, 4})
+	require.NoError(t, err)
+	assert.Equal(t, []*http.Cookie{{Name: "ids", Value: "3"}, {Name: "ids", Value: "4"}}, cookies)
+	cookies, err = StyleCookieParam("form", true, "o", object{Role: "admin", FirstName: "Alex"})
+	require.NoError(t, err)
+	assert.Equal(t, []*http.Cookie{{Name: "firstName", Value: "Alex"}, {Name: "role", Value: "admin"}}, cookies)
+
+	cookies = []*http.Cookie{
+		{Name: "ids", Value: "3"},
+		{Name: "ids", Value: "4"},
+		{Name: "a", Value: "5,6"},
+		{Name: "role", Value: "admin"},
+		{Name: "firstName", Value: "Alex"},
+		{Name: "s", Value: "7,8"},
+	}
+
+	var ids []int
+	assert.NoError(t, BindCookieParameter("form", true, true, "ids", cookies, &ids))
+	assert.Equal(t, []int{3, 4}, ids)
+
+	var a *[]int
+	assert.NoError(t, BindCookieParameter("form", false, false, "a", cookies, &a))
+	assert.Equal(t, &[]int{5, 6}, a)
+
+	var o object
+	assert.NoError(t, BindCookieParameter("form", true, true, "o", cookies, &o))
+	assert.Equal(t, object{Role: "admin", FirstName: "Alex"}, o)
+
+	var s *[]int
+	assert.NoError(t, BindCookieParameter("simple", false, false, "s", cookies, &s))
+	assert.Equal(t, &[]int{7, 8}, s)
+
+	var missing int
+	err = BindCookieParameter("form", true, true, "missing", cookies, &missing)
+	assert.EqualError(t, err, "cookie parameter 'missing' is required")
+	err = BindCookieParameter("form", true, true, "ids", cookies, &missing)
+	assert.EqualError(t, err, "multiple values for single value parameter 'ids'")
 }
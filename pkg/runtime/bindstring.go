@@ -14,6 +14,7 @@
 package runtime
 
 import (
+	"encoding"
 	"errors"
 	"fmt"
 	"reflect"
@@ -23,15 +24,50 @@ import (
 	"github.com/indigonote/oapi-codegen/pkg/types"
 )
 
+// Binder is implemented by types which bind themselves from the string value
+// of a parameter, such as IDs or enums, rather than by reflection.
+type Binder interface {
+	Bind(src string) error
+}
+
 // This function takes a string, and attempts to assign it to the destination
-// interface via whatever type conversion is necessary. We have to do this
-// via reflection instead of a much simpler type switch so that we can handle
-// type aliases. This function was the easy way out, the better way, since we
-// know the destination type each place that we use this, is to generate code
-// to read each specific type.
+// interface via whatever type conversion is necessary. Destinations which
+// implement Binder or encoding.TextUnmarshaler bind themselves, the others are
+// bound via reflection instead of a much simpler type switch so that we can
+// handle type aliases. This function was the easy way out, the better way,
+// since we know the destination type each place that we use this, is to
+// generate code to read each specific type.
 func BindStringToObject(src string, dst interface{}) error {
 	var err error
 
+	switch dstType := dst.(type) {
+	case *time.Time:
+		// Time is a special case of a struct that we handle
+		parsedTime, err := ParseTime(src)
+		if err != nil {
+			return err
+		}
+		*dstType = parsedTime
+		return nil
+	case *types.Date:
+		parsedDate, err := ParseDate(src)
+		if err != nil {
+			return err
+		}
+		*dstType = parsedDate
+		return nil
+	case Binder:
+		if err := dstType.Bind(src); err != nil {
+			return fmt.Errorf("error binding string parameter: %s", err)
+		}
+		return nil
+	case encoding.TextUnmarshaler:
+		if err := dstType.UnmarshalText([]byte(src)); err != nil {
+			return fmt.Errorf("error binding string parameter: %s", err)
+		}
+		return nil
+	}
+
 	v := reflect.ValueOf(dst)
 	t := reflect.TypeOf(dst)
 
@@ -48,18 +84,33 @@ func BindStringToObject(src string, dst interface{}) error {
 	}
 
 	switch t.Kind() {
-	case reflect.Int, reflect.Int32, reflect.Int64:
+	case reflect.Ptr:
+		// A pointer to a pointer, as for optional values. We bind a new value,
+		// which may implement Binder, and only then assign it.
+		value := reflect.New(t.Elem())
+		if err := BindStringToObject(src, value.Interface()); err != nil {
+			return err
+		}
+		v.Set(value)
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
 		var val int64
-		val, err = strconv.ParseInt(src, 10, 64)
+		val, err = strconv.ParseInt(src, 10, t.Bits())
 		if err == nil {
 			v.SetInt(val)
 		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		var val uint64
+		val, err = strconv.ParseUint(src, 10, t.Bits())
+		if err == nil {
+			v.SetUint(val)
+		}
 	case reflect.String:
 		v.SetString(src)
 		err = nil
 	case reflect.Float64, reflect.Float32:
 		var val float64
-		val, err = strconv.ParseFloat(src, 64)
+		val, err = strconv.ParseFloat(src, t.Bits())
 		if err == nil {
 			v.SetFloat(val)
 		}
@@ -69,36 +120,6 @@ func BindStringToObject(src string, dst interface{}) error {
 		if err == nil {
 			v.SetBool(val)
 		}
-	case reflect.Struct:
-		switch dstType := dst.(type) {
-		case *time.Time:
-			// Don't fail on empty string.
-			if src == "" {
-				return nil
-			}
-			// Time is a special case of a struct that we handle
-			parsedTime, err := time.Parse(time.RFC3339Nano, src)
-			if err != nil {
-				parsedTime, err = time.Parse(types.DateFormat, src)
-				if err != nil {
-					return fmt.Errorf("error parsing '%s' as RFC3339 or 2006-01-02 time: %s", src, err)
-				}
-			}
-			*dstType = parsedTime
-			return nil
-		case *types.Date:
-			// Don't fail on empty string.
-			if src == "" {
-				return nil
-			}
-			parsedTime, err := time.Parse(types.DateFormat, src)
-			if err != nil {
-				return fmt.Errorf("error parsing '%s' as date: %s", src, err)
-			}
-			dstType.Time = parsedTime
-			return nil
-		}
-		fallthrough
 	default:
 		// We've got a bunch of types unimplemented, don't fail silently.
 		err = fmt.Errorf("can not bind to destination of type: %s", t.Kind())
@@ -108,3 +129,13 @@ func BindStringToObject(src string, dst interface{}) error {
 	}
 	return nil
 }
+
+// bindsItself tells whether a destination is bound from a single string by
+// BindStringToObject, whatever its kind, rather than as an object or an array.
+func bindsItself(dst interface{}) bool {
+	switch dst.(type) {
+	case *time.Time, *types.Date, Binder, encoding.TextUnmarshaler:
+		return true
+	}
+	return false
+}
@@ -14,6 +14,8 @@
 package runtime
 
 import (
+	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -89,4 +91,78 @@ func TestBindStringToObject(t *testing.T) {
 	assert.NoError(t, BindStringToObject(strTime, &parsedTime))
 	parsedTime = parsedTime.UTC()
 	assert.EqualValues(t, now, parsedTime)
+
+	// All the widths of integers, which must hold the value
+	var i8 int8
+	assert.NoError(t, BindStringToObject("-12", &i8))
+	assert.Equal(t, int8(-12), i8)
+	assert.Error(t, BindStringToObject("300", &i8))
+
+	var i16 int16
+	assert.NoError(t, BindStringToObject("300", &i16))
+	assert.Equal(t, int16(300), i16)
+
+	var u uint
+	assert.NoError(t, BindStringToObject("5", &u))
+	assert.Equal(t, uint(5), u)
+	assert.Error(t, BindStringToObject("-5", &u))
+
+	var u8 uint8
+	assert.NoError(t, BindStringToObject("255", &u8))
+	assert.Equal(t, uint8(255), u8)
+	assert.Error(t, BindStringToObject("256", &u8))
+
+	var u64 uint64
+	assert.NoError(t, BindStringToObject("18446744073709551615", &u64))
+	assert.Equal(t, uint64(18446744073709551615), u64)
+}
+
+// color is an enum binding itself.
+type color string
+
+func (c *color) Bind(src string) error {
+	switch src {
+	case "red", "green", "blue":
+		*c = color(src)
+		return nil
+	}
+	return errors.New("unknown color " + src)
+}
+
+// upperID unmarshals itself from text.
+type upperID struct {
+	value string
+}
+
+func (id *upperID) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return errors.New("empty ID")
+	}
+	id.value = strings.ToUpper(string(text))
+	return nil
+}
+
+func TestBindStringToObjectBinders(t *testing.T) {
+	var c color
+	assert.NoError(t, BindStringToObject("green", &c))
+	assert.Equal(t, color("green"), c)
+	assert.EqualError(t, BindStringToObject("pink", &c), "error binding string parameter: unknown color pink")
+
+	var id upperID
+	assert.NoError(t, BindStringToObject("abc", &id))
+	assert.Equal(t, "ABC", id.value)
+	assert.Error(t, BindStringToObject("", &id))
+
+	// Pointers to custom types, as for optional parameters, are allocated
+	var optional *upperID
+	assert.NoError(t, BindStringToObject("def", &optional))
+	assert.Equal(t, &upperID{value: "DEF"}, optional)
+
+	var optionalInt *uint16
+	assert.NoError(t, BindStringToObject("7", &optionalInt))
+	assert.Equal(t, uint16(7), *optionalInt)
+
+	var optionalColor *color
+	assert.Error(t, BindStringToObject("pink", &optionalColor))
+	assert.Nil(t, optionalColor)
 }
@@ -0,0 +1,218 @@
+// Copyright 2019 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/indigonote/oapi-codegen/pkg/types"
+)
+
+// The functions below are used by the code generated to bind parameters of
+// known types without reflection. They fail the same way as
+// BindStyledParameter and BindQueryParameter do.
+
+// SplitStyledParameter returns the values of the elements of a styled array
+// parameter, such as a path or header parameter.
+func SplitStyledParameter(style string, explode bool, paramName string, value string) ([]string, error) {
+	if value == "" {
+		return nil, fmt.Errorf("parameter '%s' is empty, can't bind its value", paramName)
+	}
+	parts, err := splitStyledParameter(style, explode, false, paramName, value)
+	if err != nil {
+		return nil, fmt.Errorf("error splitting input '%s' into parts: %s", value, err)
+	}
+	return parts, nil
+}
+
+// QueryParameterValues returns the values of a form styled query parameter,
+// the values of the elements of an array, or a single value otherwise. found
+// is false when an optional parameter isn't in the query.
+func QueryParameterValues(explode bool, required bool, array bool, paramName string,
+	queryParams url.Values) (values []string, found bool, err error) {
+	return formParameterValues("query", explode, required, array, paramName, queryParams)
+}
+
+// CookieParameterValues returns the values of a form styled cookie parameter,
+// as QueryParameterValues does for the query.
+func CookieParameterValues(explode bool, required bool, array bool, paramName string,
+	cookies []*http.Cookie) (values []string, found bool, err error) {
+	return formParameterValues("cookie", explode, required, array, paramName, cookieValues(cookies))
+}
+
+// HeaderParameterValue returns the value of a header parameter, whatever the
+// case of its name. The values of a list, an array or an object, given in
+// several headers are joined with commas, and the whitespace around its
+// elements is trimmed. found is false when an optional header is missing.
+func HeaderParameterValue(required bool, list bool, paramName string,
+	headers http.Header) (value string, found bool, err error) {
+
+	values := headerValues(paramName, headers)
+	if len(values) == 0 {
+		if required {
+			return "", false, &RequiredParamError{ParamName: paramName, ParamLocation: ParamLocationHeader}
+		}
+		return "", false, nil
+	}
+	if !list {
+		if len(values) != 1 {
+			return "", false, &TooManyValuesForParamError{ParamName: paramName, ParamLocation: ParamLocationHeader, Count: len(values)}
+		}
+		return values[0], true, nil
+	}
+	parts := strings.Split(strings.Join(values, ","), ",")
+	for i := range parts {
+		parts[i] = strings.TrimSpace(parts[i])
+	}
+	return strings.Join(parts, ","), true, nil
+}
+
+// headerValues returns the values of the header paramName. Headers set
+// directly in the map may not have canonical names, so those are looked up
+// whatever their case too.
+func headerValues(paramName string, headers http.Header) []string {
+	if values, found := headers[http.CanonicalHeaderKey(paramName)]; found {
+		return values
+	}
+	for name, values := range headers {
+		if strings.EqualFold(name, paramName) {
+			return values
+		}
+	}
+	return nil
+}
+
+// cookieValues returns the values of the cookies by name, as the values of a
+// form.
+func cookieValues(cookies []*http.Cookie) url.Values {
+	values := make(url.Values, len(cookies))
+	for _, cookie := range cookies {
+		values.Add(cookie.Name, cookie.Value)
+	}
+	return values
+}
+
+func formParameterValues(in string, explode bool, required bool, array bool, paramName string,
+	queryParams url.Values) (values []string, found bool, err error) {
+
+	values, found = queryParams[paramName]
+	if explode && !array {
+		found = len(values) != 0
+	}
+	if !found {
+		if required {
+			return nil, false, &RequiredParamError{ParamName: paramName, ParamLocation: ParamLocation(in)}
+		}
+		return nil, false, nil
+	}
+
+	if !explode {
+		if len(values) != 1 {
+			return nil, false, &TooManyValuesForParamError{ParamName: paramName, ParamLocation: ParamLocation(in), Count: len(values)}
+		}
+		values = strings.Split(values[0], ",")
+	}
+	if !array && len(values) != 1 {
+		return nil, false, &TooManyValuesForParamError{ParamName: paramName, ParamLocation: ParamLocation(in), Count: len(values)}
+	}
+	return values, true, nil
+}
+
+// ParseInt parses the value of an int parameter.
+func ParseInt(src string) (int, error) {
+	val, err := strconv.ParseInt(src, 10, strconv.IntSize)
+	if err != nil {
+		return 0, fmt.Errorf("error binding string parameter: %s", err)
+	}
+	return int(val), nil
+}
+
+// ParseInt32 parses the value of an int32 parameter.
+func ParseInt32(src string) (int32, error) {
+	val, err := strconv.ParseInt(src, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("error binding string parameter: %s", err)
+	}
+	return int32(val), nil
+}
+
+// ParseInt64 parses the value of an int64 parameter.
+func ParseInt64(src string) (int64, error) {
+	val, err := strconv.ParseInt(src, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("error binding string parameter: %s", err)
+	}
+	return val, nil
+}
+
+// ParseFloat32 parses the value of a float32 parameter.
+func ParseFloat32(src string) (float32, error) {
+	val, err := strconv.ParseFloat(src, 32)
+	if err != nil {
+		return 0, fmt.Errorf("error binding string parameter: %s", err)
+	}
+	return float32(val), nil
+}
+
+// ParseFloat64 parses the value of a float64 parameter.
+func ParseFloat64(src string) (float64, error) {
+	val, err := strconv.ParseFloat(src, 64)
+	if err != nil {
+		return 0, fmt.Errorf("error binding string parameter: %s", err)
+	}
+	return val, nil
+}
+
+// ParseBool parses the value of a bool parameter.
+func ParseBool(src string) (bool, error) {
+	val, err := strconv.ParseBool(src)
+	if err != nil {
+		return false, fmt.Errorf("error binding string parameter: %s", err)
+	}
+	return val, nil
+}
+
+// ParseTime parses the value of a date-time parameter, which may also be a
+// date. An empty value is the zero time.
+func ParseTime(src string) (time.Time, error) {
+	if src == "" {
+		return time.Time{}, nil
+	}
+	parsedTime, err := time.Parse(time.RFC3339Nano, src)
+	if err != nil {
+		parsedTime, err = time.Parse(types.DateFormat, src)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("error parsing '%s' as RFC3339 or 2006-01-02 time: %s", src, err)
+		}
+	}
+	return parsedTime, nil
+}
+
+// ParseDate parses the value of a date parameter. An empty value is the zero
+// date.
+func ParseDate(src string) (types.Date, error) {
+	if src == "" {
+		return types.Date{}, nil
+	}
+	parsedTime, err := time.Parse(types.DateFormat, src)
+	if err != nil {
+		return types.Date{}, fmt.Errorf("error parsing '%s' as date: %s", src, err)
+	}
+	return types.Date{Time: parsedTime}, nil
+}
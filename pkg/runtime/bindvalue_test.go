@@ -0,0 +1,169 @@
+// Copyright 2019 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/indigonote/oapi-codegen/pkg/types"
+)
+
+func TestQueryParameterValues(t *testing.T) {
+	queryParams := url.Values{
+		"p":  {"5"},
+		"ea": {"3", "4"},
+		"a":  {"3,4"},
+	}
+
+	for _, tt := range []struct {
+		name      string
+		explode   bool
+		required  bool
+		array     bool
+		paramName string
+		values    []string
+		found     bool
+		err       string
+	}{
+		{name: "exploded primitive", explode: true, paramName: "p", values: []string{"5"}, found: true},
+		{name: "unexploded primitive", paramName: "p", values: []string{"5"}, found: true},
+		{name: "exploded array", explode: true, array: true, paramName: "ea", values: []string{"3", "4"}, found: true},
+		{name: "unexploded array", array: true, paramName: "a", values: []string{"3", "4"}, found: true},
+		{name: "missing optional", explode: true, paramName: "missing"},
+		{name: "missing required", explode: true, required: true, paramName: "missing", err: "query parameter 'missing' is required"},
+		{name: "repeated primitive", explode: true, paramName: "ea", err: "multiple values for single value parameter 'ea'"},
+		{name: "repeated unexploded", array: true, paramName: "ea", err: "multiple values for single value parameter 'ea'"},
+		{name: "split primitive", paramName: "a", err: "multiple values for single value parameter 'a'"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			values, found, err := QueryParameterValues(tt.explode, tt.required, tt.array, tt.paramName, queryParams)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.found, found)
+			assert.Equal(t, tt.values, values)
+		})
+	}
+}
+
+func TestHeaderAndCookieParameterValues(t *testing.T) {
+	headers := http.Header{"X-Ids": {"3, 4", "5"}, "X-Id": {"3", "4"}}
+	value, found, err := HeaderParameterValue(false, true, "x-ids", headers)
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "3,4,5", value)
+
+	_, _, err = HeaderParameterValue(false, false, "X-Id", headers)
+	assert.EqualError(t, err, "multiple values for single value parameter 'X-Id'")
+	_, found, err = HeaderParameterValue(false, false, "X-Missing", headers)
+	assert.NoError(t, err)
+	assert.False(t, found)
+
+	cookies := []*http.Cookie{{Name: "ids", Value: "3"}, {Name: "ids", Value: "4"}}
+	values, found, err := CookieParameterValues(true, false, true, "ids", cookies)
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, []string{"3", "4"}, values)
+	_, _, err = CookieParameterValues(true, true, false, "missing", cookies)
+	assert.EqualError(t, err, "cookie parameter 'missing' is required")
+}
+
+func TestSplitStyledParameter(t *testing.T) {
+	values, err := SplitStyledParameter("label", true, "id", ".3.4")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"3", "4"}, values)
+
+	values, err = SplitStyledParameter("matrix", true, "id", ";id=3;id=4")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"3", "4"}, values)
+
+	_, err = SplitStyledParameter("simple", false, "id", "")
+	assert.EqualError(t, err, "parameter 'id' is empty, can't bind its value")
+	_, err = SplitStyledParameter("label", false, "id", "3,4")
+	assert.Error(t, err)
+}
+
+func TestParseValues(t *testing.T) {
+	i32, err := ParseInt32("-5")
+	assert.NoError(t, err)
+	assert.Equal(t, int32(-5), i32)
+	_, err = ParseInt32("2147483648")
+	assert.Error(t, err)
+
+	f32, err := ParseFloat32("1.5")
+	assert.NoError(t, err)
+	assert.Equal(t, float32(1.5), f32)
+
+	b, err := ParseBool("true")
+	assert.NoError(t, err)
+	assert.True(t, b)
+	_, err = ParseBool("maybe")
+	assert.Error(t, err)
+
+	tm, err := ParseTime("2020-01-02")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC), tm)
+
+	d, err := ParseDate("2020-01-02")
+	assert.NoError(t, err)
+	assert.Equal(t, types.Date{Time: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)}, d)
+	_, err = ParseDate("2020-02-30")
+	assert.Error(t, err)
+
+	// Values are parsed as BindStringToObject does
+	for _, src := range []string{"12", "x", "99999999999"} {
+		var dest int32
+		bindErr := BindStringToObject(src, &dest)
+		parsed, parseErr := ParseInt32(src)
+		assert.Equal(t, bindErr, parseErr)
+		assert.Equal(t, dest, parsed)
+	}
+}
+
+func BenchmarkBindQueryParameter(b *testing.B) {
+	queryParams := url.Values{"ids": {"3,4,5"}}
+
+	b.Run("reflection", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var ids *[]int32
+			if err := BindQueryParameter("form", false, false, "ids", queryParams, &ids); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("generated", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			values, _, err := QueryParameterValues(false, false, true, "ids", queryParams)
+			if err != nil {
+				b.Fatal(err)
+			}
+			ids := make([]int32, len(values))
+			for i, value := range values {
+				if ids[i], err = ParseInt32(value); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+}
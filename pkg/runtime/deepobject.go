@@ -1,6 +1,7 @@
 package runtime
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -8,11 +9,8 @@ import (
 	"sort"
 	"strconv"
 	"strings"
-	"time"
 
 	"github.com/pkg/errors"
-
-	"github.com/indigonote/oapi-codegen/pkg/types"
 )
 
 func marshalDeepObject(in interface{}, path []string) ([]string, error) {
@@ -50,6 +48,9 @@ func marshalDeepObject(in interface{}, path []string) ([]string, error) {
 			}
 			result = append(result, fields...)
 		}
+	case nil:
+		// A null value is empty.
+		result = []string{"[" + strings.Join(path, "][") + "]="}
 	default:
 		// Now, for a concrete value, we will turn the path elements
 		// into a deepObject style set of subscripts. [a, b, c] turns into
@@ -72,8 +73,12 @@ func MarshalDeepObject(i interface{}, paramName string) (string, error) {
 	if err != nil {
 		return "", errors.Wrap(err, "failed to marshal input to JSON")
 	}
+	// Numbers are kept as they're written, rather than as floats, so that
+	// large integers don't turn into exponents.
 	var i2 interface{}
-	err = json.Unmarshal(buf, &i2)
+	decoder := json.NewDecoder(bytes.NewReader(buf))
+	decoder.UseNumber()
+	err = decoder.Decode(&i2)
 	if err != nil {
 		return "", errors.Wrap(err, "failed to unmarshal JSON")
 	}
@@ -124,6 +129,17 @@ func makeFieldOrValue(paths [][]string, values []string) fieldOrValue {
 	return f
 }
 
+// hasDeepObject tells whether some of the query parameters are fields of the
+// deepObject parameter.
+func hasDeepObject(paramName string, params url.Values) bool {
+	for pName := range params {
+		if strings.HasPrefix(pName, paramName+"[") {
+			return true
+		}
+	}
+	return false
+}
+
 func UnmarshalDeepObject(dst interface{}, paramName string, params url.Values) error {
 	// Params are all the query args, so we need those that look like
 	// "paramName["...
@@ -200,6 +216,12 @@ func assignPathValues(dst interface{}, pathValues fieldOrValue) error {
 	iv := reflect.Indirect(v)
 	it := iv.Type()
 
+	// Dates, times and the types binding themselves are single values,
+	// whatever their kind.
+	if bindsItself(dst) {
+		return BindStringToObject(pathValues.value, dst)
+	}
+
 	switch it.Kind() {
 	case reflect.Slice:
 		sliceLength := len(pathValues.fields)
@@ -211,27 +233,6 @@ func assignPathValues(dst interface{}, pathValues fieldOrValue) error {
 		iv.Set(dstSlice)
 		return nil
 	case reflect.Struct:
-		// Some special types we care about are structs. Handle them
-		// here.
-		if _, isDate := iv.Interface().(types.Date); isDate {
-			var date types.Date
-			var err error
-			date.Time, err = time.Parse(types.DateFormat, pathValues.value)
-			if err != nil {
-				return errors.Wrap(err, "invalid date format")
-			}
-			iv.Set(reflect.ValueOf(date))
-		}
-		if _, isTime := iv.Interface().(time.Time); isTime {
-			var tm time.Time
-			var err error
-			tm, err = time.Parse(types.DateFormat, pathValues.value)
-			if err != nil {
-				return errors.Wrap(err, "invalid date format")
-			}
-			iv.Set(reflect.ValueOf(tm))
-		}
-
 		fieldMap, err := fieldIndicesByJsonTag(iv.Interface())
 		if err != nil {
 			return errors.Wrap(err, "failed enumerating fields")
@@ -281,12 +282,19 @@ func assignPathValues(dst interface{}, pathValues fieldOrValue) error {
 		iv.SetFloat(val)
 		return nil
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		val, err := strconv.ParseInt(pathValues.value, 10, 64)
+		val, err := strconv.ParseInt(pathValues.value, 10, it.Bits())
 		if err != nil {
 			return fmt.Errorf("expected a valid int, got %s", pathValues.value)
 		}
 		iv.SetInt(val)
 		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		val, err := strconv.ParseUint(pathValues.value, 10, it.Bits())
+		if err != nil {
+			return fmt.Errorf("expected a valid unsigned int, got %s", pathValues.value)
+		}
+		iv.SetUint(val)
+		return nil
 	case reflect.String:
 		iv.SetString(pathValues.value)
 		return nil
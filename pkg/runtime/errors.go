@@ -0,0 +1,89 @@
+package runtime
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ParamLocation is the part of a request a parameter is in.
+type ParamLocation string
+
+// The locations of parameters, as the "in" of their spec.
+const (
+	ParamLocationPath   ParamLocation = "path"
+	ParamLocationQuery  ParamLocation = "query"
+	ParamLocationHeader ParamLocation = "header"
+	ParamLocationCookie ParamLocation = "cookie"
+)
+
+// InvalidParamFormatError is the error of a parameter whose value can't be
+// bound to its type.
+type InvalidParamFormatError struct {
+	ParamName     string
+	ParamLocation ParamLocation
+	Err           error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err)
+}
+
+// Unwrap returns the error binding the parameter.
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+// RequiredParamError is the error of a required parameter missing from a
+// request.
+type RequiredParamError struct {
+	ParamName     string
+	ParamLocation ParamLocation
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("%s parameter '%s' is required", e.ParamLocation, e.ParamName)
+}
+
+// UnmarshalingParamError is the error of a JSON parameter which can't be
+// unmarshaled.
+type UnmarshalingParamError struct {
+	ParamName     string
+	ParamLocation ParamLocation
+	Err           error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter '%s' as JSON: %s", e.ParamName, e.Err)
+}
+
+// Unwrap returns the error unmarshaling the parameter.
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+// TooManyValuesForParamError is the error of a single value parameter given
+// several values.
+type TooManyValuesForParamError struct {
+	ParamName     string
+	ParamLocation ParamLocation
+	Count         int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("multiple values for single value parameter '%s'", e.ParamName)
+}
+
+// ParamError returns the error of binding the parameter paramName, in the
+// location in, which failed with err: err itself when it is, or wraps, one of
+// the errors above already, and an InvalidParamFormatError of it otherwise.
+func ParamError(in ParamLocation, paramName string, err error) error {
+	var formatErr *InvalidParamFormatError
+	var requiredErr *RequiredParamError
+	var unmarshalingErr *UnmarshalingParamError
+	var tooManyErr *TooManyValuesForParamError
+	if errors.As(err, &formatErr) || errors.As(err, &requiredErr) ||
+		errors.As(err, &unmarshalingErr) || errors.As(err, &tooManyErr) {
+		return err
+	}
+	return &InvalidParamFormatError{ParamName: paramName, ParamLocation: in, Err: err}
+}
@@ -0,0 +1,44 @@
+package runtime
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParamErrors(t *testing.T) {
+	var limit int32
+	err := BindQueryParameter("form", true, true, "limit", url.Values{}, &limit)
+	assert.Equal(t, &RequiredParamError{ParamName: "limit", ParamLocation: ParamLocationQuery}, err)
+	assert.Same(t, err, ParamError(ParamLocationQuery, "limit", err))
+
+	err = BindQueryParameter("form", true, true, "limit", url.Values{"limit": {"1", "2"}}, &limit)
+	assert.Equal(t, &TooManyValuesForParamError{ParamName: "limit", ParamLocation: ParamLocationQuery, Count: 2}, err)
+
+	// Unexploded parameters are given their values at once
+	err = BindQueryParameter("form", false, true, "ids", url.Values{"ids": {"1,2", "3"}}, &[]int32{})
+	assert.Equal(t, &TooManyValuesForParamError{ParamName: "ids", ParamLocation: ParamLocationQuery, Count: 2}, err)
+	_, _, err = QueryParameterValues(false, true, true, "ids", url.Values{"ids": {"1,2", "3"}})
+	assert.Equal(t, &TooManyValuesForParamError{ParamName: "ids", ParamLocation: ParamLocationQuery, Count: 2}, err)
+
+	// Errors wrapping those above aren't wrapped again
+	wrapped := fmt.Errorf("binding limit: %w", err)
+	assert.Same(t, wrapped, ParamError(ParamLocationQuery, "limit", wrapped))
+
+	headers := http.Header{"X-Limit": {"1", "2"}}
+	err = BindHeaderParameter("simple", false, true, "X-Limit", headers, &limit)
+	assert.Equal(t, &TooManyValuesForParamError{ParamName: "X-Limit", ParamLocation: ParamLocationHeader, Count: 2}, err)
+
+	// The other errors are those of invalid formats
+	err = BindQueryParameter("form", true, true, "limit", url.Values{"limit": {"five"}}, &limit)
+	err = ParamError(ParamLocationQuery, "limit", err)
+	var formatErr *InvalidParamFormatError
+	assert.True(t, errors.As(err, &formatErr))
+	assert.Equal(t, "limit", formatErr.ParamName)
+	assert.Equal(t, ParamLocationQuery, formatErr.ParamLocation)
+	assert.Contains(t, err.Error(), "Invalid format for parameter limit: ")
+}
@@ -0,0 +1,423 @@
+package runtime
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// The operations of a JSON patch, as of RFC 6902.
+const (
+	PatchOpAdd     = "add"
+	PatchOpRemove  = "remove"
+	PatchOpReplace = "replace"
+	PatchOpMove    = "move"
+	PatchOpCopy    = "copy"
+	PatchOpTest    = "test"
+)
+
+// PatchOperation is an operation of a JSON patch, as of RFC 6902. From is the
+// source of move and copy operations, and Value is the value of add, replace
+// and test ones.
+type PatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	From  string          `json:"from,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+var rawMessageType = reflect.TypeOf(json.RawMessage{})
+
+// ApplyJSONPatch applies the operations of a JSON patch to target, which must
+// be a pointer. The paths of the operations are checked against the type of
+// target first, so that a patch can't add properties which the type doesn't
+// have. The operations are applied to the JSON encoding of target, which is
+// decoded back into it when they have all succeeded, and left alone otherwise.
+func ApplyJSONPatch(target interface{}, operations []PatchOperation) error {
+	v := reflect.ValueOf(target)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return errors.New("the target of a JSON patch must be a non-nil pointer")
+	}
+	t := v.Elem().Type()
+
+	for i, op := range operations {
+		if err := checkPatchOperation(t, op); err != nil {
+			return errors.Wrap(err, fmt.Sprintf("invalid operation %d", i))
+		}
+	}
+
+	buf, err := json.Marshal(target)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal the target to JSON")
+	}
+	doc, err := decodeJSONValue(buf)
+	if err != nil {
+		return errors.Wrap(err, "failed to unmarshal the target from JSON")
+	}
+
+	for i, op := range operations {
+		doc, err = applyPatchOperation(doc, op)
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("error applying operation %d", i))
+		}
+	}
+
+	buf, err = json.Marshal(doc)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal the patched document")
+	}
+	patched := reflect.New(t)
+	if err := json.Unmarshal(buf, patched.Interface()); err != nil {
+		return errors.Wrap(err, "the patched document doesn't fit the target")
+	}
+	v.Elem().Set(patched.Elem())
+	return nil
+}
+
+// checkPatchOperation checks that op is a valid operation on a value of type t.
+func checkPatchOperation(t reflect.Type, op PatchOperation) error {
+	switch op.Op {
+	case PatchOpAdd, PatchOpReplace, PatchOpTest:
+		if len(op.Value) == 0 {
+			return fmt.Errorf("%s operation has no value", op.Op)
+		}
+	case PatchOpMove, PatchOpCopy:
+		if err := checkPatchPath(t, op.From); err != nil {
+			return errors.Wrap(err, fmt.Sprintf("invalid from path '%s'", op.From))
+		}
+	case PatchOpRemove:
+	default:
+		return fmt.Errorf("unknown operation '%s'", op.Op)
+	}
+	if err := checkPatchPath(t, op.Path); err != nil {
+		return errors.Wrap(err, fmt.Sprintf("invalid path '%s'", op.Path))
+	}
+	return nil
+}
+
+// checkPatchPath checks that the JSON pointer path refers to a value which a
+// value of type t may have.
+func checkPatchPath(t reflect.Type, path string) error {
+	tokens, err := parseJSONPointer(path)
+	if err != nil {
+		return err
+	}
+	for _, token := range tokens {
+		t, err = patchPathElem(t, token)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// patchPathElem returns the type of the member of a value of type t that the
+// token of a JSON pointer refers to.
+func patchPathElem(t reflect.Type, token string) (reflect.Type, error) {
+	// Maps keyed by booleans are nullable types, which hold the value of
+	// the property.
+	for t.Kind() == reflect.Ptr || (t.Kind() == reflect.Map && t.Key().Kind() == reflect.Bool) {
+		t = t.Elem()
+	}
+	if t == rawMessageType {
+		return reflect.TypeOf((*interface{})(nil)).Elem(), nil
+	}
+
+	switch t.Kind() {
+	case reflect.Interface:
+		return t, nil
+	case reflect.Map:
+		if t.Key().Kind() != reflect.String {
+			return nil, fmt.Errorf("'%s' is a member of a map which isn't keyed by strings", token)
+		}
+		return t.Elem(), nil
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return nil, fmt.Errorf("'%s' is a member of a byte string", token)
+		}
+		if token != "-" {
+			if _, err := parseArrayIndex(token); err != nil {
+				return nil, err
+			}
+		}
+		return t.Elem(), nil
+	case reflect.Struct:
+		if field, found := structFieldByJsonName(t, token); found {
+			return field.Type, nil
+		}
+		// Generated types hold additional properties in a map which isn't
+		// marshaled as such.
+		if field, found := t.FieldByName("AdditionalProperties"); found && field.Type.Kind() == reflect.Map {
+			return field.Type.Elem(), nil
+		}
+		return nil, fmt.Errorf("%s has no property '%s'", t.Name(), token)
+	default:
+		return nil, fmt.Errorf("'%s' is a member of a %s", token, t.Kind())
+	}
+}
+
+// structFieldByJsonName returns the field of a struct type which is marshaled
+// to JSON with the given name.
+func structFieldByJsonName(t reflect.Type, name string) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" || field.Tag.Get("json") == "-" {
+			continue
+		}
+		if field.Anonymous && field.Tag.Get("json") == "" {
+			ft := field.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				if embedded, found := structFieldByJsonName(ft, name); found {
+					return embedded, true
+				}
+				continue
+			}
+		}
+		if getFieldName(field) == name {
+			return field, true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+// parseJSONPointer splits a JSON pointer, as of RFC 6901, into its tokens.
+func parseJSONPointer(path string) ([]string, error) {
+	if path == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(path, "/") {
+		return nil, errors.New("a JSON pointer must start with '/'")
+	}
+	tokens := strings.Split(path[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+	}
+	return tokens, nil
+}
+
+// parseArrayIndex parses the token of a JSON pointer referring to the member
+// of an array.
+func parseArrayIndex(token string) (int, error) {
+	if token == "" || (len(token) > 1 && token[0] == '0') || strings.Trim(token, "0123456789") != "" {
+		return 0, fmt.Errorf("'%s' isn't an array index", token)
+	}
+	return strconv.Atoi(token)
+}
+
+// decodeJSONValue decodes JSON into maps, slices and values, keeping numbers as
+// they are.
+func decodeJSONValue(data []byte) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// applyPatchOperation applies op to the decoded JSON document doc, and returns
+// the patched document.
+func applyPatchOperation(doc interface{}, op PatchOperation) (interface{}, error) {
+	path, err := parseJSONPointer(op.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	switch op.Op {
+	case PatchOpAdd, PatchOpReplace:
+		value, err := decodeJSONValue(op.Value)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal value")
+		}
+		if op.Op == PatchOpReplace {
+			if _, err := getJSONValue(doc, path); err != nil {
+				return nil, err
+			}
+			return setJSONValue(doc, path, value, false)
+		}
+		return setJSONValue(doc, path, value, true)
+	case PatchOpRemove:
+		return removeJSONValue(doc, path)
+	case PatchOpMove, PatchOpCopy:
+		from, err := parseJSONPointer(op.From)
+		if err != nil {
+			return nil, err
+		}
+		value, err := getJSONValue(doc, from)
+		if err != nil {
+			return nil, err
+		}
+		if op.Op == PatchOpMove {
+			if strings.HasPrefix(op.Path+"/", op.From+"/") && op.Path != op.From {
+				return nil, errors.New("can't move a value into itself")
+			}
+			doc, err = removeJSONValue(doc, from)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			// The copy mustn't share maps and slices with the original,
+			// which later operations may change.
+			buf, err := json.Marshal(value)
+			if err != nil {
+				return nil, err
+			}
+			value, err = decodeJSONValue(buf)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return setJSONValue(doc, path, value, true)
+	case PatchOpTest:
+		expected, err := decodeJSONValue(op.Value)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal value")
+		}
+		value, err := getJSONValue(doc, path)
+		if err != nil {
+			return nil, err
+		}
+		if !reflect.DeepEqual(value, expected) {
+			return nil, fmt.Errorf("the value at '%s' isn't %s", op.Path, string(op.Value))
+		}
+		return doc, nil
+	default:
+		return nil, fmt.Errorf("unknown operation '%s'", op.Op)
+	}
+}
+
+// getJSONValue returns the value at path in doc.
+func getJSONValue(doc interface{}, path []string) (interface{}, error) {
+	for _, token := range path {
+		switch container := doc.(type) {
+		case map[string]interface{}:
+			value, found := container[token]
+			if !found {
+				return nil, fmt.Errorf("there's no member '%s'", token)
+			}
+			doc = value
+		case []interface{}:
+			i, err := parseArrayIndex(token)
+			if err != nil {
+				return nil, err
+			}
+			if i >= len(container) {
+				return nil, fmt.Errorf("index %d is out of range", i)
+			}
+			doc = container[i]
+		default:
+			return nil, fmt.Errorf("there's no member '%s' of a value which isn't an object or array", token)
+		}
+	}
+	return doc, nil
+}
+
+// setJSONValue sets the value at path in doc, inserting it into arrays when
+// insert is set, and returns the changed document.
+func setJSONValue(doc interface{}, path []string, value interface{}, insert bool) (interface{}, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+	token := path[0]
+
+	switch container := doc.(type) {
+	case map[string]interface{}:
+		if len(path) == 1 {
+			container[token] = value
+			return container, nil
+		}
+		member, found := container[token]
+		if !found {
+			return nil, fmt.Errorf("there's no member '%s'", token)
+		}
+		member, err := setJSONValue(member, path[1:], value, insert)
+		if err != nil {
+			return nil, err
+		}
+		container[token] = member
+		return container, nil
+	case []interface{}:
+		if len(path) == 1 && insert && token == "-" {
+			return append(container, value), nil
+		}
+		i, err := parseArrayIndex(token)
+		if err != nil {
+			return nil, err
+		}
+		if len(path) == 1 && insert {
+			if i > len(container) {
+				return nil, fmt.Errorf("index %d is out of range", i)
+			}
+			container = append(container, nil)
+			copy(container[i+1:], container[i:])
+			container[i] = value
+			return container, nil
+		}
+		if i >= len(container) {
+			return nil, fmt.Errorf("index %d is out of range", i)
+		}
+		member, err := setJSONValue(container[i], path[1:], value, insert)
+		if err != nil {
+			return nil, err
+		}
+		container[i] = member
+		return container, nil
+	default:
+		return nil, fmt.Errorf("there's no member '%s' of a value which isn't an object or array", token)
+	}
+}
+
+// removeJSONValue removes the value at path from doc, and returns the changed
+// document.
+func removeJSONValue(doc interface{}, path []string) (interface{}, error) {
+	if len(path) == 0 {
+		return nil, errors.New("can't remove the whole document")
+	}
+	token := path[0]
+
+	switch container := doc.(type) {
+	case map[string]interface{}:
+		member, found := container[token]
+		if !found {
+			return nil, fmt.Errorf("there's no member '%s'", token)
+		}
+		if len(path) == 1 {
+			delete(container, token)
+			return container, nil
+		}
+		member, err := removeJSONValue(member, path[1:])
+		if err != nil {
+			return nil, err
+		}
+		container[token] = member
+		return container, nil
+	case []interface{}:
+		i, err := parseArrayIndex(token)
+		if err != nil {
+			return nil, err
+		}
+		if i >= len(container) {
+			return nil, fmt.Errorf("index %d is out of range", i)
+		}
+		if len(path) == 1 {
+			return append(container[:i], container[i+1:]...), nil
+		}
+		member, err := removeJSONValue(container[i], path[1:])
+		if err != nil {
+			return nil, err
+		}
+		container[i] = member
+		return container, nil
+	default:
+		return nil, fmt.Errorf("there's no member '%s' of a value which isn't an object or array", token)
+	}
+}
@@ -0,0 +1,112 @@
+package runtime
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type patchOwner struct {
+	Name string `json:"name"`
+}
+
+type patchPet struct {
+	Name    string                 `json:"name"`
+	Age     *int                   `json:"age,omitempty"`
+	Tags    []string               `json:"tags,omitempty"`
+	Owner   *patchOwner            `json:"owner,omitempty"`
+	Labels  map[string]string      `json:"labels,omitempty"`
+	Extra   json.RawMessage        `json:"extra,omitempty"`
+	Ignored string                 `json:"-"`
+	Others  map[string]interface{} `json:"-"`
+}
+
+func parsePatch(t *testing.T, patch string) []PatchOperation {
+	var ops []PatchOperation
+	require.NoError(t, json.Unmarshal([]byte(patch), &ops))
+	return ops
+}
+
+func TestApplyJSONPatch(t *testing.T) {
+	age := 3
+	pet := patchPet{
+		Name:  "Rex",
+		Tags:  []string{"a", "c"},
+		Owner: &patchOwner{Name: "Ann"},
+	}
+	err := ApplyJSONPatch(&pet, parsePatch(t, `[
+		{"op": "test", "path": "/name", "value": "Rex"},
+		{"op": "replace", "path": "/name", "value": "Max"},
+		{"op": "add", "path": "/age", "value": 3},
+		{"op": "add", "path": "/tags/1", "value": "b"},
+		{"op": "add", "path": "/tags/-", "value": "d"},
+		{"op": "copy", "from": "/owner/name", "path": "/labels"},
+		{"op": "remove", "path": "/labels"},
+		{"op": "add", "path": "/labels", "value": {}},
+		{"op": "move", "from": "/owner/name", "path": "/labels/owner"},
+		{"op": "add", "path": "/extra", "value": {"any": ["thing"]}},
+		{"op": "add", "path": "/extra/any/0", "value": 1}
+	]`))
+	require.NoError(t, err)
+	assert.Equal(t, patchPet{
+		Name:   "Max",
+		Age:    &age,
+		Tags:   []string{"a", "b", "c", "d"},
+		Owner:  &patchOwner{},
+		Labels: map[string]string{"owner": "Ann"},
+		Extra:  json.RawMessage(`{"any":[1,"thing"]}`),
+	}, pet)
+
+	// Removing a value makes it unspecified
+	err = ApplyJSONPatch(&pet, parsePatch(t, `[{"op": "remove", "path": "/owner"}, {"op": "remove", "path": "/tags/0"}]`))
+	require.NoError(t, err)
+	assert.Nil(t, pet.Owner)
+	assert.Equal(t, []string{"b", "c", "d"}, pet.Tags)
+}
+
+func TestApplyJSONPatchErrors(t *testing.T) {
+	for name, patch := range map[string]string{
+		"unknown property":         `[{"op": "add", "path": "/color", "value": "red"}]`,
+		"unknown nested property":  `[{"op": "add", "path": "/owner/age", "value": 3}]`,
+		"property marshaled never": `[{"op": "add", "path": "/Ignored", "value": "x"}]`,
+		"member of a string":       `[{"op": "add", "path": "/name/first", "value": "x"}]`,
+		"invalid index":            `[{"op": "add", "path": "/tags/01", "value": "x"}]`,
+		"invalid pointer":          `[{"op": "add", "path": "name", "value": "x"}]`,
+		"invalid from":             `[{"op": "copy", "from": "/colour", "path": "/name"}]`,
+		"unknown op":               `[{"op": "merge", "path": "/name", "value": "x"}]`,
+		"missing value":            `[{"op": "replace", "path": "/name"}]`,
+		"failed test":              `[{"op": "replace", "path": "/name", "value": "Max"}, {"op": "test", "path": "/name", "value": "Rex"}]`,
+		"missing value to replace": `[{"op": "replace", "path": "/age", "value": 3}]`,
+		"missing value to remove":  `[{"op": "remove", "path": "/tags/5"}]`,
+		"missing parent":           `[{"op": "add", "path": "/owner/name", "value": "Ann"}]`,
+		"index out of range":       `[{"op": "add", "path": "/tags/3", "value": "x"}]`,
+		"move into itself":         `[{"op": "add", "path": "/extra", "value": {}}, {"op": "move", "from": "/extra", "path": "/extra/a"}]`,
+		"value of the wrong type":  `[{"op": "replace", "path": "/name", "value": 3}]`,
+		"whole document removed":   `[{"op": "remove", "path": ""}]`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			pet := patchPet{Name: "Rex", Tags: []string{"a"}}
+			err := ApplyJSONPatch(&pet, parsePatch(t, patch))
+			assert.Error(t, err)
+			// The target is left alone
+			assert.Equal(t, patchPet{Name: "Rex", Tags: []string{"a"}}, pet)
+		})
+	}
+
+	assert.Error(t, ApplyJSONPatch(patchPet{}, nil))
+}
+
+func TestApplyJSONPatchAdditionalProperties(t *testing.T) {
+	type thing struct {
+		Name                 string            `json:"name"`
+		AdditionalProperties map[string]string `json:"-"`
+	}
+	// The paths of additional properties are valid, although thing has no
+	// marshaler to keep them here.
+	assert.NoError(t, checkPatchPath(reflect.TypeOf(thing{}), "/color"))
+	assert.NoError(t, checkPatchPath(reflect.TypeOf(thing{}), "/name"))
+	assert.Error(t, checkPatchPath(reflect.TypeOf(thing{}), "/color/red"))
+}
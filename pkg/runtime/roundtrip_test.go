@@ -0,0 +1,128 @@
+// Copyright 2019 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/indigonote/oapi-codegen/pkg/types"
+)
+
+type roundTripObject struct {
+	FirstName string `json:"firstName"`
+	Age       int    `json:"age"`
+}
+
+// TestStyleRoundTrip checks that whatever StyleParam produces for a client,
+// the Bind functions turn back into the same value on the server.
+func TestStyleRoundTrip(t *testing.T) {
+	values := map[string]interface{}{
+		"primitive": int32(5),
+		"string":    "alex",
+		"array":     []int{3, 4, 5},
+		"object":    roundTripObject{FirstName: "Alex", Age: 30},
+		"date":      types.Date{Time: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)},
+		"time":      time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+
+	for _, tt := range []struct {
+		in     string
+		style  string
+		values []string
+	}{
+		{"path", "simple", []string{"primitive", "string", "array", "object", "date", "time"}},
+		{"path", "label", []string{"primitive", "string", "array", "object", "date", "time"}},
+		{"path", "matrix", []string{"primitive", "string", "array", "object", "date", "time"}},
+		{"query", "form", []string{"primitive", "string", "array", "object", "date", "time"}},
+		{"query", "spaceDelimited", []string{"array", "object"}},
+		{"query", "pipeDelimited", []string{"array", "object"}},
+		{"query", "deepObject", []string{"object"}},
+		{"header", "simple", []string{"primitive", "string", "array", "object", "date", "time"}},
+		{"header", "deepObject", []string{"object"}},
+		{"cookie", "form", []string{"primitive", "string", "array", "object", "date", "time"}},
+		{"cookie", "simple", []string{"primitive", "string", "array", "object", "date", "time"}},
+	} {
+		for _, explode := range []bool{false, true} {
+			if tt.style == "deepObject" && !explode {
+				continue
+			}
+			for _, name := range tt.values {
+				value := values[name]
+				t.Run(tt.in+"/"+tt.style+"/"+name, func(t *testing.T) {
+					styled, err := StyleParam(tt.style, explode, "id", value)
+					require.NoError(t, err)
+
+					dest := reflect.New(reflect.TypeOf(value))
+					switch tt.in {
+					case "query":
+						queryParams, err := url.ParseQuery(styled)
+						require.NoError(t, err)
+						err = BindQueryParameter(tt.style, explode, true, "id", queryParams, dest.Interface())
+						require.NoError(t, err, "binding %q", styled)
+					case "header":
+						req := httptest.NewRequest("GET", "/", nil)
+						req.Header.Add("id", styled)
+						err = BindHeaderParameter(tt.style, explode, true, "id", req.Header, dest.Interface())
+						require.NoError(t, err, "binding %q", styled)
+					case "cookie":
+						cookies, err := StyleCookieParam(tt.style, explode, "id", value)
+						require.NoError(t, err)
+						req := httptest.NewRequest("GET", "/", nil)
+						for _, cookie := range cookies {
+							req.AddCookie(cookie)
+						}
+						err = BindCookieParameter(tt.style, explode, true, "id", req.Cookies(), dest.Interface())
+						require.NoError(t, err, "binding %q", req.Header.Get("Cookie"))
+					default:
+						err = BindStyledParameter(tt.style, explode, "id", styled, dest.Interface())
+						require.NoError(t, err, "binding %q", styled)
+					}
+					assert.Equal(t, value, dest.Elem().Interface(), "round trip of %q", styled)
+				})
+			}
+		}
+	}
+}
+
+func TestPipeDelimitedFilters(t *testing.T) {
+	queryParams, err := url.ParseQuery("filter=status|open|owner|alex&tags=a|b")
+	require.NoError(t, err)
+
+	var filter struct {
+		Status string `json:"status"`
+		Owner  string `json:"owner"`
+	}
+	err = BindQueryParameter("pipeDelimited", false, true, "filter", queryParams, &filter)
+	require.NoError(t, err)
+	assert.Equal(t, "open", filter.Status)
+	assert.Equal(t, "alex", filter.Owner)
+
+	var tags []string
+	err = BindQueryParameter("pipeDelimited", false, true, "tags", queryParams, &tags)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, tags)
+
+	// Missing optional parameters are left unset
+	var missing *string
+	err = BindQueryParameter("pipeDelimited", false, false, "missing", queryParams, &missing)
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+}
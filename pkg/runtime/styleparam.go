@@ -14,13 +14,17 @@
 package runtime
 
 import (
+	"encoding"
 	"errors"
 	"fmt"
+	"net/http"
 	"reflect"
 	"sort"
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/indigonote/oapi-codegen/pkg/types"
 )
 
 // Given an input value, such as a primitive type, array or object, turn it
@@ -39,6 +43,12 @@ func StyleParam(style string, explode bool, paramName string, value interface{})
 		t = v.Type()
 	}
 
+	// Types which marshal themselves are single values, whatever their kind,
+	// as the types which bind themselves are.
+	if _, ok := v.Interface().(encoding.TextMarshaler); ok {
+		return stylePrimitive(style, explode, paramName, v.Interface())
+	}
+
 	switch t.Kind() {
 	case reflect.Slice:
 		n := v.Len()
@@ -56,6 +66,32 @@ func StyleParam(style string, explode bool, paramName string, value interface{})
 	}
 }
 
+// StyleCookieParam styles a cookie parameter as StyleParam does, and returns
+// the cookies holding it, which BindCookieParameter binds back. Form styled
+// parameters take a cookie per value of an exploded array, and a cookie per
+// property of an exploded object, as they take a query argument each. Other
+// styles hold the whole parameter in the cookie named after it.
+func StyleCookieParam(style string, explode bool, paramName string, value interface{}) ([]*http.Cookie, error) {
+	styled, err := StyleParam(style, explode, paramName, value)
+	if err != nil {
+		return nil, err
+	}
+	switch style {
+	case "form", "spaceDelimited", "pipeDelimited":
+		var cookies []*http.Cookie
+		for _, pair := range strings.Split(styled, "&") {
+			nameValue := strings.SplitN(pair, "=", 2)
+			if len(nameValue) != 2 {
+				return nil, fmt.Errorf("invalid format for cookie parameter '%s': %s", paramName, pair)
+			}
+			cookies = append(cookies, &http.Cookie{Name: nameValue[0], Value: nameValue[1]})
+		}
+		return cookies, nil
+	default:
+		return []*http.Cookie{{Name: paramName, Value: styled}}, nil
+	}
+}
+
 func styleSlice(style string, explode bool, paramName string, values []interface{}) (string, error) {
 	if style == "deepObject" {
 		if !explode {
@@ -133,16 +169,18 @@ func sortedKeys(strMap map[string]string) []string {
 }
 
 // This is a special case. The struct may be a time, in which case, marshal
-// it in RFC3339 format.
+// it in RFC3339 format, or a date, marshaled as such.
 func marshalTimeValue(value interface{}) (string, bool) {
-	if timeVal, ok := value.(*time.Time); ok {
+	switch timeVal := value.(type) {
+	case time.Time:
 		return timeVal.Format(time.RFC3339Nano), true
-	}
-
-	if timeVal, ok := value.(time.Time); ok {
+	case *time.Time:
 		return timeVal.Format(time.RFC3339Nano), true
+	case types.Date:
+		return timeVal.Format(types.DateFormat), true
+	case *types.Date:
+		return timeVal.Format(types.DateFormat), true
 	}
-
 	return "", false
 }
 
@@ -179,8 +217,9 @@ func styleStruct(style string, explode bool, paramName string, value interface{}
 		}
 		f := v.Field(i)
 
-		// Unset optional fields will be nil pointers, skip over those.
-		if f.Type().Kind() == reflect.Ptr && f.IsNil() {
+		// Unset optional fields will be nil pointers, or nil maps for
+		// nullable types, skip over those.
+		if (f.Type().Kind() == reflect.Ptr || f.Type().Kind() == reflect.Map) && f.IsNil() {
 			continue
 		}
 		str, err := primitiveToString(f.Interface())
@@ -259,12 +298,12 @@ func processFieldDict(style string, explode bool, paramName string, fieldDict ma
 			separator = ","
 			prefix = fmt.Sprintf(";%s=", paramName)
 		}
-	case "form":
+	case "form", "spaceDelimited", "pipeDelimited":
 		if explode {
 			separator = "&"
 		} else {
 			prefix = fmt.Sprintf("%s=", paramName)
-			separator = ","
+			separator = delimiters[style]
 		}
 	case "deepObject":
 		{
@@ -311,16 +350,29 @@ func stylePrimitive(style string, explode bool, paramName string, value interfac
 func primitiveToString(value interface{}) (string, error) {
 	var output string
 
+	if timeVal, ok := marshalTimeValue(value); ok {
+		return timeVal, nil
+	}
+	if marshaler, ok := value.(encoding.TextMarshaler); ok {
+		text, err := marshaler.MarshalText()
+		if err != nil {
+			return "", err
+		}
+		return string(text), nil
+	}
+
 	// Values may come in by pointer for optionals, so make sure to dereferene.
 	v := reflect.Indirect(reflect.ValueOf(value))
 	t := v.Type()
 	kind := t.Kind()
 
 	switch kind {
-	case reflect.Int8, reflect.Int32, reflect.Int64, reflect.Int:
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Int:
 		output = strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uint:
+		output = strconv.FormatUint(v.Uint(), 10)
 	case reflect.Float32, reflect.Float64:
-		output = strconv.FormatFloat(v.Float(), 'f', -1, 64)
+		output = strconv.FormatFloat(v.Float(), 'f', -1, t.Bits())
 	case reflect.Bool:
 		if v.Bool() {
 			output = "true"
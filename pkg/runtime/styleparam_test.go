@@ -252,16 +252,20 @@ func TestStyleParam(t *testing.T) {
 	assert.EqualValues(t, "id=3&id=4&id=5", result)
 
 	result, err = StyleParam("spaceDelimited", false, "id", object)
-	assert.Error(t, err)
+	assert.NoError(t, err)
+	assert.EqualValues(t, "id=firstName Alex role admin", result)
 
 	result, err = StyleParam("spaceDelimited", true, "id", object)
-	assert.Error(t, err)
+	assert.NoError(t, err)
+	assert.EqualValues(t, "firstName=Alex&role=admin", result)
 
 	result, err = StyleParam("spaceDelimited", false, "id", dict)
-	assert.Error(t, err)
+	assert.NoError(t, err)
+	assert.EqualValues(t, "id=firstName Alex role admin", result)
 
 	result, err = StyleParam("spaceDelimited", true, "id", dict)
-	assert.Error(t, err)
+	assert.NoError(t, err)
+	assert.EqualValues(t, "firstName=Alex&role=admin", result)
 
 	result, err = StyleParam("spaceDelimited", false, "id", timestamp)
 	assert.Error(t, err)
@@ -292,16 +296,20 @@ func TestStyleParam(t *testing.T) {
 	assert.EqualValues(t, "id=3&id=4&id=5", result)
 
 	result, err = StyleParam("pipeDelimited", false, "id", object)
-	assert.Error(t, err)
+	assert.NoError(t, err)
+	assert.EqualValues(t, "id=firstName|Alex|role|admin", result)
 
 	result, err = StyleParam("pipeDelimited", true, "id", object)
-	assert.Error(t, err)
+	assert.NoError(t, err)
+	assert.EqualValues(t, "firstName=Alex&role=admin", result)
 
 	result, err = StyleParam("pipeDelimited", false, "id", dict)
-	assert.Error(t, err)
+	assert.NoError(t, err)
+	assert.EqualValues(t, "id=firstName|Alex|role|admin", result)
 
 	result, err = StyleParam("pipeDelimited", true, "id", dict)
-	assert.Error(t, err)
+	assert.NoError(t, err)
+	assert.EqualValues(t, "firstName=Alex&role=admin", result)
 
 	result, err = StyleParam("pipeDelimited", false, "id", timestamp)
 	assert.Error(t, err)